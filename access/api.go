@@ -9,6 +9,7 @@ import (
 	"github.com/onflow/flow-go/engine/access/subscription"
 	"github.com/onflow/flow-go/engine/common/rpc/convert"
 	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
 )
 
 // API provides all public-facing functionality of the Flow Access API.
@@ -28,24 +29,41 @@ type API interface {
 	GetCollectionByID(ctx context.Context, id flow.Identifier) (*flow.LightCollection, error)
 
 	SendTransaction(ctx context.Context, tx *flow.TransactionBody) error
+	SimulateTransaction(ctx context.Context, tx *flow.TransactionBody, skipSignatureChecks bool) (*flow.TransactionSimulationResult, error)
 	GetTransaction(ctx context.Context, id flow.Identifier) (*flow.TransactionBody, error)
 	GetTransactionsByBlockID(ctx context.Context, blockID flow.Identifier) ([]*flow.TransactionBody, error)
+	// GetTransactionsByAddress pages through the local account transactions index. Over gRPC it belongs to the
+	// AccountTransactionsAPI service (engine/access/rpc/accounttransactions), not to the flow AccessAPI.
+	GetTransactionsByAddress(ctx context.Context, address flow.Address, cursor storage.AccountTransactionCursor, limit uint) (*AccountTransactionsPage, error)
 	GetTransactionResult(ctx context.Context, id flow.Identifier, blockID flow.Identifier, collectionID flow.Identifier, requiredEventEncodingVersion entities.EventEncodingVersion) (*TransactionResult, error)
 	GetTransactionResultByIndex(ctx context.Context, blockID flow.Identifier, index uint32, requiredEventEncodingVersion entities.EventEncodingVersion) (*TransactionResult, error)
 	GetTransactionResultsByBlockID(ctx context.Context, blockID flow.Identifier, requiredEventEncodingVersion entities.EventEncodingVersion) ([]*TransactionResult, error)
 	GetSystemTransaction(ctx context.Context, blockID flow.Identifier) (*flow.TransactionBody, error)
 	GetSystemTransactionResult(ctx context.Context, blockID flow.Identifier, requiredEventEncodingVersion entities.EventEncodingVersion) (*TransactionResult, error)
+	GetTransactionProfile(ctx context.Context, blockID flow.Identifier, txID flow.Identifier) (*flow.TransactionProfile, error)
+	GetTransactionProfilesByBlockID(ctx context.Context, blockID flow.Identifier) ([]flow.TransactionProfile, error)
 
 	GetAccount(ctx context.Context, address flow.Address) (*flow.Account, error)
 	GetAccountAtLatestBlock(ctx context.Context, address flow.Address) (*flow.Account, error)
 	GetAccountAtBlockHeight(ctx context.Context, address flow.Address, height uint64) (*flow.Account, error)
+	// The account balance methods are served over gRPC by the AccountBalancesAPI of the access node,
+	// which is defined in engine/access/rpc/balances.
+	GetAccountBalanceAtLatestBlock(ctx context.Context, address flow.Address) (uint64, error)
+	GetAccountBalanceAtBlockHeight(ctx context.Context, address flow.Address, height uint64) (uint64, error)
+	GetAccountAvailableBalanceAtLatestBlock(ctx context.Context, address flow.Address) (uint64, error)
+	GetAccountAvailableBalanceAtBlockHeight(ctx context.Context, address flow.Address, height uint64) (uint64, error)
 
 	ExecuteScriptAtLatestBlock(ctx context.Context, script []byte, arguments [][]byte) ([]byte, error)
 	ExecuteScriptAtBlockHeight(ctx context.Context, blockHeight uint64, script []byte, arguments [][]byte) ([]byte, error)
 	ExecuteScriptAtBlockID(ctx context.Context, blockID flow.Identifier, script []byte, arguments [][]byte) ([]byte, error)
+	ExecuteScriptWithTransactionsAtBlockHeight(ctx context.Context, blockHeight uint64, script []byte, arguments [][]byte, transactions []*flow.TransactionBody) ([]byte, []flow.SpeculativeTransactionResult, error)
 
 	GetEventsForHeightRange(ctx context.Context, eventType string, startHeight, endHeight uint64, requiredEventEncodingVersion entities.EventEncodingVersion) ([]flow.BlockEvents, error)
 	GetEventsForBlockIDs(ctx context.Context, eventType string, blockIDs []flow.Identifier, requiredEventEncodingVersion entities.EventEncodingVersion) ([]flow.BlockEvents, error)
+	// GetEventsByEventType and GetEventsByContractAddress page through the local event index. Over gRPC they
+	// belong to the EventIndexAPI service (engine/access/rpc/eventindex), not to the flow AccessAPI.
+	GetEventsByEventType(ctx context.Context, eventType string, cursor storage.EventCursor, limit uint, requiredEventEncodingVersion entities.EventEncodingVersion) (*EventsPage, error)
+	GetEventsByContractAddress(ctx context.Context, address flow.Address, cursor storage.EventCursor, limit uint, requiredEventEncodingVersion entities.EventEncodingVersion) (*EventsPage, error)
 
 	GetLatestProtocolStateSnapshot(ctx context.Context) ([]byte, error)
 	GetProtocolStateSnapshotByBlockID(ctx context.Context, blockID flow.Identifier) ([]byte, error)
@@ -250,6 +268,22 @@ func MessageToTransactionResult(message *access.TransactionResultResponse) *Tran
 	}
 }
 
+// EventsPage is a page of events returned by an event index query, grouped by block in ascending height order.
+type EventsPage struct {
+	Events []flow.BlockEvents
+	// NextCursor is the position of the first event of the next page. It is nil if there are no more
+	// matching events up to the highest indexed height at the time of the query.
+	NextCursor *storage.EventCursor
+}
+
+// AccountTransactionsPage is a page of transactions an account was involved in, in ascending execution order.
+type AccountTransactionsPage struct {
+	Transactions []storage.AccountTransaction
+	// NextCursor is the position of the first transaction of the next page. It is nil if there are no more
+	// transactions up to the highest indexed height at the time of the query.
+	NextCursor *storage.AccountTransactionCursor
+}
+
 // NetworkParameters contains the network-wide parameters for the Flow blockchain.
 type NetworkParameters struct {
 	ChainID flow.ChainID
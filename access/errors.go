@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/onflow/cadence"
+
 	"github.com/onflow/flow-go/model/flow"
 )
 
@@ -98,3 +100,20 @@ type InvalidTxRateLimitedError struct {
 func (e InvalidTxRateLimitedError) Error() string {
 	return fmt.Sprintf("transaction rate limited for payer (%s)", e.Payer)
 }
+
+// InsufficientBalanceError indicates that a transaction payer does not have enough balance
+// to pay for the transaction fees.
+type InsufficientBalanceError struct {
+	Payer           flow.Address
+	RequiredBalance cadence.UFix64
+}
+
+func (e InsufficientBalanceError) Error() string {
+	return fmt.Sprintf("transaction payer (%s) has insufficient balance to pay transaction fee. Required balance: (%s)", e.Payer, e.RequiredBalance.String())
+}
+
+// IsInsufficientBalanceError returns true if the given error is an InsufficientBalanceError.
+func IsInsufficientBalanceError(err error) bool {
+	var balanceError InsufficientBalanceError
+	return errors.As(err, &balanceError)
+}
@@ -13,6 +13,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	storage "github.com/onflow/flow-go/storage"
+
 	subscription "github.com/onflow/flow-go/engine/access/subscription"
 )
 
@@ -99,6 +101,41 @@ func (_m *API) ExecuteScriptAtLatestBlock(ctx context.Context, script []byte, ar
 	return r0, r1
 }
 
+// ExecuteScriptWithTransactionsAtBlockHeight provides a mock function with given fields: ctx, blockHeight, script, arguments, transactions
+func (_m *API) ExecuteScriptWithTransactionsAtBlockHeight(ctx context.Context, blockHeight uint64, script []byte, arguments [][]byte, transactions []*flow.TransactionBody) ([]byte, []flow.SpeculativeTransactionResult, error) {
+	ret := _m.Called(ctx, blockHeight, script, arguments, transactions)
+
+	var r0 []byte
+	var r1 []flow.SpeculativeTransactionResult
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, []byte, [][]byte, []*flow.TransactionBody) ([]byte, []flow.SpeculativeTransactionResult, error)); ok {
+		return rf(ctx, blockHeight, script, arguments, transactions)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, []byte, [][]byte, []*flow.TransactionBody) []byte); ok {
+		r0 = rf(ctx, blockHeight, script, arguments, transactions)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, []byte, [][]byte, []*flow.TransactionBody) []flow.SpeculativeTransactionResult); ok {
+		r1 = rf(ctx, blockHeight, script, arguments, transactions)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]flow.SpeculativeTransactionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, uint64, []byte, [][]byte, []*flow.TransactionBody) error); ok {
+		r2 = rf(ctx, blockHeight, script, arguments, transactions)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // GetAccount provides a mock function with given fields: ctx, address
 func (_m *API) GetAccount(ctx context.Context, address flow.Address) (*flow.Account, error) {
 	ret := _m.Called(ctx, address)
@@ -177,6 +214,102 @@ func (_m *API) GetAccountAtLatestBlock(ctx context.Context, address flow.Address
 	return r0, r1
 }
 
+// GetAccountAvailableBalanceAtBlockHeight provides a mock function with given fields: ctx, address, height
+func (_m *API) GetAccountAvailableBalanceAtBlockHeight(ctx context.Context, address flow.Address, height uint64) (uint64, error) {
+	ret := _m.Called(ctx, address, height)
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Address, uint64) (uint64, error)); ok {
+		return rf(ctx, address, height)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Address, uint64) uint64); ok {
+		r0 = rf(ctx, address, height)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, flow.Address, uint64) error); ok {
+		r1 = rf(ctx, address, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAccountAvailableBalanceAtLatestBlock provides a mock function with given fields: ctx, address
+func (_m *API) GetAccountAvailableBalanceAtLatestBlock(ctx context.Context, address flow.Address) (uint64, error) {
+	ret := _m.Called(ctx, address)
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Address) (uint64, error)); ok {
+		return rf(ctx, address)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Address) uint64); ok {
+		r0 = rf(ctx, address)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, flow.Address) error); ok {
+		r1 = rf(ctx, address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAccountBalanceAtBlockHeight provides a mock function with given fields: ctx, address, height
+func (_m *API) GetAccountBalanceAtBlockHeight(ctx context.Context, address flow.Address, height uint64) (uint64, error) {
+	ret := _m.Called(ctx, address, height)
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Address, uint64) (uint64, error)); ok {
+		return rf(ctx, address, height)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Address, uint64) uint64); ok {
+		r0 = rf(ctx, address, height)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, flow.Address, uint64) error); ok {
+		r1 = rf(ctx, address, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAccountBalanceAtLatestBlock provides a mock function with given fields: ctx, address
+func (_m *API) GetAccountBalanceAtLatestBlock(ctx context.Context, address flow.Address) (uint64, error) {
+	ret := _m.Called(ctx, address)
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Address) (uint64, error)); ok {
+		return rf(ctx, address)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Address) uint64); ok {
+		r0 = rf(ctx, address)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, flow.Address) error); ok {
+		r1 = rf(ctx, address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBlockByHeight provides a mock function with given fields: ctx, height
 func (_m *API) GetBlockByHeight(ctx context.Context, height uint64) (*flow.Block, flow.BlockStatus, error) {
 	ret := _m.Called(ctx, height)
@@ -335,6 +468,58 @@ func (_m *API) GetCollectionByID(ctx context.Context, id flow.Identifier) (*flow
 	return r0, r1
 }
 
+// GetEventsByContractAddress provides a mock function with given fields: ctx, address, cursor, limit, requiredEventEncodingVersion
+func (_m *API) GetEventsByContractAddress(ctx context.Context, address flow.Address, cursor storage.EventCursor, limit uint, requiredEventEncodingVersion entities.EventEncodingVersion) (*access.EventsPage, error) {
+	ret := _m.Called(ctx, address, cursor, limit, requiredEventEncodingVersion)
+
+	var r0 *access.EventsPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Address, storage.EventCursor, uint, entities.EventEncodingVersion) (*access.EventsPage, error)); ok {
+		return rf(ctx, address, cursor, limit, requiredEventEncodingVersion)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Address, storage.EventCursor, uint, entities.EventEncodingVersion) *access.EventsPage); ok {
+		r0 = rf(ctx, address, cursor, limit, requiredEventEncodingVersion)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*access.EventsPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, flow.Address, storage.EventCursor, uint, entities.EventEncodingVersion) error); ok {
+		r1 = rf(ctx, address, cursor, limit, requiredEventEncodingVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEventsByEventType provides a mock function with given fields: ctx, eventType, cursor, limit, requiredEventEncodingVersion
+func (_m *API) GetEventsByEventType(ctx context.Context, eventType string, cursor storage.EventCursor, limit uint, requiredEventEncodingVersion entities.EventEncodingVersion) (*access.EventsPage, error) {
+	ret := _m.Called(ctx, eventType, cursor, limit, requiredEventEncodingVersion)
+
+	var r0 *access.EventsPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, storage.EventCursor, uint, entities.EventEncodingVersion) (*access.EventsPage, error)); ok {
+		return rf(ctx, eventType, cursor, limit, requiredEventEncodingVersion)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, storage.EventCursor, uint, entities.EventEncodingVersion) *access.EventsPage); ok {
+		r0 = rf(ctx, eventType, cursor, limit, requiredEventEncodingVersion)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*access.EventsPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, storage.EventCursor, uint, entities.EventEncodingVersion) error); ok {
+		r1 = rf(ctx, eventType, cursor, limit, requiredEventEncodingVersion)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetEventsForBlockIDs provides a mock function with given fields: ctx, eventType, blockIDs, requiredEventEncodingVersion
 func (_m *API) GetEventsForBlockIDs(ctx context.Context, eventType string, blockIDs []flow.Identifier, requiredEventEncodingVersion entities.EventEncodingVersion) ([]flow.BlockEvents, error) {
 	ret := _m.Called(ctx, eventType, blockIDs, requiredEventEncodingVersion)
@@ -701,6 +886,58 @@ func (_m *API) GetTransaction(ctx context.Context, id flow.Identifier) (*flow.Tr
 	return r0, r1
 }
 
+// GetTransactionProfile provides a mock function with given fields: ctx, blockID, txID
+func (_m *API) GetTransactionProfile(ctx context.Context, blockID flow.Identifier, txID flow.Identifier) (*flow.TransactionProfile, error) {
+	ret := _m.Called(ctx, blockID, txID)
+
+	var r0 *flow.TransactionProfile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Identifier, flow.Identifier) (*flow.TransactionProfile, error)); ok {
+		return rf(ctx, blockID, txID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Identifier, flow.Identifier) *flow.TransactionProfile); ok {
+		r0 = rf(ctx, blockID, txID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flow.TransactionProfile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, flow.Identifier, flow.Identifier) error); ok {
+		r1 = rf(ctx, blockID, txID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTransactionProfilesByBlockID provides a mock function with given fields: ctx, blockID
+func (_m *API) GetTransactionProfilesByBlockID(ctx context.Context, blockID flow.Identifier) ([]flow.TransactionProfile, error) {
+	ret := _m.Called(ctx, blockID)
+
+	var r0 []flow.TransactionProfile
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Identifier) ([]flow.TransactionProfile, error)); ok {
+		return rf(ctx, blockID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Identifier) []flow.TransactionProfile); ok {
+		r0 = rf(ctx, blockID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]flow.TransactionProfile)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, flow.Identifier) error); ok {
+		r1 = rf(ctx, blockID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTransactionResult provides a mock function with given fields: ctx, id, blockID, collectionID, requiredEventEncodingVersion
 func (_m *API) GetTransactionResult(ctx context.Context, id flow.Identifier, blockID flow.Identifier, collectionID flow.Identifier, requiredEventEncodingVersion entities.EventEncodingVersion) (*access.TransactionResult, error) {
 	ret := _m.Called(ctx, id, blockID, collectionID, requiredEventEncodingVersion)
@@ -779,6 +1016,32 @@ func (_m *API) GetTransactionResultsByBlockID(ctx context.Context, blockID flow.
 	return r0, r1
 }
 
+// GetTransactionsByAddress provides a mock function with given fields: ctx, address, cursor, limit
+func (_m *API) GetTransactionsByAddress(ctx context.Context, address flow.Address, cursor storage.AccountTransactionCursor, limit uint) (*access.AccountTransactionsPage, error) {
+	ret := _m.Called(ctx, address, cursor, limit)
+
+	var r0 *access.AccountTransactionsPage
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Address, storage.AccountTransactionCursor, uint) (*access.AccountTransactionsPage, error)); ok {
+		return rf(ctx, address, cursor, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Address, storage.AccountTransactionCursor, uint) *access.AccountTransactionsPage); ok {
+		r0 = rf(ctx, address, cursor, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*access.AccountTransactionsPage)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, flow.Address, storage.AccountTransactionCursor, uint) error); ok {
+		r1 = rf(ctx, address, cursor, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTransactionsByBlockID provides a mock function with given fields: ctx, blockID
 func (_m *API) GetTransactionsByBlockID(ctx context.Context, blockID flow.Identifier) ([]*flow.TransactionBody, error) {
 	ret := _m.Called(ctx, blockID)
@@ -833,6 +1096,32 @@ func (_m *API) SendTransaction(ctx context.Context, tx *flow.TransactionBody) er
 	return r0
 }
 
+// SimulateTransaction provides a mock function with given fields: ctx, tx, skipSignatureChecks
+func (_m *API) SimulateTransaction(ctx context.Context, tx *flow.TransactionBody, skipSignatureChecks bool) (*flow.TransactionSimulationResult, error) {
+	ret := _m.Called(ctx, tx, skipSignatureChecks)
+
+	var r0 *flow.TransactionSimulationResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *flow.TransactionBody, bool) (*flow.TransactionSimulationResult, error)); ok {
+		return rf(ctx, tx, skipSignatureChecks)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *flow.TransactionBody, bool) *flow.TransactionSimulationResult); ok {
+		r0 = rf(ctx, tx, skipSignatureChecks)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flow.TransactionSimulationResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *flow.TransactionBody, bool) error); ok {
+		r1 = rf(ctx, tx, skipSignatureChecks)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SubscribeBlockDigestsFromLatest provides a mock function with given fields: ctx, blockStatus
 func (_m *API) SubscribeBlockDigestsFromLatest(ctx context.Context, blockStatus flow.BlockStatus) subscription.Subscription {
 	ret := _m.Called(ctx, blockStatus)
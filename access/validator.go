@@ -1,28 +1,52 @@
 package access
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
 	"github.com/onflow/cadence/runtime/parser"
 	"github.com/onflow/crypto"
+	"github.com/rs/zerolog"
 
+	"github.com/onflow/flow-go/fvm"
+	"github.com/onflow/flow-go/fvm/blueprints"
 	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module"
+	"github.com/onflow/flow-go/module/metrics"
+	"github.com/onflow/flow-go/module/state_synchronization"
 	"github.com/onflow/flow-go/state"
 	"github.com/onflow/flow-go/state/protocol"
+	"github.com/onflow/flow-go/utils/logging"
 )
 
+// ErrIndexReporterNotAvailable indicates that the highest indexed height cannot be determined,
+// because no index reporter was provided.
+var ErrIndexReporterNotAvailable = errors.New("index reporter not available")
+
 type Blocks interface {
 	HeaderByID(id flow.Identifier) (*flow.Header, error)
 	FinalizedHeader() (*flow.Header, error)
+	// IndexedHeight returns the highest height for which the execution state was indexed locally.
+	// Expected errors during normal operation:
+	//   - ErrIndexReporterNotAvailable if the node does not index the execution state.
+	IndexedHeight() (uint64, error)
 }
 
 type ProtocolStateBlocks struct {
-	state protocol.State
+	state         protocol.State
+	indexReporter state_synchronization.IndexReporter
 }
 
-func NewProtocolStateBlocks(state protocol.State) *ProtocolStateBlocks {
-	return &ProtocolStateBlocks{state: state}
+// NewProtocolStateBlocks creates a new ProtocolStateBlocks. The indexReporter is optional,
+// and should only be provided if the node indexes the execution state.
+func NewProtocolStateBlocks(state protocol.State, indexReporter state_synchronization.IndexReporter) *ProtocolStateBlocks {
+	return &ProtocolStateBlocks{
+		state:         state,
+		indexReporter: indexReporter,
+	}
 }
 
 func (b *ProtocolStateBlocks) HeaderByID(id flow.Identifier) (*flow.Header, error) {
@@ -42,6 +66,16 @@ func (b *ProtocolStateBlocks) FinalizedHeader() (*flow.Header, error) {
 	return b.state.Final().Head()
 }
 
+// IndexedHeight returns the highest height for which the execution state was indexed locally.
+// Expected errors during normal operation:
+//   - ErrIndexReporterNotAvailable if no index reporter was provided.
+func (b *ProtocolStateBlocks) IndexedHeight() (uint64, error) {
+	if b.indexReporter == nil {
+		return 0, ErrIndexReporterNotAvailable
+	}
+	return b.indexReporter.HighestIndexedHeight()
+}
+
 // RateLimiter is an interface for checking if an address is rate limited.
 // By convention, the address used is the payer field of a transaction.
 // This rate limiter is applied when a transaction is first received by a
@@ -61,6 +95,56 @@ func (l *NoopLimiter) IsRateLimited(address flow.Address) bool {
 	return false
 }
 
+// ScriptExecutor executes scripts against the locally indexed execution state.
+type ScriptExecutor interface {
+	// ExecuteAtBlockHeight executes provided script against the block height.
+	// A result value is returned encoded as byte array. An error will be returned if script
+	// doesn't successfully execute.
+	ExecuteAtBlockHeight(ctx context.Context, script []byte, arguments [][]byte, height uint64) ([]byte, error)
+}
+
+// PayerBalanceMode defines how the payer balance check is applied during transaction validation.
+type PayerBalanceMode int
+
+const (
+	// Disabled means the payer balance is not checked.
+	Disabled PayerBalanceMode = iota
+
+	// WarnCheck means the payer balance is checked, but transactions whose payer cannot
+	// cover the fees are only logged and counted, not rejected.
+	WarnCheck
+
+	// EnforceCheck means the payer balance is checked, and transactions whose payer cannot
+	// cover the fees are rejected.
+	EnforceCheck
+)
+
+func ParsePayerBalanceMode(s string) (PayerBalanceMode, error) {
+	switch s {
+	case Disabled.String():
+		return Disabled, nil
+	case WarnCheck.String():
+		return WarnCheck, nil
+	case EnforceCheck.String():
+		return EnforceCheck, nil
+	default:
+		return 0, errors.New("invalid payer balance mode")
+	}
+}
+
+func (m PayerBalanceMode) String() string {
+	switch m {
+	case Disabled:
+		return "disabled"
+	case WarnCheck:
+		return "warn"
+	case EnforceCheck:
+		return "enforce"
+	default:
+		return ""
+	}
+}
+
 type TransactionValidationOptions struct {
 	Expiry                       uint
 	ExpiryBuffer                 uint
@@ -70,30 +154,51 @@ type TransactionValidationOptions struct {
 	CheckScriptsParse            bool
 	MaxTransactionByteSize       uint64
 	MaxCollectionByteSize        uint64
+	CheckPayerBalanceMode        PayerBalanceMode
 }
 
 type TransactionValidator struct {
-	blocks                Blocks     // for looking up blocks to check transaction expiry
-	chain                 flow.Chain // for checking validity of addresses
-	options               TransactionValidationOptions
-	serviceAccountAddress flow.Address
-	limiter               RateLimiter
+	log                          zerolog.Logger
+	blocks                       Blocks     // for looking up blocks to check transaction expiry
+	chain                        flow.Chain // for checking validity of addresses
+	options                      TransactionValidationOptions
+	serviceAccountAddress        flow.Address
+	limiter                      RateLimiter
+	scriptExecutor               ScriptExecutor // for checking the payer balance
+	verifyPayerBalanceScript     []byte
+	transactionValidationMetrics module.TransactionValidationMetrics
 }
 
+// NewTransactionValidator creates a new TransactionValidator.
+// The scriptExecutor is only used to check the payer balance, and may be nil if the check is disabled.
+// No errors are expected during normal operation.
 func NewTransactionValidator(
+	log zerolog.Logger,
 	blocks Blocks,
 	chain flow.Chain,
+	transactionValidationMetrics module.TransactionValidationMetrics,
 	options TransactionValidationOptions,
-) *TransactionValidator {
-	return &TransactionValidator{
-		blocks:                blocks,
-		chain:                 chain,
-		options:               options,
-		serviceAccountAddress: chain.ServiceAddress(),
-		limiter:               NewNoopLimiter(),
+	scriptExecutor ScriptExecutor,
+) (*TransactionValidator, error) {
+	if options.CheckPayerBalanceMode != Disabled && scriptExecutor == nil {
+		return nil, errors.New("transaction validator cannot check the payer balance without a script executor")
 	}
+
+	return &TransactionValidator{
+		log:                          log.With().Str("component", "transaction_validator").Logger(),
+		blocks:                       blocks,
+		chain:                        chain,
+		options:                      options,
+		serviceAccountAddress:        chain.ServiceAddress(),
+		limiter:                      NewNoopLimiter(),
+		scriptExecutor:               scriptExecutor,
+		verifyPayerBalanceScript:     blueprints.VerifyPayerBalanceForTxExecutionScript(chain.ChainID()),
+		transactionValidationMetrics: transactionValidationMetrics,
+	}, nil
 }
 
+// NewTransactionValidatorWithLimiter creates a new TransactionValidator using the given rate limiter.
+// The payer balance check is not supported by this validator.
 func NewTransactionValidatorWithLimiter(
 	blocks Blocks,
 	chain flow.Chain,
@@ -101,66 +206,103 @@ func NewTransactionValidatorWithLimiter(
 	rateLimiter RateLimiter,
 ) *TransactionValidator {
 	return &TransactionValidator{
-		blocks:                blocks,
-		chain:                 chain,
-		options:               options,
-		serviceAccountAddress: chain.ServiceAddress(),
-		limiter:               rateLimiter,
+		log:                          zerolog.Nop(),
+		blocks:                       blocks,
+		chain:                        chain,
+		options:                      options,
+		serviceAccountAddress:        chain.ServiceAddress(),
+		limiter:                      rateLimiter,
+		transactionValidationMetrics: metrics.NewNoopCollector(),
 	}
 }
 
-func (v *TransactionValidator) Validate(tx *flow.TransactionBody) (err error) {
+func (v *TransactionValidator) Validate(ctx context.Context, tx *flow.TransactionBody) (err error) {
 	// rate limit transactions for specific payers.
 	// a short term solution to prevent attacks that send too many failed transactions
 	// if a transaction is from a payer that should be rate limited, all the following
 	// checks will be skipped
 	err = v.checkRateLimitPayer(tx)
 	if err != nil {
+		v.transactionValidationMetrics.TransactionValidationFailed(metrics.InvalidTransactionRateLimited)
 		return err
 	}
 
 	err = v.checkTxSizeLimit(tx)
 	if err != nil {
+		v.transactionValidationMetrics.TransactionValidationFailed(metrics.InvalidTransactionByteSize)
 		return err
 	}
 
 	err = v.checkMissingFields(tx)
 	if err != nil {
+		v.transactionValidationMetrics.TransactionValidationFailed(metrics.IncompleteTransaction)
 		return err
 	}
 
 	err = v.checkGasLimit(tx)
 	if err != nil {
+		v.transactionValidationMetrics.TransactionValidationFailed(metrics.InvalidGasLimit)
 		return err
 	}
 
 	err = v.checkExpiry(tx)
 	if err != nil {
+		v.transactionValidationMetrics.TransactionValidationFailed(metrics.ExpiredTransaction)
 		return err
 	}
 
 	err = v.checkCanBeParsed(tx)
 	if err != nil {
+		v.transactionValidationMetrics.TransactionValidationFailed(metrics.InvalidScript)
 		return err
 	}
 
 	err = v.checkAddresses(tx)
 	if err != nil {
+		v.transactionValidationMetrics.TransactionValidationFailed(metrics.InvalidAddresses)
 		return err
 	}
 
 	err = v.checkSignatureFormat(tx)
 	if err != nil {
+		v.transactionValidationMetrics.TransactionValidationFailed(metrics.InvalidSignature)
 		return err
 	}
 
 	err = v.checkSignatureDuplications(tx)
 	if err != nil {
+		v.transactionValidationMetrics.TransactionValidationFailed(metrics.DuplicatedSignature)
 		return err
 	}
 
 	// TODO replace checkSignatureFormat by verifying the account/payer signatures
 
+	err = v.checkSufficientBalanceToPayForTransaction(ctx, tx)
+	if err != nil {
+		// only an insufficient balance results in the transaction being rejected, since it is
+		// caused by the user. All other errors are caused by the local script execution (e.g.
+		// the state is not indexed yet), and must not prevent the transaction from proceeding.
+		if !IsInsufficientBalanceError(err) {
+			v.transactionValidationMetrics.TransactionValidationSkipped()
+			v.log.Debug().Err(err).
+				Hex("tx_id", logging.Entity(tx)).
+				Msg("payer balance check skipped")
+			return nil
+		}
+
+		v.transactionValidationMetrics.TransactionValidationFailed(metrics.InsufficientBalance)
+		if v.options.CheckPayerBalanceMode == EnforceCheck {
+			return err
+		}
+
+		v.log.Warn().Err(err).
+			Hex("tx_id", logging.Entity(tx)).
+			Str("payer", tx.Payer.String()).
+			Msg("payer has insufficient balance to pay for the transaction")
+	}
+
+	v.transactionValidationMetrics.TransactionValidated()
+
 	return nil
 }
 
@@ -346,6 +488,62 @@ func (v *TransactionValidator) checkSignatureFormat(tx *flow.TransactionBody) er
 	return nil
 }
 
+// checkSufficientBalanceToPayForTransaction checks whether the payer has enough balance to pay
+// for the inclusion and the maximum execution fees of the transaction, using the latest locally
+// indexed execution state.
+// Expected errors during normal operation:
+//   - InsufficientBalanceError if the payer cannot pay for the transaction.
+//
+// All other errors are caused by the local script execution, and should not result in
+// the transaction being rejected.
+func (v *TransactionValidator) checkSufficientBalanceToPayForTransaction(ctx context.Context, tx *flow.TransactionBody) error {
+	if v.options.CheckPayerBalanceMode == Disabled {
+		return nil
+	}
+
+	height, err := v.blocks.IndexedHeight()
+	if err != nil {
+		return fmt.Errorf("could not get indexed height: %w", err)
+	}
+
+	args := make([][]byte, 0, 3)
+	for _, arg := range []cadence.Value{
+		cadence.NewAddress(tx.Payer),
+		cadence.UFix64(tx.InclusionEffort()),
+		cadence.UFix64(tx.GasLimit),
+	} {
+		encoded, err := jsoncdc.Encode(arg)
+		if err != nil {
+			return fmt.Errorf("could not encode script argument: %w", err)
+		}
+		args = append(args, encoded)
+	}
+
+	encodedResult, err := v.scriptExecutor.ExecuteAtBlockHeight(ctx, v.verifyPayerBalanceScript, args, height)
+	if err != nil {
+		return fmt.Errorf("could not execute payer balance check script: %w", err)
+	}
+
+	result, err := jsoncdc.Decode(nil, encodedResult)
+	if err != nil {
+		return fmt.Errorf("could not decode payer balance check result: %w", err)
+	}
+
+	canExecuteTransaction, requiredBalance, _, err := fvm.DecodeVerifyPayerBalanceResult(result)
+	if err != nil {
+		return err
+	}
+
+	if !canExecuteTransaction {
+		return InsufficientBalanceError{
+			Payer:           tx.Payer,
+			RequiredBalance: requiredBalance,
+		}
+	}
+
+	return nil
+}
+
 func remove(s []string, r string) []string {
 	for i, v := range s {
 		if v == r {
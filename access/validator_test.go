@@ -0,0 +1,214 @@
+package access_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/cadence/runtime/common"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/onflow/flow-go/access"
+	accessmock "github.com/onflow/flow-go/access/mock"
+	"github.com/onflow/flow-go/fvm"
+	"github.com/onflow/flow-go/fvm/systemcontracts"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/execution"
+	executionmock "github.com/onflow/flow-go/module/execution/mock"
+	"github.com/onflow/flow-go/module/metrics"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestTransactionValidatorSuite(t *testing.T) {
+	suite.Run(t, new(TransactionValidatorSuite))
+}
+
+type TransactionValidatorSuite struct {
+	suite.Suite
+	blocks           *accessmock.Blocks
+	header           *flow.Header
+	chain            flow.Chain
+	validatorOptions access.TransactionValidationOptions
+	scriptExecutor   *executionmock.ScriptExecutor
+	metrics          *metrics.NoopCollector
+}
+
+func (s *TransactionValidatorSuite) SetupTest() {
+	s.metrics = metrics.NewNoopCollector()
+	s.blocks = accessmock.NewBlocks(s.T())
+	assert.NotNil(s.T(), s.blocks)
+
+	s.header = unittest.BlockHeaderFixture()
+	assert.NotNil(s.T(), s.header)
+
+	s.blocks.
+		On("HeaderByID", mock.Anything).
+		Return(s.header, nil).
+		Maybe()
+
+	s.blocks.
+		On("FinalizedHeader").
+		Return(s.header, nil).
+		Maybe()
+
+	s.chain = flow.Testnet.Chain()
+	s.validatorOptions = access.TransactionValidationOptions{
+		CheckPayerBalanceMode:  access.EnforceCheck,
+		MaxTransactionByteSize: flow.DefaultMaxTransactionByteSize,
+		MaxCollectionByteSize:  flow.DefaultMaxCollectionByteSize,
+		MaxGasLimit:            flow.DefaultMaxTransactionGasLimit,
+		Expiry:                 flow.DefaultTransactionExpiry,
+	}
+
+	s.scriptExecutor = executionmock.NewScriptExecutor(s.T())
+}
+
+// encodedPayerBalanceResult returns the JSON-CDC encoded result of the payer balance check script.
+func (s *TransactionValidatorSuite) encodedPayerBalanceResult(canExecuteTransaction bool) []byte {
+	sc := systemcontracts.SystemContractsForChain(s.chain.ChainID())
+
+	resultType := &cadence.StructType{
+		Location: common.AddressLocation{
+			Address: common.Address(sc.FlowFees.Address),
+			Name:    systemcontracts.ContractNameFlowFees,
+		},
+		QualifiedIdentifier: "FlowFees.VerifyPayerBalanceResult",
+		Fields:              fvm.VerifyPayerBalanceResultType.Fields,
+	}
+
+	result := cadence.NewStruct([]cadence.Value{
+		cadence.NewBool(canExecuteTransaction),
+		cadence.UFix64(100),
+		cadence.UFix64(0),
+	}).WithType(resultType)
+
+	encoded, err := jsoncdc.Encode(result)
+	s.Require().NoError(err)
+
+	return encoded
+}
+
+func (s *TransactionValidatorSuite) newValidator(executor execution.ScriptExecutor) *access.TransactionValidator {
+	validator, err := access.NewTransactionValidator(zerolog.Nop(), s.blocks, s.chain, s.metrics, s.validatorOptions, executor)
+	s.Require().NoError(err)
+	return validator
+}
+
+func (s *TransactionValidatorSuite) TestTransactionValidator_ScriptExecutorInternalError() {
+	s.blocks.
+		On("IndexedHeight").
+		Return(s.header.Height, nil)
+
+	scriptExecutor := executionmock.NewScriptExecutor(s.T())
+	scriptExecutor.
+		On("ExecuteAtBlockHeight", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.New("script executor internal error")).
+		Once()
+
+	validator := s.newValidator(scriptExecutor)
+
+	txBody := unittest.TransactionBodyFixture()
+
+	// internal errors of the script execution do not result in the transaction being rejected
+	err := validator.Validate(context.Background(), &txBody)
+	s.Require().NoError(err)
+}
+
+func (s *TransactionValidatorSuite) TestTransactionValidator_SufficientBalance() {
+	s.blocks.
+		On("IndexedHeight").
+		Return(s.header.Height, nil)
+
+	s.scriptExecutor.
+		On("ExecuteAtBlockHeight", mock.Anything, mock.Anything, mock.Anything, s.header.Height).
+		Return(s.encodedPayerBalanceResult(true), nil).
+		Once()
+
+	validator := s.newValidator(s.scriptExecutor)
+
+	txBody := unittest.TransactionBodyFixture()
+
+	err := validator.Validate(context.Background(), &txBody)
+	s.Require().NoError(err)
+}
+
+func (s *TransactionValidatorSuite) TestTransactionValidator_InsufficientBalance() {
+	s.blocks.
+		On("IndexedHeight").
+		Return(s.header.Height, nil)
+
+	s.scriptExecutor.
+		On("ExecuteAtBlockHeight", mock.Anything, mock.Anything, mock.Anything, s.header.Height).
+		Return(s.encodedPayerBalanceResult(false), nil).
+		Twice()
+
+	txBody := unittest.TransactionBodyFixture()
+
+	s.Run("enforce check", func() {
+		validator := s.newValidator(s.scriptExecutor)
+
+		err := validator.Validate(context.Background(), &txBody)
+		s.Require().Error(err)
+		s.Assert().True(access.IsInsufficientBalanceError(err))
+
+		var balanceErr access.InsufficientBalanceError
+		s.Require().ErrorAs(err, &balanceErr)
+		s.Assert().Equal(txBody.Payer, balanceErr.Payer)
+		s.Assert().Equal(cadence.UFix64(100), balanceErr.RequiredBalance)
+	})
+
+	s.Run("warn check", func() {
+		s.validatorOptions.CheckPayerBalanceMode = access.WarnCheck
+		validator := s.newValidator(s.scriptExecutor)
+
+		err := validator.Validate(context.Background(), &txBody)
+		s.Require().NoError(err)
+	})
+}
+
+func (s *TransactionValidatorSuite) TestTransactionValidator_IndexedHeightNotAvailable() {
+	s.blocks.
+		On("IndexedHeight").
+		Return(uint64(0), access.ErrIndexReporterNotAvailable)
+
+	// the script is not executed if the indexed height is not available
+	validator := s.newValidator(s.scriptExecutor)
+
+	txBody := unittest.TransactionBodyFixture()
+
+	err := validator.Validate(context.Background(), &txBody)
+	s.Require().NoError(err)
+}
+
+func (s *TransactionValidatorSuite) TestTransactionValidator_CheckDisabled() {
+	s.validatorOptions.CheckPayerBalanceMode = access.Disabled
+
+	// no script executor is required if the check is disabled
+	validator := s.newValidator(nil)
+
+	txBody := unittest.TransactionBodyFixture()
+
+	err := validator.Validate(context.Background(), &txBody)
+	s.Require().NoError(err)
+}
+
+func (s *TransactionValidatorSuite) TestNewTransactionValidator_MissingScriptExecutor() {
+	_, err := access.NewTransactionValidator(zerolog.Nop(), s.blocks, s.chain, s.metrics, s.validatorOptions, nil)
+	s.Require().Error(err)
+}
+
+func TestParsePayerBalanceMode(t *testing.T) {
+	for _, mode := range []access.PayerBalanceMode{access.Disabled, access.WarnCheck, access.EnforceCheck} {
+		parsed, err := access.ParsePayerBalanceMode(mode.String())
+		assert.NoError(t, err)
+		assert.Equal(t, mode, parsed)
+	}
+
+	_, err := access.ParsePayerBalanceMode("unknown")
+	assert.Error(t, err)
+}
@@ -0,0 +1,126 @@
+package execution
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/admin/commands"
+	"github.com/onflow/flow-go/engine/execution/reexecution"
+)
+
+var _ commands.AdminCommand = (*ReexecuteBlocksCommand)(nil)
+
+// MaxReexecuteBlocksRange is the maximum number of blocks re-executed by a single command,
+// since re-execution competes with block execution for resources.
+const MaxReexecuteBlocksRange = uint64(100)
+
+// ReexecuteBlocksCommand re-executes a range of executed blocks and reports the divergences
+// of the re-executed results from the stored and sealed results.
+type ReexecuteBlocksCommand struct {
+	reexecutor *reexecution.Reexecutor
+}
+
+// NewReexecuteBlocksCommand creates a new ReexecuteBlocksCommand object
+func NewReexecuteBlocksCommand(reexecutor *reexecution.Reexecutor) *ReexecuteBlocksCommand {
+	return &ReexecuteBlocksCommand{
+		reexecutor: reexecutor,
+	}
+}
+
+type ReexecuteBlocksReq struct {
+	from         uint64
+	to           uint64
+	divergedOnly bool
+}
+
+// Handler re-executes the requested blocks and returns a report for each block.
+// If diverged_only is set, only the reports of diverging blocks are returned.
+func (c *ReexecuteBlocksCommand) Handler(ctx context.Context, req *admin.CommandRequest) (interface{}, error) {
+	data := req.ValidatorData.(ReexecuteBlocksReq)
+
+	log.Info().
+		Uint64("from", data.from).
+		Uint64("to", data.to).
+		Msg("admintool: re-executing blocks")
+
+	reports, err := c.reexecutor.ReexecuteRange(ctx, data.from, data.to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to re-execute blocks: %w", err)
+	}
+
+	diverged := make([]*reexecution.BlockReport, 0, len(reports))
+	for _, report := range reports {
+		if report.Diverged() {
+			diverged = append(diverged, report)
+		}
+	}
+
+	log.Info().
+		Uint64("from", data.from).
+		Uint64("to", data.to).
+		Int("diverged_blocks", len(diverged)).
+		Msg("admintool: blocks re-executed")
+
+	if data.divergedOnly {
+		reports = diverged
+	}
+
+	return commands.ConvertToInterfaceList(reports)
+}
+
+// Validator checks the inputs for ReexecuteBlocks command.
+// It expects the following fields in the Data field of the req object:
+//   - from, the first height to re-execute, in a numeric format
+//   - to, the last height to re-execute, in a numeric format. Optional, defaults to from.
+//   - diverged_only, a boolean. Optional, defaults to false.
+//
+// Additionally, from must be positive, to must not be below from, and at most
+// MaxReexecuteBlocksRange blocks can be re-executed at a time.
+// The following sentinel errors are expected during normal operations:
+// * `admin.InvalidAdminReqError` if any required field is missing or in a wrong format
+func (c *ReexecuteBlocksCommand) Validator(req *admin.CommandRequest) error {
+	input, ok := req.Data.(map[string]interface{})
+	if !ok {
+		return admin.NewInvalidAdminReqFormatError("expected map[string]any")
+	}
+
+	result, ok := input["from"]
+	if !ok {
+		return admin.NewInvalidAdminReqErrorf("missing required field: 'from'")
+	}
+	from, ok := result.(float64)
+	if !ok || from <= 0 {
+		return admin.NewInvalidAdminReqParameterError("from", "must be number > 0", result)
+	}
+
+	to := from
+	if result, ok = input["to"]; ok {
+		to, ok = result.(float64)
+		if !ok || to < from {
+			return admin.NewInvalidAdminReqParameterError("to", "must be number >= from", result)
+		}
+	}
+
+	if uint64(to)-uint64(from)+1 > MaxReexecuteBlocksRange {
+		return admin.NewInvalidAdminReqErrorf("re-executing more than %v blocks at a time is not allowed", MaxReexecuteBlocksRange)
+	}
+
+	divergedOnly := false
+	if result, ok = input["diverged_only"]; ok {
+		divergedOnly, ok = result.(bool)
+		if !ok {
+			return admin.NewInvalidAdminReqParameterError("diverged_only", "must be bool", result)
+		}
+	}
+
+	req.ValidatorData = ReexecuteBlocksReq{
+		from:         uint64(from),
+		to:           uint64(to),
+		divergedOnly: divergedOnly,
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package execution
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/admin"
+)
+
+func TestReexecuteBlocksCommandParsing(t *testing.T) {
+	cmd := ReexecuteBlocksCommand{}
+
+	t.Run("happy path", func(t *testing.T) {
+		req := &admin.CommandRequest{
+			Data: map[string]interface{}{
+				"from":          float64(10), // raw json parses to float64
+				"to":            float64(20),
+				"diverged_only": true,
+			},
+		}
+
+		err := cmd.Validator(req)
+		require.NoError(t, err)
+
+		require.Equal(t, ReexecuteBlocksReq{from: 10, to: 20, divergedOnly: true}, req.ValidatorData)
+	})
+
+	t.Run("single block", func(t *testing.T) {
+		req := &admin.CommandRequest{
+			Data: map[string]interface{}{
+				"from": float64(10),
+			},
+		}
+
+		err := cmd.Validator(req)
+		require.NoError(t, err)
+
+		require.Equal(t, ReexecuteBlocksReq{from: 10, to: 10}, req.ValidatorData)
+	})
+
+	t.Run("missing from", func(t *testing.T) {
+		req := &admin.CommandRequest{
+			Data: map[string]interface{}{
+				"to": float64(10),
+			},
+		}
+
+		err := cmd.Validator(req)
+		require.True(t, admin.IsInvalidAdminParameterError(err))
+	})
+
+	t.Run("to below from", func(t *testing.T) {
+		req := &admin.CommandRequest{
+			Data: map[string]interface{}{
+				"from": float64(10),
+				"to":   float64(9),
+			},
+		}
+
+		err := cmd.Validator(req)
+		require.True(t, admin.IsInvalidAdminParameterError(err))
+	})
+
+	t.Run("range too large", func(t *testing.T) {
+		req := &admin.CommandRequest{
+			Data: map[string]interface{}{
+				"from": float64(1),
+				"to":   float64(1 + MaxReexecuteBlocksRange),
+			},
+		}
+
+		err := cmd.Validator(req)
+		require.True(t, admin.IsInvalidAdminParameterError(err))
+	})
+}
@@ -0,0 +1,50 @@
+package execution
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/admin/commands"
+	"github.com/onflow/flow-go/engine/execution/ingestion/stop"
+)
+
+var _ commands.AdminCommand = (*ResumeExecutionCommand)(nil)
+
+// ResumeExecutionCommand will send a signal to engine to resume the execution
+// paused by a stop, or paused at startup
+type ResumeExecutionCommand struct {
+	stopControl *stop.StopControl
+}
+
+// NewResumeExecutionCommand creates a new ResumeExecutionCommand object
+func NewResumeExecutionCommand(stopControl *stop.StopControl) *ResumeExecutionCommand {
+	return &ResumeExecutionCommand{
+		stopControl: stopControl,
+	}
+}
+
+// Handler method resumes the execution. Pending stops are removed.
+// Errors if the execution is not stopped.
+// Returns "ok" if successful.
+func (s *ResumeExecutionCommand) Handler(_ context.Context, _ *admin.CommandRequest) (interface{}, error) {
+	lastStop := s.stopControl.GetLastStop()
+
+	err := s.stopControl.Resume()
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Str("lastStopReason", lastStop.Reason).
+		Uint64("stoppedAfterHeight", lastStop.AfterHeight).
+		Msgf("admintool: En execution resumed")
+
+	return "ok", nil
+}
+
+// Validator is a no-op, the command has no input.
+func (s *ResumeExecutionCommand) Validator(_ *admin.CommandRequest) error {
+	return nil
+}
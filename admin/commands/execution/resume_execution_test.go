@@ -0,0 +1,46 @@
+package execution
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/engine"
+	"github.com/onflow/flow-go/engine/execution/ingestion/stop"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+func TestResumeExecutionCommand(t *testing.T) {
+
+	stopControl := stop.NewStopControl(
+		engine.NewUnit(),
+		time.Second,
+		zerolog.Nop(),
+		nil,
+		nil,
+		nil,
+		nil,
+		&flow.Header{Height: 1},
+		true,
+		false,
+		nil,
+		nil,
+	)
+
+	cmd := NewResumeExecutionCommand(stopControl)
+	req := &admin.CommandRequest{}
+
+	require.NoError(t, cmd.Validator(req))
+
+	_, err := cmd.Handler(context.TODO(), req)
+	require.NoError(t, err)
+	require.False(t, stopControl.IsExecutionStopped())
+
+	// execution is no longer stopped
+	_, err = cmd.Handler(context.TODO(), req)
+	require.ErrorIs(t, err, stop.ErrCannotResume)
+}
@@ -0,0 +1,114 @@
+package execution
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/admin/commands"
+	"github.com/onflow/flow-go/engine/execution/ingestion/stop"
+)
+
+var _ commands.AdminCommand = (*ScheduleStopCommand)(nil)
+
+// ScheduleStopCommand will send a signal to engine to stop/crash EN
+// before the first block reaching a given time, view or epoch
+type ScheduleStopCommand struct {
+	stopControl *stop.StopControl
+}
+
+// NewScheduleStopCommand creates a new ScheduleStopCommand object
+func NewScheduleStopCommand(stopControl *stop.StopControl) *ScheduleStopCommand {
+	return &ScheduleStopCommand{
+		stopControl: stopControl,
+	}
+}
+
+// Handler method sets the scheduled stop.
+// Errors only if setting of the scheduled stop fails.
+// Returns "ok" if successful.
+func (s *ScheduleStopCommand) Handler(_ context.Context, req *admin.CommandRequest) (interface{}, error) {
+	newStop := req.ValidatorData.(stop.ScheduledStop)
+
+	oldStop := s.stopControl.GetScheduledStop()
+
+	err := s.stopControl.SetScheduledStop(newStop)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info().
+		Stringer("newStop", newStop).
+		Stringer("oldStop", oldStop).
+		Msgf("admintool: New En scheduled stop set")
+
+	return "ok", nil
+}
+
+// Validator checks the inputs for ScheduleStop command.
+// It expects the following fields in the Data field of the req object:
+//   - time, an RFC3339 timestamp (optional)
+//   - view in a numeric format (optional)
+//   - epoch in a numeric format (optional)
+//   - crash, a boolean
+//
+// At least one of time, view and epoch must be given. The execution stops before the
+// first block satisfying any of them.
+// The following sentinel errors are expected during normal operations:
+// * `admin.InvalidAdminReqError` if any required field is missing or in a wrong format
+func (s *ScheduleStopCommand) Validator(req *admin.CommandRequest) error {
+	input, ok := req.Data.(map[string]interface{})
+	if !ok {
+		return admin.NewInvalidAdminReqFormatError("expected map[string]any")
+	}
+
+	var scheduledStop stop.ScheduledStop
+
+	if result, ok := input["time"]; ok {
+		str, ok := result.(string)
+		if !ok {
+			return admin.NewInvalidAdminReqParameterError("time", "must be an RFC3339 timestamp", result)
+		}
+		stopTime, err := time.Parse(time.RFC3339, str)
+		if err != nil || stopTime.IsZero() {
+			return admin.NewInvalidAdminReqParameterError("time", "must be an RFC3339 timestamp", result)
+		}
+		scheduledStop.BeforeTime = stopTime
+	}
+
+	if result, ok := input["view"]; ok {
+		view, ok := result.(float64)
+		if !ok || view <= 0 {
+			return admin.NewInvalidAdminReqParameterError("view", "must be number >0", result)
+		}
+		scheduledStop.BeforeView = uint64(view)
+	}
+
+	if result, ok := input["epoch"]; ok {
+		epoch, ok := result.(float64)
+		if !ok || epoch <= 0 {
+			return admin.NewInvalidAdminReqParameterError("epoch", "must be number >0", result)
+		}
+		scheduledStop.BeforeEpoch = uint64(epoch)
+	}
+
+	if !scheduledStop.Set() {
+		return admin.NewInvalidAdminReqErrorf("missing required field: one of 'time', 'view' or 'epoch'")
+	}
+
+	result, ok := input["crash"]
+	if !ok {
+		return admin.NewInvalidAdminReqErrorf("missing required field: 'crash'")
+	}
+	crash, ok := result.(bool)
+	if !ok {
+		return admin.NewInvalidAdminReqParameterError("crash", "must be bool", result)
+	}
+	scheduledStop.ShouldCrash = crash
+
+	req.ValidatorData = scheduledStop
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package execution
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/engine"
+	"github.com/onflow/flow-go/engine/execution/ingestion/stop"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+func TestScheduleStopCommandParsing(t *testing.T) {
+	cmd := ScheduleStopCommand{}
+
+	t.Run("happy path", func(t *testing.T) {
+
+		req := &admin.CommandRequest{
+			Data: map[string]interface{}{
+				"time":  "2024-05-01T12:00:00Z",
+				"view":  float64(1000), // raw json parses to float64
+				"epoch": float64(7),
+				"crash": true,
+			},
+		}
+
+		err := cmd.Validator(req)
+		require.NoError(t, err)
+
+		require.IsType(t, stop.ScheduledStop{}, req.ValidatorData)
+
+		parsedReq := req.ValidatorData.(stop.ScheduledStop)
+
+		require.Equal(t, time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC), parsedReq.BeforeTime.UTC())
+		require.Equal(t, uint64(1000), parsedReq.BeforeView)
+		require.Equal(t, uint64(7), parsedReq.BeforeEpoch)
+		require.Equal(t, true, parsedReq.ShouldCrash)
+	})
+
+	t.Run("single condition", func(t *testing.T) {
+
+		req := &admin.CommandRequest{
+			Data: map[string]interface{}{
+				"view":  float64(1000),
+				"crash": false,
+			},
+		}
+
+		err := cmd.Validator(req)
+		require.NoError(t, err)
+
+		parsedReq := req.ValidatorData.(stop.ScheduledStop)
+
+		require.True(t, parsedReq.BeforeTime.IsZero())
+		require.Equal(t, uint64(1000), parsedReq.BeforeView)
+		require.Equal(t, uint64(0), parsedReq.BeforeEpoch)
+		require.Equal(t, false, parsedReq.ShouldCrash)
+	})
+
+	t.Run("no condition", func(t *testing.T) {
+
+		req := &admin.CommandRequest{
+			Data: map[string]interface{}{
+				"crash": true,
+			},
+		}
+
+		err := cmd.Validator(req)
+
+		require.True(t, admin.IsInvalidAdminParameterError(err))
+	})
+
+	t.Run("missing crash", func(t *testing.T) {
+
+		req := &admin.CommandRequest{
+			Data: map[string]interface{}{
+				"epoch": float64(7),
+			},
+		}
+
+		err := cmd.Validator(req)
+
+		require.True(t, admin.IsInvalidAdminParameterError(err))
+	})
+
+	t.Run("wrong time format", func(t *testing.T) {
+
+		req := &admin.CommandRequest{
+			Data: map[string]interface{}{
+				"time":  "yesterday",
+				"crash": true,
+			},
+		}
+
+		err := cmd.Validator(req)
+
+		require.True(t, admin.IsInvalidAdminParameterError(err))
+	})
+
+	t.Run("negative view", func(t *testing.T) {
+
+		req := &admin.CommandRequest{
+			Data: map[string]interface{}{
+				"view":  float64(-12),
+				"crash": true,
+			},
+		}
+
+		err := cmd.Validator(req)
+
+		require.True(t, admin.IsInvalidAdminParameterError(err))
+	})
+}
+
+func TestScheduleStopCommandSetsValues(t *testing.T) {
+
+	stopControl := stop.NewStopControl(
+		engine.NewUnit(),
+		time.Second,
+		zerolog.Nop(),
+		nil,
+		nil,
+		nil,
+		nil,
+		&flow.Header{Height: 1},
+		false,
+		false,
+		nil,
+		nil,
+	)
+
+	cmd := NewScheduleStopCommand(stopControl)
+
+	scheduledStop := stop.ScheduledStop{
+		BeforeView:  1000,
+		BeforeEpoch: 7,
+		ShouldCrash: true,
+	}
+
+	req := &admin.CommandRequest{
+		ValidatorData: scheduledStop,
+	}
+
+	_, err := cmd.Handler(context.TODO(), req)
+	require.NoError(t, err)
+
+	require.Equal(t, scheduledStop, stopControl.GetScheduledStop())
+}
@@ -102,6 +102,8 @@ func TestCommandsSetsValues(t *testing.T) {
 		&flow.Header{Height: 1},
 		false,
 		false,
+		nil,
+		nil,
 	)
 
 	cmd := NewStopAtHeightCommand(stopControl)
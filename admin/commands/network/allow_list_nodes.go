@@ -0,0 +1,55 @@
+package network
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/admin/commands"
+	"github.com/onflow/flow-go/model/flow"
+	flownet "github.com/onflow/flow-go/network"
+)
+
+var _ commands.AdminCommand = (*AllowListNodesCommand)(nil)
+
+// AllowListNodesCommand removes the admin disallow-listing of the given nodes at the networking layer, so that
+// connections to them can be established again. Nodes that are disallow-listed for other causes (e.g., by ALSP)
+// remain disallow-listed for those causes.
+// Input: {"node_ids": ["<node id>", ...]}
+type AllowListNodesCommand struct {
+	consumer flownet.DisallowListNotificationConsumer
+}
+
+// NewAllowListNodesCommand creates a new AllowListNodesCommand.
+func NewAllowListNodesCommand(consumer flownet.DisallowListNotificationConsumer) *AllowListNodesCommand {
+	return &AllowListNodesCommand{
+		consumer: consumer,
+	}
+}
+
+// Handler distributes the allow-list update.
+// Returns "ok" if successful.
+func (a *AllowListNodesCommand) Handler(_ context.Context, req *admin.CommandRequest) (interface{}, error) {
+	nodeIDs := req.ValidatorData.(flow.IdentifierList)
+
+	a.consumer.OnAllowListNotification(&flownet.AllowListingUpdate{
+		FlowIds: nodeIDs,
+		Cause:   flownet.DisallowListedCauseAdmin,
+	})
+
+	log.Info().Strs("node_ids", nodeIDs.Strings()).Msg("admintool: nodes allow-listed")
+
+	return "ok", nil
+}
+
+// Validator validates the request.
+// Returns admin.InvalidAdminReqError for invalid/malformed requests.
+func (a *AllowListNodesCommand) Validator(req *admin.CommandRequest) error {
+	nodeIDs, err := parseNodeIDs(req.Data)
+	if err != nil {
+		return err
+	}
+	req.ValidatorData = nodeIDs
+	return nil
+}
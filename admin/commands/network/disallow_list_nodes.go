@@ -0,0 +1,57 @@
+package network
+
+import (
+	"context"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/admin/commands"
+	"github.com/onflow/flow-go/model/flow"
+	flownet "github.com/onflow/flow-go/network"
+)
+
+var _ commands.AdminCommand = (*DisallowListNodesCommand)(nil)
+
+// DisallowListNodesCommand disallow-lists the given nodes at the networking layer, i.e., the existing connections to
+// these nodes are closed and no new connections are established with them. The update is distributed through the
+// disallow-list notification consumer of the network with the admin cause. It is only persisted across restarts if
+// the ALSP spam record snapshots are enabled (alsp-spam-record-snapshot-dir).
+// For a persistent disallow-list, use the "network-id-provider-blocklist" config instead.
+// Input: {"node_ids": ["<node id>", ...]}
+type DisallowListNodesCommand struct {
+	consumer flownet.DisallowListNotificationConsumer
+}
+
+// NewDisallowListNodesCommand creates a new DisallowListNodesCommand.
+func NewDisallowListNodesCommand(consumer flownet.DisallowListNotificationConsumer) *DisallowListNodesCommand {
+	return &DisallowListNodesCommand{
+		consumer: consumer,
+	}
+}
+
+// Handler distributes the disallow-list update.
+// Returns "ok" if successful.
+func (d *DisallowListNodesCommand) Handler(_ context.Context, req *admin.CommandRequest) (interface{}, error) {
+	nodeIDs := req.ValidatorData.(flow.IdentifierList)
+
+	d.consumer.OnDisallowListNotification(&flownet.DisallowListingUpdate{
+		FlowIds: nodeIDs,
+		Cause:   flownet.DisallowListedCauseAdmin,
+	})
+
+	log.Info().Strs("node_ids", nodeIDs.Strings()).Msg("admintool: nodes disallow-listed")
+
+	return "ok", nil
+}
+
+// Validator validates the request.
+// Returns admin.InvalidAdminReqError for invalid/malformed requests.
+func (d *DisallowListNodesCommand) Validator(req *admin.CommandRequest) error {
+	nodeIDs, err := parseNodeIDs(req.Data)
+	if err != nil {
+		return err
+	}
+	req.ValidatorData = nodeIDs
+	return nil
+}
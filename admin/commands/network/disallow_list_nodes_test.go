@@ -0,0 +1,79 @@
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/admin"
+	flownet "github.com/onflow/flow-go/network"
+	"github.com/onflow/flow-go/network/mocknetwork"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestDisallowAndAllowListNodes tests that the disallow-list and allow-list commands distribute the updates with the
+// admin cause to the disallow-list notification consumer.
+func TestDisallowAndAllowListNodes(t *testing.T) {
+	nodeIDs := unittest.IdentifierListFixture(2)
+	data := map[string]interface{}{
+		"node_ids": []interface{}{nodeIDs[0].String(), nodeIDs[1].String()},
+	}
+
+	t.Run("disallow-list", func(t *testing.T) {
+		consumer := mocknetwork.NewDisallowListNotificationConsumer(t)
+		consumer.On("OnDisallowListNotification", &flownet.DisallowListingUpdate{
+			FlowIds: nodeIDs,
+			Cause:   flownet.DisallowListedCauseAdmin,
+		}).Return().Once()
+
+		cmd := NewDisallowListNodesCommand(consumer)
+		req := &admin.CommandRequest{Data: data}
+		require.NoError(t, cmd.Validator(req))
+
+		result, err := cmd.Handler(context.Background(), req)
+		require.NoError(t, err)
+		require.Equal(t, "ok", result)
+	})
+
+	t.Run("allow-list", func(t *testing.T) {
+		consumer := mocknetwork.NewDisallowListNotificationConsumer(t)
+		consumer.On("OnAllowListNotification", &flownet.AllowListingUpdate{
+			FlowIds: nodeIDs,
+			Cause:   flownet.DisallowListedCauseAdmin,
+		}).Return().Once()
+
+		cmd := NewAllowListNodesCommand(consumer)
+		req := &admin.CommandRequest{Data: data}
+		require.NoError(t, cmd.Validator(req))
+
+		result, err := cmd.Handler(context.Background(), req)
+		require.NoError(t, err)
+		require.Equal(t, "ok", result)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		consumer := mocknetwork.NewDisallowListNotificationConsumer(t)
+		invalid := []interface{}{
+			nil,
+			"abc",
+			map[string]interface{}{},
+			map[string]interface{}{"node_ids": nodeIDs[0].String()},
+			map[string]interface{}{"node_ids": []interface{}{}},
+			map[string]interface{}{"node_ids": []interface{}{"abc"}},
+			map[string]interface{}{"node_ids": []interface{}{nodeIDs[0].String(), float64(1)}},
+		}
+		for _, cmd := range []interface {
+			Validator(*admin.CommandRequest) error
+		}{NewDisallowListNodesCommand(consumer), NewAllowListNodesCommand(consumer)} {
+			for _, data := range invalid {
+				err := cmd.Validator(&admin.CommandRequest{Data: data})
+				require.Error(t, err)
+				require.True(t, admin.IsInvalidAdminParameterError(err), "data: %v", data)
+			}
+		}
+		consumer.AssertNotCalled(t, "OnDisallowListNotification", mock.Anything)
+		consumer.AssertNotCalled(t, "OnAllowListNotification", mock.Anything)
+	})
+}
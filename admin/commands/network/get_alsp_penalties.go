@@ -0,0 +1,85 @@
+package network
+
+import (
+	"context"
+	"sort"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/admin/commands"
+	"github.com/onflow/flow-go/model/flow"
+	flownet "github.com/onflow/flow-go/network"
+)
+
+var _ commands.AdminCommand = (*GetAlspPenaltiesCommand)(nil)
+
+// alspPenalty is the admin representation of an ALSP spam record.
+type alspPenalty struct {
+	NodeID         string  `json:"node_id"`
+	Penalty        float64 `json:"penalty"`
+	Decay          float64 `json:"decay"`
+	CutoffCounter  uint64  `json:"cutoff_counter"`
+	DisallowListed bool    `json:"disallow_listed"`
+}
+
+// GetAlspPenaltiesCommand dumps the penalties the application layer spam prevention (ALSP) module currently
+// holds for remote nodes. Input is optional; {"node_id": "<node id>"} restricts the output to a single node.
+type GetAlspPenaltiesCommand struct {
+	exposer flownet.SpamRecordsExposer
+}
+
+// NewGetAlspPenaltiesCommand creates a new GetAlspPenaltiesCommand.
+func NewGetAlspPenaltiesCommand(exposer flownet.SpamRecordsExposer) *GetAlspPenaltiesCommand {
+	return &GetAlspPenaltiesCommand{
+		exposer: exposer,
+	}
+}
+
+// Handler returns the ALSP penalties, sorted from the most to the least penalized node.
+// No errors are expected during normal operation.
+func (g *GetAlspPenaltiesCommand) Handler(_ context.Context, req *admin.CommandRequest) (interface{}, error) {
+	nodeID, filterByNode := req.ValidatorData.(flow.Identifier)
+
+	penalties := make([]alspPenalty, 0)
+	for _, record := range g.exposer.SpamRecords() {
+		if filterByNode && record.OriginId != nodeID {
+			continue
+		}
+		penalties = append(penalties, alspPenalty{
+			NodeID:         record.OriginId.String(),
+			Penalty:        record.Penalty,
+			Decay:          record.Decay,
+			CutoffCounter:  record.CutoffCounter,
+			DisallowListed: record.DisallowListed,
+		})
+	}
+	sort.Slice(penalties, func(i, j int) bool {
+		return penalties[i].Penalty < penalties[j].Penalty
+	})
+
+	return commands.ConvertToInterfaceList(penalties)
+}
+
+// Validator validates the optional node ID.
+// Returns admin.InvalidAdminReqError for invalid/malformed requests.
+func (g *GetAlspPenaltiesCommand) Validator(req *admin.CommandRequest) error {
+	if req.Data == nil {
+		return nil
+	}
+
+	input, ok := req.Data.(map[string]interface{})
+	if !ok {
+		return admin.NewInvalidAdminReqFormatError("expected map[string]any")
+	}
+
+	nodeIDRaw, ok := input["node_id"]
+	if !ok {
+		return nil
+	}
+	nodeID, err := parseNodeID("node_id", nodeIDRaw)
+	if err != nil {
+		return err
+	}
+	req.ValidatorData = nodeID
+
+	return nil
+}
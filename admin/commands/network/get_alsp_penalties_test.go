@@ -0,0 +1,72 @@
+package network
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/network/alsp/model"
+	"github.com/onflow/flow-go/network/mocknetwork"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestGetAlspPenalties tests that the ALSP penalties are returned sorted from the most to the least penalized node,
+// and that the optional node ID filter is applied.
+func TestGetAlspPenalties(t *testing.T) {
+	nodeIDs := unittest.IdentifierListFixture(2)
+	exposer := mocknetwork.NewSpamRecordsExposer(t)
+	exposer.On("SpamRecords").Return([]model.ProtocolSpamRecord{
+		{OriginId: nodeIDs[0], Penalty: -10, Decay: 1000},
+		{OriginId: nodeIDs[1], Penalty: -100, Decay: 100, CutoffCounter: 1, DisallowListed: true},
+	})
+	cmd := NewGetAlspPenaltiesCommand(exposer)
+
+	t.Run("all nodes", func(t *testing.T) {
+		req := &admin.CommandRequest{}
+		require.NoError(t, cmd.Validator(req))
+
+		result, err := cmd.Handler(context.Background(), req)
+		require.NoError(t, err)
+		require.Equal(t, []interface{}{
+			map[string]interface{}{
+				"node_id":         nodeIDs[1].String(),
+				"penalty":         float64(-100),
+				"decay":           float64(100),
+				"cutoff_counter":  float64(1),
+				"disallow_listed": true,
+			},
+			map[string]interface{}{
+				"node_id":         nodeIDs[0].String(),
+				"penalty":         float64(-10),
+				"decay":           float64(1000),
+				"cutoff_counter":  float64(0),
+				"disallow_listed": false,
+			},
+		}, result)
+	})
+
+	t.Run("single node", func(t *testing.T) {
+		req := &admin.CommandRequest{Data: map[string]interface{}{"node_id": nodeIDs[0].String()}}
+		require.NoError(t, cmd.Validator(req))
+
+		result, err := cmd.Handler(context.Background(), req)
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		require.Equal(t, nodeIDs[0].String(), result.([]interface{})[0].(map[string]interface{})["node_id"])
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		invalid := []interface{}{
+			"abc",
+			map[string]interface{}{"node_id": "abc"},
+			map[string]interface{}{"node_id": float64(1)},
+		}
+		for _, data := range invalid {
+			err := cmd.Validator(&admin.CommandRequest{Data: data})
+			require.Error(t, err)
+			require.True(t, admin.IsInvalidAdminParameterError(err), "data: %v", data)
+		}
+	})
+}
@@ -0,0 +1,90 @@
+package network
+
+import (
+	"context"
+	"sort"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/admin/commands"
+	"github.com/onflow/flow-go/module"
+	"github.com/onflow/flow-go/network/channels"
+	"github.com/onflow/flow-go/network/p2p"
+)
+
+var _ commands.AdminCommand = (*GetGossipSubMeshCommand)(nil)
+
+// meshPeer is the admin representation of a peer in the local GossipSub mesh of a topic.
+type meshPeer struct {
+	PeerID string `json:"peer_id"`
+	NodeID string `json:"node_id,omitempty"`
+	Role   string `json:"role,omitempty"`
+}
+
+// GetGossipSubMeshCommand returns the local GossipSub mesh peers per topic, as tracked by the GossipSub mesh tracer.
+// Input is optional; {"topic": "<topic>"} restricts the output to a single topic, otherwise the mesh of every
+// topic the node is subscribed to is returned.
+type GetGossipSubMeshCommand struct {
+	libP2PNode p2p.LibP2PNode
+	idProvider module.IdentityProvider
+}
+
+// NewGetGossipSubMeshCommand creates a new GetGossipSubMeshCommand.
+func NewGetGossipSubMeshCommand(libP2PNode p2p.LibP2PNode, idProvider module.IdentityProvider) *GetGossipSubMeshCommand {
+	return &GetGossipSubMeshCommand{
+		libP2PNode: libP2PNode,
+		idProvider: idProvider,
+	}
+}
+
+// Handler returns the mesh peers keyed by topic.
+// No errors are expected during normal operation.
+func (g *GetGossipSubMeshCommand) Handler(_ context.Context, req *admin.CommandRequest) (interface{}, error) {
+	topics := g.libP2PNode.SubscribedTopics()
+	if topic, ok := req.ValidatorData.(channels.Topic); ok {
+		topics = []channels.Topic{topic}
+	}
+
+	meshes := make(map[string][]meshPeer, len(topics))
+	for _, topic := range topics {
+		peers := make([]meshPeer, 0)
+		for _, pid := range g.libP2PNode.GetLocalMeshPeers(topic) {
+			p := meshPeer{PeerID: pid.String()}
+			if identity, ok := g.idProvider.ByPeerID(pid); ok {
+				p.NodeID = identity.NodeID.String()
+				p.Role = identity.Role.String()
+			}
+			peers = append(peers, p)
+		}
+		sort.Slice(peers, func(i, j int) bool {
+			return peers[i].PeerID < peers[j].PeerID
+		})
+		meshes[topic.String()] = peers
+	}
+
+	return commands.ConvertToMap(meshes)
+}
+
+// Validator validates the optional topic.
+// Returns admin.InvalidAdminReqError for invalid/malformed requests.
+func (g *GetGossipSubMeshCommand) Validator(req *admin.CommandRequest) error {
+	if req.Data == nil {
+		return nil
+	}
+
+	input, ok := req.Data.(map[string]interface{})
+	if !ok {
+		return admin.NewInvalidAdminReqFormatError("expected map[string]any")
+	}
+
+	topicRaw, ok := input["topic"]
+	if !ok {
+		return nil
+	}
+	topic, ok := topicRaw.(string)
+	if !ok || topic == "" {
+		return admin.NewInvalidAdminReqParameterError("topic", "must be a non-empty string", topicRaw)
+	}
+	req.ValidatorData = channels.Topic(topic)
+
+	return nil
+}
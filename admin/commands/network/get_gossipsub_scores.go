@@ -0,0 +1,138 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/admin/commands"
+	"github.com/onflow/flow-go/module"
+	"github.com/onflow/flow-go/network/p2p"
+)
+
+var _ commands.AdminCommand = (*GetGossipSubScoresCommand)(nil)
+
+// topicScore is the admin representation of the score of a peer on a single topic.
+type topicScore struct {
+	TimeInMesh               string  `json:"time_in_mesh"`
+	FirstMessageDeliveries   float64 `json:"first_message_deliveries"`
+	MeshMessageDeliveries    float64 `json:"mesh_message_deliveries"`
+	InvalidMessageDeliveries float64 `json:"invalid_message_deliveries"`
+}
+
+// peerScore is the admin representation of the GossipSub score of a peer.
+type peerScore struct {
+	PeerID             string                `json:"peer_id"`
+	NodeID             string                `json:"node_id,omitempty"`
+	Role               string                `json:"role,omitempty"`
+	Score              float64               `json:"score"`
+	AppScore           float64               `json:"app_score"`
+	IPColocationFactor float64               `json:"ip_colocation_factor"`
+	BehaviourPenalty   float64               `json:"behaviour_penalty"`
+	Topics             map[string]topicScore `json:"topics,omitempty"`
+}
+
+// GetGossipSubScoresCommand returns the GossipSub scores of peers as last reported to the GossipSub score tracer.
+// Input is optional; {"peer_id": "<peer id>"} restricts the output to a single peer, otherwise the scores of all
+// connected peers are returned. The command fails if the node runs without a score tracer.
+type GetGossipSubScoresCommand struct {
+	libP2PNode p2p.LibP2PNode
+	idProvider module.IdentityProvider
+}
+
+// NewGetGossipSubScoresCommand creates a new GetGossipSubScoresCommand.
+func NewGetGossipSubScoresCommand(libP2PNode p2p.LibP2PNode, idProvider module.IdentityProvider) *GetGossipSubScoresCommand {
+	return &GetGossipSubScoresCommand{
+		libP2PNode: libP2PNode,
+		idProvider: idProvider,
+	}
+}
+
+// Handler returns the GossipSub scores of the requested peers.
+// Peers unknown to the score tracer are omitted, unless a single peer is requested, in which case an error is returned.
+func (g *GetGossipSubScoresCommand) Handler(_ context.Context, req *admin.CommandRequest) (interface{}, error) {
+	exposer := g.libP2PNode.PeerScoreExposer()
+	if exposer == nil {
+		return nil, fmt.Errorf("gossipsub score tracer is not enabled on this node")
+	}
+
+	if pid, ok := req.ValidatorData.(peer.ID); ok {
+		score, ok := g.peerScore(exposer, pid)
+		if !ok {
+			return nil, fmt.Errorf("no gossipsub score found for peer %s", pid)
+		}
+		return commands.ConvertToMap(score)
+	}
+
+	scores := make([]peerScore, 0)
+	for _, pid := range g.libP2PNode.Host().Network().Peers() {
+		if score, ok := g.peerScore(exposer, pid); ok {
+			scores = append(scores, score)
+		}
+	}
+	return commands.ConvertToInterfaceList(scores)
+}
+
+// peerScore assembles the score of the given peer from the exposer. Returns false if the exposer has no score for the peer.
+func (g *GetGossipSubScoresCommand) peerScore(exposer p2p.PeerScoreExposer, pid peer.ID) (peerScore, bool) {
+	score, ok := exposer.GetScore(pid)
+	if !ok {
+		return peerScore{}, false
+	}
+	appScore, _ := exposer.GetAppScore(pid)
+	ipColocationFactor, _ := exposer.GetIPColocationFactor(pid)
+	behaviourPenalty, _ := exposer.GetBehaviourPenalty(pid)
+
+	result := peerScore{
+		PeerID:             pid.String(),
+		Score:              score,
+		AppScore:           appScore,
+		IPColocationFactor: ipColocationFactor,
+		BehaviourPenalty:   behaviourPenalty,
+	}
+	if identity, ok := g.idProvider.ByPeerID(pid); ok {
+		result.NodeID = identity.NodeID.String()
+		result.Role = identity.Role.String()
+	}
+
+	if topics, ok := exposer.GetTopicScores(pid); ok {
+		result.Topics = make(map[string]topicScore, len(topics))
+		for topic, snapshot := range topics {
+			result.Topics[topic] = topicScore{
+				TimeInMesh:               snapshot.TimeInMesh.String(),
+				FirstMessageDeliveries:   snapshot.FirstMessageDeliveries,
+				MeshMessageDeliveries:    snapshot.MeshMessageDeliveries,
+				InvalidMessageDeliveries: snapshot.InvalidMessageDeliveries,
+			}
+		}
+	}
+
+	return result, true
+}
+
+// Validator validates the optional peer ID.
+// Returns admin.InvalidAdminReqError for invalid/malformed requests.
+func (g *GetGossipSubScoresCommand) Validator(req *admin.CommandRequest) error {
+	if req.Data == nil {
+		return nil
+	}
+
+	input, ok := req.Data.(map[string]interface{})
+	if !ok {
+		return admin.NewInvalidAdminReqFormatError("expected map[string]any")
+	}
+
+	pidRaw, ok := input["peer_id"]
+	if !ok {
+		return nil
+	}
+	pid, err := parsePeerID(pidRaw)
+	if err != nil {
+		return err
+	}
+	req.ValidatorData = pid
+
+	return nil
+}
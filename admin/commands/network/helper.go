@@ -0,0 +1,60 @@
+package network
+
+import (
+	"encoding/hex"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// parsePeerID parses the "peer_id" field of a request.
+// Returns admin.InvalidAdminReqError if the value is not a valid peer ID string.
+func parsePeerID(raw interface{}) (peer.ID, error) {
+	if str, ok := raw.(string); ok {
+		if pid, err := peer.Decode(str); err == nil {
+			return pid, nil
+		}
+	}
+	return "", admin.NewInvalidAdminReqParameterError("peer_id", "must be valid peer id string", raw)
+}
+
+// parseNodeID parses a hex encoded Flow node ID from the given field of a request.
+// Returns admin.InvalidAdminReqError if the value is not a 64-char hex string.
+func parseNodeID(field string, raw interface{}) (flow.Identifier, error) {
+	if str, ok := raw.(string); ok && len(str) == 2*flow.IdentifierLen {
+		if b, err := hex.DecodeString(str); err == nil {
+			return flow.HashToID(b), nil
+		}
+	}
+	return flow.ZeroID, admin.NewInvalidAdminReqParameterError(field, "must be 64-char hex string", raw)
+}
+
+// parseNodeIDs parses the "node_ids" field of a request, a non-empty list of hex encoded Flow node IDs.
+// Returns admin.InvalidAdminReqError for invalid/malformed requests.
+func parseNodeIDs(data interface{}) (flow.IdentifierList, error) {
+	input, ok := data.(map[string]interface{})
+	if !ok {
+		return nil, admin.NewInvalidAdminReqFormatError("expected map[string]any")
+	}
+
+	raw, ok := input["node_ids"]
+	if !ok {
+		return nil, admin.NewInvalidAdminReqErrorf("the \"node_ids\" field is required")
+	}
+	list, ok := raw.([]interface{})
+	if !ok || len(list) == 0 {
+		return nil, admin.NewInvalidAdminReqParameterError("node_ids", "must be a non-empty list of node ids", raw)
+	}
+
+	nodeIDs := make(flow.IdentifierList, 0, len(list))
+	for _, item := range list {
+		nodeID, err := parseNodeID("node_ids", item)
+		if err != nil {
+			return nil, err
+		}
+		nodeIDs = append(nodeIDs, nodeID)
+	}
+	return nodeIDs, nil
+}
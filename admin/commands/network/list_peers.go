@@ -0,0 +1,114 @@
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/admin/commands"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module"
+	"github.com/onflow/flow-go/network/p2p"
+)
+
+var _ commands.AdminCommand = (*ListPeersCommand)(nil)
+
+// peerInfo is the admin representation of a peer the node is connected to.
+type peerInfo struct {
+	PeerID      string   `json:"peer_id"`
+	NodeID      string   `json:"node_id,omitempty"`
+	Role        string   `json:"role,omitempty"`
+	Address     string   `json:"address,omitempty"`
+	Connections int      `json:"connections"`
+	Protocols   []string `json:"protocols,omitempty"`
+	// DisallowListed lists the causes the peer is disallow-listed for, if any.
+	DisallowListed []string `json:"disallow_listed,omitempty"`
+}
+
+// ListPeersCommand lists the peers the node is currently connected to, along with their Flow identity (if known).
+// Input is optional; {"role": "<role>"} restricts the output to peers of the given role.
+type ListPeersCommand struct {
+	libP2PNode p2p.LibP2PNode
+	idProvider module.IdentityProvider
+}
+
+// NewListPeersCommand creates a new ListPeersCommand.
+func NewListPeersCommand(libP2PNode p2p.LibP2PNode, idProvider module.IdentityProvider) *ListPeersCommand {
+	return &ListPeersCommand{
+		libP2PNode: libP2PNode,
+		idProvider: idProvider,
+	}
+}
+
+// Handler lists the connected peers.
+// No errors are expected during normal operation.
+func (l *ListPeersCommand) Handler(_ context.Context, req *admin.CommandRequest) (interface{}, error) {
+	role, filterByRole := req.ValidatorData.(flow.Role)
+
+	net := l.libP2PNode.Host().Network()
+	peerStore := l.libP2PNode.Host().Peerstore()
+
+	peers := make([]peerInfo, 0)
+	for _, pid := range net.Peers() {
+		info := peerInfo{
+			PeerID:      pid.String(),
+			Connections: len(net.ConnsToPeer(pid)),
+		}
+
+		identity, ok := l.idProvider.ByPeerID(pid)
+		if ok {
+			info.NodeID = identity.NodeID.String()
+			info.Role = identity.Role.String()
+			info.Address = identity.Address
+		}
+		if filterByRole && (!ok || identity.Role != role) {
+			continue
+		}
+
+		protocols, err := peerStore.GetProtocols(pid)
+		if err != nil {
+			return nil, fmt.Errorf("could not get protocols of peer %s: %w", pid, err)
+		}
+		for _, p := range protocols {
+			info.Protocols = append(info.Protocols, string(p))
+		}
+
+		causes, _ := l.libP2PNode.IsDisallowListed(pid)
+		for _, cause := range causes {
+			info.DisallowListed = append(info.DisallowListed, cause.String())
+		}
+
+		peers = append(peers, info)
+	}
+
+	return commands.ConvertToInterfaceList(peers)
+}
+
+// Validator validates the optional role filter.
+// Returns admin.InvalidAdminReqError for invalid/malformed requests.
+func (l *ListPeersCommand) Validator(req *admin.CommandRequest) error {
+	if req.Data == nil {
+		return nil
+	}
+
+	input, ok := req.Data.(map[string]interface{})
+	if !ok {
+		return admin.NewInvalidAdminReqFormatError("expected map[string]any")
+	}
+
+	roleRaw, ok := input["role"]
+	if !ok {
+		return nil
+	}
+	roleStr, ok := roleRaw.(string)
+	if !ok {
+		return admin.NewInvalidAdminReqParameterError("role", "must be a string", roleRaw)
+	}
+	role, err := flow.ParseRole(roleStr)
+	if err != nil {
+		return admin.NewInvalidAdminReqParameterError("role", err.Error(), roleRaw)
+	}
+	req.ValidatorData = role
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/admin/commands"
+	"github.com/onflow/flow-go/storage/badger"
+)
+
+var _ commands.AdminCommand = (*GetProtocolDataPruningProgressCommand)(nil)
+
+// GetProtocolDataPruningProgressCommand reports the progress of the protocol data pruner.
+type GetProtocolDataPruningProgressCommand struct {
+	pruner *badger.ProtocolDataPruner
+}
+
+// NewGetProtocolDataPruningProgressCommand creates a new GetProtocolDataPruningProgressCommand object
+func NewGetProtocolDataPruningProgressCommand(pruner *badger.ProtocolDataPruner) *GetProtocolDataPruningProgressCommand {
+	return &GetProtocolDataPruningProgressCommand{
+		pruner: pruner,
+	}
+}
+
+// Handler returns the pruned height, the height targeted by the current (or last) pruning run,
+// the pruner configuration, and whether pruning is currently in progress.
+func (g *GetProtocolDataPruningProgressCommand) Handler(_ context.Context, _ *admin.CommandRequest) (interface{}, error) {
+	progress := g.pruner.Progress()
+
+	return map[string]interface{}{
+		"pruned-height":       progress.PrunedHeight,
+		"target-height":       progress.TargetHeight,
+		"height-range-target": progress.HeightRangeTarget,
+		"threshold":           progress.Threshold,
+		"in-progress":         progress.InProgress,
+	}, nil
+}
+
+// Validator is a no-op, as the command does not take any input.
+func (g *GetProtocolDataPruningProgressCommand) Validator(_ *admin.CommandRequest) error {
+	return nil
+}
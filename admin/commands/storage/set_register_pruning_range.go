@@ -0,0 +1,108 @@
+package storage
+
+import (
+	"context"
+	"math"
+
+	"github.com/rs/zerolog/log"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/admin/commands"
+	"github.com/onflow/flow-go/storage/pebble"
+)
+
+var _ commands.AdminCommand = (*SetRegisterPruningRangeCommand)(nil)
+
+type setRegisterPruningRangeRequest struct {
+	heightRangeTarget *uint64
+	threshold         *uint64
+}
+
+// SetRegisterPruningRangeCommand updates the number of heights retained in the register db
+// by the register pruner.
+type SetRegisterPruningRangeCommand struct {
+	pruner *pebble.RegisterPruner
+}
+
+// NewSetRegisterPruningRangeCommand creates a new SetRegisterPruningRangeCommand object
+func NewSetRegisterPruningRangeCommand(pruner *pebble.RegisterPruner) *SetRegisterPruningRangeCommand {
+	return &SetRegisterPruningRangeCommand{
+		pruner: pruner,
+	}
+}
+
+// Handler updates the pruner's height range target and/or threshold.
+// Returns the resulting pruner configuration.
+func (s *SetRegisterPruningRangeCommand) Handler(_ context.Context, req *admin.CommandRequest) (interface{}, error) {
+	data := req.ValidatorData.(*setRegisterPruningRangeRequest)
+
+	oldHeightRangeTarget := s.pruner.HeightRangeTarget()
+	oldThreshold := s.pruner.Threshold()
+
+	if data.heightRangeTarget != nil {
+		s.pruner.SetHeightRangeTarget(*data.heightRangeTarget)
+	}
+	if data.threshold != nil {
+		s.pruner.SetThreshold(*data.threshold)
+	}
+
+	log.Info().
+		Uint64("old_height_range_target", oldHeightRangeTarget).
+		Uint64("old_threshold", oldThreshold).
+		Uint64("height_range_target", s.pruner.HeightRangeTarget()).
+		Uint64("threshold", s.pruner.Threshold()).
+		Msg("admintool: register db pruning range updated")
+
+	return map[string]interface{}{
+		"height-range-target": s.pruner.HeightRangeTarget(),
+		"threshold":           s.pruner.Threshold(),
+	}, nil
+}
+
+// Validator validates the request.
+// It expects at least one of the following fields in the Data field of the req object:
+//   - height-range-target, a non-negative integer
+//   - threshold, a non-negative integer
+//
+// Returns admin.InvalidAdminReqError for invalid/malformed requests.
+func (s *SetRegisterPruningRangeCommand) Validator(req *admin.CommandRequest) error {
+	input, ok := req.Data.(map[string]interface{})
+	if !ok {
+		return admin.NewInvalidAdminReqFormatError("expected map[string]any")
+	}
+
+	data := &setRegisterPruningRangeRequest{}
+
+	if raw, ok := input["height-range-target"]; ok {
+		value, err := parseNonNegativeInt("height-range-target", raw)
+		if err != nil {
+			return err
+		}
+		data.heightRangeTarget = &value
+	}
+
+	if raw, ok := input["threshold"]; ok {
+		value, err := parseNonNegativeInt("threshold", raw)
+		if err != nil {
+			return err
+		}
+		data.threshold = &value
+	}
+
+	if data.heightRangeTarget == nil && data.threshold == nil {
+		return admin.NewInvalidAdminReqErrorf("at least one of 'height-range-target' or 'threshold' must be provided")
+	}
+
+	req.ValidatorData = data
+	return nil
+}
+
+// parseNonNegativeInt verifies that the input is an integral float64 value >=0.
+// Returns admin.InvalidAdminReqError if the value is invalid.
+func parseNonNegativeInt(field string, raw interface{}) (uint64, error) {
+	value, ok := raw.(float64)
+	if !ok || math.Trunc(value) != value || value < 0 {
+		return 0, admin.NewInvalidAdminReqParameterError(field, "must be a non-negative integer", raw)
+	}
+	return uint64(value), nil
+}
@@ -0,0 +1,68 @@
+package storage
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/admin"
+	"github.com/onflow/flow-go/module/metrics"
+	"github.com/onflow/flow-go/storage/pebble"
+)
+
+func TestSetRegisterPruningRange(t *testing.T) {
+	pruner := pebble.NewRegisterPruner(
+		zerolog.Nop(),
+		metrics.NewNoopCollector(),
+		pebble.WithRegisterPruningHeightRangeTarget(100),
+		pebble.WithRegisterPruningThreshold(10),
+	)
+	cmd := NewSetRegisterPruningRangeCommand(pruner)
+
+	t.Run("happy path", func(t *testing.T) {
+		req := &admin.CommandRequest{
+			Data: map[string]interface{}{
+				"height-range-target": float64(50), // raw json parses to float64
+			},
+		}
+		require.NoError(t, cmd.Validator(req))
+
+		result, err := cmd.Handler(context.Background(), req)
+		require.NoError(t, err)
+		require.Equal(t, map[string]interface{}{
+			"height-range-target": uint64(50),
+			"threshold":           uint64(10),
+		}, result)
+
+		req = &admin.CommandRequest{
+			Data: map[string]interface{}{
+				"height-range-target": float64(60),
+				"threshold":           float64(0),
+			},
+		}
+		require.NoError(t, cmd.Validator(req))
+
+		_, err = cmd.Handler(context.Background(), req)
+		require.NoError(t, err)
+		require.Equal(t, uint64(60), pruner.HeightRangeTarget())
+		require.Equal(t, uint64(0), pruner.Threshold())
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		invalid := []interface{}{
+			"abc",
+			map[string]interface{}{},
+			map[string]interface{}{"height-range-target": "abc"},
+			map[string]interface{}{"height-range-target": float64(-1)},
+			map[string]interface{}{"height-range-target": float64(1.5)},
+			map[string]interface{}{"threshold": true},
+		}
+		for _, data := range invalid {
+			err := cmd.Validator(&admin.CommandRequest{Data: data})
+			require.Error(t, err)
+			require.True(t, admin.IsInvalidAdminParameterError(err), "data: %v", data)
+		}
+	})
+}
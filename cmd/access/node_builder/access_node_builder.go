@@ -23,6 +23,7 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 
+	accessNode "github.com/onflow/flow-go/access"
 	"github.com/onflow/flow-go/admin/commands"
 	stateSyncCommands "github.com/onflow/flow-go/admin/commands/state_synchronization"
 	storageCommands "github.com/onflow/flow-go/admin/commands/storage"
@@ -37,6 +38,7 @@ import (
 	"github.com/onflow/flow-go/consensus/hotstuff/verification"
 	recovery "github.com/onflow/flow-go/consensus/recovery/protocol"
 	"github.com/onflow/flow-go/engine"
+	"github.com/onflow/flow-go/engine/access/eth"
 	"github.com/onflow/flow-go/engine/access/index"
 	"github.com/onflow/flow-go/engine/access/ingestion"
 	pingeng "github.com/onflow/flow-go/engine/access/ping"
@@ -134,6 +136,7 @@ type AccessNodeConfig struct {
 	rpcConf                           rpc.Config
 	stateStreamConf                   statestreambackend.Config
 	stateStreamFilterConf             map[string]int
+	ethRPCConf                        eth.Config
 	ExecutionNodeAddress              string // deprecated
 	HistoricalAccessRPCs              []access.AccessAPIClient
 	logTxTimeToFinalized              bool
@@ -158,6 +161,9 @@ type AccessNodeConfig struct {
 	registerCacheType                 string
 	registerCacheSize                 uint
 	programCacheSize                  uint
+	registerDBPruningEnabled          bool
+	registerDBPruningHeightRange      uint64
+	registerDBPruningThreshold        uint64
 }
 
 type PublicNetworkConfig struct {
@@ -193,15 +199,18 @@ func DefaultAccessNodeConfig() *AccessNodeConfig {
 					MaxFailures:    5,
 					MaxRequests:    1,
 				},
-				ScriptExecutionMode: backend.IndexQueryModeExecutionNodesOnly.String(), // default to ENs only for now
-				EventQueryMode:      backend.IndexQueryModeExecutionNodesOnly.String(), // default to ENs only for now
-				TxResultQueryMode:   backend.IndexQueryModeExecutionNodesOnly.String(), // default to ENs only for now
+				ScriptExecutionMode:   backend.IndexQueryModeExecutionNodesOnly.String(), // default to ENs only for now
+				EventQueryMode:        backend.IndexQueryModeExecutionNodesOnly.String(), // default to ENs only for now
+				TxResultQueryMode:     backend.IndexQueryModeExecutionNodesOnly.String(), // default to ENs only for now
+				CheckPayerBalanceMode: accessNode.Disabled.String(),
 			},
 			RestConfig: rest.Config{
 				ListenAddress: "",
 				WriteTimeout:  rest.DefaultWriteTimeout,
 				ReadTimeout:   rest.DefaultReadTimeout,
 				IdleTimeout:   rest.DefaultIdleTimeout,
+
+				MaxWebSocketSubscriptionsPerConnection: rest.DefaultMaxWebSocketSubscriptionsPerConnection,
 			},
 			MaxMsgSize:     grpcutils.DefaultMaxMsgSize,
 			CompressorName: grpcutils.NoCompressor,
@@ -218,6 +227,7 @@ func DefaultAccessNodeConfig() *AccessNodeConfig {
 			HeartbeatInterval:       subscription.DefaultHeartbeatInterval,
 		},
 		stateStreamFilterConf:        nil,
+		ethRPCConf:                   eth.DefaultConfig(),
 		ExecutionNodeAddress:         "localhost:9000",
 		logTxTimeToFinalized:         false,
 		logTxTimeToExecuted:          false,
@@ -255,6 +265,9 @@ func DefaultAccessNodeConfig() *AccessNodeConfig {
 		registerCacheType:            pStorage.CacheTypeTwoQueue.String(),
 		registerCacheSize:            0,
 		programCacheSize:             0,
+		registerDBPruningEnabled:     false,
+		registerDBPruningHeightRange: pStorage.DefaultRegisterPruningHeightRangeTarget,
+		registerDBPruningThreshold:   pStorage.DefaultRegisterPruningThreshold,
 	}
 }
 
@@ -293,8 +306,11 @@ type FlowAccessNodeBuilder struct {
 	ExecutionIndexerCore       *indexer.IndexerCore
 	ScriptExecutor             *backend.ScriptExecutor
 	RegistersAsyncStore        *execution.RegistersAsyncStore
+	RegisterPruner             *pStorage.RegisterPruner
 	EventsIndex                *index.EventsIndex
+	EventLookupIndex           *index.EventLookupIndex
 	TxResultsIndex             *index.TransactionResultsIndex
+	AccountTxsIndex            *index.AccountTransactionsIndex
 	IndexerDependencies        *cmd.DependencyList
 	collectionExecutedMetric   module.CollectionExecutedMetric
 
@@ -721,6 +737,9 @@ func (builder *FlowAccessNodeBuilder) BuildExecutionSyncComponents() *FlowAccess
 	if builder.executionDataIndexingEnabled {
 		var indexedBlockHeight storage.ConsumerProgress
 
+		// setup dependency chain to ensure the register db pruner starts after the indexer
+		indexerDependable := module.NewProxiedReadyDoneAware()
+
 		builder.
 			AdminCommand("execute-script", func(config *cmd.NodeConfig) commands.AdminCommand {
 				return stateSyncCommands.NewExecuteScriptCommand(builder.ScriptExecutor)
@@ -824,6 +843,8 @@ func (builder *FlowAccessNodeBuilder) BuildExecutionSyncComponents() *FlowAccess
 					builder.Storage.RegisterIndex,
 					builder.Storage.Headers,
 					builder.Storage.Events,
+					builder.Storage.EventLookups,
+					builder.Storage.AccountTransactions,
 					builder.Storage.Collections,
 					builder.Storage.Transactions,
 					builder.Storage.LightTransactionResults,
@@ -865,6 +886,7 @@ func (builder *FlowAccessNodeBuilder) BuildExecutionSyncComponents() *FlowAccess
 					builder.scriptExecutorConfig,
 					queryDerivedChainData,
 					builder.programCacheSize > 0,
+					builder.FvmOptions,
 				)
 
 				err = builder.ScriptExecutor.Initialize(builder.ExecutionIndexer, scripts)
@@ -877,6 +899,16 @@ func (builder *FlowAccessNodeBuilder) BuildExecutionSyncComponents() *FlowAccess
 					return nil, err
 				}
 
+				err = builder.EventLookupIndex.Initialize(builder.ExecutionIndexer)
+				if err != nil {
+					return nil, err
+				}
+
+				err = builder.AccountTxsIndex.Initialize(builder.ExecutionIndexer)
+				if err != nil {
+					return nil, err
+				}
+
 				err = builder.TxResultsIndex.Initialize(builder.ExecutionIndexer)
 				if err != nil {
 					return nil, err
@@ -887,8 +919,36 @@ func (builder *FlowAccessNodeBuilder) BuildExecutionSyncComponents() *FlowAccess
 					return nil, err
 				}
 
+				if builder.registerDBPruningEnabled {
+					err = builder.RegisterPruner.Initialize(registers)
+					if err != nil {
+						return nil, err
+					}
+				}
+
+				indexerDependable.Init(builder.ExecutionIndexer)
+
 				return builder.ExecutionIndexer, nil
 			}, builder.IndexerDependencies)
+
+		if builder.registerDBPruningEnabled {
+			builder.
+				AdminCommand("set-register-pruning-range", func(config *cmd.NodeConfig) commands.AdminCommand {
+					return storageCommands.NewSetRegisterPruningRangeCommand(builder.RegisterPruner)
+				}).
+				Module("register db pruner", func(node *cmd.NodeConfig) error {
+					builder.RegisterPruner = pStorage.NewRegisterPruner(
+						node.Logger,
+						metrics.NewRegisterDBPrunerCollector(),
+						pStorage.WithRegisterPruningHeightRangeTarget(builder.registerDBPruningHeightRange),
+						pStorage.WithRegisterPruningThreshold(builder.registerDBPruningThreshold),
+					)
+					return nil
+				}).
+				DependableComponent("register db pruner", func(node *cmd.NodeConfig) (module.ReadyDoneAware, error) {
+					return builder.RegisterPruner, nil
+				}, cmd.NewDependencyList(indexerDependable))
+		}
 	}
 
 	if builder.stateStreamConf.ListenAddr != "" {
@@ -980,6 +1040,23 @@ func (builder *FlowAccessNodeBuilder) BuildExecutionSyncComponents() *FlowAccess
 		})
 	}
 
+	if builder.ethRPCConf.ListenAddress != "" {
+		builder.Component("eth json-rpc server", func(node *cmd.NodeConfig) (module.ReadyDoneAware, error) {
+			api, err := eth.NewAPI(
+				node.Logger,
+				builder.ethRPCConf,
+				node.RootChainID,
+				builder.RegistersAsyncStore,
+				builder.EventLookupIndex,
+			)
+			if err != nil {
+				return nil, fmt.Errorf("could not create eth json-rpc api: %w", err)
+			}
+
+			return eth.NewServer(node.Logger, builder.ethRPCConf, api)
+		})
+	}
+
 	return builder
 }
 
@@ -1063,6 +1140,10 @@ func (builder *FlowAccessNodeBuilder) extraFlags() {
 			defaultConfig.rpcConf.RestConfig.ReadTimeout,
 			"timeout to use when reading REST request headers")
 		flags.DurationVar(&builder.rpcConf.RestConfig.IdleTimeout, "rest-idle-timeout", defaultConfig.rpcConf.RestConfig.IdleTimeout, "idle timeout for REST connections")
+		flags.Uint64Var(&builder.rpcConf.RestConfig.MaxWebSocketSubscriptionsPerConnection,
+			"rest-max-websocket-subscriptions-per-connection",
+			defaultConfig.rpcConf.RestConfig.MaxWebSocketSubscriptionsPerConnection,
+			"maximum number of subscriptions a single connection to the multiplexed REST WebSocket endpoint may have open at the same time (0 means no limit)")
 		flags.StringVarP(&builder.rpcConf.CollectionAddr,
 			"static-collection-ingress-addr",
 			"",
@@ -1221,6 +1302,22 @@ func (builder *FlowAccessNodeBuilder) extraFlags() {
 			"execution-data-indexing-enabled",
 			defaultConfig.executionDataIndexingEnabled,
 			"whether to enable the execution data indexing")
+		flags.StringVar(&builder.ethRPCConf.ListenAddress,
+			"eth-rpc-addr",
+			defaultConfig.ethRPCConf.ListenAddress,
+			"the address the Ethereum JSON-RPC server for the EVM listens on. requires execution data indexing. disabled if empty")
+		flags.Uint64Var(&builder.ethRPCConf.MaxLogsHeightRange,
+			"eth-rpc-max-logs-height-range",
+			defaultConfig.ethRPCConf.MaxLogsHeightRange,
+			"maximum number of blocks searched by a single eth_getLogs request")
+		flags.Uint64Var(&builder.ethRPCConf.ReceiptLookbackHeights,
+			"eth-rpc-receipt-lookback-heights",
+			defaultConfig.ethRPCConf.ReceiptLookbackHeights,
+			"number of blocks below the highest indexed height searched for transaction receipts")
+		flags.Uint64Var(&builder.ethRPCConf.CallGasLimit,
+			"eth-rpc-call-gas-limit",
+			defaultConfig.ethRPCConf.CallGasLimit,
+			"maximum gas available to eth_call")
 		flags.StringVar(&builder.registersDBPath, "execution-state-dir", defaultConfig.registersDBPath, "directory to use for execution-state database")
 		flags.StringVar(&builder.checkpointFile, "execution-state-checkpoint", defaultConfig.checkpointFile, "execution-state checkpoint file")
 
@@ -1263,6 +1360,10 @@ func (builder *FlowAccessNodeBuilder) extraFlags() {
 			"script-execution-max-height",
 			defaultConfig.scriptExecMaxBlock,
 			"highest block height to allow for script execution. default: no limit")
+		flags.StringVar(&builder.rpcConf.BackendConfig.CheckPayerBalanceMode,
+			"check-payer-balance-mode",
+			defaultConfig.rpcConf.BackendConfig.CheckPayerBalanceMode,
+			"flag for payer balance validation that specifies whether or not to enforce the balance check. one of [disabled(default), warn, enforce]")
 		flags.StringVar(&builder.registerCacheType,
 			"register-cache-type",
 			defaultConfig.registerCacheType,
@@ -1275,6 +1376,20 @@ func (builder *FlowAccessNodeBuilder) extraFlags() {
 			"program-cache-size",
 			defaultConfig.programCacheSize,
 			"[experimental] number of blocks to cache for cadence programs. use 0 to disable cache. default: 0. Note: this is an experimental feature and may cause nodes to become unstable under certain workloads. Use with caution.")
+
+		// Register DB pruning
+		flags.BoolVar(&builder.registerDBPruningEnabled,
+			"registerdb-pruning-enabled",
+			defaultConfig.registerDBPruningEnabled,
+			"whether to enable pruning of old register versions from the register db")
+		flags.Uint64Var(&builder.registerDBPruningHeightRange,
+			"registerdb-pruning-height-range-target",
+			defaultConfig.registerDBPruningHeightRange,
+			"number of most recent heights for which registers are retained when pruning the register db")
+		flags.Uint64Var(&builder.registerDBPruningThreshold,
+			"registerdb-pruning-threshold",
+			defaultConfig.registerDBPruningThreshold,
+			"number of heights the register db may exceed the height range target by before pruning is triggered")
 	}).ValidateFlags(func() error {
 		if builder.supportsObserver && (builder.PublicNetworkConfig.BindAddress == cmd.NotSet || builder.PublicNetworkConfig.BindAddress == "") {
 			return errors.New("public-network-address must be set if supports-observer is true")
@@ -1337,6 +1452,24 @@ func (builder *FlowAccessNodeBuilder) extraFlags() {
 		if builder.TxErrorMessagesCacheSize == 0 {
 			return errors.New("transaction-error-messages-cache-size must be greater than 0")
 		}
+		checkPayerBalanceMode, err := accessNode.ParsePayerBalanceMode(builder.rpcConf.BackendConfig.CheckPayerBalanceMode)
+		if err != nil {
+			return fmt.Errorf("could not parse check-payer-balance-mode: %w", err)
+		}
+		if checkPayerBalanceMode != accessNode.Disabled && !builder.executionDataIndexingEnabled {
+			return errors.New("execution-data-indexing-enabled must be set if check-payer-balance-mode is enabled")
+		}
+		if builder.ethRPCConf.ListenAddress != "" {
+			if !builder.executionDataIndexingEnabled {
+				return errors.New("execution-data-indexing-enabled must be set if eth-rpc-addr is set")
+			}
+			if builder.ethRPCConf.MaxLogsHeightRange == 0 {
+				return errors.New("eth-rpc-max-logs-height-range must be greater than 0")
+			}
+			if builder.ethRPCConf.CallGasLimit == 0 {
+				return errors.New("eth-rpc-call-gas-limit must be greater than 0")
+			}
+		}
 
 		return nil
 	})
@@ -1540,6 +1673,7 @@ func (builder *FlowAccessNodeBuilder) Build() (cmd.Node, error) {
 		Module("access metrics", func(node *cmd.NodeConfig) error {
 			builder.AccessMetrics = metrics.NewAccessCollector(
 				metrics.WithTransactionMetrics(builder.TransactionMetrics),
+				metrics.WithTransactionValidationMetrics(metrics.NewTransactionValidationCollector()),
 				metrics.WithBackendScriptsMetrics(builder.TransactionMetrics),
 				metrics.WithRestMetrics(builder.RestMetrics),
 			)
@@ -1618,16 +1752,23 @@ func (builder *FlowAccessNodeBuilder) Build() (cmd.Node, error) {
 		}).
 		Module("events storage", func(node *cmd.NodeConfig) error {
 			builder.Storage.Events = bstorage.NewEvents(node.Metrics.Cache, node.DB)
+			builder.Storage.EventLookups = bstorage.NewEventLookups(node.DB)
 			return nil
 		}).
 		Module("events index", func(node *cmd.NodeConfig) error {
 			builder.EventsIndex = index.NewEventsIndex(builder.Storage.Events)
+			builder.EventLookupIndex = index.NewEventLookupIndex(builder.Storage.EventLookups)
 			return nil
 		}).
 		Module("transaction result index", func(node *cmd.NodeConfig) error {
 			builder.TxResultsIndex = index.NewTransactionResultsIndex(builder.Storage.LightTransactionResults)
 			return nil
 		}).
+		Module("account transactions index", func(node *cmd.NodeConfig) error {
+			builder.Storage.AccountTransactions = bstorage.NewAccountTransactions(node.DB)
+			builder.AccountTxsIndex = index.NewAccountTransactionsIndex(builder.Storage.AccountTransactions)
+			return nil
+		}).
 		Module("processed block height consumer progress", func(node *cmd.NodeConfig) error {
 			processedBlockHeight = bstorage.NewConsumerProgress(builder.DB, module.ConsumeProgressIngestionEngineBlockHeight)
 			return nil
@@ -1703,6 +1844,17 @@ func (builder *FlowAccessNodeBuilder) Build() (cmd.Node, error) {
 			if err != nil {
 				return nil, fmt.Errorf("failed to initialize block tracker: %w", err)
 			}
+			checkPayerBalanceMode, err := accessNode.ParsePayerBalanceMode(config.BackendConfig.CheckPayerBalanceMode)
+			if err != nil {
+				return nil, fmt.Errorf("could not parse payer balance mode: %w", err)
+			}
+
+			// the events index reports the heights indexed by the execution state indexer
+			var indexReporter state_synchronization.IndexReporter
+			if builder.executionDataIndexingEnabled {
+				indexReporter = builder.EventsIndex
+			}
+
 			txResultQueryMode, err := backend.ParseIndexQueryMode(config.BackendConfig.TxResultQueryMode)
 			if err != nil {
 				return nil, fmt.Errorf("could not parse transaction result query mode: %w", err)
@@ -1745,14 +1897,26 @@ func (builder *FlowAccessNodeBuilder) Build() (cmd.Node, error) {
 					builder.stateStreamConf.ClientSendBufferSize,
 				),
 				EventsIndex:         builder.EventsIndex,
+				EventLookupIndex:    builder.EventLookupIndex,
 				TxResultQueryMode:   txResultQueryMode,
 				TxResultsIndex:      builder.TxResultsIndex,
+				AccountTxsIndex:     builder.AccountTxsIndex,
 				LastFullBlockHeight: lastFullBlockHeight,
+				IndexReporter:       indexReporter,
+
+				CheckPayerBalanceMode: checkPayerBalanceMode,
 			})
 			if err != nil {
 				return nil, fmt.Errorf("could not initialize backend: %w", err)
 			}
 
+			// the state stream backend is nil if state streaming is disabled. It must be passed as a nil
+			// interface rather than a nil pointer, for the REST server to detect that it is disabled.
+			var stateStreamApi state_stream.API
+			if builder.stateStreamBackend != nil {
+				stateStreamApi = builder.stateStreamBackend
+			}
+
 			engineBuilder, err := rpc.NewBuilder(
 				node.Logger,
 				node.State,
@@ -1765,7 +1929,7 @@ func (builder *FlowAccessNodeBuilder) Build() (cmd.Node, error) {
 				nodeBackend,
 				builder.secureGrpcServer,
 				builder.unsecureGrpcServer,
-				builder.stateStreamBackend,
+				stateStreamApi,
 				builder.stateStreamConf,
 			)
 			if err != nil {
@@ -1919,6 +2083,13 @@ func (builder *FlowAccessNodeBuilder) enqueuePublicNetworkInit() {
 				return nil, fmt.Errorf("could not register networking receive cache metric: %w", err)
 			}
 
+			networkOptions := []underlay.NetworkOption{underlay.WithMessageValidators(msgValidators...)}
+			captureOptions, err := builder.MessageCaptureOptions()
+			if err != nil {
+				return nil, err
+			}
+			networkOptions = append(networkOptions, captureOptions...)
+
 			net, err := underlay.NewNetwork(&underlay.NetworkConfig{
 				Logger:                builder.Logger.With().Str("module", "public-network").Logger(),
 				Libp2pNode:            publicLibp2pNode,
@@ -1939,6 +2110,9 @@ func (builder *FlowAccessNodeBuilder) enqueuePublicNetworkInit() {
 					SpamReportQueueSize:     builder.FlowConfig.NetworkConfig.AlspConfig.SpamReportQueueSize,
 					DisablePenalty:          builder.FlowConfig.NetworkConfig.AlspConfig.DisablePenalty,
 					HeartBeatInterval:       builder.FlowConfig.NetworkConfig.AlspConfig.HearBeatInterval,
+					SnapshotDir:             builder.FlowConfig.NetworkConfig.AlspConfig.SpamRecordSnapshotDir,
+					SnapshotInterval:        builder.FlowConfig.NetworkConfig.AlspConfig.SpamRecordSnapshotInterval,
+					IdentityProvider:        builder.IdentityProvider,
 					AlspMetrics:             builder.Metrics.Network,
 					NetworkType:             network.PublicNetwork,
 					HeroCacheMetricsFactory: builder.HeroCacheMetricsFactory(),
@@ -1946,7 +2120,7 @@ func (builder *FlowAccessNodeBuilder) enqueuePublicNetworkInit() {
 				SlashingViolationConsumerFactory: func(adapter network.ConduitAdapter) network.ViolationsConsumer {
 					return slashing.NewSlashingViolationsConsumer(builder.Logger, builder.Metrics.Network, adapter)
 				},
-			}, underlay.WithMessageValidators(msgValidators...))
+			}, networkOptions...)
 			if err != nil {
 				return nil, fmt.Errorf("could not initialize network: %w", err)
 			}
@@ -1985,7 +2159,7 @@ func (builder *FlowAccessNodeBuilder) initPublicLibp2pNode(networkKey crypto.Pri
 	if err != nil {
 		return nil, fmt.Errorf("could not create connection manager: %w", err)
 	}
-	libp2pNode, err := p2pbuilder.NewNodeBuilder(builder.Logger, &builder.FlowConfig.NetworkConfig.GossipSub, &p2pbuilderconfig.MetricsConfig{
+	nodeBuilder := p2pbuilder.NewNodeBuilder(builder.Logger, &builder.FlowConfig.NetworkConfig.GossipSub, &p2pbuilderconfig.MetricsConfig{
 		HeroCacheFactory: builder.HeroCacheMetricsFactory(),
 		Metrics:          networkMetrics,
 	},
@@ -2014,8 +2188,12 @@ func (builder *FlowAccessNodeBuilder) initPublicLibp2pNode(networkKey crypto.Pri
 		SetConnectionManager(connManager).
 		SetRoutingSystem(func(ctx context.Context, h host.Host) (routing.Routing, error) {
 			return dht.NewDHT(ctx, h, protocols.FlowPublicDHTProtocolID(builder.SporkID), builder.Logger, networkMetrics, dht.AsServer())
-		}).
-		Build()
+		})
+	if builder.FlowConfig.NetworkConfig.QUICTransportEnabled {
+		nodeBuilder.EnableQUICTransport()
+	}
+
+	libp2pNode, err := nodeBuilder.Build()
 	if err != nil {
 		return nil, fmt.Errorf("could not build libp2p node for staked access node: %w", err)
 	}
@@ -76,6 +76,9 @@ func main() {
 		hotstuffProposalDuration          time.Duration
 		startupTimeString                 string
 		startupTime                       time.Time
+		protocolDBPruningEnabled          bool
+		protocolDBPruningHeightRange      uint64
+		protocolDBPruningThreshold        uint64
 
 		mainConsensusCommittee  *committees.Consensus
 		followerState           protocol.FollowerState
@@ -176,6 +179,9 @@ func main() {
 		flags.Float64Var(&txRatelimits, "ingest-tx-rate-limits", 2.5, "per second rate limits for processing transactions for limited account")
 		flags.IntVar(&txBurstlimits, "ingest-tx-burst-limits", 2, "burst limits for processing transactions for limited account")
 		flags.StringVar(&txRatelimitPayers, "ingest-tx-rate-limit-payers", "", "comma separated list of accounts to apply rate limiting to")
+		flags.BoolVar(&protocolDBPruningEnabled, "protocol-db-pruning-enabled", false, "whether to enable pruning of finalized blocks and their indexes from the protocol database")
+		flags.Uint64Var(&protocolDBPruningHeightRange, "protocol-db-pruning-height-range-target", badger.DefaultProtocolDataPruningHeightRangeTarget, "number of most recent finalized heights to retain in the protocol database. blocks within the sealing segment of the latest finalized block are always retained")
+		flags.Uint64Var(&protocolDBPruningThreshold, "protocol-db-pruning-threshold", badger.DefaultProtocolDataPruningThreshold, "number of heights the retained range may exceed the height range target by before pruning is triggered")
 
 		// deprecated flags
 		flags.DurationVar(&deprecatedFlagBlockRateDelay, "block-rate-delay", 0, "the delay to broadcast block proposal in order to control block production rate")
@@ -640,6 +646,28 @@ func main() {
 			return manager, err
 		})
 
+	if protocolDBPruningEnabled {
+		var protocolDataPruner *badger.ProtocolDataPruner
+		nodeBuilder.
+			AdminCommand("get-protocol-data-pruning-progress", func(config *cmd.NodeConfig) commands.AdminCommand {
+				return storageCommands.NewGetProtocolDataPruningProgressCommand(protocolDataPruner)
+			}).
+			Module("protocol data pruner", func(node *cmd.NodeConfig) error {
+				protocolDataPruner, err = badger.NewProtocolDataPruner(
+					node.Logger,
+					node.DB,
+					node.State,
+					badger.WithProtocolDataPruningHeightRangeTarget(protocolDBPruningHeightRange),
+					badger.WithProtocolDataPruningThreshold(protocolDBPruningThreshold),
+					badger.WithProtocolDataPruningCaches(node.Storage),
+				)
+				return err
+			}).
+			Component("protocol data pruner", func(node *cmd.NodeConfig) (module.ReadyDoneAware, error) {
+				return protocolDataPruner, nil
+			})
+	}
+
 	node, err := nodeBuilder.Build()
 	if err != nil {
 		nodeBuilder.Logger.Fatal().Err(err).Send()
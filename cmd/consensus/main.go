@@ -12,6 +12,8 @@ import (
 
 	client "github.com/onflow/flow-go-sdk/access/grpc"
 	"github.com/onflow/flow-go-sdk/crypto"
+	"github.com/onflow/flow-go/admin/commands"
+	storageCommands "github.com/onflow/flow-go/admin/commands/storage"
 	"github.com/onflow/flow-go/cmd"
 	"github.com/onflow/flow-go/cmd/util/cmd/common"
 	"github.com/onflow/flow-go/consensus"
@@ -96,6 +98,9 @@ func main() {
 		cruiseCtlEnabledFlag                  bool
 		startupTimeString                     string
 		startupTime                           time.Time
+		protocolDBPruningEnabled              bool
+		protocolDBPruningHeightRange          uint64
+		protocolDBPruningThreshold            uint64
 
 		// DKG contract client
 		machineAccountInfo *bootstrap.NodeMachineAccountInfo
@@ -163,6 +168,9 @@ func main() {
 		flags.Uint64Var(&dkgMessagingEngineConfig.RetryJitterPercent, "dkg-messaging-engine-retry-jitter-percent", dkgMessagingEngineConfig.RetryJitterPercent, "the percentage of jitter to apply to each inter-attempt wait time")
 		flags.StringVar(&startupTimeString, "hotstuff-startup-time", cmd.NotSet, "specifies date and time (in ISO 8601 format) after which the consensus participant may enter the first view (e.g 1996-04-24T15:04:05-07:00)")
 		flags.DurationVar(&deprecatedFlagBlockRateDelay, "block-rate-delay", 0, "[deprecated in v0.30; Jun 2023] Use `cruise-ctl-*` flags instead, this flag has no effect and will eventually be removed")
+		flags.BoolVar(&protocolDBPruningEnabled, "protocol-db-pruning-enabled", false, "whether to enable pruning of finalized blocks and their indexes from the protocol database")
+		flags.Uint64Var(&protocolDBPruningHeightRange, "protocol-db-pruning-height-range-target", bstorage.DefaultProtocolDataPruningHeightRangeTarget, "number of most recent finalized heights to retain in the protocol database. blocks within the sealing segment of the latest finalized block are always retained")
+		flags.Uint64Var(&protocolDBPruningThreshold, "protocol-db-pruning-threshold", bstorage.DefaultProtocolDataPruningThreshold, "number of heights the retained range may exceed the height range target by before pruning is triggered")
 	}).ValidateFlags(func() error {
 		nodeBuilder.Logger.Info().Str("startup_time_str", startupTimeString).Msg("got startup_time_str")
 		if startupTimeString != cmd.NotSet {
@@ -926,6 +934,28 @@ func main() {
 			return reactorEngine, nil
 		})
 
+	if protocolDBPruningEnabled {
+		var protocolDataPruner *bstorage.ProtocolDataPruner
+		nodeBuilder.
+			AdminCommand("get-protocol-data-pruning-progress", func(config *cmd.NodeConfig) commands.AdminCommand {
+				return storageCommands.NewGetProtocolDataPruningProgressCommand(protocolDataPruner)
+			}).
+			Module("protocol data pruner", func(node *cmd.NodeConfig) error {
+				protocolDataPruner, err = bstorage.NewProtocolDataPruner(
+					node.Logger,
+					node.DB,
+					node.State,
+					bstorage.WithProtocolDataPruningHeightRangeTarget(protocolDBPruningHeightRange),
+					bstorage.WithProtocolDataPruningThreshold(protocolDBPruningThreshold),
+					bstorage.WithProtocolDataPruningCaches(node.Storage),
+				)
+				return err
+			}).
+			Component("protocol data pruner", func(node *cmd.NodeConfig) (module.ReadyDoneAware, error) {
+				return protocolDataPruner, nil
+			})
+	}
+
 	node, err := nodeBuilder.Build()
 	if err != nil {
 		nodeBuilder.Logger.Fatal().Err(err).Send()
@@ -47,6 +47,7 @@ import (
 	"github.com/onflow/flow-go/engine/common/provider"
 	"github.com/onflow/flow-go/engine/common/requester"
 	"github.com/onflow/flow-go/engine/common/synchronization"
+	"github.com/onflow/flow-go/engine/execution"
 	"github.com/onflow/flow-go/engine/execution/checker"
 	"github.com/onflow/flow-go/engine/execution/computation"
 	"github.com/onflow/flow-go/engine/execution/computation/committer"
@@ -56,12 +57,14 @@ import (
 	"github.com/onflow/flow-go/engine/execution/ingestion/stop"
 	"github.com/onflow/flow-go/engine/execution/ingestion/uploader"
 	exeprovider "github.com/onflow/flow-go/engine/execution/provider"
+	"github.com/onflow/flow-go/engine/execution/reexecution"
 	"github.com/onflow/flow-go/engine/execution/rpc"
 	"github.com/onflow/flow-go/engine/execution/scripts"
 	"github.com/onflow/flow-go/engine/execution/state"
 	"github.com/onflow/flow-go/engine/execution/state/bootstrap"
 	"github.com/onflow/flow-go/engine/execution/storehouse"
 	"github.com/onflow/flow-go/fvm"
+	"github.com/onflow/flow-go/fvm/evm/debug"
 	"github.com/onflow/flow-go/fvm/storage/snapshot"
 	"github.com/onflow/flow-go/fvm/systemcontracts"
 	ledgerpkg "github.com/onflow/flow-go/ledger"
@@ -138,9 +141,11 @@ type ExecutionNode struct {
 	committee              hotstuff.DynamicCommittee
 	ledgerStorage          *ledger.Ledger
 	registerStore          *storehouse.RegisterStore
+	registerDiskStore      *storagepebble.Registers
 	events                 *storage.Events
 	serviceEvents          *storage.ServiceEvents
 	txResults              *storage.TransactionResults
+	txProfiles             *storage.TransactionProfiles
 	results                *storage.ExecutionResults
 	myReceipts             *storage.MyExecutionReceipts
 	providerEngine         exeprovider.ProviderEngine
@@ -159,12 +164,14 @@ type ExecutionNode struct {
 	executionDataStore     execution_data.ExecutionDataStore
 	toTriggerCheckpoint    *atomic.Bool      // create the checkpoint trigger to be controlled by admin tool, and listened by the compactor
 	stopControl            *stop.StopControl // stop the node at given block height
+	reexecutor             *reexecution.Reexecutor
 	executionDataDatastore *badger.Datastore
 	executionDataPruner    *pruner.Pruner
 	executionDataBlobstore blobs.Blobstore
 	executionDataTracker   tracker.Storage
 	blobService            network.BlobService
 	blobserviceDependable  *module.ProxiedReadyDoneAware
+	evmTracer              *debug.Tracer
 }
 
 func (builder *ExecutionNodeBuilder) LoadComponentsAndModules() {
@@ -186,6 +193,15 @@ func (builder *ExecutionNodeBuilder) LoadComponentsAndModules() {
 		AdminCommand("stop-at-height", func(config *NodeConfig) commands.AdminCommand {
 			return executionCommands.NewStopAtHeightCommand(exeNode.stopControl)
 		}).
+		AdminCommand("schedule-stop", func(config *NodeConfig) commands.AdminCommand {
+			return executionCommands.NewScheduleStopCommand(exeNode.stopControl)
+		}).
+		AdminCommand("resume-execution", func(config *NodeConfig) commands.AdminCommand {
+			return executionCommands.NewResumeExecutionCommand(exeNode.stopControl)
+		}).
+		AdminCommand("reexecute-blocks", func(config *NodeConfig) commands.AdminCommand {
+			return executionCommands.NewReexecuteBlocksCommand(exeNode.reexecutor)
+		}).
 		AdminCommand("set-uploader-enabled", func(config *NodeConfig) commands.AdminCommand {
 			return uploaderCommands.NewToggleUploaderCommand(exeNode.blockDataUploader)
 		}).
@@ -233,6 +249,7 @@ func (builder *ExecutionNodeBuilder) LoadComponentsAndModules() {
 		Component("block data upload manager", exeNode.LoadBlockUploaderManager).
 		Component("GCP block data uploader", exeNode.LoadGCPBlockDataUploader).
 		Component("S3 block data uploader", exeNode.LoadS3BlockDataUploader).
+		Component("evm tracer", exeNode.LoadEVMTracer).
 		Component("provider engine", exeNode.LoadProviderEngine).
 		Component("checker engine", exeNode.LoadCheckerEngine).
 		Component("ingestion engine", exeNode.LoadIngestionEngine).
@@ -500,6 +517,48 @@ func (exeNode *ExecutionNode) LoadS3BlockDataUploader(
 	return asyncUploader, nil
 }
 
+// LoadEVMTracer creates the tracer used to trace the EVM transactions executed by the node, if EVM tracing is enabled.
+// The tracer is a component, its upload workers run as long as the node is running.
+func (exeNode *ExecutionNode) LoadEVMTracer(
+	node *NodeConfig,
+) (
+	module.ReadyDoneAware,
+	error,
+) {
+	if !exeNode.exeConf.evmTracingEnabled {
+		return &module.NoopReadyDoneAware{}, nil
+	}
+
+	evmTracer, err := exeNode.createEVMTracer(node)
+	if err != nil {
+		return nil, fmt.Errorf("could not create evm tracer: %w", err)
+	}
+	exeNode.evmTracer = evmTracer
+
+	return evmTracer, nil
+}
+
+// createEVMTracer creates the tracer used to trace the EVM transactions executed by the node.
+// Traces are uploaded to the configured GCP bucket, or written to the configured directory.
+func (exeNode *ExecutionNode) createEVMTracer(node *NodeConfig) (*debug.Tracer, error) {
+	var uploader debug.Uploader
+	if exeNode.exeConf.evmTracesGCPBucketName != "" {
+		gcpUploader, err := debug.NewGCPUploader(exeNode.exeConf.evmTracesGCPBucketName)
+		if err != nil {
+			return nil, err
+		}
+		uploader = gcpUploader
+	} else {
+		fileUploader, err := debug.NewFileUploader(exeNode.exeConf.evmTracesDir)
+		if err != nil {
+			return nil, err
+		}
+		uploader = fileUploader
+	}
+
+	return debug.NewEVMTracer(node.Logger, exeNode.exeConf.evmTracerName, nil, uploader)
+}
+
 func (exeNode *ExecutionNode) LoadProviderEngine(
 	node *NodeConfig,
 ) (
@@ -530,6 +589,11 @@ func (exeNode *ExecutionNode) LoadProviderEngine(
 		)},
 		node.FvmOptions...,
 	)
+
+	if exeNode.evmTracer != nil {
+		opts = append(opts, fvm.WithEVMTracer(exeNode.evmTracer))
+	}
+
 	vmCtx := fvm.NewContext(opts...)
 
 	ledgerViewCommitter := committer.NewLedgerViewCommitter(exeNode.ledgerStorage, node.Tracer)
@@ -549,6 +613,28 @@ func (exeNode *ExecutionNode) LoadProviderEngine(
 	}
 	exeNode.computationManager = manager
 
+	exeNode.reexecutor = reexecution.NewReexecutor(
+		node.Logger,
+		manager.VM(),
+		vmCtx,
+		node.State,
+		node.Me,
+		reexecution.Storages{
+			Headers:            node.Storage.Headers,
+			Blocks:             node.Storage.Blocks,
+			Collections:        node.Storage.Collections,
+			Commits:            node.Storage.Commits,
+			Results:            exeNode.results,
+			Seals:              node.Storage.Seals,
+			Events:             exeNode.events,
+			ServiceEvents:      exeNode.serviceEvents,
+			TransactionResults: exeNode.txResults,
+		},
+		exeNode.executionState,
+		exeNode.ledgerStorage,
+		exeNode.executionDataStore,
+	)
+
 	if node.ObserverMode {
 		exeNode.providerEngine = &exeprovider.NoopEngine{}
 	} else {
@@ -726,6 +812,7 @@ func (exeNode *ExecutionNode) LoadExecutionState(
 	exeNode.events = storage.NewEvents(node.Metrics.Cache, node.DB)
 	exeNode.serviceEvents = storage.NewServiceEvents(node.Metrics.Cache, node.DB)
 	exeNode.txResults = storage.NewTransactionResults(node.Metrics.Cache, node.DB, exeNode.exeConf.transactionResultsCacheSize)
+	exeNode.txProfiles = storage.NewTransactionProfiles(node.Metrics.Cache, node.DB, exeNode.exeConf.transactionResultsCacheSize)
 
 	exeNode.executionState = state.NewExecutionState(
 		exeNode.ledgerStorage,
@@ -739,6 +826,7 @@ func (exeNode *ExecutionNode) LoadExecutionState(
 		exeNode.events,
 		exeNode.serviceEvents,
 		exeNode.txResults,
+		exeNode.txProfiles,
 		node.DB,
 		node.Tracer,
 		exeNode.registerStore,
@@ -793,6 +881,8 @@ func (exeNode *ExecutionNode) LoadStopControl(
 		// TODO: rename to exeNode.exeConf.executionStopped to make it more consistent
 		exeNode.exeConf.pauseExecution,
 		true,
+		node.State,
+		storage.NewExecutionStops(node.DB),
 	)
 	// stopControl needs to consume BlockFinalized events.
 	node.ProtocolEvents.AddConsumer(stopControl)
@@ -877,6 +967,7 @@ func (exeNode *ExecutionNode) LoadRegisterStore(
 	}
 
 	exeNode.registerStore = registerStore
+	exeNode.registerDiskStore = diskStore
 	return nil
 }
 
@@ -915,6 +1006,7 @@ func (exeNode *ExecutionNode) LoadExecutionStateLedgerWALCompactor(
 		exeNode.exeConf.checkpointsToKeep,
 		exeNode.toTriggerCheckpoint, // compactor will listen to the signal from admin tool for force triggering checkpointing
 		exeNode.collector,
+		ledger.WithDeltaCheckpoints(exeNode.exeConf.deltaCheckpoints),
 	)
 }
 
@@ -1358,6 +1450,14 @@ func (exeNode *ExecutionNode) LoadGrpcServer(
 	module.ReadyDoneAware,
 	error,
 ) {
+	// the registers are only served from the storehouse if it is enabled
+	var registerStore execution.RegisterStore
+	var ownerRegisters rpc.OwnerRegisterIndex
+	if exeNode.registerStore != nil {
+		registerStore = exeNode.registerStore
+		ownerRegisters = exeNode.registerDiskStore
+	}
+
 	return rpc.New(
 		node.Logger,
 		exeNode.exeConf.rpcConf,
@@ -1367,9 +1467,12 @@ func (exeNode *ExecutionNode) LoadGrpcServer(
 		exeNode.events,
 		exeNode.results,
 		exeNode.txResults,
+		exeNode.txProfiles,
 		node.Storage.Commits,
 		node.RootChainID,
 		signature.NewBlockSignerDecoder(exeNode.committee),
+		registerStore,
+		ownerRegisters,
 		exeNode.exeConf.apiRatelimits,
 		exeNode.exeConf.apiBurstlimits,
 	), nil
@@ -12,6 +12,7 @@ import (
 	"github.com/onflow/flow-go/engine/execution/computation/query"
 	exeprovider "github.com/onflow/flow-go/engine/execution/provider"
 	"github.com/onflow/flow-go/fvm"
+	"github.com/onflow/flow-go/fvm/evm/debug"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module/mempool"
 	"github.com/onflow/flow-go/utils/grpcutils"
@@ -33,6 +34,7 @@ type ExecutionConfig struct {
 	transactionResultsCacheSize          uint
 	checkpointDistance                   uint
 	checkpointsToKeep                    uint
+	deltaCheckpoints                     uint
 	chunkDataPackDir                     string
 	chunkDataPackCacheSize               uint
 	chunkDataPackRequestsCacheSize       uint32
@@ -54,6 +56,10 @@ type ExecutionConfig struct {
 	chunkDataPackRequestWorkers          uint
 	maxGracefulStopDuration              time.Duration
 	importCheckpointWorkerCount          int
+	evmTracingEnabled                    bool
+	evmTracerName                        string
+	evmTracesGCPBucketName               string
+	evmTracesDir                         string
 
 	computationConfig        computation.ComputationConfig
 	receiptRequestWorkers    uint   // common provider engine workers
@@ -81,6 +87,7 @@ func (exeConf *ExecutionConfig) SetupFlags(flags *pflag.FlagSet) {
 	flags.Uint32Var(&exeConf.mTrieCacheSize, "mtrie-cache-size", 500, "cache size for MTrie")
 	flags.UintVar(&exeConf.checkpointDistance, "checkpoint-distance", 20, "number of WAL segments between checkpoints")
 	flags.UintVar(&exeConf.checkpointsToKeep, "checkpoints-to-keep", 5, "number of recent checkpoints to keep (0 to keep all)")
+	flags.UintVar(&exeConf.deltaCheckpoints, "delta-checkpoints", 0, "number of delta checkpoints, which only store the trie nodes changed since the previous checkpoint, to create between full checkpoints (0 to disable). The tries of the previous checkpoint are kept in memory until the next checkpoint is created")
 	flags.UintVar(&exeConf.computationConfig.DerivedDataCacheSize, "cadence-execution-cache", derived.DefaultDerivedDataCacheSize,
 		"cache size for Cadence execution")
 	flags.BoolVar(&exeConf.computationConfig.ExtensiveTracing, "extensive-tracing", false, "adds high-overhead tracing to execution")
@@ -117,6 +124,10 @@ func (exeConf *ExecutionConfig) SetupFlags(flags *pflag.FlagSet) {
 	flags.IntVar(&exeConf.blobstoreBurstLimit, "blobstore-burst-limit", 0, "outgoing burst limit for Execution Data blobstore")
 	flags.DurationVar(&exeConf.maxGracefulStopDuration, "max-graceful-stop-duration", stop.DefaultMaxGracefulStopDuration, "the maximum amount of time stop control will wait for ingestion engine to gracefully shutdown before crashing")
 	flags.IntVar(&exeConf.importCheckpointWorkerCount, "import-checkpoint-worker-count", 10, "number of workers to import checkpoint file during bootstrap")
+	flags.BoolVar(&exeConf.evmTracingEnabled, "evm-tracing-enabled", false, "enable tracing of the executed EVM transactions, default is false")
+	flags.StringVar(&exeConf.evmTracerName, "evm-tracer", debug.CallTracerName, fmt.Sprintf("name of the geth tracer used to trace EVM transactions, e.g. %s or %s", debug.CallTracerName, debug.StructLoggerName))
+	flags.StringVar(&exeConf.evmTracesGCPBucketName, "evm-traces-gcp-bucket-name", "", "GCP Bucket name the EVM transaction traces are uploaded to")
+	flags.StringVar(&exeConf.evmTracesDir, "evm-traces-dir", "", "directory the EVM transaction traces are written to")
 
 	flags.BoolVar(&exeConf.onflowOnlyLNs, "temp-onflow-only-lns", false, "do not use unless required. forces node to only request collections from onflow collection nodes")
 	flags.BoolVar(&exeConf.enableStorehouse, "enable-storehouse", false, "enable storehouse to store registers on disk, default is false")
@@ -131,6 +142,11 @@ func (exeConf *ExecutionConfig) ValidateFlags() error {
 			return fmt.Errorf("invalid flag. gcp-bucket-name or s3-bucket-name required when blockdata-uploader is enabled")
 		}
 	}
+	if exeConf.evmTracingEnabled {
+		if exeConf.evmTracesGCPBucketName == "" && exeConf.evmTracesDir == "" {
+			return fmt.Errorf("invalid flag. evm-traces-gcp-bucket-name or evm-traces-dir required when evm-tracing is enabled")
+		}
+	}
 	if exeConf.executionDataAllowedPeers != "" {
 		ids := strings.Split(exeConf.executionDataAllowedPeers, ",")
 		for _, id := range ids {
@@ -186,6 +186,8 @@ func DefaultObserverServiceConfig() *ObserverServiceConfig {
 				WriteTimeout:  rest.DefaultWriteTimeout,
 				ReadTimeout:   rest.DefaultReadTimeout,
 				IdleTimeout:   rest.DefaultIdleTimeout,
+
+				MaxWebSocketSubscriptionsPerConnection: rest.DefaultMaxWebSocketSubscriptionsPerConnection,
 			},
 			MaxMsgSize:     grpcutils.DefaultMaxMsgSize,
 			CompressorName: grpcutils.NoCompressor,
@@ -259,6 +261,7 @@ type ObserverServiceBuilder struct {
 	ExecutionIndexer     *indexer.Indexer
 	ExecutionIndexerCore *indexer.IndexerCore
 	TxResultsIndex       *index.TransactionResultsIndex
+	AccountTxsIndex      *index.AccountTransactionsIndex
 	IndexerDependencies  *cmd.DependencyList
 
 	ExecutionDataDownloader execution_data.Downloader
@@ -267,6 +270,7 @@ type ObserverServiceBuilder struct {
 
 	RegistersAsyncStore *execution.RegistersAsyncStore
 	EventsIndex         *index.EventsIndex
+	EventLookupIndex    *index.EventLookupIndex
 	ScriptExecutor      *backend.ScriptExecutor
 
 	// available until after the network has started. Hence, a factory function that needs to be called just before
@@ -599,6 +603,10 @@ func (builder *ObserverServiceBuilder) extraFlags() {
 			defaultConfig.rpcConf.RestConfig.ReadTimeout,
 			"timeout to use when reading REST request headers")
 		flags.DurationVar(&builder.rpcConf.RestConfig.IdleTimeout, "rest-idle-timeout", defaultConfig.rpcConf.RestConfig.IdleTimeout, "idle timeout for REST connections")
+		flags.Uint64Var(&builder.rpcConf.RestConfig.MaxWebSocketSubscriptionsPerConnection,
+			"rest-max-websocket-subscriptions-per-connection",
+			defaultConfig.rpcConf.RestConfig.MaxWebSocketSubscriptionsPerConnection,
+			"maximum number of subscriptions a single connection to the multiplexed REST WebSocket endpoint may have open at the same time (0 means no limit)")
 		flags.UintVar(&builder.rpcConf.MaxMsgSize,
 			"rpc-max-message-size",
 			defaultConfig.rpcConf.MaxMsgSize,
@@ -968,8 +976,14 @@ func (builder *ObserverServiceBuilder) validateParams() error {
 func (builder *ObserverServiceBuilder) initPublicLibp2pNode(networkKey crypto.PrivateKey) (p2p.LibP2PNode, error) {
 	var pis []peer.AddrInfo
 
+	// the bootstrap identities only carry their tcp addresses, their quic addresses are derived from them if quic is enabled.
+	peerAddressInfo := utils.PeerAddressInfo
+	if builder.FlowConfig.NetworkConfig.QUICTransportEnabled {
+		peerAddressInfo = utils.PeerAddressInfoWithQUIC
+	}
+
 	for _, b := range builder.bootstrapIdentities {
-		pi, err := utils.PeerAddressInfo(*b)
+		pi, err := peerAddressInfo(*b)
 		if err != nil {
 			return nil, fmt.Errorf("could not extract peer address info from bootstrap identity %v: %w", b, err)
 		}
@@ -977,7 +991,7 @@ func (builder *ObserverServiceBuilder) initPublicLibp2pNode(networkKey crypto.Pr
 		pis = append(pis, pi)
 	}
 
-	node, err := p2pbuilder.NewNodeBuilder(
+	nodeBuilder := p2pbuilder.NewNodeBuilder(
 		builder.Logger,
 		&builder.FlowConfig.NetworkConfig.GossipSub,
 		&p2pbuilderconfig.MetricsConfig{
@@ -1010,8 +1024,12 @@ func (builder *ObserverServiceBuilder) initPublicLibp2pNode(networkKey crypto.Pr
 				p2pdht.AsClient(),
 				dht.BootstrapPeers(pis...),
 			)
-		}).
-		Build()
+		})
+	if builder.FlowConfig.NetworkConfig.QUICTransportEnabled {
+		nodeBuilder.EnableQUICTransport()
+	}
+
+	node, err := nodeBuilder.Build()
 	if err != nil {
 		return nil, fmt.Errorf("could not initialize libp2p node for observer: %w", err)
 	}
@@ -1334,6 +1352,8 @@ func (builder *ObserverServiceBuilder) BuildExecutionSyncComponents() *ObserverS
 				builder.Storage.RegisterIndex,
 				builder.Storage.Headers,
 				builder.Storage.Events,
+				builder.Storage.EventLookups,
+				builder.Storage.AccountTransactions,
 				builder.Storage.Collections,
 				builder.Storage.Transactions,
 				builder.Storage.LightTransactionResults,
@@ -1368,6 +1388,16 @@ func (builder *ObserverServiceBuilder) BuildExecutionSyncComponents() *ObserverS
 				return nil, err
 			}
 
+			err = builder.EventLookupIndex.Initialize(builder.ExecutionIndexer)
+			if err != nil {
+				return nil, err
+			}
+
+			err = builder.AccountTxsIndex.Initialize(builder.ExecutionIndexer)
+			if err != nil {
+				return nil, err
+			}
+
 			// create script execution module, this depends on the indexer being initialized and the
 			// having the register storage bootstrapped
 			scripts := execution.NewScripts(
@@ -1380,6 +1410,7 @@ func (builder *ObserverServiceBuilder) BuildExecutionSyncComponents() *ObserverS
 				builder.scriptExecutorConfig,
 				queryDerivedChainData,
 				builder.programCacheSize > 0,
+				builder.FvmOptions,
 			)
 
 			err = builder.ScriptExecutor.Initialize(builder.ExecutionIndexer, scripts)
@@ -1543,6 +1574,13 @@ func (builder *ObserverServiceBuilder) enqueuePublicNetworkInit() {
 				return nil, fmt.Errorf("could not register networking receive cache metric: %w", err)
 			}
 
+			networkOptions := []underlay.NetworkOption{underlay.WithMessageValidators(publicNetworkMsgValidators(node.Logger, node.IdentityProvider, node.NodeID)...)}
+			captureOptions, err := builder.MessageCaptureOptions()
+			if err != nil {
+				return nil, err
+			}
+			networkOptions = append(networkOptions, captureOptions...)
+
 			net, err := underlay.NewNetwork(&underlay.NetworkConfig{
 				Logger:                builder.Logger.With().Str("component", "public-network").Logger(),
 				Codec:                 builder.CodecFactory(),
@@ -1563,6 +1601,9 @@ func (builder *ObserverServiceBuilder) enqueuePublicNetworkInit() {
 					SpamReportQueueSize:     builder.FlowConfig.NetworkConfig.AlspConfig.SpamReportQueueSize,
 					DisablePenalty:          builder.FlowConfig.NetworkConfig.AlspConfig.DisablePenalty,
 					HeartBeatInterval:       builder.FlowConfig.NetworkConfig.AlspConfig.HearBeatInterval,
+					SnapshotDir:             builder.FlowConfig.NetworkConfig.AlspConfig.SpamRecordSnapshotDir,
+					SnapshotInterval:        builder.FlowConfig.NetworkConfig.AlspConfig.SpamRecordSnapshotInterval,
+					IdentityProvider:        builder.IdentityProvider,
 					AlspMetrics:             builder.Metrics.Network,
 					HeroCacheMetricsFactory: builder.HeroCacheMetricsFactory(),
 					NetworkType:             network.PublicNetwork,
@@ -1570,7 +1611,7 @@ func (builder *ObserverServiceBuilder) enqueuePublicNetworkInit() {
 				SlashingViolationConsumerFactory: func(adapter network.ConduitAdapter) network.ViolationsConsumer {
 					return slashing.NewSlashingViolationsConsumer(builder.Logger, builder.Metrics.Network, adapter)
 				},
-			}, underlay.WithMessageValidators(publicNetworkMsgValidators(node.Logger, node.IdentityProvider, node.NodeID)...))
+			}, networkOptions...)
 			if err != nil {
 				return nil, fmt.Errorf("could not initialize network: %w", err)
 			}
@@ -1628,6 +1669,7 @@ func (builder *ObserverServiceBuilder) enqueueRPCServer() {
 	builder.Module("access metrics", func(node *cmd.NodeConfig) error {
 		builder.AccessMetrics = metrics.NewAccessCollector(
 			metrics.WithTransactionMetrics(builder.TransactionMetrics),
+			metrics.WithTransactionValidationMetrics(metrics.NewTransactionValidationCollector()),
 			metrics.WithBackendScriptsMetrics(builder.TransactionMetrics),
 			metrics.WithRestMetrics(builder.RestMetrics),
 		)
@@ -1680,16 +1722,23 @@ func (builder *ObserverServiceBuilder) enqueueRPCServer() {
 	})
 	builder.Module("events storage", func(node *cmd.NodeConfig) error {
 		builder.Storage.Events = bstorage.NewEvents(node.Metrics.Cache, node.DB)
+		builder.Storage.EventLookups = bstorage.NewEventLookups(node.DB)
 		return nil
 	})
 	builder.Module("events index", func(node *cmd.NodeConfig) error {
 		builder.EventsIndex = index.NewEventsIndex(builder.Storage.Events)
+		builder.EventLookupIndex = index.NewEventLookupIndex(builder.Storage.EventLookups)
 		return nil
 	})
 	builder.Module("transaction result index", func(node *cmd.NodeConfig) error {
 		builder.TxResultsIndex = index.NewTransactionResultsIndex(builder.Storage.LightTransactionResults)
 		return nil
 	})
+	builder.Module("account transactions index", func(node *cmd.NodeConfig) error {
+		builder.Storage.AccountTransactions = bstorage.NewAccountTransactions(node.DB)
+		builder.AccountTxsIndex = index.NewAccountTransactionsIndex(builder.Storage.AccountTransactions)
+		return nil
+	})
 	builder.Module("script executor", func(node *cmd.NodeConfig) error {
 		builder.ScriptExecutor = backend.NewScriptExecutor(builder.Logger, builder.scriptExecMinBlock, builder.scriptExecMaxBlock)
 		return nil
@@ -1771,7 +1820,9 @@ func (builder *ObserverServiceBuilder) enqueueRPCServer() {
 			backendParams.ScriptExecutionMode = backend.IndexQueryModeLocalOnly
 			backendParams.EventQueryMode = backend.IndexQueryModeLocalOnly
 			backendParams.TxResultsIndex = builder.TxResultsIndex
+			backendParams.AccountTxsIndex = builder.AccountTxsIndex
 			backendParams.EventsIndex = builder.EventsIndex
+			backendParams.EventLookupIndex = builder.EventLookupIndex
 			backendParams.ScriptExecutor = builder.ScriptExecutor
 		}
 
@@ -1792,6 +1843,13 @@ func (builder *ObserverServiceBuilder) enqueueRPCServer() {
 			return nil, err
 		}
 
+		// the state stream backend is nil if state streaming is disabled. It must be passed as a nil
+		// interface rather than a nil pointer, for the REST server to detect that it is disabled.
+		var stateStreamApi state_stream.API
+		if builder.stateStreamBackend != nil {
+			stateStreamApi = builder.stateStreamBackend
+		}
+
 		engineBuilder, err := rpc.NewBuilder(
 			node.Logger,
 			node.State,
@@ -1804,7 +1862,7 @@ func (builder *ObserverServiceBuilder) enqueueRPCServer() {
 			restHandler,
 			builder.secureGrpcServer,
 			builder.unsecureGrpcServer,
-			builder.stateStreamBackend,
+			stateStreamApi,
 			builder.stateStreamConf,
 		)
 		if err != nil {
@@ -29,6 +29,7 @@ import (
 	"github.com/onflow/flow-go/admin"
 	"github.com/onflow/flow-go/admin/commands"
 	"github.com/onflow/flow-go/admin/commands/common"
+	networkCommands "github.com/onflow/flow-go/admin/commands/network"
 	storageCommands "github.com/onflow/flow-go/admin/commands/storage"
 	"github.com/onflow/flow-go/cmd/build"
 	"github.com/onflow/flow-go/config"
@@ -53,6 +54,7 @@ import (
 	"github.com/onflow/flow-go/network"
 	alspmgr "github.com/onflow/flow-go/network/alsp/manager"
 	netcache "github.com/onflow/flow-go/network/cache"
+	"github.com/onflow/flow-go/network/capture"
 	"github.com/onflow/flow-go/network/channels"
 	"github.com/onflow/flow-go/network/converter"
 	"github.com/onflow/flow-go/network/p2p"
@@ -140,6 +142,7 @@ type FlowNodeBuilder struct {
 	componentBuilder         component.ComponentManagerBuilder
 	bootstrapNodeAddresses   []string
 	bootstrapNodePublicKeys  []string
+	messageRecorder          *capture.Recorder // shared by all the networks of the node; nil unless message capture is enabled.
 }
 
 var _ NodeBuilder = (*FlowNodeBuilder)(nil)
@@ -453,6 +456,9 @@ func (fnb *FlowNodeBuilder) EnqueueNetworkInit() {
 		if err != nil {
 			return nil, fmt.Errorf("could not create libp2p node builder: %w", err)
 		}
+		if fnb.FlowConfig.NetworkConfig.QUICTransportEnabled {
+			builder.EnableQUICTransport()
+		}
 
 		libp2pNode, err := builder.Build()
 		if err != nil {
@@ -518,8 +524,14 @@ func (fnb *FlowNodeBuilder) BuildPublicLibp2pNode(address string) (p2p.LibP2PNod
 		return nil, fmt.Errorf("could not create bootstrap identities: %w", err)
 	}
 
+	// the bootstrap identities only carry their tcp addresses, their quic addresses are derived from them if quic is enabled.
+	peerAddressInfo := utils.PeerAddressInfo
+	if fnb.FlowConfig.NetworkConfig.QUICTransportEnabled {
+		peerAddressInfo = utils.PeerAddressInfoWithQUIC
+	}
+
 	for _, b := range ids {
-		pi, err := utils.PeerAddressInfo(*b)
+		pi, err := peerAddressInfo(*b)
 		if err != nil {
 			return nil, fmt.Errorf("could not extract peer address info from bootstrap identity %v: %w", b, err)
 		}
@@ -528,7 +540,7 @@ func (fnb *FlowNodeBuilder) BuildPublicLibp2pNode(address string) (p2p.LibP2PNod
 	}
 
 	for _, b := range ids {
-		pi, err := utils.PeerAddressInfo(*b)
+		pi, err := peerAddressInfo(*b)
 		if err != nil {
 			return nil, fmt.Errorf("could not extract peer address info from bootstrap identity %v: %w", b, err)
 		}
@@ -536,7 +548,7 @@ func (fnb *FlowNodeBuilder) BuildPublicLibp2pNode(address string) (p2p.LibP2PNod
 		pis = append(pis, pi)
 	}
 
-	node, err := p2pbuilder.NewNodeBuilder(
+	builder := p2pbuilder.NewNodeBuilder(
 		fnb.Logger,
 		&fnb.FlowConfig.NetworkConfig.GossipSub,
 		&p2pbuilderconfig.MetricsConfig{
@@ -569,15 +581,42 @@ func (fnb *FlowNodeBuilder) BuildPublicLibp2pNode(address string) (p2p.LibP2PNod
 				p2pdht.AsClient(),
 				dht.BootstrapPeers(pis...),
 			)
-		}).
-		Build()
+		})
+	if fnb.FlowConfig.NetworkConfig.QUICTransportEnabled {
+		builder.EnableQUICTransport()
+	}
 
+	node, err := builder.Build()
 	if err != nil {
 		return nil, fmt.Errorf("could not initialize libp2p node for observer: %w", err)
 	}
 	return node, nil
 }
 
+// MessageCaptureOptions returns the network options that record the inbound and outbound messages of a network to the
+// message capture file of the node, or no options if message capture is disabled. Message capture is only enabled for
+// debugging purposes, e.g., to reproduce a production incident. All the networks of the node (e.g., the public network
+// of an access node) share a single recorder, hence a single capture file, which is closed when the node shuts down.
+// No errors are expected during normal operation.
+func (fnb *FlowNodeBuilder) MessageCaptureOptions() ([]underlay.NetworkOption, error) {
+	captureFile := fnb.FlowConfig.NetworkConfig.MessageCaptureFile
+	if captureFile == "" {
+		return nil, nil
+	}
+
+	if fnb.messageRecorder == nil {
+		recorder, err := capture.NewFileRecorder(fnb.Logger, captureFile, capture.DefaultQueueSize)
+		if err != nil {
+			return nil, fmt.Errorf("could not create network message recorder: %w", err)
+		}
+		fnb.Logger.Warn().Str("capture_file", captureFile).Msg("network message capture enabled, all inbound and outbound messages are recorded")
+		fnb.ShutdownFunc(recorder.Close)
+		fnb.messageRecorder = recorder
+	}
+
+	return []underlay.NetworkOption{underlay.WithMessageRecorder(fnb.messageRecorder)}, nil
+}
+
 func (fnb *FlowNodeBuilder) InitFlowNetworkWithConduitFactory(
 	node *NodeConfig,
 	cf network.ConduitFactory,
@@ -602,11 +641,17 @@ func (fnb *FlowNodeBuilder) InitFlowNetworkWithConduitFactory(
 		networkOptions = append(networkOptions, underlay.WithPeerManagerFilters(peerManagerFilters...))
 	}
 
+	captureOptions, err := fnb.MessageCaptureOptions()
+	if err != nil {
+		return nil, err
+	}
+	networkOptions = append(networkOptions, captureOptions...)
+
 	receiveCache := netcache.NewHeroReceiveCache(fnb.FlowConfig.NetworkConfig.NetworkReceivedMessageCacheSize,
 		fnb.Logger,
 		metrics.NetworkReceiveCacheMetricsFactory(fnb.HeroCacheMetricsFactory(), network.PrivateNetwork))
 
-	err := node.Metrics.Mempool.Register(metrics.ResourceNetworkingReceiveCache, receiveCache.Size)
+	err = node.Metrics.Mempool.Register(metrics.ResourceNetworkingReceiveCache, receiveCache.Size)
 	if err != nil {
 		return nil, fmt.Errorf("could not register networking receive cache metric: %w", err)
 	}
@@ -638,6 +683,9 @@ func (fnb *FlowNodeBuilder) InitFlowNetworkWithConduitFactory(
 			SpamReportQueueSize:     fnb.FlowConfig.NetworkConfig.AlspConfig.SpamReportQueueSize,
 			DisablePenalty:          fnb.FlowConfig.NetworkConfig.AlspConfig.DisablePenalty,
 			HeartBeatInterval:       fnb.FlowConfig.NetworkConfig.AlspConfig.HearBeatInterval,
+			SnapshotDir:             fnb.FlowConfig.NetworkConfig.AlspConfig.SpamRecordSnapshotDir,
+			SnapshotInterval:        fnb.FlowConfig.NetworkConfig.AlspConfig.SpamRecordSnapshotInterval,
+			IdentityProvider:        fnb.IdentityProvider,
 			AlspMetrics:             fnb.Metrics.Network,
 			HeroCacheMetricsFactory: fnb.HeroCacheMetricsFactory(),
 			NetworkType:             networkType,
@@ -2017,6 +2065,18 @@ func (fnb *FlowNodeBuilder) RegisterDefaultAdminCommands() {
 		return storageCommands.NewReadSealsCommand(config.State, config.Storage.Seals, config.Storage.Index)
 	}).AdminCommand("get-latest-identity", func(config *NodeConfig) commands.AdminCommand {
 		return common.NewGetIdentityCommand(config.IdentityProvider)
+	}).AdminCommand("list-peers", func(config *NodeConfig) commands.AdminCommand {
+		return networkCommands.NewListPeersCommand(config.LibP2PNode, config.IdentityProvider)
+	}).AdminCommand("get-gossipsub-scores", func(config *NodeConfig) commands.AdminCommand {
+		return networkCommands.NewGetGossipSubScoresCommand(config.LibP2PNode, config.IdentityProvider)
+	}).AdminCommand("get-gossipsub-mesh", func(config *NodeConfig) commands.AdminCommand {
+		return networkCommands.NewGetGossipSubMeshCommand(config.LibP2PNode, config.IdentityProvider)
+	}).AdminCommand("get-alsp-penalties", func(config *NodeConfig) commands.AdminCommand {
+		return networkCommands.NewGetAlspPenaltiesCommand(config.NetworkUnderlay)
+	}).AdminCommand("disallow-list-nodes", func(config *NodeConfig) commands.AdminCommand {
+		return networkCommands.NewDisallowListNodesCommand(config.NetworkUnderlay)
+	}).AdminCommand("allow-list-nodes", func(config *NodeConfig) commands.AdminCommand {
+		return networkCommands.NewAllowListNodesCommand(config.NetworkUnderlay)
 	})
 }
 
@@ -0,0 +1,197 @@
+package checkpoint_from_registers
+
+import (
+	"fmt"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/onflow/flow-go/cmd/util/cmd/common"
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/common/convert"
+	"github.com/onflow/flow-go/ledger/common/pathfinder"
+	"github.com/onflow/flow-go/ledger/complete"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/trie"
+	"github.com/onflow/flow-go/ledger/complete/wal"
+	"github.com/onflow/flow-go/model/bootstrap"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/pebble"
+)
+
+// defaultBatchSize is the number of registers applied to the trie at once.
+const defaultBatchSize = 100_000
+
+var (
+	flagDatadir            string
+	flagRegisterDir        string
+	flagHeight             uint64
+	flagOutputDir          string
+	flagCheckpointFilename string
+	flagBatchSize          int
+)
+
+var Cmd = &cobra.Command{
+	Use:   "checkpoint-from-registers",
+	Short: "Rebuilds a checkpoint from the registers at a sealed height in the storehouse register database",
+	Run:   run,
+}
+
+func init() {
+	Cmd.Flags().StringVarP(&flagDatadir, "datadir", "d", "/var/flow/data/protocol", "directory to the badger database")
+
+	Cmd.Flags().StringVar(&flagRegisterDir, "register-dir", "",
+		"directory to the storehouse register database of a stopped execution node")
+	_ = Cmd.MarkFlagRequired("register-dir")
+
+	Cmd.Flags().Uint64Var(&flagHeight, "height", 0,
+		"sealed height to rebuild the checkpoint at, defaults to the latest indexed height")
+
+	Cmd.Flags().StringVar(&flagOutputDir, "output-dir", "",
+		"directory to write the checkpoint to")
+	_ = Cmd.MarkFlagRequired("output-dir")
+
+	Cmd.Flags().StringVar(&flagCheckpointFilename, "checkpoint-filename", bootstrap.FilenameWALRootCheckpoint,
+		"file name of the written checkpoint")
+
+	Cmd.Flags().IntVar(&flagBatchSize, "batch-size", defaultBatchSize,
+		"number of registers applied to the trie at once")
+}
+
+func run(*cobra.Command, []string) {
+	if flagBatchSize <= 0 {
+		log.Fatal().Msg("--batch-size must be positive")
+	}
+
+	db := common.InitStorage(flagDatadir)
+	defer db.Close()
+
+	storages := common.InitStorages(db)
+
+	registerDB, err := pebble.OpenRegisterPebbleDB(flagRegisterDir)
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not open register database")
+	}
+	defer registerDB.Close()
+
+	registers, err := pebble.NewRegisters(registerDB)
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not init registers")
+	}
+
+	height := flagHeight
+	if height == 0 {
+		height = registers.LatestHeight()
+	}
+
+	sealedCommit, err := sealedCommitAtHeight(storages, height)
+	if err != nil {
+		log.Fatal().Err(err).Msgf("could not get sealed state commitment at height %d", height)
+	}
+
+	log.Info().
+		Uint64("height", height).
+		Hex("sealed_commit", sealedCommit[:]).
+		Msg("rebuilding trie from registers")
+
+	t, err := buildTrie(registers, height, flagBatchSize)
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not rebuild trie")
+	}
+
+	err = verifyTrie(t, sealedCommit)
+	if err != nil {
+		log.Fatal().Err(err).Msg("rebuilt trie does not match the sealed state, checkpoint is not written")
+	}
+
+	log.Info().
+		Uint64("allocated_reg_count", t.AllocatedRegCount()).
+		Msgf("rebuilt trie matches sealed state, writing checkpoint to %v", flagOutputDir)
+
+	err = wal.StoreCheckpointV6Concurrently([]*trie.MTrie{t}, flagOutputDir, flagCheckpointFilename, log.Logger)
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not write checkpoint")
+	}
+
+	log.Info().Msgf("checkpoint %v written", flagCheckpointFilename)
+}
+
+// sealedCommitAtHeight returns the sealed state commitment of the finalized block at the given height.
+func sealedCommitAtHeight(storages *storage.All, height uint64) (flow.StateCommitment, error) {
+	blockID, err := storages.Headers.BlockIDByHeight(height)
+	if err != nil {
+		return flow.DummyStateCommitment, fmt.Errorf("could not get finalized block at height %d: %w", height, err)
+	}
+
+	seal, err := storages.Seals.FinalizedSealForBlock(blockID)
+	if err != nil {
+		return flow.DummyStateCommitment, fmt.Errorf("could not get seal of block %v, the block might not be sealed yet: %w", blockID, err)
+	}
+
+	return seal.FinalState, nil
+}
+
+// buildTrie builds a trie from all registers at the given height in the register database.
+// Registers are applied to the trie in batches of the given size.
+func buildTrie(registers *pebble.Registers, height uint64, batchSize int) (*trie.MTrie, error) {
+	t := trie.NewEmptyMTrie()
+
+	paths := make([]ledger.Path, 0, batchSize)
+	payloads := make([]ledger.Payload, 0, batchSize)
+	count := 0
+
+	apply := func() error {
+		if len(paths) == 0 {
+			return nil
+		}
+
+		updated, _, err := trie.NewTrieWithUpdatedRegisters(t, paths, payloads, true)
+		if err != nil {
+			return fmt.Errorf("could not update trie: %w", err)
+		}
+		t = updated
+
+		count += len(paths)
+		log.Info().Msgf("applied %d registers to the trie", count)
+
+		paths = paths[:0]
+		payloads = payloads[:0]
+		return nil
+	}
+
+	err := registers.IterateRegisters(height, func(entry flow.RegisterEntry) error {
+		key := convert.RegisterIDToLedgerKey(entry.Key)
+
+		path, err := pathfinder.KeyToPath(key, complete.DefaultPathFinderVersion)
+		if err != nil {
+			return fmt.Errorf("could not compute path of register %v: %w", entry.Key, err)
+		}
+
+		paths = append(paths, path)
+		payloads = append(payloads, *ledger.NewPayload(key, entry.Value))
+
+		if len(paths) >= batchSize {
+			return apply()
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not iterate registers at height %d: %w", height, err)
+	}
+
+	err = apply()
+	if err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// verifyTrie returns an error if the root hash of the trie does not match the given state commitment.
+func verifyTrie(t *trie.MTrie, expected flow.StateCommitment) error {
+	actual := flow.StateCommitment(t.RootHash())
+	if actual != expected {
+		return fmt.Errorf("root hash %v of the rebuilt trie does not match the state commitment %v", actual, expected)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package checkpoint_from_registers
+
+import (
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/common/convert"
+	"github.com/onflow/flow-go/ledger/common/pathfinder"
+	"github.com/onflow/flow-go/ledger/complete"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/trie"
+	"github.com/onflow/flow-go/ledger/complete/wal"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage/pebble"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestBuildTrie(t *testing.T) {
+	pebble.RunWithRegistersStorageAtInitialHeights(t, 1, 1, func(r *pebble.Registers) {
+		entries := flow.RegisterEntries{
+			{Key: flow.RegisterID{Owner: "", Key: "uuid"}, Value: []byte("1")},
+		}
+		for i := 0; i < 10; i++ {
+			address := unittest.RandomAddressFixture()
+			entries = append(entries,
+				flow.RegisterEntry{Key: flow.NewRegisterID(address, "key1"), Value: unittest.RandomBytes(10)},
+				flow.RegisterEntry{Key: flow.NewRegisterID(address, "key/2"), Value: unittest.RandomBytes(10)},
+			)
+		}
+		require.NoError(t, r.Store(entries, 2))
+
+		// remove a register and update another one at the next height
+		removed := entries[1]
+		updated := flow.RegisterEntry{Key: entries[2].Key, Value: []byte("updated")}
+		require.NoError(t, r.Store(flow.RegisterEntries{
+			{Key: removed.Key, Value: []byte{}},
+			updated,
+		}, 3))
+
+		expectedAt3 := append(flow.RegisterEntries{updated}, entries[3:]...)
+		expectedAt3 = append(expectedAt3, entries[0])
+
+		t.Run("matches trie of all registers", func(t *testing.T) {
+			rebuilt, err := buildTrie(r, 2, 3)
+			require.NoError(t, err)
+			require.Equal(t, trieOf(t, entries).RootHash(), rebuilt.RootHash())
+			require.Equal(t, uint64(len(entries)), rebuilt.AllocatedRegCount())
+
+			rebuilt, err = buildTrie(r, 3, 3)
+			require.NoError(t, err)
+			require.Equal(t, trieOf(t, expectedAt3).RootHash(), rebuilt.RootHash())
+		})
+
+		t.Run("verifies against state commitment", func(t *testing.T) {
+			rebuilt, err := buildTrie(r, 3, defaultBatchSize)
+			require.NoError(t, err)
+
+			require.NoError(t, verifyTrie(rebuilt, flow.StateCommitment(trieOf(t, expectedAt3).RootHash())))
+			require.Error(t, verifyTrie(rebuilt, flow.StateCommitment(trieOf(t, entries).RootHash())))
+		})
+
+		t.Run("checkpoint can be loaded", func(t *testing.T) {
+			unittest.RunWithTempDir(t, func(dir string) {
+				rebuilt, err := buildTrie(r, 3, defaultBatchSize)
+				require.NoError(t, err)
+
+				err = wal.StoreCheckpointV6Concurrently([]*trie.MTrie{rebuilt}, dir, "checkpoint", zerolog.Nop())
+				require.NoError(t, err)
+
+				tries, err := wal.LoadCheckpoint(dir+"/checkpoint", zerolog.Nop())
+				require.NoError(t, err)
+				require.Len(t, tries, 1)
+				require.Equal(t, rebuilt.RootHash(), tries[0].RootHash())
+			})
+		})
+	})
+}
+
+// trieOf builds a trie from the given registers in a single update.
+func trieOf(t *testing.T, entries flow.RegisterEntries) *trie.MTrie {
+	paths := make([]ledger.Path, len(entries))
+	payloads := make([]ledger.Payload, len(entries))
+	for i, entry := range entries {
+		key := convert.RegisterIDToLedgerKey(entry.Key)
+		path, err := pathfinder.KeyToPath(key, complete.DefaultPathFinderVersion)
+		require.NoError(t, err)
+		paths[i] = path
+		payloads[i] = *ledger.NewPayload(key, entry.Value)
+	}
+
+	result, _, err := trie.NewTrieWithUpdatedRegisters(trie.NewEmptyMTrie(), paths, payloads, true)
+	require.NoError(t, err)
+	return result
+}
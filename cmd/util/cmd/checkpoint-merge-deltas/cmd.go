@@ -0,0 +1,52 @@
+package checkpoint_merge_deltas
+
+import (
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/onflow/flow-go/ledger/complete/wal"
+)
+
+var (
+	flagCheckpoint string
+	flagOutputDir  string
+	flagOutputFile string
+)
+
+var Cmd = &cobra.Command{
+	Use:   "checkpoint-merge-deltas",
+	Short: "Merges a delta checkpoint and the chain of checkpoints it is based on into a full checkpoint",
+	Run:   run,
+}
+
+func init() {
+
+	Cmd.Flags().StringVar(&flagCheckpoint, "checkpoint", "",
+		"delta checkpoint file to merge, its base checkpoints are read from the same directory")
+	_ = Cmd.MarkFlagRequired("checkpoint")
+
+	Cmd.Flags().StringVar(&flagOutputDir, "output-dir", "",
+		"directory to write the full checkpoint to")
+	_ = Cmd.MarkFlagRequired("output-dir")
+
+	Cmd.Flags().StringVar(&flagOutputFile, "output-file", "",
+		"file name of the full checkpoint")
+	_ = Cmd.MarkFlagRequired("output-file")
+}
+
+func run(*cobra.Command, []string) {
+
+	log.Info().Msgf("loading checkpoint %v", flagCheckpoint)
+	tries, err := wal.LoadCheckpoint(flagCheckpoint, log.Logger)
+	if err != nil {
+		log.Fatal().Err(err).Msg("error while loading checkpoint")
+	}
+	log.Info().Msgf("checkpoint loaded, total tries: %v", len(tries))
+
+	err = wal.StoreCheckpointV6Concurrently(tries, flagOutputDir, flagOutputFile, log.Logger)
+	if err != nil {
+		log.Fatal().Err(err).Msg("error while storing full checkpoint")
+	}
+
+	log.Info().Msgf("full checkpoint stored in %v/%v", flagOutputDir, flagOutputFile)
+}
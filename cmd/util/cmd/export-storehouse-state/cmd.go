@@ -0,0 +1,253 @@
+package export_storehouse_state
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/onflow/flow-go/cmd/util/ledger/util"
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/common/convert"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage/pebble"
+)
+
+const (
+	formatPayload = "payload"
+	formatJSON    = "json"
+)
+
+var (
+	flagRegisterDir string
+	flagHeight      uint64
+	flagOutputFile  string
+	flagFormat      string
+	flagAddresses   string
+	flagGzip        bool
+)
+
+var Cmd = &cobra.Command{
+	Use:   "export-storehouse-state",
+	Short: "exports the execution state at a finalized height from the storehouse register database, without loading a checkpoint",
+	Run:   run,
+}
+
+func init() {
+	Cmd.Flags().StringVar(&flagRegisterDir, "register-dir", "",
+		"directory to the storehouse register database of a stopped execution node")
+	_ = Cmd.MarkFlagRequired("register-dir")
+
+	Cmd.Flags().Uint64Var(&flagHeight, "height", 0,
+		"finalized height to export the state at, defaults to the latest indexed height")
+
+	Cmd.Flags().StringVar(&flagOutputFile, "output-file", "",
+		"file to write the exported state to")
+	_ = Cmd.MarkFlagRequired("output-file")
+
+	Cmd.Flags().StringVar(&flagFormat, "format", formatPayload,
+		fmt.Sprintf("output format, either %q (payload file) or %q (one JSON payload per line)", formatPayload, formatJSON))
+
+	Cmd.Flags().StringVar(&flagAddresses, "addresses", "",
+		"only export payloads of addresses (comma separated hex-encoded addresses)")
+
+	Cmd.Flags().BoolVar(&flagGzip, "gzip", false,
+		"write GZip-encoded, only for the JSON format")
+}
+
+func run(*cobra.Command, []string) {
+	if flagFormat != formatPayload && flagFormat != formatJSON {
+		log.Fatal().Msgf("unknown format %q, expected %q or %q", flagFormat, formatPayload, formatJSON)
+	}
+
+	if flagGzip && flagFormat != formatJSON {
+		log.Fatal().Msg("--gzip is only supported for the JSON format")
+	}
+
+	var addresses []flow.Address
+	if flagAddresses != "" {
+		var err error
+		addresses, err = parseAddresses(strings.Split(flagAddresses, ","))
+		if err != nil {
+			log.Fatal().Err(err).Msg("cannot parse addresses")
+		}
+	}
+
+	db, err := pebble.OpenRegisterPebbleDB(flagRegisterDir)
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not open register database")
+	}
+	defer db.Close()
+
+	registers, err := pebble.NewRegisters(db)
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not init registers")
+	}
+
+	height := flagHeight
+	if height == 0 {
+		height = registers.LatestHeight()
+	}
+
+	log.Info().
+		Uint64("height", height).
+		Uint64("first_height", registers.FirstHeight()).
+		Uint64("latest_height", registers.LatestHeight()).
+		Int("addresses", len(addresses)).
+		Str("format", flagFormat).
+		Msgf("exporting registers to %s", flagOutputFile)
+
+	var count int
+	switch flagFormat {
+	case formatPayload:
+		count, err = exportPayloadFile(registers, height, addresses, flagOutputFile)
+	case formatJSON:
+		count, err = exportJSON(registers, height, addresses, flagOutputFile, flagGzip)
+	}
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not export registers")
+	}
+
+	log.Info().Msgf("exported %d payloads at height %d to %s", count, height, flagOutputFile)
+}
+
+// exportPayloadFile writes the payloads at the given height to a payload file.
+func exportPayloadFile(
+	registers *pebble.Registers,
+	height uint64,
+	addresses []flow.Address,
+	outputFile string,
+) (int, error) {
+	writer, err := util.NewPayloadFileWriter(outputFile, len(addresses) > 0)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := exportRegisters(registers, height, addresses, writer.Write)
+	if err != nil {
+		_ = writer.Close()
+		return 0, err
+	}
+
+	err = writer.Close()
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// exportJSON writes the payloads at the given height as one JSON object per line,
+// in the same format as export-json-execution-state.
+func exportJSON(
+	registers *pebble.Registers,
+	height uint64,
+	addresses []flow.Address,
+	outputFile string,
+	gzipped bool,
+) (int, error) {
+	f, err := os.Create(outputFile)
+	if err != nil {
+		return 0, fmt.Errorf("cannot create %s: %w", outputFile, err)
+	}
+	defer f.Close()
+
+	fileWriter := bufio.NewWriter(f)
+	var writer io.Writer = fileWriter
+
+	var gzipWriter *gzip.Writer
+	if gzipped {
+		gzipWriter = gzip.NewWriter(fileWriter)
+		writer = gzipWriter
+	}
+
+	enc := json.NewEncoder(writer)
+	count, err := exportRegisters(registers, height, addresses, func(payload *ledger.Payload) error {
+		return enc.Encode(payload)
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if gzipWriter != nil {
+		err = gzipWriter.Close()
+		if err != nil {
+			return 0, fmt.Errorf("cannot close gzip writer: %w", err)
+		}
+	}
+
+	err = fileWriter.Flush()
+	if err != nil {
+		return 0, fmt.Errorf("cannot flush %s: %w", outputFile, err)
+	}
+
+	return count, f.Close()
+}
+
+// exportRegisters calls write with the payload of every register at the given height,
+// or only of the registers of the given addresses if any are given.
+// Registers are streamed from the register database, so memory usage does not grow with the state size.
+// Returns the number of written payloads.
+func exportRegisters(
+	registers *pebble.Registers,
+	height uint64,
+	addresses []flow.Address,
+	write func(payload *ledger.Payload) error,
+) (int, error) {
+	count := 0
+	writeEntry := func(entry flow.RegisterEntry) error {
+		key := convert.RegisterIDToLedgerKey(entry.Key)
+		err := write(ledger.NewPayload(key, entry.Value))
+		if err != nil {
+			return fmt.Errorf("cannot write payload of register %v: %w", entry.Key, err)
+		}
+
+		count++
+		if count%1_000_000 == 0 {
+			log.Info().Msgf("exported %d payloads", count)
+		}
+		return nil
+	}
+
+	if len(addresses) == 0 {
+		err := registers.IterateRegisters(height, writeEntry)
+		if err != nil {
+			return 0, fmt.Errorf("cannot export registers at height %d: %w", height, err)
+		}
+		return count, nil
+	}
+
+	for _, address := range addresses {
+		err := registers.IterateOwnerRegisters(string(address.Bytes()), height, writeEntry)
+		if err != nil {
+			return 0, fmt.Errorf("cannot export registers of %s at height %d: %w", address, height, err)
+		}
+	}
+
+	return count, nil
+}
+
+func parseAddresses(hexAddresses []string) ([]flow.Address, error) {
+	addresses := make([]flow.Address, 0, len(hexAddresses))
+	for _, hexAddr := range hexAddresses {
+		b, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(hexAddr), "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("address is not hex encoded %s: %w", strings.TrimSpace(hexAddr), err)
+		}
+
+		if len(b) != flow.AddressLength {
+			return nil, fmt.Errorf("address %x has %d bytes, expected %d", b, len(b), flow.AddressLength)
+		}
+
+		addresses = append(addresses, flow.BytesToAddress(b))
+	}
+
+	return addresses, nil
+}
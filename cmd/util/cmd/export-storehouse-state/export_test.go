@@ -0,0 +1,132 @@
+package export_storehouse_state
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/cmd/util/ledger/util"
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/common/convert"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage/pebble"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestExportRegisters(t *testing.T) {
+	address1 := flow.HexToAddress("0x01")
+	address2 := flow.BytesToAddress([]byte{'/', 1, 2, 3, 4, 5, 6, '/'})
+
+	global := flow.RegisterID{Owner: "", Key: "uuid"}
+	key1 := flow.NewRegisterID(address1, "key1")
+	key2 := flow.NewRegisterID(address2, "key/2")
+	removed := flow.NewRegisterID(address2, "removed")
+
+	pebble.RunWithRegistersStorageAtInitialHeights(t, 1, 1, func(r *pebble.Registers) {
+		require.NoError(t, r.Store(flow.RegisterEntries{
+			{Key: global, Value: []byte("g")},
+			{Key: key1, Value: []byte("v1")},
+			{Key: removed, Value: []byte("r")},
+		}, 2))
+		require.NoError(t, r.Store(flow.RegisterEntries{
+			{Key: key2, Value: []byte("v2")},
+			{Key: removed, Value: []byte{}},
+		}, 3))
+
+		readPayloadFile := func(t *testing.T, file string) (bool, map[flow.RegisterID]string) {
+			partialState, payloads, err := util.ReadPayloadFile(zerolog.Nop(), file)
+			require.NoError(t, err)
+
+			values := make(map[flow.RegisterID]string, len(payloads))
+			for _, payload := range payloads {
+				id, value, err := convert.PayloadToRegister(payload)
+				require.NoError(t, err)
+				values[id] = string(value)
+			}
+			return partialState, values
+		}
+
+		t.Run("payload file", func(t *testing.T) {
+			unittest.RunWithTempDir(t, func(dir string) {
+				file := filepath.Join(dir, "state.payload")
+
+				count, err := exportPayloadFile(r, 3, nil, file)
+				require.NoError(t, err)
+				require.Equal(t, 3, count)
+
+				partialState, values := readPayloadFile(t, file)
+				require.False(t, partialState)
+				require.Equal(t, map[flow.RegisterID]string{
+					global: "g",
+					key1:   "v1",
+					key2:   "v2",
+				}, values)
+			})
+		})
+
+		t.Run("payload file at earlier height", func(t *testing.T) {
+			unittest.RunWithTempDir(t, func(dir string) {
+				file := filepath.Join(dir, "state.payload")
+
+				count, err := exportPayloadFile(r, 2, nil, file)
+				require.NoError(t, err)
+				require.Equal(t, 3, count)
+
+				_, values := readPayloadFile(t, file)
+				require.Equal(t, map[flow.RegisterID]string{
+					global:  "g",
+					key1:    "v1",
+					removed: "r",
+				}, values)
+			})
+		})
+
+		t.Run("payload file filtered by address", func(t *testing.T) {
+			unittest.RunWithTempDir(t, func(dir string) {
+				file := filepath.Join(dir, "state.payload")
+
+				count, err := exportPayloadFile(r, 3, []flow.Address{address2}, file)
+				require.NoError(t, err)
+				require.Equal(t, 1, count)
+
+				partialState, values := readPayloadFile(t, file)
+				require.True(t, partialState)
+				require.Equal(t, map[flow.RegisterID]string{
+					key2: "v2",
+				}, values)
+			})
+		})
+
+		t.Run("json", func(t *testing.T) {
+			unittest.RunWithTempDir(t, func(dir string) {
+				file := filepath.Join(dir, "state.jsonl")
+
+				count, err := exportJSON(r, 3, []flow.Address{address1}, file, false)
+				require.NoError(t, err)
+				require.Equal(t, 1, count)
+
+				f, err := os.Open(file)
+				require.NoError(t, err)
+				defer f.Close()
+
+				scanner := bufio.NewScanner(f)
+				require.True(t, scanner.Scan())
+
+				var payload ledger.Payload
+				require.NoError(t, json.Unmarshal(scanner.Bytes(), &payload))
+
+				id, value, err := convert.PayloadToRegister(&payload)
+				require.NoError(t, err)
+				require.Equal(t, key1, id)
+				require.Equal(t, []byte("v1"), []byte(value))
+
+				require.False(t, scanner.Scan())
+			})
+		})
+	})
+}
@@ -0,0 +1,259 @@
+package reexecute_blocks
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	badgerds "github.com/ipfs/go-ds-badger2"
+	"github.com/onflow/crypto"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/onflow/flow-go/cmd/util/cmd/common"
+	"github.com/onflow/flow-go/engine/execution/reexecution"
+	"github.com/onflow/flow-go/fvm"
+	"github.com/onflow/flow-go/fvm/storage/snapshot"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/trie"
+	"github.com/onflow/flow-go/ledger/complete/wal"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/blobs"
+	"github.com/onflow/flow-go/module/executiondatasync/execution_data"
+	"github.com/onflow/flow-go/module/local"
+	"github.com/onflow/flow-go/module/metrics"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/badger"
+	"github.com/onflow/flow-go/storage/pebble"
+)
+
+var (
+	flagDatadir          string
+	flagChain            string
+	flagFrom             uint64
+	flagTo               uint64
+	flagCheckpoint       string
+	flagRegisterDir      string
+	flagExecutionDataDir string
+	flagOutput           string
+	flagDivergedOnly     bool
+)
+
+var Cmd = &cobra.Command{
+	Use:   "reexecute-blocks",
+	Short: "Re-executes a range of executed blocks and reports the divergences from the stored and sealed results",
+	Run:   run,
+}
+
+func init() {
+	Cmd.Flags().StringVarP(&flagDatadir, "datadir", "d", "/var/flow/data/protocol", "directory to the badger database")
+
+	Cmd.Flags().StringVar(&flagChain, "chain", "", "chain name")
+	_ = Cmd.MarkFlagRequired("chain")
+
+	Cmd.Flags().Uint64Var(&flagFrom, "from", 0, "the first block height to re-execute")
+	_ = Cmd.MarkFlagRequired("from")
+
+	Cmd.Flags().Uint64Var(&flagTo, "to", 0, "the last block height to re-execute, defaults to --from")
+
+	Cmd.Flags().StringVar(&flagCheckpoint, "checkpoint", "",
+		"checkpoint file containing the trie at the parent commit of the first block, "+
+			"end states are only computed if it is given")
+
+	Cmd.Flags().StringVar(&flagRegisterDir, "register-dir", "",
+		"directory to the storehouse register database, used if --checkpoint is not given")
+
+	Cmd.Flags().StringVar(&flagExecutionDataDir, "execution-data-dir", "",
+		"directory to the execution data, register updates are only compared if it is given")
+
+	Cmd.Flags().StringVar(&flagOutput, "output", "", "file to write the JSON reports to, defaults to stdout")
+
+	Cmd.Flags().BoolVar(&flagDivergedOnly, "diverged-only", false, "only report diverging blocks")
+}
+
+func run(*cobra.Command, []string) {
+	if flagCheckpoint == "" && flagRegisterDir == "" {
+		log.Fatal().Msg("either --checkpoint or --register-dir must be given")
+	}
+
+	to := flagTo
+	if to == 0 {
+		to = flagFrom
+	}
+
+	chain := flow.ChainID(flagChain).Chain()
+
+	db := common.InitStorage(flagDatadir)
+	defer db.Close()
+
+	storages := common.InitStorages(db)
+	state, err := common.InitProtocolState(db, storages)
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not init protocol state")
+	}
+
+	var tries reexecution.TrieSource
+	if flagCheckpoint != "" {
+		log.Info().Msgf("loading checkpoint %v", flagCheckpoint)
+		loaded, err := wal.LoadCheckpoint(flagCheckpoint, log.Logger)
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not load checkpoint")
+		}
+		log.Info().Msgf("checkpoint loaded, total tries: %v", len(loaded))
+		tries = newCheckpointTries(loaded)
+	}
+
+	var snapshots reexecution.StorageSnapshotProvider
+	if flagRegisterDir != "" {
+		registerDB, err := pebble.OpenRegisterPebbleDB(flagRegisterDir)
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not open register database")
+		}
+		defer registerDB.Close()
+
+		registers, err := pebble.NewRegisters(registerDB)
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not init registers")
+		}
+		snapshots = &registerSnapshots{registers: registers}
+	}
+
+	var executionData execution_data.ExecutionDataGetter
+	if flagExecutionDataDir != "" {
+		ds, err := badgerds.NewDatastore(filepath.Join(flagExecutionDataDir, "blobstore"), &badgerds.DefaultOptions)
+		if err != nil {
+			log.Fatal().Err(err).Msg("could not open execution data datastore")
+		}
+		defer ds.Close()
+
+		executionData = execution_data.NewExecutionDataStore(blobs.NewBlobstore(ds), execution_data.DefaultSerializer)
+	}
+
+	signer, err := newSigner()
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not create signer")
+	}
+
+	reexecutor := reexecution.NewReexecutor(
+		log.Logger,
+		fvm.NewVirtualMachine(),
+		fvm.NewContext(fvm.WithChain(chain), fvm.WithLogger(log.Logger)),
+		state,
+		signer,
+		reexecution.Storages{
+			Headers:            storages.Headers,
+			Blocks:             storages.Blocks,
+			Collections:        storages.Collections,
+			Commits:            storages.Commits,
+			Results:            storages.Results,
+			Seals:              storages.Seals,
+			Events:             storages.Events,
+			ServiceEvents:      badger.NewServiceEvents(metrics.NewNoopCollector(), db),
+			TransactionResults: storages.TransactionResults,
+		},
+		snapshots,
+		tries,
+		executionData,
+	)
+
+	reports, err := reexecutor.ReexecuteRange(context.Background(), flagFrom, to)
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not re-execute blocks")
+	}
+
+	diverged := make([]*reexecution.BlockReport, 0, len(reports))
+	for _, report := range reports {
+		if report.Diverged() {
+			diverged = append(diverged, report)
+		}
+	}
+
+	log.Info().
+		Uint64("from", flagFrom).
+		Uint64("to", to).
+		Int("diverged_blocks", len(diverged)).
+		Msg("blocks re-executed")
+
+	if flagDivergedOnly {
+		reports = diverged
+	}
+
+	err = writeReports(reports, flagOutput)
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not write reports")
+	}
+}
+
+func writeReports(reports []*reexecution.BlockReport, output string) error {
+	out := os.Stdout
+	if output != "" {
+		f, err := os.Create(output)
+		if err != nil {
+			return fmt.Errorf("could not create output file: %w", err)
+		}
+		defer f.Close()
+		out = f
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(reports)
+}
+
+// newSigner creates a signer with a random key. Re-executed receipts are never published,
+// so the signer only has to be valid.
+func newSigner() (*local.Local, error) {
+	seed := make([]byte, crypto.KeyGenSeedMinLen)
+	_, err := rand.Read(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	sk, err := crypto.GeneratePrivateKey(crypto.BLSBLS12381, seed)
+	if err != nil {
+		return nil, err
+	}
+
+	return local.New(flow.IdentitySkeleton{StakingPubKey: sk.PublicKey()}, sk)
+}
+
+// checkpointTries provides the tries loaded from a checkpoint.
+type checkpointTries map[flow.StateCommitment]*trie.MTrie
+
+func newCheckpointTries(tries []*trie.MTrie) checkpointTries {
+	byCommit := make(checkpointTries, len(tries))
+	for _, t := range tries {
+		byCommit[flow.StateCommitment(t.RootHash())] = t
+	}
+	return byCommit
+}
+
+func (c checkpointTries) FindTrieByStateCommit(commit flow.StateCommitment) (*trie.MTrie, error) {
+	t, ok := c[commit]
+	if !ok {
+		return nil, fmt.Errorf("trie %v is not in the checkpoint", commit)
+	}
+	return t, nil
+}
+
+// registerSnapshots provides the state at the end of a block from the storehouse register database.
+type registerSnapshots struct {
+	registers *pebble.Registers
+}
+
+func (r *registerSnapshots) NewStorageSnapshot(
+	_ flow.StateCommitment,
+	_ flow.Identifier,
+	height uint64,
+) snapshot.StorageSnapshot {
+	return snapshot.NewReadFuncStorageSnapshot(func(id flow.RegisterID) (flow.RegisterValue, error) {
+		value, err := r.registers.Get(id, height)
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, nil
+		}
+		return value, err
+	})
+}
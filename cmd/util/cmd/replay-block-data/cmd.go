@@ -0,0 +1,318 @@
+package replay_block_data
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/rs/zerolog/log"
+	"github.com/spf13/cobra"
+
+	"github.com/onflow/flow-go/cmd/util/cmd/common"
+	"github.com/onflow/flow-go/engine/execution/ingestion/uploader"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/trie"
+	"github.com/onflow/flow-go/ledger/complete/wal"
+	"github.com/onflow/flow-go/model/bootstrap"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/pebble"
+)
+
+var (
+	flagDatadir     string
+	flagArchiveDir  string
+	flagS3Bucket    string
+	flagS3Endpoint  string
+	flagFrom        uint64
+	flagTo          uint64
+	flagCheckpoint  string
+	flagOutputDir   string
+	flagRegisterDir string
+)
+
+var Cmd = &cobra.Command{
+	Use:   "replay-block-data",
+	Short: "Validates the uploaded block data archive, and rebuilds the execution state or register index from it",
+	Long: `Validates the block data uploaded by execution nodes against the stored state commitments.
+
+Without --from, all blocks in the archive are validated. With --from, the finalized blocks in the
+range are validated in order, and are replayed on top of the trie in --checkpoint and/or the
+register index in --register-dir.`,
+	Run: run,
+}
+
+func init() {
+	Cmd.Flags().StringVarP(&flagDatadir, "datadir", "d", "/var/flow/data/protocol", "directory to the badger database")
+
+	Cmd.Flags().StringVar(&flagArchiveDir, "archive-dir", "", "directory the block data was uploaded to")
+	Cmd.Flags().StringVar(&flagS3Bucket, "s3-bucket", "", "S3 bucket the block data was uploaded to")
+	Cmd.Flags().StringVar(&flagS3Endpoint, "s3-endpoint", "",
+		"endpoint of an S3-compatible storage, defaults to AWS S3")
+
+	Cmd.Flags().Uint64Var(&flagFrom, "from", 0, "the first finalized block height to replay")
+	Cmd.Flags().Uint64Var(&flagTo, "to", 0, "the last finalized block height to replay, defaults to --from")
+
+	Cmd.Flags().StringVar(&flagCheckpoint, "checkpoint", "",
+		"checkpoint file containing the trie at the parent commit of the first block, "+
+			"the execution state is only rebuilt if it is given")
+	Cmd.Flags().StringVar(&flagOutputDir, "output-dir", "",
+		"directory to write the root checkpoint of the rebuilt execution state to")
+
+	Cmd.Flags().StringVar(&flagRegisterDir, "register-dir", "",
+		"directory to the storehouse register database indexed up to the parent of the first block, "+
+			"the register index is only rebuilt if it is given")
+}
+
+func run(*cobra.Command, []string) {
+	if (flagArchiveDir == "") == (flagS3Bucket == "") {
+		log.Fatal().Msg("exactly one of --archive-dir or --s3-bucket must be given")
+	}
+
+	if flagFrom == 0 && (flagCheckpoint != "" || flagRegisterDir != "") {
+		log.Fatal().Msg("--from must be given to rebuild the execution state or register index")
+	}
+
+	if flagCheckpoint != "" && flagOutputDir == "" {
+		log.Fatal().Msg("--output-dir must be given to rebuild the execution state")
+	}
+
+	ctx := context.Background()
+
+	reader, err := newReader(ctx)
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not create block data reader")
+	}
+
+	db := common.InitStorage(flagDatadir)
+	defer db.Close()
+
+	storages := common.InitStorages(db)
+
+	if flagFrom == 0 {
+		err = validateArchive(ctx, reader, storages)
+		if err != nil {
+			log.Fatal().Err(err).Msg("archive is invalid")
+		}
+		return
+	}
+
+	to := flagTo
+	if to == 0 {
+		to = flagFrom
+	}
+	if to < flagFrom {
+		log.Fatal().Msgf("--to (%d) must not be lower than --from (%d)", to, flagFrom)
+	}
+
+	err = replay(ctx, reader, storages, flagFrom, to)
+	if err != nil {
+		log.Fatal().Err(err).Msg("could not replay block data")
+	}
+}
+
+func newReader(ctx context.Context) (uploader.Reader, error) {
+	if flagArchiveDir != "" {
+		return uploader.NewFileReader(flagArchiveDir), nil
+	}
+
+	config, err := awsconfig.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load AWS configuration: %w", err)
+	}
+
+	client := s3.NewFromConfig(config, func(o *s3.Options) {
+		if flagS3Endpoint != "" {
+			// S3-compatible storages commonly only support path-style addressing
+			o.EndpointResolver = s3.EndpointResolverFromURL(flagS3Endpoint)
+			o.UsePathStyle = true
+		}
+	})
+
+	return uploader.NewS3Reader(client, flagS3Bucket), nil
+}
+
+// validateArchive validates all blocks in the archive, in order of height.
+// Blocks which are unknown to or were not executed by this node are skipped.
+func validateArchive(ctx context.Context, reader uploader.Reader, storages *storage.All) error {
+	blockIDs, err := reader.BlockIDs(ctx)
+	if err != nil {
+		return fmt.Errorf("could not list archived blocks: %w", err)
+	}
+
+	skipped := 0
+	headers := make([]*flow.Header, 0, len(blockIDs))
+	for _, blockID := range blockIDs {
+		header, err := storages.Headers.ByBlockID(blockID)
+		if errors.Is(err, storage.ErrNotFound) {
+			log.Warn().Hex("block_id", blockID[:]).Msg("archived block is unknown, skipping")
+			skipped++
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("could not get header of archived block %v: %w", blockID, err)
+		}
+		headers = append(headers, header)
+	}
+	sort.Slice(headers, func(i, j int) bool {
+		return headers[i].Height < headers[j].Height
+	})
+
+	invalid := 0
+	for _, header := range headers {
+		blockID := header.ID()
+		lg := log.With().Uint64("height", header.Height).Hex("block_id", blockID[:]).Logger()
+
+		blockData, err := reader.Read(ctx, blockID)
+		if err != nil {
+			return fmt.Errorf("could not read block data of block %v: %w", blockID, err)
+		}
+
+		err = uploader.ValidateBlockData(blockID, blockData, storages.Commits)
+		if errors.Is(err, storage.ErrNotFound) {
+			lg.Warn().Err(err).Msg("block was not executed, skipping")
+			skipped++
+			continue
+		}
+		if err != nil {
+			lg.Error().Err(err).Msg("invalid block data")
+			invalid++
+			continue
+		}
+
+		lg.Debug().Msg("block data is valid")
+	}
+
+	log.Info().
+		Int("blocks", len(blockIDs)).
+		Int("invalid", invalid).
+		Int("skipped", skipped).
+		Msg("archive validated")
+
+	if invalid > 0 {
+		return fmt.Errorf("%d of %d archived blocks are invalid", invalid, len(blockIDs))
+	}
+	return nil
+}
+
+// replay validates the finalized blocks in the given height range, and applies them to the
+// execution state and register index if they are rebuilt.
+func replay(
+	ctx context.Context,
+	reader uploader.Reader,
+	storages *storage.All,
+	from uint64,
+	to uint64,
+) error {
+	var registers *pebble.Registers
+	if flagRegisterDir != "" {
+		registerDB, err := pebble.OpenRegisterPebbleDB(flagRegisterDir)
+		if err != nil {
+			return fmt.Errorf("could not open register database: %w", err)
+		}
+		defer registerDB.Close()
+
+		registers, err = pebble.NewRegisters(registerDB)
+		if err != nil {
+			return fmt.Errorf("could not init registers: %w", err)
+		}
+
+		if registers.LatestHeight()+1 != from {
+			return fmt.Errorf("registers are indexed up to height %d, but replay starts at height %d",
+				registers.LatestHeight(), from)
+		}
+	}
+
+	var current *trie.MTrie
+	if flagCheckpoint != "" {
+		var err error
+		current, err = loadStartTrie(storages, from)
+		if err != nil {
+			return err
+		}
+	}
+
+	for height := from; height <= to; height++ {
+		blockID, err := storages.Headers.BlockIDByHeight(height)
+		if err != nil {
+			return fmt.Errorf("could not get finalized block at height %d: %w", height, err)
+		}
+
+		blockData, err := reader.Read(ctx, blockID)
+		if err != nil {
+			return fmt.Errorf("could not read block data of block %v at height %d: %w", blockID, height, err)
+		}
+
+		err = uploader.ValidateBlockData(blockID, blockData, storages.Commits)
+		if err != nil {
+			return fmt.Errorf("invalid block data of block %v at height %d: %w", blockID, height, err)
+		}
+
+		if current != nil {
+			current, err = uploader.ApplyTrieUpdates(current, blockData)
+			if err != nil {
+				return fmt.Errorf("could not apply block %v at height %d: %w", blockID, height, err)
+			}
+		}
+
+		if registers != nil {
+			entries, err := uploader.RegisterEntries(blockData)
+			if err != nil {
+				return fmt.Errorf("could not get registers of block %v at height %d: %w", blockID, height, err)
+			}
+
+			err = registers.Store(entries, height)
+			if err != nil {
+				return fmt.Errorf("could not index registers of block %v at height %d: %w", blockID, height, err)
+			}
+		}
+
+		log.Info().Uint64("height", height).Hex("block_id", blockID[:]).Msg("block replayed")
+	}
+
+	if current != nil {
+		log.Info().
+			Stringer("state_commitment", current.RootHash()).
+			Msgf("writing checkpoint to %v", flagOutputDir)
+
+		err := wal.StoreCheckpointV6Concurrently(
+			[]*trie.MTrie{current}, flagOutputDir, bootstrap.FilenameWALRootCheckpoint, log.Logger)
+		if err != nil {
+			return fmt.Errorf("could not write checkpoint: %w", err)
+		}
+	}
+
+	log.Info().Uint64("from", from).Uint64("to", to).Msg("block data replayed")
+
+	return nil
+}
+
+// loadStartTrie loads the trie at the start state of the finalized block at the given height
+// from the checkpoint.
+func loadStartTrie(storages *storage.All, height uint64) (*trie.MTrie, error) {
+	header, err := storages.Headers.ByHeight(height)
+	if err != nil {
+		return nil, fmt.Errorf("could not get finalized block at height %d: %w", height, err)
+	}
+
+	startState, err := storages.Commits.ByBlockID(header.ParentID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get start state of block at height %d: %w", height, err)
+	}
+
+	log.Info().Msgf("loading checkpoint %v", flagCheckpoint)
+	tries, err := wal.LoadCheckpoint(flagCheckpoint, log.Logger)
+	if err != nil {
+		return nil, fmt.Errorf("could not load checkpoint: %w", err)
+	}
+
+	for _, t := range tries {
+		if flow.StateCommitment(t.RootHash()) == startState {
+			return t, nil
+		}
+	}
+
+	return nil, fmt.Errorf("checkpoint has no trie at start state %v of block at height %d", startState, height)
+}
@@ -59,6 +59,7 @@ func run(*cobra.Command, []string) {
 
 	metrics := &metrics.NoopCollector{}
 	transactionResults := badger.NewTransactionResults(metrics, db, badger.DefaultCacheSize)
+	transactionProfiles := badger.NewTransactionProfiles(metrics, db, badger.DefaultCacheSize)
 	commits := badger.NewCommits(metrics, db)
 	chunkDataPacks := badger.NewChunkDataPacks(metrics, db, badger.NewCollections(db, badger.NewTransactions(metrics, db)), badger.DefaultCacheSize)
 	results := badger.NewExecutionResults(metrics, db)
@@ -75,6 +76,7 @@ func run(*cobra.Command, []string) {
 		state,
 		headers,
 		transactionResults,
+		transactionProfiles,
 		commits,
 		chunkDataPacks,
 		results,
@@ -112,6 +114,7 @@ func removeExecutionResultsFromHeight(
 	protoState protocol.State,
 	headers *badger.Headers,
 	transactionResults *badger.TransactionResults,
+	transactionProfiles *badger.TransactionProfiles,
 	commits *badger.Commits,
 	chunkDataPacks *badger.ChunkDataPacks,
 	results *badger.ExecutionResults,
@@ -148,7 +151,7 @@ func removeExecutionResultsFromHeight(
 
 		blockID := head.ID()
 
-		err = removeForBlockID(writeBatch, headers, commits, transactionResults, results, chunkDataPacks, myReceipts, events, serviceEvents, blockID)
+		err = removeForBlockID(writeBatch, headers, commits, transactionResults, transactionProfiles, results, chunkDataPacks, myReceipts, events, serviceEvents, blockID)
 		if err != nil {
 			return fmt.Errorf("could not remove result for finalized block: %v, %w", blockID, err)
 		}
@@ -167,7 +170,7 @@ func removeExecutionResultsFromHeight(
 	total = len(pendings)
 
 	for _, pending := range pendings {
-		err = removeForBlockID(writeBatch, headers, commits, transactionResults, results, chunkDataPacks, myReceipts, events, serviceEvents, pending)
+		err = removeForBlockID(writeBatch, headers, commits, transactionResults, transactionProfiles, results, chunkDataPacks, myReceipts, events, serviceEvents, pending)
 
 		if err != nil {
 			return fmt.Errorf("could not remove result for pending block %v: %w", pending, err)
@@ -191,6 +194,7 @@ func removeForBlockID(
 	headers *badger.Headers,
 	commits *badger.Commits,
 	transactionResults *badger.TransactionResults,
+	transactionProfiles *badger.TransactionProfiles,
 	results *badger.ExecutionResults,
 	chunks *badger.ChunkDataPacks,
 	myReceipts *badger.MyExecutionReceipts,
@@ -239,6 +243,12 @@ func removeForBlockID(
 		return fmt.Errorf("could not remove transaction results by BlockID %v: %w", blockID, err)
 	}
 
+	// remove transaction profiles
+	err = transactionProfiles.BatchRemoveByBlockID(blockID, writeBatch)
+	if err != nil {
+		return fmt.Errorf("could not remove transaction profiles by BlockID %v: %w", blockID, err)
+	}
+
 	// remove own execution results index
 	err = myReceipts.BatchRemoveIndexByBlockID(blockID, writeBatch)
 	if err != nil {
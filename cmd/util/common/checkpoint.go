@@ -97,6 +97,8 @@ func FindHeightsByCheckpoints(
 // GenerateProtocolSnapshotForCheckpoint finds a sealed block that produces the state commitment contained in the latest
 // checkpoint file, and return a protocol snapshot for the finalized block that seals the sealed block.
 // The returned protocol snapshot can be used for dynamic bootstrapping an execution node along with the latest checkpoint file.
+// If the latest checkpoint is a delta checkpoint, the checkpoints it is based on are needed as well, or can be merged
+// into a full checkpoint with the checkpoint-merge-deltas util command.
 //
 // When finding a sealed block it iterates backwards through each sealed height from the last sealed height, and see
 // if the state commitment matches with one of the state commitments contained in the checkpoint file.
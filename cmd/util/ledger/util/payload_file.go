@@ -189,6 +189,95 @@ func CreatePayloadFile(
 	return writtenPayloadCount, nil
 }
 
+// PayloadFileWriter writes payloads to a payload file one at a time,
+// so that the payloads don't have to be held in memory.
+// Close must be called after all payloads are written to complete the file.
+type PayloadFileWriter struct {
+	payloadFile          string
+	f                    *os.File
+	writer               *bufio.Writer
+	crc32Writer          *wal.Crc32Writer
+	enc                  *cbor.Encoder
+	payloadScratchBuffer [1024 * 2]byte
+	payloadCount         int
+}
+
+// NewPayloadFileWriter creates the given payload file and writes its header.
+func NewPayloadFileWriter(payloadFile string, partialState bool) (*PayloadFileWriter, error) {
+	f, err := os.Create(payloadFile)
+	if err != nil {
+		return nil, fmt.Errorf("can't create %s: %w", payloadFile, err)
+	}
+
+	writer := bufio.NewWriterSize(f, defaultBufioWriteSize)
+	crc32Writer := wal.NewCRC32Writer(writer)
+
+	// Write header with magic bytes, version, and flags.
+	header := newPayloadFileHeader(PayloadFileVersionV1, partialState)
+
+	_, err = crc32Writer.Write(header)
+	if err != nil {
+		_ = f.Close()
+		return nil, fmt.Errorf("can't write payload file head for %s: %w", payloadFile, err)
+	}
+
+	return &PayloadFileWriter{
+		payloadFile: payloadFile,
+		f:           f,
+		writer:      writer,
+		crc32Writer: crc32Writer,
+		enc:         cbor.NewEncoder(crc32Writer),
+	}, nil
+}
+
+// Write writes the given payload to the file.
+func (w *PayloadFileWriter) Write(p *ledger.Payload) error {
+	buf := ledger.EncodeAndAppendPayloadWithoutPrefix(w.payloadScratchBuffer[:0], p, payloadEncodingVersion)
+
+	// Encode payload
+	err := w.enc.Encode(buf)
+	if err != nil {
+		return fmt.Errorf("can't write payload for %s: %w", w.payloadFile, err)
+	}
+
+	w.payloadCount++
+	return nil
+}
+
+// PayloadCount returns the number of payloads written so far.
+func (w *PayloadFileWriter) PayloadCount() int {
+	return w.payloadCount
+}
+
+// Close writes the footer and checksum of the file, and closes it.
+func (w *PayloadFileWriter) Close() error {
+	defer w.f.Close()
+
+	// Write footer with payload count.
+	footer := newPayloadFileFooter(w.payloadCount)
+
+	_, err := w.crc32Writer.Write(footer)
+	if err != nil {
+		return fmt.Errorf("can't write payload footer for %s: %w", w.payloadFile, err)
+	}
+
+	// Write CRC32 sum for validation
+	var crc32buf [crc32SumSize]byte
+	binary.BigEndian.PutUint32(crc32buf[:], w.crc32Writer.Crc32())
+
+	_, err = w.writer.Write(crc32buf[:])
+	if err != nil {
+		return fmt.Errorf("can't write CRC32 for %s: %w", w.payloadFile, err)
+	}
+
+	err = w.writer.Flush()
+	if err != nil {
+		return fmt.Errorf("can't flush %s: %w", w.payloadFile, err)
+	}
+
+	return w.f.Close()
+}
+
 func writePayloads(logger zerolog.Logger, w io.Writer, payloads []*ledger.Payload) (int, error) {
 	logger.Info().Msgf("writing %d payloads to file", len(payloads))
 
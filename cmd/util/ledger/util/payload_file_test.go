@@ -260,6 +260,52 @@ func TestPayloadFile(t *testing.T) {
 			require.True(t, partialState)
 		})
 	})
+
+	t.Run("payload file writer", func(t *testing.T) {
+		unittest.RunWithTempDir(t, func(datadir string) {
+			size := 10
+
+			payloadFileName := filepath.Join(datadir, fileName)
+
+			keysValues := make(map[string]keyPair)
+
+			writer, err := util.NewPayloadFileWriter(payloadFileName, false)
+			require.NoError(t, err)
+
+			for i := 0; i < size; i++ {
+				keys, values := getSampleKeyValues(i)
+
+				for j, key := range keys {
+					keysValues[key.String()] = keyPair{
+						key:   key,
+						value: values[j],
+					}
+
+					err = writer.Write(ledger.NewPayload(key, values[j]))
+					require.NoError(t, err)
+				}
+			}
+			require.Equal(t, len(keysValues), writer.PayloadCount())
+
+			err = writer.Close()
+			require.NoError(t, err)
+
+			partialState, payloadsFromFile, err := util.ReadPayloadFile(zerolog.Nop(), payloadFileName)
+			require.NoError(t, err)
+			require.Equal(t, len(keysValues), len(payloadsFromFile))
+			require.False(t, partialState)
+
+			for _, payloadFromFile := range payloadsFromFile {
+				k, err := payloadFromFile.Key()
+				require.NoError(t, err)
+
+				kv, exist := keysValues[k.String()]
+				require.True(t, exist)
+
+				require.Equal(t, kv.value, payloadFromFile.Value())
+			}
+		})
+	})
 }
 
 func getSampleKeyValues(i int) ([]ledger.Key, []ledger.Value) {
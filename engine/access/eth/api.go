@@ -0,0 +1,494 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	gethCommon "github.com/onflow/go-ethereum/common"
+	"github.com/onflow/go-ethereum/common/hexutil"
+	gethTypes "github.com/onflow/go-ethereum/core/types"
+	"github.com/onflow/go-ethereum/eth/filters"
+	"github.com/onflow/go-ethereum/rpc"
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/fvm/evm"
+	"github.com/onflow/flow-go/fvm/evm/emulator"
+	"github.com/onflow/flow-go/fvm/evm/types"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+)
+
+// eventsPageSize is the number of events retrieved from the event index at once.
+const eventsPageSize = 250
+
+// EventReader provides access to the events indexed by type.
+type EventReader interface {
+	// ByEventType returns up to limit events of the given type, starting at the given cursor.
+	ByEventType(eventType flow.EventType, start storage.EventCursor, limit uint) ([]storage.IndexedEvent, *storage.EventCursor, error)
+	// LowestIndexedHeight returns the lowest indexed Flow height.
+	LowestIndexedHeight() (uint64, error)
+	// HighestIndexedHeight returns the highest indexed Flow height.
+	HighestIndexedHeight() (uint64, error)
+}
+
+// TransactionArgs are the arguments of eth_call, following the Ethereum JSON-RPC specification.
+type TransactionArgs struct {
+	From     *gethCommon.Address `json:"from"`
+	To       *gethCommon.Address `json:"to"`
+	Gas      *hexutil.Uint64     `json:"gas"`
+	GasPrice *hexutil.Big        `json:"gasPrice"`
+	Value    *hexutil.Big        `json:"value"`
+	Nonce    *hexutil.Uint64     `json:"nonce"`
+
+	// both "data" and "input" are accepted, "input" takes precedence
+	Data  *hexutil.Bytes `json:"data"`
+	Input *hexutil.Bytes `json:"input"`
+}
+
+// Receipt is the receipt of an executed EVM transaction, following the Ethereum JSON-RPC specification.
+type Receipt struct {
+	BlockHash         gethCommon.Hash     `json:"blockHash"`
+	BlockNumber       hexutil.Uint64      `json:"blockNumber"`
+	TransactionHash   gethCommon.Hash     `json:"transactionHash"`
+	TransactionIndex  hexutil.Uint64      `json:"transactionIndex"`
+	From              *gethCommon.Address `json:"from"`
+	To                *gethCommon.Address `json:"to"`
+	GasUsed           hexutil.Uint64      `json:"gasUsed"`
+	CumulativeGasUsed hexutil.Uint64      `json:"cumulativeGasUsed"`
+	EffectiveGasPrice *hexutil.Big        `json:"effectiveGasPrice"`
+	ContractAddress   *gethCommon.Address `json:"contractAddress"`
+	Logs              []*gethTypes.Log    `json:"logs"`
+	LogsBloom         gethTypes.Bloom     `json:"logsBloom"`
+	Type              hexutil.Uint64      `json:"type"`
+	Status            hexutil.Uint64      `json:"status"`
+}
+
+// API implements the read methods of the `eth` JSON-RPC namespace for the EVM embedded in Flow.
+// The EVM state is read from the registers indexed by the node, and transactions are looked up in the
+// EVM events indexed by the node. EVM blocks are mapped to the Flow block which produced them.
+//
+// The state is indexed per Flow block, so methods reading the state (eth_getBalance, eth_getCode,
+// eth_getTransactionCount and eth_call) can only be queried at the latest block, or at EVM blocks which
+// are the last EVM block produced by their Flow block. Other block numbers are rejected with an invalid
+// params error.
+//
+// All methods are exported to the JSON-RPC server, which derives the method names from the Go names,
+// e.g. GetBalance is served as eth_getBalance.
+type API struct {
+	log            zerolog.Logger
+	config         Config
+	evmChainID     *big.Int
+	signer         gethTypes.Signer
+	storageAddress flow.Address
+	txExecutedType flow.EventType
+	registers      RegisterReader
+	events         EventReader
+}
+
+// NewAPI returns a new API for the EVM of the given Flow chain.
+func NewAPI(
+	log zerolog.Logger,
+	config Config,
+	chainID flow.ChainID,
+	registers RegisterReader,
+	events EventReader,
+) (*API, error) {
+	storageAddress, err := evm.StorageAccountAddress(chainID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get EVM storage address: %w", err)
+	}
+	contractAddress, err := evm.ContractAccountAddress(chainID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get EVM contract address: %w", err)
+	}
+
+	evmChainID := types.EVMChainIDFromFlowChainID(chainID)
+
+	return &API{
+		log:            log.With().Str("module", "eth_api").Logger(),
+		config:         config,
+		evmChainID:     evmChainID,
+		signer:         gethTypes.LatestSignerForChainID(evmChainID),
+		storageAddress: storageAddress,
+		txExecutedType: flow.EventType(fmt.Sprintf("A.%s.%s", contractAddress.Hex(), types.EventTypeTransactionExecuted)),
+		registers:      registers,
+		events:         events,
+	}, nil
+}
+
+// ChainId returns the chain ID of the EVM (eth_chainId).
+func (a *API) ChainId(_ context.Context) *hexutil.Big {
+	return (*hexutil.Big)(a.evmChainID)
+}
+
+// BlockNumber returns the height of the latest EVM block available on this node (eth_blockNumber).
+func (a *API) BlockNumber(_ context.Context) (hexutil.Uint64, error) {
+	_, highest, err := a.indexedRange()
+	if err != nil {
+		return 0, err
+	}
+	block, err := a.blockAt(highest)
+	if err != nil {
+		return 0, err
+	}
+	return hexutil.Uint64(block.Height), nil
+}
+
+// GetBalance returns the balance in attoflow of the given address at the given block (eth_getBalance).
+func (a *API) GetBalance(_ context.Context, address gethCommon.Address, blockNrOrHash rpc.BlockNumberOrHash) (*hexutil.Big, error) {
+	view, err := a.readOnlyView(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	balance, err := view.BalanceOf(types.NewAddress(address))
+	if err != nil {
+		return nil, fmt.Errorf("could not get balance: %w", err)
+	}
+	return (*hexutil.Big)(balance), nil
+}
+
+// GetCode returns the code deployed at the given address at the given block (eth_getCode).
+func (a *API) GetCode(_ context.Context, address gethCommon.Address, blockNrOrHash rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
+	view, err := a.readOnlyView(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	code, err := view.CodeOf(types.NewAddress(address))
+	if err != nil {
+		return nil, fmt.Errorf("could not get code: %w", err)
+	}
+	return hexutil.Bytes(code), nil
+}
+
+// GetTransactionCount returns the nonce of the given address at the given block (eth_getTransactionCount).
+func (a *API) GetTransactionCount(_ context.Context, address gethCommon.Address, blockNrOrHash rpc.BlockNumberOrHash) (*hexutil.Uint64, error) {
+	view, err := a.readOnlyView(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := view.NonceOf(types.NewAddress(address))
+	if err != nil {
+		return nil, fmt.Errorf("could not get nonce: %w", err)
+	}
+	return (*hexutil.Uint64)(&nonce), nil
+}
+
+// Call executes the given call against the state of the given block, without creating a transaction on chain,
+// and returns the returned data (eth_call). The block defaults to the latest block.
+//
+// Calls are dry-run by the EVM emulator, and therefore cannot use the precompiles which depend on the Flow
+// environment, such as the Cadence arch.
+func (a *API) Call(_ context.Context, args TransactionArgs, blockNrOrHash *rpc.BlockNumberOrHash) (hexutil.Bytes, error) {
+	if blockNrOrHash == nil {
+		latest := rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+		blockNrOrHash = &latest
+	}
+
+	height, err := a.resolveHeight(*blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+	block, err := a.blockAt(height)
+	if err != nil {
+		return nil, err
+	}
+
+	gas := a.config.CallGasLimit
+	if args.Gas != nil && uint64(*args.Gas) < gas {
+		gas = uint64(*args.Gas)
+	}
+	var data []byte
+	if args.Input != nil {
+		data = *args.Input
+	} else if args.Data != nil {
+		data = *args.Data
+	}
+	value := new(big.Int)
+	if args.Value != nil {
+		value = args.Value.ToInt()
+	}
+	gasPrice := new(big.Int)
+	if args.GasPrice != nil {
+		gasPrice = args.GasPrice.ToInt()
+	}
+	var nonce uint64
+	if args.Nonce != nil {
+		nonce = uint64(*args.Nonce)
+	}
+	var from gethCommon.Address
+	if args.From != nil {
+		from = *args.From
+	}
+
+	tx := gethTypes.NewTx(&gethTypes.LegacyTx{
+		Nonce:    nonce,
+		To:       args.To,
+		Value:    value,
+		Gas:      gas,
+		GasPrice: gasPrice,
+		Data:     data,
+	})
+
+	storageSnapshot := registersSnapshot(a.registers, height)
+	ctx := types.BlockContext{
+		ChainID:                a.evmChainID,
+		BlockNumber:            block.Height + 1,
+		BlockTimestamp:         block.Timestamp,
+		DirectCallBaseGasUsage: types.DefaultDirectCallBaseGasUsage,
+		GetHashFunc:            a.blockHashFunc(storageSnapshot),
+	}
+
+	view, err := emulator.NewEmulator(newRegisterLedger(storageSnapshot), a.storageAddress).NewBlockView(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not create block view: %w", err)
+	}
+
+	res, err := view.DryRunTransaction(tx, from)
+	if err != nil {
+		return nil, fmt.Errorf("could not execute call: %w", err)
+	}
+	if res.Invalid() {
+		return nil, invalidParamsError(res.ValidationError.Error())
+	}
+	if res.Failed() {
+		return nil, newExecutionError(res.VMError, res.ReturnedValue)
+	}
+
+	return res.ReturnedValue, nil
+}
+
+// GetLogs returns the logs matching the given filter (eth_getLogs). The range of Flow blocks spanned by the
+// requested EVM blocks is limited by the configured maximum height range.
+func (a *API) GetLogs(_ context.Context, criteria filters.FilterCriteria) ([]*gethTypes.Log, error) {
+	if criteria.BlockHash != nil {
+		return nil, invalidParamsError("querying blocks by hash is not supported")
+	}
+
+	lowest, highest, err := a.indexedRange()
+	if err != nil {
+		return nil, err
+	}
+	latest, err := a.blockAt(highest)
+	if err != nil {
+		return nil, err
+	}
+
+	from := blockNumberOrLatest(criteria.FromBlock, latest.Height)
+	to := blockNumberOrLatest(criteria.ToBlock, latest.Height)
+	if from > to {
+		return nil, invalidParamsError("invalid block range: from block is greater than to block")
+	}
+	if to > latest.Height {
+		to = latest.Height
+	}
+	if from > to {
+		return []*gethTypes.Log{}, nil
+	}
+
+	startHeight, err := a.flowHeight(from)
+	if err != nil {
+		if !errors.Is(err, ErrBlockNotIndexed) {
+			return nil, err
+		}
+		// blocks produced before the lowest indexed height can't be searched
+		startHeight = lowest
+	}
+	endHeight, err := a.flowHeight(to)
+	if err != nil {
+		return nil, err
+	}
+	if endHeight-startHeight > a.config.MaxLogsHeightRange {
+		return nil, invalidParamsError(fmt.Sprintf(
+			"block range spans %d Flow blocks, which exceeds the maximum of %d", endHeight-startHeight+1, a.config.MaxLogsHeightRange))
+	}
+
+	logs := make([]*gethTypes.Log, 0)
+	var logIndex uint
+	var blockHeight uint64
+	err = a.forEachTransaction(startHeight, endHeight, func(tx *transactionExecuted) bool {
+		// log indexes are counted within each EVM block
+		if tx.BlockHeight != blockHeight {
+			blockHeight = tx.BlockHeight
+			logIndex = 0
+		}
+		for _, log := range tx.logs {
+			log.Index = logIndex
+			logIndex++
+
+			if tx.BlockHeight >= from && tx.BlockHeight <= to && matchLog(log, criteria.Addresses, criteria.Topics) {
+				logs = append(logs, log)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return logs, nil
+}
+
+// GetTransactionReceipt returns the receipt of the transaction with the given hash (eth_getTransactionReceipt).
+// Transactions are looked up within the configured number of Flow blocks below the highest indexed height.
+// Null is returned if the transaction is not found.
+func (a *API) GetTransactionReceipt(_ context.Context, hash gethCommon.Hash) (*Receipt, error) {
+	lowest, highest, err := a.indexedRange()
+	if err != nil {
+		return nil, err
+	}
+	startHeight := lowest
+	if highest-lowest > a.config.ReceiptLookbackHeights {
+		startHeight = highest - a.config.ReceiptLookbackHeights
+	}
+
+	var found *transactionExecuted
+	var cumulativeGasUsed uint64
+	var blockHeight uint64
+	err = a.forEachTransaction(startHeight, highest, func(tx *transactionExecuted) bool {
+		if tx.BlockHeight != blockHeight {
+			blockHeight = tx.BlockHeight
+			cumulativeGasUsed = 0
+		}
+		cumulativeGasUsed += tx.GasConsumed
+		if tx.hash == hash {
+			found = tx
+			return false
+		}
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+	if found == nil {
+		return nil, nil
+	}
+
+	return a.receipt(found, cumulativeGasUsed), nil
+}
+
+// receipt builds the receipt of the given executed transaction.
+func (a *API) receipt(tx *transactionExecuted, cumulativeGasUsed uint64) *Receipt {
+	from, to := tx.addresses(a.signer)
+
+	logs := tx.logs
+	if logs == nil {
+		logs = []*gethTypes.Log{}
+	}
+
+	receipt := &Receipt{
+		BlockHash:         tx.blockHash,
+		BlockNumber:       hexutil.Uint64(tx.BlockHeight),
+		TransactionHash:   tx.hash,
+		TransactionIndex:  hexutil.Uint64(tx.Index),
+		From:              from,
+		To:                to,
+		GasUsed:           hexutil.Uint64(tx.GasConsumed),
+		CumulativeGasUsed: hexutil.Uint64(cumulativeGasUsed),
+		EffectiveGasPrice: (*hexutil.Big)(new(big.Int)),
+		Logs:              logs,
+		LogsBloom:         gethTypes.BytesToBloom(gethTypes.LogsBloom(logs)),
+		Type:              hexutil.Uint64(tx.TransactionType),
+		Status:            hexutil.Uint64(gethTypes.ReceiptStatusSuccessful),
+	}
+	if tx.ErrorCode != uint16(types.ErrCodeNoError) {
+		receipt.Status = hexutil.Uint64(gethTypes.ReceiptStatusFailed)
+	}
+	if tx.ContractAddress != "" {
+		address := gethCommon.HexToAddress(tx.ContractAddress)
+		receipt.ContractAddress = &address
+	}
+
+	return receipt
+}
+
+// readOnlyView returns a read only view of the EVM state at the given block.
+func (a *API) readOnlyView(blockNrOrHash rpc.BlockNumberOrHash) (types.ReadOnlyBlockView, error) {
+	height, err := a.resolveHeight(blockNrOrHash)
+	if err != nil {
+		return nil, err
+	}
+
+	ledger := newRegisterLedger(registersSnapshot(a.registers, height))
+	view, err := emulator.NewEmulator(ledger, a.storageAddress).NewReadOnlyBlockView(types.NewDefaultBlockContext(0))
+	if err != nil {
+		return nil, fmt.Errorf("could not create read only view: %w", err)
+	}
+	return view, nil
+}
+
+// forEachTransaction calls the given function for each EVM transaction executed between the given Flow heights
+// (inclusive), in execution order, until the function returns false.
+func (a *API) forEachTransaction(startHeight uint64, endHeight uint64, fn func(tx *transactionExecuted) bool) error {
+	cursor := &storage.EventCursor{Height: startHeight}
+	for cursor != nil {
+		events, next, err := a.events.ByEventType(a.txExecutedType, *cursor, eventsPageSize)
+		if err != nil {
+			return fmt.Errorf("could not get EVM transaction events: %w", err)
+		}
+
+		for _, event := range events {
+			if event.BlockHeight > endHeight {
+				return nil
+			}
+
+			tx, err := decodeTransactionExecuted(event.Event)
+			if err != nil {
+				return fmt.Errorf("could not decode EVM transaction event at height %d: %w", event.BlockHeight, err)
+			}
+			if !fn(tx) {
+				return nil
+			}
+		}
+
+		cursor = next
+	}
+	return nil
+}
+
+// blockNumberOrLatest returns the given block number, or the latest block height if the number is unset or a tag.
+func blockNumberOrLatest(number *big.Int, latest uint64) uint64 {
+	if number == nil || number.Sign() < 0 || !number.IsUint64() {
+		return latest
+	}
+	return number.Uint64()
+}
+
+// matchLog returns true if the log was emitted by one of the given addresses, and matches the given topics.
+// An empty list of addresses or topics at a position matches any value, as specified by eth_getLogs.
+func matchLog(log *gethTypes.Log, addresses []gethCommon.Address, topics [][]gethCommon.Hash) bool {
+	if len(addresses) > 0 {
+		found := false
+		for _, address := range addresses {
+			if log.Address == address {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if len(topics) > len(log.Topics) {
+		return false
+	}
+	for i, alternatives := range topics {
+		if len(alternatives) == 0 {
+			continue
+		}
+		found := false
+		for _, topic := range alternatives {
+			if log.Topics[i] == topic {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	return true
+}
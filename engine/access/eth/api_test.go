@@ -0,0 +1,494 @@
+package eth
+
+import (
+	"context"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/onflow/cadence/encoding/ccf"
+	"github.com/onflow/cadence/runtime/common"
+	gethCommon "github.com/onflow/go-ethereum/common"
+	"github.com/onflow/go-ethereum/common/hexutil"
+	gethTypes "github.com/onflow/go-ethereum/core/types"
+	"github.com/onflow/go-ethereum/eth/filters"
+	"github.com/onflow/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/fvm/evm"
+	"github.com/onflow/flow-go/fvm/evm/emulator"
+	"github.com/onflow/flow-go/fvm/evm/handler"
+	"github.com/onflow/flow-go/fvm/evm/testutils"
+	"github.com/onflow/flow-go/fvm/evm/types"
+	"github.com/onflow/flow-go/fvm/storage/snapshot"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+const testChainID = flow.Emulator
+
+// testRegisters is an in-memory RegisterReader storing the registers of each height.
+type testRegisters map[uint64]snapshot.MapStorageSnapshot
+
+func (r testRegisters) RegisterValues(ids flow.RegisterIDs, height uint64) ([]flow.RegisterValue, error) {
+	registers, ok := r[height]
+	if !ok {
+		return nil, storage.ErrHeightNotIndexed
+	}
+	values := make([]flow.RegisterValue, len(ids))
+	for i, id := range ids {
+		value, ok := registers[id]
+		if !ok {
+			return nil, storage.ErrNotFound
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+// testEvents is an in-memory EventReader over a list of events in execution order.
+type testEvents struct {
+	events  []storage.IndexedEvent
+	lowest  uint64
+	highest uint64
+}
+
+func (e *testEvents) ByEventType(eventType flow.EventType, start storage.EventCursor, limit uint) ([]storage.IndexedEvent, *storage.EventCursor, error) {
+	matching := make([]storage.IndexedEvent, 0)
+	for _, event := range e.events {
+		if event.Event.Type != eventType || event.BlockHeight < start.Height {
+			continue
+		}
+		if uint(len(matching)) == limit {
+			return matching, &storage.EventCursor{
+				Height:           event.BlockHeight,
+				TransactionIndex: event.Event.TransactionIndex,
+				EventIndex:       event.Event.EventIndex,
+			}, nil
+		}
+		matching = append(matching, event)
+	}
+	return matching, nil, nil
+}
+
+func (e *testEvents) LowestIndexedHeight() (uint64, error) {
+	return e.lowest, nil
+}
+
+func (e *testEvents) HighestIndexedHeight() (uint64, error) {
+	return e.highest, nil
+}
+
+// testChain builds the EVM state and events of consecutive Flow blocks.
+type testChain struct {
+	t               *testing.T
+	storageAddress  flow.Address
+	contractAddress flow.Address
+	registers       testRegisters
+	events          *testEvents
+	state           snapshot.MapStorageSnapshot
+	height          uint64
+	block           *types.Block
+	results         []*types.Result
+	payloads        [][]byte
+}
+
+func newTestChain(t *testing.T, startHeight uint64) *testChain {
+	storageAddress, err := evm.StorageAccountAddress(testChainID)
+	require.NoError(t, err)
+	contractAddress, err := evm.ContractAccountAddress(testChainID)
+	require.NoError(t, err)
+
+	c := &testChain{
+		t:               t,
+		storageAddress:  storageAddress,
+		contractAddress: contractAddress,
+		registers:       make(testRegisters),
+		events:          &testEvents{lowest: startHeight, highest: startHeight},
+		state:           make(snapshot.MapStorageSnapshot),
+		height:          startHeight,
+		block:           types.GenesisBlock,
+	}
+
+	// the EVM storage account must exist to allocate storage indexes
+	ledger := newRegisterLedger(c.state)
+	require.NoError(t, ledger.accounts.Create(nil, storageAddress))
+	c.commit(ledger)
+
+	return c
+}
+
+// execute executes the given direct call in a new EVM block of the next Flow block.
+func (c *testChain) execute(ledger *registerLedger, call *types.DirectCall) *types.Result {
+	ctx := types.NewDefaultBlockContext(c.block.Height + 1)
+	ctx.ChainID = types.EVMChainIDFromFlowChainID(testChainID)
+
+	view, err := emulator.NewEmulator(ledger, c.storageAddress).NewBlockView(ctx)
+	require.NoError(c.t, err)
+	res, err := view.DirectCall(call)
+	require.NoError(c.t, err)
+	require.False(c.t, res.Invalid())
+	require.False(c.t, res.Failed())
+
+	payload, err := call.Encode()
+	require.NoError(c.t, err)
+
+	res.Index = uint16(len(c.results))
+	c.results = append(c.results, res)
+	c.payloads = append(c.payloads, payload)
+	return res
+}
+
+// commit produces an EVM block with the executed calls if any, and stores the state and events
+// at the next Flow height.
+func (c *testChain) commit(ledger *registerLedger) {
+	c.commitBlock(ledger)
+
+	executionSnapshot, err := ledger.txnState.FinalizeMainTransaction()
+	require.NoError(c.t, err)
+	for id, value := range executionSnapshot.WriteSet {
+		c.state[id] = value
+	}
+
+	registers := make(snapshot.MapStorageSnapshot, len(c.state))
+	for id, value := range c.state {
+		registers[id] = value
+	}
+	c.registers[c.height] = registers
+	c.events.highest = c.height
+	c.height++
+}
+
+// commitBlock produces an EVM block with the executed calls if any, within the next Flow block.
+func (c *testChain) commitBlock(ledger *registerLedger) {
+	if len(c.results) > 0 {
+		parentHash, err := c.block.Hash()
+		require.NoError(c.t, err)
+		c.block = &types.Block{
+			ParentBlockHash: parentHash,
+			Height:          c.block.Height + 1,
+			TotalSupply:     new(big.Int),
+		}
+		for _, res := range c.results {
+			c.block.AppendTxHash(res.TxHash)
+		}
+		encoded, err := c.block.ToBytes()
+		require.NoError(c.t, err)
+		require.NoError(c.t, ledger.SetValue(c.storageAddress[:], []byte(handler.BlockStoreLatestBlockKey), encoded))
+
+		blockHash, err := c.block.Hash()
+		require.NoError(c.t, err)
+		location := common.NewAddressLocation(nil, common.Address(c.contractAddress), "EVM")
+		for i, res := range c.results {
+			event, err := types.NewTransactionEvent(res, c.payloads[i], c.block.Height, blockHash).Payload.ToCadence(location)
+			require.NoError(c.t, err)
+			payload, err := ccf.Encode(event)
+			require.NoError(c.t, err)
+
+			c.events.events = append(c.events.events, storage.IndexedEvent{
+				BlockID:     unittest.IdentifierFixture(),
+				BlockHeight: c.height,
+				Event: flow.Event{
+					Type:             flow.EventType(event.EventType.ID()),
+					TransactionID:    unittest.IdentifierFixture(),
+					TransactionIndex: uint32(i),
+					EventIndex:       0,
+					Payload:          payload,
+				},
+			})
+		}
+		c.results = nil
+		c.payloads = nil
+	}
+}
+
+// next returns a ledger to build the state of the next Flow block.
+func (c *testChain) next() *registerLedger {
+	return newRegisterLedger(c.state)
+}
+
+func TestAPI(t *testing.T) {
+	storageContract := testutils.GetStorageTestContract(t)
+	caller := testutils.RandomAddress(t)
+	bridge := testutils.RandomAddress(t)
+
+	chain := newTestChain(t, 10)
+
+	// Flow block 11 produces EVM block 1, which funds the caller and deploys the contract
+	ledger := chain.next()
+	chain.execute(ledger, types.NewDepositCall(bridge, caller, big.NewInt(1e18), 0))
+	deployed := chain.execute(ledger, types.NewDeployCall(caller, storageContract.ByteCode, math.MaxUint64, big.NewInt(0), 0))
+	require.NotNil(t, deployed.DeployedContractAddress)
+	storageContract.SetDeployedAt(*deployed.DeployedContractAddress)
+	chain.commit(ledger)
+
+	// Flow block 12 has no EVM transactions
+	chain.commit(chain.next())
+
+	// Flow block 13 produces EVM block 2, which stores a value and emits a log
+	ledger = chain.next()
+	stored := chain.execute(ledger, types.NewContractCall(
+		caller,
+		storageContract.DeployedAt,
+		storageContract.MakeCallData(t, "storeWithLog", big.NewInt(42)),
+		1_000_000,
+		big.NewInt(0),
+		1,
+	))
+	require.Len(t, stored.Logs, 1)
+	chain.commit(ledger)
+
+	// Flow block 14 has no EVM transactions
+	chain.commit(chain.next())
+
+	config := DefaultConfig()
+	api, err := NewAPI(unittest.Logger(), config, testChainID, chain.registers, chain.events)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	latest := rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber)
+	block := func(n int64) rpc.BlockNumberOrHash {
+		return rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(n))
+	}
+	contractAddress := storageContract.DeployedAt.ToCommon()
+
+	t.Run("chain ID", func(t *testing.T) {
+		assert.Equal(t, types.EVMChainIDFromFlowChainID(testChainID), api.ChainId(ctx).ToInt())
+	})
+
+	t.Run("block number", func(t *testing.T) {
+		number, err := api.BlockNumber(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, hexutil.Uint64(2), number)
+	})
+
+	t.Run("block heights", func(t *testing.T) {
+		for evmHeight, flowHeight := range map[uint64]uint64{0: 10, 1: 11, 2: 13} {
+			height, err := api.flowHeight(evmHeight)
+			require.NoError(t, err)
+			assert.Equal(t, flowHeight, height)
+		}
+
+		_, err := api.flowHeight(3)
+		assert.ErrorIs(t, err, ErrBlockNotFound)
+	})
+
+	t.Run("balance", func(t *testing.T) {
+		balance, err := api.GetBalance(ctx, caller.ToCommon(), latest)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(1e18), balance.ToInt())
+
+		balance, err = api.GetBalance(ctx, caller.ToCommon(), block(0))
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), balance.ToInt().Int64())
+	})
+
+	t.Run("code", func(t *testing.T) {
+		code, err := api.GetCode(ctx, contractAddress, latest)
+		require.NoError(t, err)
+		assert.NotEmpty(t, code)
+
+		code, err = api.GetCode(ctx, contractAddress, block(0))
+		require.NoError(t, err)
+		assert.Empty(t, code)
+	})
+
+	t.Run("transaction count", func(t *testing.T) {
+		// contract deployments increment the nonce of the contract, as in geth
+		nonce, err := api.GetTransactionCount(ctx, contractAddress, latest)
+		require.NoError(t, err)
+		assert.Equal(t, hexutil.Uint64(1), *nonce)
+
+		nonce, err = api.GetTransactionCount(ctx, contractAddress, block(0))
+		require.NoError(t, err)
+		assert.Equal(t, hexutil.Uint64(0), *nonce)
+	})
+
+	t.Run("call", func(t *testing.T) {
+		data := hexutil.Bytes(storageContract.MakeCallData(t, "retrieve"))
+		args := TransactionArgs{From: &gethCommon.Address{}, To: &contractAddress, Data: &data}
+
+		result, err := api.Call(ctx, args, nil)
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), new(big.Int).SetBytes(result).Int64())
+
+		before := block(1)
+		result, err = api.Call(ctx, args, &before)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), new(big.Int).SetBytes(result).Int64())
+	})
+
+	t.Run("reverted call", func(t *testing.T) {
+		data := hexutil.Bytes(storageContract.MakeCallData(t, "storeButRevert", big.NewInt(1)))
+		_, err := api.Call(ctx, TransactionArgs{To: &contractAddress, Data: &data}, nil)
+		require.Error(t, err)
+
+		var execErr *executionError
+		assert.ErrorAs(t, err, &execErr)
+		assert.Equal(t, executionErrorCode, execErr.ErrorCode())
+	})
+
+	t.Run("call by block hash", func(t *testing.T) {
+		byHash := rpc.BlockNumberOrHashWithHash(gethCommon.Hash{1}, false)
+		_, err := api.Call(ctx, TransactionArgs{To: &contractAddress}, &byHash)
+		require.Error(t, err)
+		assert.IsType(t, invalidParamsError(""), err)
+	})
+
+	t.Run("logs", func(t *testing.T) {
+		logs, err := api.GetLogs(ctx, filters.FilterCriteria{FromBlock: big.NewInt(0)})
+		require.NoError(t, err)
+		require.Len(t, logs, 1)
+
+		log := logs[0]
+		assert.Equal(t, contractAddress, log.Address)
+		assert.Equal(t, uint64(2), log.BlockNumber)
+		assert.Equal(t, stored.TxHash, log.TxHash)
+		assert.Equal(t, uint(0), log.TxIndex)
+		assert.Equal(t, uint(0), log.Index)
+
+		// filter by address and topic
+		logs, err = api.GetLogs(ctx, filters.FilterCriteria{
+			FromBlock: big.NewInt(0),
+			Addresses: []gethCommon.Address{contractAddress},
+			Topics:    [][]gethCommon.Hash{{stored.Logs[0].Topics[0]}},
+		})
+		require.NoError(t, err)
+		assert.Len(t, logs, 1)
+
+		logs, err = api.GetLogs(ctx, filters.FilterCriteria{
+			FromBlock: big.NewInt(0),
+			Addresses: []gethCommon.Address{caller.ToCommon()},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, logs)
+
+		logs, err = api.GetLogs(ctx, filters.FilterCriteria{
+			FromBlock: big.NewInt(0),
+			Topics:    [][]gethCommon.Hash{{}, {}, {}, {gethCommon.Hash{1}}},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, logs)
+
+		// the log is outside of the block range
+		logs, err = api.GetLogs(ctx, filters.FilterCriteria{FromBlock: big.NewInt(0), ToBlock: big.NewInt(1)})
+		require.NoError(t, err)
+		assert.Empty(t, logs)
+	})
+
+	t.Run("logs height range", func(t *testing.T) {
+		limited, err := NewAPI(unittest.Logger(), Config{MaxLogsHeightRange: 1}, testChainID, chain.registers, chain.events)
+		require.NoError(t, err)
+
+		_, err = limited.GetLogs(ctx, filters.FilterCriteria{FromBlock: big.NewInt(0)})
+		require.Error(t, err)
+		assert.IsType(t, invalidParamsError(""), err)
+
+		logs, err := limited.GetLogs(ctx, filters.FilterCriteria{FromBlock: big.NewInt(2)})
+		require.NoError(t, err)
+		assert.Len(t, logs, 1)
+	})
+
+	t.Run("receipt", func(t *testing.T) {
+		receipt, err := api.GetTransactionReceipt(ctx, stored.TxHash)
+		require.NoError(t, err)
+		require.NotNil(t, receipt)
+
+		expectedBlockHash, err := chain.block.Hash()
+		require.NoError(t, err)
+		assert.Equal(t, expectedBlockHash, receipt.BlockHash)
+		assert.Equal(t, hexutil.Uint64(2), receipt.BlockNumber)
+		assert.Equal(t, stored.TxHash, receipt.TransactionHash)
+		assert.Equal(t, hexutil.Uint64(gethTypes.ReceiptStatusSuccessful), receipt.Status)
+		assert.Equal(t, hexutil.Uint64(stored.GasConsumed), receipt.GasUsed)
+		assert.Equal(t, caller.ToCommon(), *receipt.From)
+		assert.Equal(t, contractAddress, *receipt.To)
+		assert.Nil(t, receipt.ContractAddress)
+		assert.Len(t, receipt.Logs, 1)
+		assert.True(t, receipt.LogsBloom.Test(contractAddress.Bytes()))
+
+		receipt, err = api.GetTransactionReceipt(ctx, deployed.TxHash)
+		require.NoError(t, err)
+		require.NotNil(t, receipt)
+		assert.Equal(t, hexutil.Uint64(1), receipt.BlockNumber)
+		assert.Equal(t, hexutil.Uint64(1), receipt.TransactionIndex)
+		assert.Equal(t, contractAddress, *receipt.ContractAddress)
+		assert.Empty(t, receipt.Logs)
+	})
+
+	t.Run("json-rpc", func(t *testing.T) {
+		server, err := NewServer(unittest.Logger(), config, api)
+		require.NoError(t, err)
+
+		client := rpc.DialInProc(server.rpcServer)
+		defer client.Close()
+
+		var number hexutil.Uint64
+		require.NoError(t, client.Call(&number, "eth_blockNumber"))
+		assert.Equal(t, hexutil.Uint64(2), number)
+
+		var balance hexutil.Big
+		require.NoError(t, client.Call(&balance, "eth_getBalance", caller.ToCommon(), "latest"))
+		assert.Equal(t, big.NewInt(1e18), balance.ToInt())
+
+		var logs []*gethTypes.Log
+		require.NoError(t, client.Call(&logs, "eth_getLogs", map[string]interface{}{"fromBlock": "earliest"}))
+		assert.Len(t, logs, 1)
+
+		var receipt *Receipt
+		require.NoError(t, client.Call(&receipt, "eth_getTransactionReceipt", stored.TxHash))
+		require.NotNil(t, receipt)
+		assert.Equal(t, stored.TxHash, receipt.TransactionHash)
+	})
+
+	t.Run("unknown receipt", func(t *testing.T) {
+		receipt, err := api.GetTransactionReceipt(ctx, gethCommon.Hash{1})
+		require.NoError(t, err)
+		assert.Nil(t, receipt)
+	})
+}
+
+func TestAPI_SeveralBlocksPerFlowBlock(t *testing.T) {
+	caller := testutils.RandomAddress(t)
+	bridge := testutils.RandomAddress(t)
+
+	chain := newTestChain(t, 10)
+
+	// Flow block 11 produces EVM blocks 1 and 2, each funding the caller
+	ledger := chain.next()
+	chain.execute(ledger, types.NewDepositCall(bridge, caller, big.NewInt(1e18), 0))
+	chain.commitBlock(ledger)
+	chain.execute(ledger, types.NewDepositCall(bridge, caller, big.NewInt(1e18), 1))
+	chain.commit(ledger)
+
+	api, err := NewAPI(unittest.Logger(), DefaultConfig(), testChainID, chain.registers, chain.events)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	block := func(n int64) rpc.BlockNumberOrHash {
+		return rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(n))
+	}
+
+	t.Run("last block of the Flow block", func(t *testing.T) {
+		balance, err := api.GetBalance(ctx, caller.ToCommon(), block(2))
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(2e18), balance.ToInt())
+
+		balance, err = api.GetBalance(ctx, caller.ToCommon(), rpc.BlockNumberOrHashWithNumber(rpc.LatestBlockNumber))
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(2e18), balance.ToInt())
+	})
+
+	t.Run("earlier block of the Flow block", func(t *testing.T) {
+		_, err := api.GetBalance(ctx, caller.ToCommon(), block(1))
+		require.Error(t, err)
+		assert.IsType(t, invalidParamsError(""), err)
+
+		before := block(1)
+		_, err = api.Call(ctx, TransactionArgs{To: &gethCommon.Address{}}, &before)
+		require.Error(t, err)
+		assert.IsType(t, invalidParamsError(""), err)
+	})
+}
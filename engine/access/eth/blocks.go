@@ -0,0 +1,153 @@
+package eth
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	gethCommon "github.com/onflow/go-ethereum/common"
+	"github.com/onflow/go-ethereum/rpc"
+
+	"github.com/onflow/flow-go/fvm/evm/handler"
+	"github.com/onflow/flow-go/fvm/evm/types"
+	"github.com/onflow/flow-go/fvm/storage/snapshot"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// ErrBlockNotFound is returned when the requested EVM block has not been produced yet.
+var ErrBlockNotFound = errors.New("block not found")
+
+// ErrBlockNotIndexed is returned when the requested EVM block was produced below the lowest indexed Flow height.
+var ErrBlockNotIndexed = errors.New("block not indexed")
+
+// blockAt returns the latest EVM block committed at the given Flow height.
+// If no EVM block was committed yet, the genesis block is returned.
+func (a *API) blockAt(height uint64) (*types.Block, error) {
+	id := flow.NewRegisterID(a.storageAddress, handler.BlockStoreLatestBlockKey)
+	data, err := registersSnapshot(a.registers, height).Get(id)
+	if err != nil {
+		return nil, fmt.Errorf("could not read latest EVM block at height %d: %w", height, err)
+	}
+	if len(data) == 0 {
+		return types.GenesisBlock, nil
+	}
+	return types.NewBlockFromBytes(data)
+}
+
+// blockHashFunc returns a function resolving the hashes of the recent EVM blocks from the block hash list
+// stored at the given Flow height. Unknown blocks resolve to the empty hash, as in geth.
+func (a *API) blockHashFunc(storageSnapshot snapshot.StorageSnapshot) func(n uint64) gethCommon.Hash {
+	return func(n uint64) gethCommon.Hash {
+		id := flow.NewRegisterID(a.storageAddress, handler.BlockStoreBlockHashesKey)
+		data, err := storageSnapshot.Get(id)
+		if err != nil || len(data) == 0 {
+			return gethCommon.Hash{}
+		}
+		list, err := types.NewBlockHashListFromEncoded(data)
+		if err != nil {
+			return gethCommon.Hash{}
+		}
+		_, hash := list.BlockHashByHeight(n)
+		return hash
+	}
+}
+
+// indexedRange returns the lowest and highest Flow heights indexed by the node.
+func (a *API) indexedRange() (uint64, uint64, error) {
+	lowest, err := a.events.LowestIndexedHeight()
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not get lowest indexed height: %w", err)
+	}
+	highest, err := a.events.HighestIndexedHeight()
+	if err != nil {
+		return 0, 0, fmt.Errorf("could not get highest indexed height: %w", err)
+	}
+	return lowest, highest, nil
+}
+
+// flowHeight returns the Flow height of the block which produced the EVM block with the given height.
+// EVM blocks are produced during the execution of Flow transactions, so the latest EVM block never decreases
+// with the Flow height. The state at the returned height is the state after the EVM block, unless more EVM blocks
+// were produced within the same Flow block.
+// Expected errors:
+//   - ErrBlockNotFound if the EVM block is beyond the highest indexed height
+//   - ErrBlockNotIndexed if the EVM block was produced before the lowest indexed height
+func (a *API) flowHeight(evmHeight uint64) (uint64, error) {
+	lowest, highest, err := a.indexedRange()
+	if err != nil {
+		return 0, err
+	}
+
+	latest, err := a.blockAt(highest)
+	if err != nil {
+		return 0, err
+	}
+	if latest.Height < evmHeight {
+		return 0, fmt.Errorf("%w: latest block is %d", ErrBlockNotFound, latest.Height)
+	}
+
+	first, err := a.blockAt(lowest)
+	if err != nil {
+		return 0, err
+	}
+	if first.Height > evmHeight {
+		return 0, fmt.Errorf("%w: lowest available block is %d", ErrBlockNotIndexed, first.Height)
+	}
+
+	// search for the lowest Flow height at which the EVM block exists
+	var searchErr error
+	offset := sort.Search(int(highest-lowest), func(i int) bool {
+		if searchErr != nil {
+			return true
+		}
+		block, err := a.blockAt(lowest + uint64(i))
+		if err != nil {
+			searchErr = err
+			return true
+		}
+		return block.Height >= evmHeight
+	})
+	if searchErr != nil {
+		return 0, searchErr
+	}
+
+	return lowest + uint64(offset), nil
+}
+
+// resolveHeight returns the Flow height whose state corresponds to the given block number or hash.
+// Tags such as "latest" and "finalized" resolve to the highest indexed height, since only sealed
+// blocks are indexed.
+//
+// Each EVM transaction commits its own EVM block, so a Flow block can produce several EVM blocks, and
+// only the state after the last of them is indexed. Block numbers of the other EVM blocks are rejected,
+// since the state of the Flow block does not correspond to them.
+func (a *API) resolveHeight(blockNrOrHash rpc.BlockNumberOrHash) (uint64, error) {
+	if _, ok := blockNrOrHash.Hash(); ok {
+		return 0, invalidParamsError("querying blocks by hash is not supported")
+	}
+
+	number, ok := blockNrOrHash.Number()
+	if !ok || number < 0 {
+		_, highest, err := a.indexedRange()
+		return highest, err
+	}
+
+	height, err := a.flowHeight(uint64(number))
+	if err != nil {
+		return 0, err
+	}
+
+	block, err := a.blockAt(height)
+	if err != nil {
+		return 0, err
+	}
+	if block.Height != uint64(number) {
+		return 0, invalidParamsError(fmt.Sprintf(
+			"state of block %d is not available, the state is only indexed after block %d which was produced by the same Flow block",
+			uint64(number),
+			block.Height,
+		))
+	}
+
+	return height, nil
+}
@@ -0,0 +1,55 @@
+package eth
+
+import (
+	"github.com/onflow/go-ethereum/common/hexutil"
+)
+
+// invalidParamsErrorCode is the JSON-RPC error code for invalid method parameters.
+const invalidParamsErrorCode = -32602
+
+// executionErrorCode is the JSON-RPC error code used by Ethereum clients for reverted calls.
+const executionErrorCode = 3
+
+// invalidParamsError is returned when the parameters of a request can't be served.
+type invalidParamsError string
+
+func (e invalidParamsError) Error() string {
+	return string(e)
+}
+
+// ErrorCode returns the JSON-RPC error code of the error.
+func (e invalidParamsError) ErrorCode() int {
+	return invalidParamsErrorCode
+}
+
+// executionError is returned when a call fails in the EVM. The data returned by the call, e.g. the
+// revert reason, is included in the error data.
+type executionError struct {
+	err  error
+	data hexutil.Bytes
+}
+
+func newExecutionError(err error, data []byte) *executionError {
+	return &executionError{
+		err:  err,
+		data: data,
+	}
+}
+
+func (e *executionError) Error() string {
+	return e.err.Error()
+}
+
+func (e *executionError) Unwrap() error {
+	return e.err
+}
+
+// ErrorCode returns the JSON-RPC error code of the error.
+func (e *executionError) ErrorCode() int {
+	return executionErrorCode
+}
+
+// ErrorData returns the hex encoded data returned by the call.
+func (e *executionError) ErrorData() interface{} {
+	return e.data.String()
+}
@@ -0,0 +1,99 @@
+package eth
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/encoding/ccf"
+	gethCommon "github.com/onflow/go-ethereum/common"
+	gethTypes "github.com/onflow/go-ethereum/core/types"
+	"github.com/onflow/go-ethereum/rlp"
+
+	"github.com/onflow/flow-go/fvm/evm/types"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// transactionExecuted is a decoded EVM.TransactionExecuted event.
+type transactionExecuted struct {
+	*types.TransactionEventPayload
+	hash      gethCommon.Hash
+	blockHash gethCommon.Hash
+	logs      []*gethTypes.Log
+}
+
+// decodeTransactionExecuted decodes the CCF encoded payload of an EVM.TransactionExecuted event.
+func decodeTransactionExecuted(event flow.Event) (*transactionExecuted, error) {
+	value, err := ccf.Decode(nil, event.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode event payload: %w", err)
+	}
+
+	cadenceEvent, ok := value.(cadence.Event)
+	if !ok {
+		return nil, fmt.Errorf("unexpected event payload type %T", value)
+	}
+
+	payload, err := types.DecodeTransactionEventPayload(cadenceEvent)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode transaction event: %w", err)
+	}
+
+	var logs []*gethTypes.Log
+	if len(payload.Logs) > 0 {
+		encodedLogs, err := hex.DecodeString(payload.Logs)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode transaction logs: %w", err)
+		}
+		err = rlp.DecodeBytes(encodedLogs, &logs)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode transaction logs: %w", err)
+		}
+	}
+
+	tx := &transactionExecuted{
+		TransactionEventPayload: payload,
+		hash:                    gethCommon.HexToHash(payload.Hash),
+		blockHash:               gethCommon.HexToHash(payload.BlockHash),
+		logs:                    logs,
+	}
+
+	// populate the derived fields, which are not part of the consensus encoding of logs
+	for _, log := range tx.logs {
+		log.BlockNumber = payload.BlockHeight
+		log.BlockHash = tx.blockHash
+		log.TxHash = tx.hash
+		log.TxIndex = uint(payload.Index)
+	}
+
+	return tx, nil
+}
+
+// addresses returns the sender and recipient of the executed transaction, if they can be decoded from the payload.
+// Direct calls carry the sender, while the sender of EVM transactions is recovered from their signature.
+func (tx *transactionExecuted) addresses(signer gethTypes.Signer) (*gethCommon.Address, *gethCommon.Address) {
+	encoded, err := hex.DecodeString(tx.Payload)
+	if err != nil || len(encoded) == 0 {
+		return nil, nil
+	}
+
+	if encoded[0] == types.DirectCallTxType {
+		call, err := types.DirectCallFromEncoded(encoded)
+		if err != nil {
+			return nil, nil
+		}
+		from := call.From.ToCommon()
+		return &from, call.Transaction().To()
+	}
+
+	var gethTx gethTypes.Transaction
+	err = gethTx.UnmarshalBinary(encoded)
+	if err != nil {
+		return nil, nil
+	}
+	from, err := gethTypes.Sender(signer, &gethTx)
+	if err != nil {
+		return nil, gethTx.To()
+	}
+	return &from, gethTx.To()
+}
@@ -0,0 +1,78 @@
+package eth
+
+import (
+	"errors"
+
+	"github.com/onflow/atree"
+
+	"github.com/onflow/flow-go/fvm/environment"
+	"github.com/onflow/flow-go/fvm/storage/snapshot"
+	"github.com/onflow/flow-go/fvm/storage/state"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+)
+
+// RegisterReader provides read access to the registers indexed at a given Flow block height.
+type RegisterReader interface {
+	// RegisterValues returns the values of the given registers at the given height.
+	// Expected errors:
+	//   - indexer.ErrIndexNotInitialized if the register index is still bootstrapping
+	//   - storage.ErrHeightNotIndexed if the height is not indexed
+	//   - storage.ErrNotFound if a register does not exist at the height
+	RegisterValues(ids flow.RegisterIDs, height uint64) ([]flow.RegisterValue, error)
+}
+
+// registerLedger implements atree.Ledger on top of the registers indexed at a single Flow block height,
+// which allows the EVM emulator to run against historic state.
+// Writes are kept in an in-memory transaction state and are never persisted, so the ledger can be
+// used to dry-run transactions.
+type registerLedger struct {
+	txnState state.NestedTransactionPreparer
+	accounts *environment.StatefulAccounts
+}
+
+var _ atree.Ledger = (*registerLedger)(nil)
+
+// newRegisterLedger returns a ledger reading the registers of the given snapshot.
+func newRegisterLedger(storageSnapshot snapshot.StorageSnapshot) *registerLedger {
+	txnState := state.NewTransactionState(storageSnapshot, state.DefaultParameters())
+	return &registerLedger{
+		txnState: txnState,
+		accounts: environment.NewAccounts(txnState),
+	}
+}
+
+// registersSnapshot returns a storage snapshot of the registers indexed at the given height.
+// Registers that do not exist at the height are read as empty values.
+func registersSnapshot(registers RegisterReader, height uint64) snapshot.StorageSnapshot {
+	return snapshot.NewReadFuncStorageSnapshot(func(id flow.RegisterID) (flow.RegisterValue, error) {
+		values, err := registers.RegisterValues(flow.RegisterIDs{id}, height)
+		if err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return values[0], nil
+	})
+}
+
+func (l *registerLedger) GetValue(owner, key []byte) ([]byte, error) {
+	return l.accounts.GetValue(flow.NewRegisterID(flow.BytesToAddress(owner), string(key)))
+}
+
+func (l *registerLedger) SetValue(owner, key, value []byte) error {
+	return l.accounts.SetValue(flow.NewRegisterID(flow.BytesToAddress(owner), string(key)), value)
+}
+
+func (l *registerLedger) ValueExists(owner, key []byte) (bool, error) {
+	value, err := l.GetValue(owner, key)
+	if err != nil {
+		return false, err
+	}
+	return len(value) > 0, nil
+}
+
+func (l *registerLedger) AllocateStorageIndex(owner []byte) (atree.StorageIndex, error) {
+	return l.accounts.AllocateStorageIndex(flow.BytesToAddress(owner))
+}
@@ -0,0 +1,115 @@
+package eth
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/onflow/go-ethereum/rpc"
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/module/component"
+	"github.com/onflow/flow-go/module/irrecoverable"
+)
+
+// Config defines the configurable options of the Ethereum JSON-RPC server.
+type Config struct {
+	// ListenAddress is the address the server listens on. The server is disabled if empty.
+	ListenAddress string
+	// MaxLogsHeightRange is the maximum number of Flow blocks searched by a single eth_getLogs request.
+	MaxLogsHeightRange uint64
+	// ReceiptLookbackHeights is the number of Flow blocks below the highest indexed height searched
+	// for the transaction requested by eth_getTransactionReceipt.
+	ReceiptLookbackHeights uint64
+	// CallGasLimit is the maximum gas available to eth_call.
+	CallGasLimit uint64
+	// MaxBatchSize is the maximum number of requests in a JSON-RPC batch.
+	MaxBatchSize int
+	// MaxBatchResponseSize is the maximum size in bytes of the response of a JSON-RPC batch.
+	MaxBatchResponseSize int
+}
+
+// DefaultConfig returns the default configuration of the Ethereum JSON-RPC server.
+func DefaultConfig() Config {
+	return Config{
+		ListenAddress:          "",
+		MaxLogsHeightRange:     250,
+		ReceiptLookbackHeights: 1000,
+		CallGasLimit:           50_000_000,
+		MaxBatchSize:           100,
+		MaxBatchResponseSize:   25 * 1000 * 1000,
+	}
+}
+
+// Server serves the eth namespace of the Ethereum JSON-RPC API over HTTP.
+type Server struct {
+	component.Component
+
+	log        zerolog.Logger
+	config     Config
+	rpcServer  *rpc.Server
+	httpServer *http.Server
+}
+
+// NewServer returns a new JSON-RPC server serving the given API.
+// No errors are expected during normal operation.
+func NewServer(log zerolog.Logger, config Config, api *API) (*Server, error) {
+	rpcServer := rpc.NewServer()
+	rpcServer.SetBatchLimits(config.MaxBatchSize, config.MaxBatchResponseSize)
+	err := rpcServer.RegisterName("eth", api)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{
+		log:       log.With().Str("component", "eth_json_rpc_server").Logger(),
+		config:    config,
+		rpcServer: rpcServer,
+		httpServer: &http.Server{
+			Addr:              config.ListenAddress,
+			Handler:           rpcServer,
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+	}
+
+	s.Component = component.NewComponentManagerBuilder().
+		AddWorker(s.serve).
+		AddWorker(s.shutdownOnCancel).
+		Build()
+
+	return s, nil
+}
+
+// serve listens on the configured address and serves requests until the server is shut down.
+func (s *Server) serve(ctx irrecoverable.SignalerContext, ready component.ReadyFunc) {
+	listener, err := net.Listen("tcp", s.config.ListenAddress)
+	if err != nil {
+		ctx.Throw(err)
+		return
+	}
+
+	s.log.Info().Str("address", listener.Addr().String()).Msg("starting Ethereum JSON-RPC server")
+	ready()
+
+	err = s.httpServer.Serve(listener) // blocking call
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
+		ctx.Throw(err)
+	}
+}
+
+// shutdownOnCancel gracefully shuts down the server once the context is cancelled.
+func (s *Server) shutdownOnCancel(ctx irrecoverable.SignalerContext, ready component.ReadyFunc) {
+	ready()
+	<-ctx.Done()
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := s.httpServer.Shutdown(shutdownCtx)
+	if err != nil {
+		s.log.Warn().Err(err).Msg("error shutting down Ethereum JSON-RPC server")
+	}
+	s.rpcServer.Stop()
+}
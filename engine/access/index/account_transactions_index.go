@@ -0,0 +1,156 @@
+package index
+
+import (
+	"errors"
+	"fmt"
+
+	"go.uber.org/atomic"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/state_synchronization"
+	"github.com/onflow/flow-go/module/state_synchronization/indexer"
+	"github.com/onflow/flow-go/storage"
+)
+
+var _ state_synchronization.IndexReporter = (*AccountTransactionsIndex)(nil)
+
+// AccountTransactionsIndex implements a wrapper around `storage.AccountTransactions` ensuring that needed data has been synced and is available to the client.
+// Lookups start at a cursor within the indexed height range and return transactions up to the highest indexed height.
+// The index is not backfilled, hence the indexed range starts at the first height indexed by the account transactions index
+// if it is above the lowest height indexed by the execution state indexer, e.g. on nodes that indexed execution data before
+// the account transactions index was added.
+// Note: `AccountTransactionsIndex` is created with empty report for the same reasons as `EventsIndex`. During the initialization phase,
+// all calls to retrieve data from this struct return indexer.ErrIndexNotInitialized.
+type AccountTransactionsIndex struct {
+	transactions storage.AccountTransactions
+	reporter     *atomic.Pointer[state_synchronization.IndexReporter]
+}
+
+func NewAccountTransactionsIndex(transactions storage.AccountTransactions) *AccountTransactionsIndex {
+	return &AccountTransactionsIndex{
+		transactions: transactions,
+		reporter:     atomic.NewPointer[state_synchronization.IndexReporter](nil),
+	}
+}
+
+// Initialize replaces a previously non-initialized reporter. Can be called once.
+// No errors are expected during normal operations.
+func (a *AccountTransactionsIndex) Initialize(indexReporter state_synchronization.IndexReporter) error {
+	if a.reporter.CompareAndSwap(nil, &indexReporter) {
+		return nil
+	}
+	return fmt.Errorf("index reporter already initialized")
+}
+
+// ByAddress checks data availability and returns up to limit transactions the account with the given address was
+// involved in, starting at the given cursor.
+// The returned cursor points to the next transaction, or is nil if there are no more transactions up to the highest
+// indexed height.
+// Expected errors:
+//   - indexer.ErrIndexNotInitialized if the `AccountTransactionsIndex` has not been initialized
+//   - storage.ErrHeightNotIndexed if the cursor height is outside the indexed range
+func (a *AccountTransactionsIndex) ByAddress(
+	address flow.Address,
+	start storage.AccountTransactionCursor,
+	limit uint,
+) ([]storage.AccountTransaction, *storage.AccountTransactionCursor, error) {
+	highestHeight, err := a.checkDataAvailability(start.Height)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return a.transactions.ByAddress(address, start, highestHeight, limit)
+}
+
+// LowestIndexedHeight returns the lowest height indexed by both the execution state indexer and the account transactions index.
+// Expected errors:
+// - indexer.ErrIndexNotInitialized if the AccountTransactionsIndex has not been initialized
+// - storage.ErrHeightNotIndexed if the account transactions index has not indexed any block yet
+func (a *AccountTransactionsIndex) LowestIndexedHeight() (uint64, error) {
+	reporter, err := a.getReporter()
+	if err != nil {
+		return 0, err
+	}
+
+	return a.lowestIndexedHeight(reporter)
+}
+
+// HighestIndexedHeight returns the highest height indexed by the execution state indexer.
+// Expected errors:
+// - indexer.ErrIndexNotInitialized if the AccountTransactionsIndex has not been initialized
+func (a *AccountTransactionsIndex) HighestIndexedHeight() (uint64, error) {
+	reporter, err := a.getReporter()
+	if err != nil {
+		return 0, err
+	}
+
+	return reporter.HighestIndexedHeight()
+}
+
+// checkDataAvailability checks the availability of data at the given height by comparing it with the highest and lowest
+// indexed heights, and returns the highest indexed height. If the height is beyond the indexed range, an error is returned.
+// Expected errors:
+//   - indexer.ErrIndexNotInitialized if the `AccountTransactionsIndex` has not been initialized
+//   - storage.ErrHeightNotIndexed if the block at the provided height is not indexed yet, or is below the first height
+//     indexed by the account transactions index
+//   - fmt.Errorf with custom message if the highest or lowest indexed heights cannot be retrieved
+func (a *AccountTransactionsIndex) checkDataAvailability(height uint64) (uint64, error) {
+	reporter, err := a.getReporter()
+	if err != nil {
+		return 0, err
+	}
+
+	highestHeight, err := reporter.HighestIndexedHeight()
+	if err != nil {
+		return 0, fmt.Errorf("could not get highest indexed height: %w", err)
+	}
+	if height > highestHeight {
+		return 0, fmt.Errorf("%w: block not indexed yet", storage.ErrHeightNotIndexed)
+	}
+
+	lowestHeight, err := a.lowestIndexedHeight(reporter)
+	if err != nil {
+		return 0, err
+	}
+	if height < lowestHeight {
+		return 0, fmt.Errorf("%w: block is before lowest indexed height", storage.ErrHeightNotIndexed)
+	}
+
+	return highestHeight, nil
+}
+
+// lowestIndexedHeight returns the higher of the lowest height indexed by the execution state indexer and the first
+// height indexed by the account transactions index.
+// Expected errors:
+//   - storage.ErrHeightNotIndexed if the account transactions index has not indexed any block yet
+//   - fmt.Errorf with custom message if the heights cannot be retrieved
+func (a *AccountTransactionsIndex) lowestIndexedHeight(reporter state_synchronization.IndexReporter) (uint64, error) {
+	lowestHeight, err := reporter.LowestIndexedHeight()
+	if err != nil {
+		return 0, fmt.Errorf("could not get lowest indexed height: %w", err)
+	}
+
+	firstHeight, err := a.transactions.FirstIndexedHeight()
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return 0, fmt.Errorf("%w: no block indexed yet", storage.ErrHeightNotIndexed)
+		}
+		return 0, fmt.Errorf("could not get first indexed height of the account transactions index: %w", err)
+	}
+
+	if firstHeight > lowestHeight {
+		return firstHeight, nil
+	}
+	return lowestHeight, nil
+}
+
+// getReporter retrieves the current index reporter instance from the atomic pointer.
+// Expected errors:
+//   - indexer.ErrIndexNotInitialized if the reporter is not initialized
+func (a *AccountTransactionsIndex) getReporter() (state_synchronization.IndexReporter, error) {
+	reporter := a.reporter.Load()
+	if reporter == nil {
+		return nil, indexer.ErrIndexNotInitialized
+	}
+	return *reporter, nil
+}
@@ -0,0 +1,167 @@
+package index
+
+import (
+	"errors"
+	"fmt"
+
+	"go.uber.org/atomic"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/state_synchronization"
+	"github.com/onflow/flow-go/module/state_synchronization/indexer"
+	"github.com/onflow/flow-go/storage"
+)
+
+var _ state_synchronization.IndexReporter = (*EventLookupIndex)(nil)
+
+// EventLookupIndex implements a wrapper around `storage.EventLookups` ensuring that needed data has been synced and is available to the client.
+// Lookups start at a cursor within the indexed height range and return events up to the highest indexed height.
+// The lookup indexes are not backfilled, hence the indexed range starts at the first height indexed by the lookup indexes
+// if it is above the lowest height indexed by the execution state indexer, e.g. on nodes that indexed events before the
+// lookup indexes were added.
+// Note: `EventLookupIndex` is created with empty report for the same reasons as `EventsIndex`. During the initialization phase,
+// all calls to retrieve data from this struct return indexer.ErrIndexNotInitialized.
+type EventLookupIndex struct {
+	lookups  storage.EventLookups
+	reporter *atomic.Pointer[state_synchronization.IndexReporter]
+}
+
+func NewEventLookupIndex(lookups storage.EventLookups) *EventLookupIndex {
+	return &EventLookupIndex{
+		lookups:  lookups,
+		reporter: atomic.NewPointer[state_synchronization.IndexReporter](nil),
+	}
+}
+
+// Initialize replaces a previously non-initialized reporter. Can be called once.
+// No errors are expected during normal operations.
+func (e *EventLookupIndex) Initialize(indexReporter state_synchronization.IndexReporter) error {
+	if e.reporter.CompareAndSwap(nil, &indexReporter) {
+		return nil
+	}
+	return fmt.Errorf("index reporter already initialized")
+}
+
+// ByEventType checks data availability and returns up to limit events of the given type, starting at the given cursor.
+// The returned cursor points to the next matching event, or is nil if there are no more matching events up to
+// the highest indexed height.
+// Expected errors:
+//   - indexer.ErrIndexNotInitialized if the `EventLookupIndex` has not been initialized
+//   - storage.ErrHeightNotIndexed if the cursor height is outside the indexed range
+func (e *EventLookupIndex) ByEventType(eventType flow.EventType, start storage.EventCursor, limit uint) ([]storage.IndexedEvent, *storage.EventCursor, error) {
+	highestHeight, err := e.checkDataAvailability(start.Height)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return e.lookups.ByEventType(eventType, start, highestHeight, limit)
+}
+
+// ByContractAddress checks data availability and returns up to limit events emitted by contracts deployed to the given
+// address, starting at the given cursor.
+// The returned cursor points to the next matching event, or is nil if there are no more matching events up to
+// the highest indexed height.
+// Expected errors:
+//   - indexer.ErrIndexNotInitialized if the `EventLookupIndex` has not been initialized
+//   - storage.ErrHeightNotIndexed if the cursor height is outside the indexed range
+func (e *EventLookupIndex) ByContractAddress(address flow.Address, start storage.EventCursor, limit uint) ([]storage.IndexedEvent, *storage.EventCursor, error) {
+	highestHeight, err := e.checkDataAvailability(start.Height)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return e.lookups.ByContractAddress(address, start, highestHeight, limit)
+}
+
+// LowestIndexedHeight returns the lowest height indexed by both the execution state indexer and the lookup indexes.
+// Expected errors:
+// - indexer.ErrIndexNotInitialized if the EventLookupIndex has not been initialized
+// - storage.ErrHeightNotIndexed if the lookup indexes have not indexed any block yet
+func (e *EventLookupIndex) LowestIndexedHeight() (uint64, error) {
+	reporter, err := e.getReporter()
+	if err != nil {
+		return 0, err
+	}
+
+	return e.lowestIndexedHeight(reporter)
+}
+
+// HighestIndexedHeight returns the highest height indexed by the execution state indexer.
+// Expected errors:
+// - indexer.ErrIndexNotInitialized if the EventLookupIndex has not been initialized
+func (e *EventLookupIndex) HighestIndexedHeight() (uint64, error) {
+	reporter, err := e.getReporter()
+	if err != nil {
+		return 0, err
+	}
+
+	return reporter.HighestIndexedHeight()
+}
+
+// checkDataAvailability checks the availability of data at the given height by comparing it with the highest and lowest
+// indexed heights, and returns the highest indexed height. If the height is beyond the indexed range, an error is returned.
+// Expected errors:
+//   - indexer.ErrIndexNotInitialized if the `EventLookupIndex` has not been initialized
+//   - storage.ErrHeightNotIndexed if the block at the provided height is not indexed yet, or is below the first height
+//     indexed by the lookup indexes
+//   - fmt.Errorf with custom message if the highest or lowest indexed heights cannot be retrieved
+func (e *EventLookupIndex) checkDataAvailability(height uint64) (uint64, error) {
+	reporter, err := e.getReporter()
+	if err != nil {
+		return 0, err
+	}
+
+	highestHeight, err := reporter.HighestIndexedHeight()
+	if err != nil {
+		return 0, fmt.Errorf("could not get highest indexed height: %w", err)
+	}
+	if height > highestHeight {
+		return 0, fmt.Errorf("%w: block not indexed yet", storage.ErrHeightNotIndexed)
+	}
+
+	lowestHeight, err := e.lowestIndexedHeight(reporter)
+	if err != nil {
+		return 0, err
+	}
+	if height < lowestHeight {
+		return 0, fmt.Errorf("%w: block is before lowest indexed height", storage.ErrHeightNotIndexed)
+	}
+
+	return highestHeight, nil
+}
+
+// lowestIndexedHeight returns the higher of the lowest height indexed by the execution state indexer and the first
+// height indexed by the lookup indexes.
+// Expected errors:
+//   - storage.ErrHeightNotIndexed if the lookup indexes have not indexed any block yet
+//   - fmt.Errorf with custom message if the heights cannot be retrieved
+func (e *EventLookupIndex) lowestIndexedHeight(reporter state_synchronization.IndexReporter) (uint64, error) {
+	lowestHeight, err := reporter.LowestIndexedHeight()
+	if err != nil {
+		return 0, fmt.Errorf("could not get lowest indexed height: %w", err)
+	}
+
+	firstHeight, err := e.lookups.FirstIndexedHeight()
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return 0, fmt.Errorf("%w: no block indexed yet", storage.ErrHeightNotIndexed)
+		}
+		return 0, fmt.Errorf("could not get first indexed height of the event lookups: %w", err)
+	}
+
+	if firstHeight > lowestHeight {
+		return firstHeight, nil
+	}
+	return lowestHeight, nil
+}
+
+// getReporter retrieves the current index reporter instance from the atomic pointer.
+// Expected errors:
+//   - indexer.ErrIndexNotInitialized if the reporter is not initialized
+func (e *EventLookupIndex) getReporter() (state_synchronization.IndexReporter, error) {
+	reporter := e.reporter.Load()
+	if reporter == nil {
+		return nil, indexer.ErrIndexNotInitialized
+	}
+	return *reporter, nil
+}
@@ -46,6 +46,9 @@ type responseWriter struct {
 // http.Hijacker necessary for using middleware with gorilla websocket connections.
 var _ http.Hijacker = (*responseWriter)(nil)
 
+// http.Flusher necessary for streaming newline delimited JSON responses.
+var _ http.Flusher = (*responseWriter)(nil)
+
 func newResponseWriter(w http.ResponseWriter) *responseWriter {
 	return &responseWriter{w, http.StatusOK}
 }
@@ -62,3 +65,9 @@ func (rw *responseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
 	}
 	return hijacker.Hijack()
 }
+
+func (rw *responseWriter) Flush() {
+	if flusher, ok := rw.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
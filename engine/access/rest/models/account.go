@@ -41,6 +41,11 @@ func (a *Account) Build(flowAccount *flow.Account, link LinkGenerator, expand ma
 	return nil
 }
 
+func (a *AccountBalance) Build(balance uint64, availableBalance uint64) {
+	a.Balance = util.FromUint64(balance)
+	a.AvailableBalance = util.FromUint64(availableBalance)
+}
+
 func (a *AccountPublicKey) Build(k flow.AccountPublicKey) {
 	sigAlgo := SigningAlgorithm(k.SignAlgo.String())
 	hashAlgo := HashingAlgorithm(k.HashAlgo.String())
@@ -0,0 +1,37 @@
+package models
+
+import (
+	"github.com/onflow/flow-go/engine/access/rest/util"
+	"github.com/onflow/flow-go/storage"
+)
+
+func (a *AccountTransaction) Build(tx storage.AccountTransaction, link LinkGenerator) error {
+	self, err := SelfLink(tx.TransactionID, link.TransactionLink)
+	if err != nil {
+		return err
+	}
+
+	a.BlockId = tx.BlockID.String()
+	a.BlockHeight = util.FromUint64(tx.BlockHeight)
+	a.TransactionId = tx.TransactionID.String()
+	a.TransactionIndex = util.FromUint64(uint64(tx.TransactionIndex))
+	a.Roles = tx.Roles.Strings()
+	a.Links = self
+	return nil
+}
+
+// Build builds a page of account transactions. The next cursor is already encoded, since its
+// format is defined by the request parsing it.
+func (a *AccountTransactions) Build(transactions []storage.AccountTransaction, nextCursor string, link LinkGenerator) error {
+	txs := make([]AccountTransaction, len(transactions))
+	for i, tx := range transactions {
+		err := txs[i].Build(tx, link)
+		if err != nil {
+			return err
+		}
+	}
+
+	a.Transactions = txs
+	a.NextCursor = nextCursor
+	return nil
+}
@@ -89,6 +89,12 @@ func (b *BlockHeader) Build(header *flow.Header) {
 	b.ParentVoterSignature = util.ToBase64(header.ParentVoterSigData)
 }
 
+func (b *BlockDigest) Build(digest *flow.BlockDigest) {
+	b.BlockId = digest.ID().String()
+	b.Height = util.FromUint64(digest.Height)
+	b.Timestamp = digest.Timestamp
+}
+
 type BlockSeals []BlockSeal
 
 func (b *BlockSeals) Build(seals []*flow.Seal) error {
@@ -48,3 +48,13 @@ func (b *BlocksEvents) Build(blocksEvents []flow.BlockEvents) {
 
 	*b = evs
 }
+
+// Build builds a page of events grouped by block. The next cursor is already encoded, since its
+// format is defined by the request parsing it.
+func (e *EventsPage) Build(blocksEvents []flow.BlockEvents, nextCursor string) {
+	var events BlocksEvents
+	events.Build(blocksEvents)
+
+	e.Events = events
+	e.NextCursor = nextCursor
+}
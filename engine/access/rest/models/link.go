@@ -1,6 +1,8 @@
 package models
 
 import (
+	"net/url"
+
 	"github.com/gorilla/mux"
 
 	"github.com/onflow/flow-go/model/flow"
@@ -25,6 +27,8 @@ type LinkGenerator interface {
 	ExecutionResultLink(id flow.Identifier) (string, error)
 	AccountLink(address string) (string, error)
 	CollectionLink(id flow.Identifier) (string, error)
+	EventsLink(query url.Values) (string, error)
+	BlocksLink(query url.Values) (string, error)
 }
 
 type LinkFunc func(id flow.Identifier) (string, error)
@@ -65,6 +69,14 @@ func (generator *LinkGeneratorImpl) AccountLink(address string) (string, error)
 	return generator.link("getAccount", "address", address)
 }
 
+func (generator *LinkGeneratorImpl) EventsLink(query url.Values) (string, error) {
+	return generator.linkWithQuery("getEvents", query)
+}
+
+func (generator *LinkGeneratorImpl) BlocksLink(query url.Values) (string, error) {
+	return generator.linkWithQuery("getBlocksByHeight", query)
+}
+
 // SelfLink generates the _link key value pair for the response
 // e.g.
 // "_links": { "_self": "/v1/blocks/c5e935bc75163db82e4a6cf9dc3b54656709d3e21c87385138300abd479c33b7" sx}
@@ -92,3 +104,12 @@ func (generator *LinkGeneratorImpl) link(route string, key string, value string)
 	}
 	return url.String(), nil
 }
+
+func (generator *LinkGeneratorImpl) linkWithQuery(route string, query url.Values) (string, error) {
+	link, err := generator.router.Get(route).URLPath()
+	if err != nil {
+		return "", err
+	}
+	link.RawQuery = query.Encode()
+	return link.String(), nil
+}
@@ -0,0 +1,16 @@
+/*
+ * Access API
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package models
+
+type AccountBalance struct {
+	// Flow balance of the account.
+	Balance string `json:"balance"`
+	// Flow balance of the account which is not reserved for storage.
+	AvailableBalance string `json:"available_balance"`
+}
@@ -0,0 +1,16 @@
+/*
+ * Access API
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package models
+
+type ScriptWithTransactionsResult struct {
+	// Base64 encoded result of the script.
+	Value string `json:"value"`
+	// Results of the transactions applied before executing the script, in order.
+	Transactions []SpeculativeTransactionResult `json:"transactions"`
+}
@@ -0,0 +1,18 @@
+/*
+ * Access API
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package models
+
+type ScriptsWithTransactionsBody struct {
+	// Base64 encoded content of the Cadence script.
+	Script string `json:"script,omitempty"`
+	// An array containing arguments each encoded as Base64 passed in the [JSON-Cadence interchange format](https://docs.onflow.org/cadence/json-cadence-spec/).
+	Arguments []string `json:"arguments,omitempty"`
+	// Transactions applied in order before executing the script. Signatures are optional.
+	Transactions []TransactionsBody `json:"transactions,omitempty"`
+}
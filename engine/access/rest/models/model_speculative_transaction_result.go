@@ -0,0 +1,17 @@
+/*
+ * Access API
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package models
+
+type SpeculativeTransactionResult struct {
+	TransactionId   string  `json:"transaction_id"`
+	ComputationUsed string  `json:"computation_used"`
+	Events          []Event `json:"events"`
+	// Error message of the transaction, empty if the transaction succeeded.
+	ErrorMessage string `json:"error_message"`
+}
@@ -0,0 +1,17 @@
+/*
+ * Access API
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package models
+
+type StorageUsedChange struct {
+	Address string `json:"address"`
+	// Storage used by the account before the transaction, in bytes.
+	StorageUsedBefore string `json:"storage_used_before"`
+	// Storage used by the account after the transaction, in bytes.
+	StorageUsedAfter string `json:"storage_used_after"`
+}
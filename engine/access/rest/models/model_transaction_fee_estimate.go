@@ -0,0 +1,21 @@
+/*
+ * Access API
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package models
+
+// Fee estimate of a transaction. All values are UFix64 values, in units of 10^-8.
+type TransactionFeeEstimate struct {
+	SurgeFactor         string `json:"surge_factor"`
+	InclusionEffortCost string `json:"inclusion_effort_cost"`
+	ExecutionEffortCost string `json:"execution_effort_cost"`
+	InclusionEffort     string `json:"inclusion_effort"`
+	ExecutionEffort     string `json:"execution_effort"`
+	InclusionFee        string `json:"inclusion_fee"`
+	ExecutionFee        string `json:"execution_fee"`
+	TotalFee            string `json:"total_fee"`
+}
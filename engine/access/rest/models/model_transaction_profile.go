@@ -0,0 +1,28 @@
+/*
+ * Access API
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package models
+
+type TransactionProfile struct {
+	BlockId         string `json:"block_id"`
+	TransactionId   string `json:"transaction_id"`
+	ComputationUsed string `json:"computation_used"`
+	// Computation used by the transaction per Cadence computation kind, ordered by kind.
+	ComputationIntensities []ResourceIntensity `json:"computation_intensities"`
+	MemoryEstimate         string              `json:"memory_estimate"`
+	// Memory used by the transaction per Cadence memory kind, ordered by kind.
+	MemoryIntensities []ResourceIntensity `json:"memory_intensities"`
+	RegistersRead     string              `json:"registers_read"`
+	RegistersWritten  string              `json:"registers_written"`
+	BytesRead         string              `json:"bytes_read"`
+	BytesWritten      string              `json:"bytes_written"`
+	EventsSize        string              `json:"events_size"`
+	// Time the execution node spent executing the transaction, in nanoseconds.
+	ExecutionTime string `json:"execution_time"`
+	Links         *Links `json:"_links,omitempty"`
+}
@@ -0,0 +1,20 @@
+/*
+ * Access API
+ *
+ * No description provided (generated by Swagger Codegen https://github.com/swagger-api/swagger-codegen)
+ *
+ * API version: 1.0.0
+ * Generated by: Swagger Codegen (https://github.com/swagger-api/swagger-codegen.git)
+ */
+package models
+
+type TransactionSimulation struct {
+	TransactionId   string                  `json:"transaction_id"`
+	ComputationUsed string                  `json:"computation_used"`
+	Fees            *TransactionFeeEstimate `json:"fees"`
+	Events          []Event                 `json:"events"`
+	// Error message of the transaction, empty if the transaction succeeded.
+	ErrorMessage string `json:"error_message"`
+	// Storage used changes of the accounts updated by the transaction.
+	StorageChanges []StorageUsedChange `json:"storage_changes"`
+}
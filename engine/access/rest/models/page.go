@@ -0,0 +1,12 @@
+package models
+
+// Page is a page of a paginated collection of items. It is returned by the handlers of paginated
+// endpoints, and is written as the list of items, with the link to the next page in the Link header.
+type Page struct {
+	// Items calls yield with each item of the page in order, and returns the first error returned by
+	// yield or encountered while producing the items. Items may be produced lazily, so that newline
+	// delimited JSON responses can write each item to the client as soon as it is produced.
+	Items func(yield func(item interface{}) error) error
+	// Next is the link to the next page, or empty if this is the last page.
+	Next string
+}
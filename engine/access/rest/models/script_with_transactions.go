@@ -0,0 +1,26 @@
+package models
+
+import (
+	"github.com/onflow/flow-go/engine/access/rest/util"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+func (s *ScriptWithTransactionsResult) Build(value []byte, results []flow.SpeculativeTransactionResult) {
+	transactions := make([]SpeculativeTransactionResult, len(results))
+	for i, result := range results {
+		transactions[i].Build(result)
+	}
+
+	s.Value = util.ToBase64(value)
+	s.Transactions = transactions
+}
+
+func (t *SpeculativeTransactionResult) Build(result flow.SpeculativeTransactionResult) {
+	var events Events
+	events.Build(result.Events)
+
+	t.TransactionId = result.TransactionID.String()
+	t.ComputationUsed = util.FromUint64(result.ComputationUsed)
+	t.Events = events
+	t.ErrorMessage = result.ErrorMessage
+}
@@ -0,0 +1,66 @@
+package models
+
+import (
+	"sort"
+
+	"github.com/onflow/flow-go/engine/access/rest/util"
+	"github.com/onflow/flow-go/engine/common/rpc/convert"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+func (t *TransactionProfile) Build(blockID flow.Identifier, profile flow.TransactionProfile, link LinkGenerator) error {
+	self, err := SelfLink(profile.TransactionID, link.TransactionLink)
+	if err != nil {
+		return err
+	}
+
+	t.BlockId = blockID.String()
+	t.TransactionId = profile.TransactionID.String()
+	t.ComputationUsed = util.FromUint64(profile.ComputationUsed)
+	t.ComputationIntensities = buildResourceIntensities(profile.ComputationIntensities, convert.ComputationKindName)
+	t.MemoryEstimate = util.FromUint64(profile.MemoryEstimate)
+	t.MemoryIntensities = buildResourceIntensities(profile.MemoryIntensities, convert.MemoryKindName)
+	t.RegistersRead = util.FromUint64(profile.RegistersRead)
+	t.RegistersWritten = util.FromUint64(profile.RegistersWritten)
+	t.BytesRead = util.FromUint64(profile.BytesRead)
+	t.BytesWritten = util.FromUint64(profile.BytesWritten)
+	t.EventsSize = util.FromUint64(profile.EventsSize)
+	t.ExecutionTime = util.FromUint64(uint64(profile.ExecutionTime.Nanoseconds()))
+	t.Links = self
+	return nil
+}
+
+type TransactionProfiles []TransactionProfile
+
+func (t *TransactionProfiles) Build(blockID flow.Identifier, profiles []flow.TransactionProfile, link LinkGenerator) error {
+	txProfiles := make([]TransactionProfile, len(profiles))
+	for i, profile := range profiles {
+		err := txProfiles[i].Build(blockID, profile, link)
+		if err != nil {
+			return err
+		}
+	}
+
+	*t = txProfiles
+	return nil
+}
+
+// buildResourceIntensities builds the intensities of computation or memory kinds, ordered by kind.
+func buildResourceIntensities(intensities map[uint]uint, name func(uint) string) []ResourceIntensity {
+	kinds := make([]uint, 0, len(intensities))
+	for kind := range intensities {
+		kinds = append(kinds, kind)
+	}
+	sort.Slice(kinds, func(i, j int) bool {
+		return kinds[i] < kinds[j]
+	})
+
+	result := make([]ResourceIntensity, len(kinds))
+	for i, kind := range kinds {
+		result[i] = ResourceIntensity{
+			Kind:      name(kind),
+			Intensity: util.FromUint64(uint64(intensities[kind])),
+		}
+	}
+	return result
+}
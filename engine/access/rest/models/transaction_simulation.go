@@ -0,0 +1,43 @@
+package models
+
+import (
+	"github.com/onflow/flow-go/engine/access/rest/util"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+func (t *TransactionSimulation) Build(result *flow.TransactionSimulationResult) {
+	var events Events
+	events.Build(result.Events)
+
+	var fees TransactionFeeEstimate
+	fees.Build(result.Fees)
+
+	changes := make([]StorageUsedChange, len(result.StorageChanges))
+	for i, change := range result.StorageChanges {
+		changes[i].Build(change)
+	}
+
+	t.TransactionId = result.TransactionID.String()
+	t.ComputationUsed = util.FromUint64(result.ComputationUsed)
+	t.Fees = &fees
+	t.Events = events
+	t.ErrorMessage = result.ErrorMessage
+	t.StorageChanges = changes
+}
+
+func (f *TransactionFeeEstimate) Build(fees flow.TransactionFeeEstimate) {
+	f.SurgeFactor = util.FromUint64(fees.SurgeFactor)
+	f.InclusionEffortCost = util.FromUint64(fees.InclusionEffortCost)
+	f.ExecutionEffortCost = util.FromUint64(fees.ExecutionEffortCost)
+	f.InclusionEffort = util.FromUint64(fees.InclusionEffort)
+	f.ExecutionEffort = util.FromUint64(fees.ExecutionEffort)
+	f.InclusionFee = util.FromUint64(fees.InclusionFee)
+	f.ExecutionFee = util.FromUint64(fees.ExecutionFee)
+	f.TotalFee = util.FromUint64(fees.TotalFee)
+}
+
+func (s *StorageUsedChange) Build(change flow.StorageUsedChange) {
+	s.Address = change.Address.String()
+	s.StorageUsedBefore = util.FromUint64(change.StorageUsedBefore)
+	s.StorageUsedAfter = util.FromUint64(change.StorageUsedAfter)
+}
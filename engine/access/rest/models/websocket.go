@@ -0,0 +1,49 @@
+package models
+
+// Actions a client can request over a multiplexed WebSocket connection.
+const (
+	SubscribeAction         = "subscribe"
+	UnsubscribeAction       = "unsubscribe"
+	ListSubscriptionsAction = "list_subscriptions"
+)
+
+// WebSocketArguments holds the topic specific arguments of a subscribe request.
+// Values are either strings, numbers or lists of strings.
+type WebSocketArguments map[string]interface{}
+
+// WebSocketRequest is a message sent by the client over a multiplexed WebSocket connection.
+type WebSocketRequest struct {
+	// Action is one of subscribe, unsubscribe or list_subscriptions.
+	Action string `json:"action"`
+	// SubscriptionID identifies the subscription to close for unsubscribe requests. It may optionally
+	// be provided with subscribe requests to choose the ID of the new subscription.
+	SubscriptionID string `json:"subscription_id,omitempty"`
+	// Topic is the type of data to subscribe to.
+	Topic string `json:"topic,omitempty"`
+	// Arguments are the topic specific subscription arguments.
+	Arguments WebSocketArguments `json:"arguments,omitempty"`
+}
+
+// WebSocketSubscription describes an active subscription of a connection.
+type WebSocketSubscription struct {
+	SubscriptionID string `json:"subscription_id"`
+	Topic          string `json:"topic"`
+}
+
+// WebSocketError is returned to the client when a request or a subscription fails.
+type WebSocketError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// WebSocketResponse is a message sent by the server over a multiplexed WebSocket connection. It is
+// either a reply to a client request, in which case Action is set, or data produced by a subscription,
+// in which case Payload is set.
+type WebSocketResponse struct {
+	SubscriptionID string                  `json:"subscription_id,omitempty"`
+	Topic          string                  `json:"topic,omitempty"`
+	Action         string                  `json:"action,omitempty"`
+	Payload        interface{}             `json:"payload,omitempty"`
+	Subscriptions  []WebSocketSubscription `json:"subscriptions,omitempty"`
+	Error          *WebSocketError         `json:"error,omitempty"`
+}
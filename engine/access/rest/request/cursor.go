@@ -0,0 +1,106 @@
+package request
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"fmt"
+	"math"
+
+	"github.com/onflow/flow-go/storage"
+)
+
+const cursorQuery = "cursor"
+
+// Cursors are opaque to clients. They are encoded as the url safe base64 encoding of the big endian
+// encoding of their fields.
+
+// HeightCursor is the position of the next page of a paginated height range request.
+type HeightCursor struct {
+	// Height is the first height of the next page.
+	Height uint64
+	// EndHeight is the last height of the range, resolved when the first page was requested.
+	EndHeight uint64
+}
+
+// EncodeHeightCursor encodes the cursor as an opaque url safe string.
+func EncodeHeightCursor(cursor HeightCursor) string {
+	return encodeCursor(cursor.Height, cursor.EndHeight)
+}
+
+// DecodeHeightCursor decodes a cursor encoded by EncodeHeightCursor.
+func DecodeHeightCursor(encoded string) (HeightCursor, error) {
+	fields, err := decodeCursor(encoded, 2)
+	if err != nil {
+		return HeightCursor{}, err
+	}
+
+	cursor := HeightCursor{Height: fields[0], EndHeight: fields[1]}
+	if cursor.Height > cursor.EndHeight {
+		return HeightCursor{}, fmt.Errorf("invalid cursor")
+	}
+	return cursor, nil
+}
+
+// EncodeAccountTransactionCursor encodes the cursor as an opaque url safe string.
+func EncodeAccountTransactionCursor(cursor storage.AccountTransactionCursor) string {
+	return encodeCursor(cursor.Height, uint64(cursor.TransactionIndex))
+}
+
+// DecodeAccountTransactionCursor decodes a cursor encoded by EncodeAccountTransactionCursor.
+func DecodeAccountTransactionCursor(encoded string) (storage.AccountTransactionCursor, error) {
+	fields, err := decodeCursor(encoded, 2)
+	if err != nil {
+		return storage.AccountTransactionCursor{}, err
+	}
+	if fields[1] > math.MaxUint32 {
+		return storage.AccountTransactionCursor{}, fmt.Errorf("invalid cursor")
+	}
+
+	return storage.AccountTransactionCursor{
+		Height:           fields[0],
+		TransactionIndex: uint32(fields[1]),
+	}, nil
+}
+
+// EncodeEventCursor encodes the cursor as an opaque url safe string.
+func EncodeEventCursor(cursor storage.EventCursor) string {
+	return encodeCursor(cursor.Height, uint64(cursor.TransactionIndex), uint64(cursor.EventIndex))
+}
+
+// DecodeEventCursor decodes a cursor encoded by EncodeEventCursor.
+func DecodeEventCursor(encoded string) (storage.EventCursor, error) {
+	fields, err := decodeCursor(encoded, 3)
+	if err != nil {
+		return storage.EventCursor{}, err
+	}
+	if fields[1] > math.MaxUint32 || fields[2] > math.MaxUint32 {
+		return storage.EventCursor{}, fmt.Errorf("invalid cursor")
+	}
+
+	return storage.EventCursor{
+		Height:           fields[0],
+		TransactionIndex: uint32(fields[1]),
+		EventIndex:       uint32(fields[2]),
+	}, nil
+}
+
+func encodeCursor(fields ...uint64) string {
+	raw := make([]byte, 8*len(fields))
+	for i, field := range fields {
+		binary.BigEndian.PutUint64(raw[8*i:], field)
+	}
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func decodeCursor(encoded string, count int) ([]uint64, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil || len(raw) != 8*count {
+		return nil, fmt.Errorf("invalid cursor")
+	}
+
+	fields := make([]uint64, count)
+	for i := range fields {
+		fields[i] = binary.BigEndian.Uint64(raw[8*i:])
+	}
+	return fields, nil
+}
@@ -0,0 +1,70 @@
+package request
+
+import (
+	"fmt"
+
+	"github.com/onflow/flow-go/engine/access/rest/models"
+	"github.com/onflow/flow-go/engine/access/rest/util"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+const maxScriptTransactions = 100
+
+type ExecuteScriptWithTransactions struct {
+	BlockHeight  uint64
+	Script       Script
+	Transactions []flow.TransactionBody
+}
+
+func (e *ExecuteScriptWithTransactions) Build(r *Request) error {
+	var height Height
+	err := height.Parse(r.GetQueryParam(blockHeightQuery))
+	if err != nil {
+		return err
+	}
+	e.BlockHeight = height.Flow()
+
+	// default to last sealed block
+	if e.BlockHeight == EmptyHeight {
+		e.BlockHeight = SealedHeight
+	}
+
+	var body models.ScriptsWithTransactionsBody
+	err = parseBody(r.Body, &body)
+	if err != nil {
+		return err
+	}
+
+	source, err := util.FromBase64(body.Script)
+	if err != nil {
+		return fmt.Errorf("invalid script source encoding")
+	}
+
+	var args Arguments
+	err = args.Parse(body.Arguments)
+	if err != nil {
+		return err
+	}
+
+	e.Script = Script{
+		Source: source,
+		Args:   args,
+	}
+
+	if len(body.Transactions) > maxScriptTransactions {
+		return fmt.Errorf("too many transactions. Maximum transactions allowed: %d", maxScriptTransactions)
+	}
+
+	// the transactions are applied without authorization checks, so signatures are not required
+	e.Transactions = make([]flow.TransactionBody, len(body.Transactions))
+	for i, rawTx := range body.Transactions {
+		var tx Transaction
+		err = tx.build(rawTx, r.Chain, false)
+		if err != nil {
+			return fmt.Errorf("invalid transaction %d: %w", i, err)
+		}
+		e.Transactions[i] = tx.Flow()
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package request
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+)
+
+// DefaultAccountTransactionsLimit is the page size used if the request does not provide a limit.
+const DefaultAccountTransactionsLimit = 50
+
+type GetAccountTransactions struct {
+	Address flow.Address
+	Cursor  storage.AccountTransactionCursor
+	Limit   uint
+}
+
+func (g *GetAccountTransactions) Build(r *Request) error {
+	return g.Parse(
+		r.GetVar(addressVar),
+		r.GetQueryParam(startHeightQuery),
+		r.GetQueryParam(cursorQuery),
+		r.GetQueryParam(limitQuery),
+		r.Chain,
+	)
+}
+
+func (g *GetAccountTransactions) Parse(rawAddress string, rawStart string, rawCursor string, rawLimit string, chain flow.Chain) error {
+	address, err := ParseAddress(rawAddress, chain)
+	if err != nil {
+		return err
+	}
+	g.Address = address
+
+	if rawStart != "" && rawCursor != "" {
+		return fmt.Errorf("can only provide either start height or cursor")
+	}
+
+	switch {
+	case rawCursor != "":
+		cursor, err := DecodeAccountTransactionCursor(rawCursor)
+		if err != nil {
+			return err
+		}
+		g.Cursor = cursor
+	case rawStart != "":
+		var height Height
+		err = height.Parse(rawStart)
+		if err != nil {
+			return fmt.Errorf("invalid start height: %w", err)
+		}
+		if height.Flow() == FinalHeight || height.Flow() == SealedHeight {
+			return fmt.Errorf("invalid start height: must be a block height")
+		}
+		g.Cursor = storage.AccountTransactionCursor{Height: height.Flow()}
+	default:
+		return fmt.Errorf("must provide either start height or cursor")
+	}
+
+	g.Limit = DefaultAccountTransactionsLimit
+	if rawLimit != "" {
+		limit, err := strconv.ParseUint(rawLimit, 10, 32)
+		if err != nil || limit == 0 {
+			return fmt.Errorf("invalid limit: must be a positive integer")
+		}
+		g.Limit = uint(limit)
+	}
+
+	return nil
+}
@@ -18,9 +18,25 @@ type GetBlock struct {
 	EndHeight    uint64
 	FinalHeight  bool
 	SealedHeight bool
+	// Page is set if the blocks are requested by pages of heights rather than by heights or a height range.
+	Page *HeightPage
 }
 
 func (g *GetBlock) Build(r *Request) error {
+	if IsHeightPageRequest(r) {
+		if len(r.GetQueryParams(heightQuery)) > 0 {
+			return fmt.Errorf("can only paginate blocks requested by height range")
+		}
+
+		var page HeightPage
+		err := page.Build(r, MaxBlockRequestHeightRange)
+		if err != nil {
+			return err
+		}
+		g.Page = &page
+		return nil
+	}
+
 	return g.Parse(
 		r.GetQueryParams(heightQuery),
 		r.GetQueryParam(startHeightQuery),
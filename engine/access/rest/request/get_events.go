@@ -15,9 +15,22 @@ type GetEvents struct {
 	EndHeight   uint64
 	Type        string
 	BlockIDs    []flow.Identifier
+	// Page is set if the events are requested by pages of heights rather than by a height range or block IDs.
+	Page *HeightPage
 }
 
 func (g *GetEvents) Build(r *Request) error {
+	if IsHeightPageRequest(r) {
+		return g.ParsePage(
+			r.GetQueryParam(eventTypeQuery),
+			r.GetQueryParams(blockQuery),
+			r.GetQueryParam(startHeightQuery),
+			r.GetQueryParam(endHeightQuery),
+			r.GetQueryParam(cursorQuery),
+			r.GetQueryParam(limitQuery),
+		)
+	}
+
 	return g.Parse(
 		r.GetQueryParam(eventTypeQuery),
 		r.GetQueryParam(startHeightQuery),
@@ -79,3 +92,29 @@ func (g *GetEvents) Parse(rawType string, rawStart string, rawEnd string, rawBlo
 
 	return nil
 }
+
+// ParsePage parses a request for a page of events of the given type.
+func (g *GetEvents) ParsePage(rawType string, rawBlockIDs []string, rawStart string, rawEnd string, rawCursor string, rawLimit string) error {
+	if len(rawBlockIDs) > 0 {
+		return fmt.Errorf("can only paginate events requested by height range")
+	}
+
+	if rawType == "" {
+		return fmt.Errorf("event type must be provided")
+	}
+	var eventType EventType
+	err := eventType.Parse(rawType)
+	if err != nil {
+		return err
+	}
+	g.Type = eventType.Flow()
+
+	var page HeightPage
+	err = page.Parse(rawStart, rawEnd, rawCursor, rawLimit, MaxEventRequestHeightRange)
+	if err != nil {
+		return err
+	}
+	g.Page = &page
+
+	return nil
+}
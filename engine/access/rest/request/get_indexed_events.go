@@ -0,0 +1,109 @@
+package request
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+)
+
+const eventTypeVar = "type"
+
+// DefaultIndexedEventsLimit is the page size used if the request does not provide a limit.
+const DefaultIndexedEventsLimit = 50
+
+type GetEventsByEventType struct {
+	Type   string
+	Cursor storage.EventCursor
+	Limit  uint
+}
+
+func (g *GetEventsByEventType) Build(r *Request) error {
+	return g.Parse(
+		r.GetVar(eventTypeVar),
+		r.GetQueryParam(startHeightQuery),
+		r.GetQueryParam(cursorQuery),
+		r.GetQueryParam(limitQuery),
+	)
+}
+
+func (g *GetEventsByEventType) Parse(rawType string, rawStart string, rawCursor string, rawLimit string) error {
+	var eventType EventType
+	err := eventType.Parse(rawType)
+	if err != nil {
+		return err
+	}
+	g.Type = eventType.Flow()
+
+	g.Cursor, g.Limit, err = parseEventsPage(rawStart, rawCursor, rawLimit)
+	return err
+}
+
+type GetEventsByContractAddress struct {
+	Address flow.Address
+	Cursor  storage.EventCursor
+	Limit   uint
+}
+
+func (g *GetEventsByContractAddress) Build(r *Request) error {
+	return g.Parse(
+		r.GetVar(addressVar),
+		r.GetQueryParam(startHeightQuery),
+		r.GetQueryParam(cursorQuery),
+		r.GetQueryParam(limitQuery),
+		r.Chain,
+	)
+}
+
+func (g *GetEventsByContractAddress) Parse(rawAddress string, rawStart string, rawCursor string, rawLimit string, chain flow.Chain) error {
+	address, err := ParseAddress(rawAddress, chain)
+	if err != nil {
+		return err
+	}
+	g.Address = address
+
+	g.Cursor, g.Limit, err = parseEventsPage(rawStart, rawCursor, rawLimit)
+	return err
+}
+
+// parseEventsPage parses the position and size of a page of indexed events. The position is either
+// a start height or the cursor returned with the previous page.
+func parseEventsPage(rawStart string, rawCursor string, rawLimit string) (storage.EventCursor, uint, error) {
+	if rawStart != "" && rawCursor != "" {
+		return storage.EventCursor{}, 0, fmt.Errorf("can only provide either start height or cursor")
+	}
+
+	var cursor storage.EventCursor
+	switch {
+	case rawCursor != "":
+		var err error
+		cursor, err = DecodeEventCursor(rawCursor)
+		if err != nil {
+			return storage.EventCursor{}, 0, err
+		}
+	case rawStart != "":
+		var height Height
+		err := height.Parse(rawStart)
+		if err != nil {
+			return storage.EventCursor{}, 0, fmt.Errorf("invalid start height: %w", err)
+		}
+		if height.Flow() == FinalHeight || height.Flow() == SealedHeight {
+			return storage.EventCursor{}, 0, fmt.Errorf("invalid start height: must be a block height")
+		}
+		cursor = storage.EventCursor{Height: height.Flow()}
+	default:
+		return storage.EventCursor{}, 0, fmt.Errorf("must provide either start height or cursor")
+	}
+
+	limit := uint(DefaultIndexedEventsLimit)
+	if rawLimit != "" {
+		parsed, err := strconv.ParseUint(rawLimit, 10, 32)
+		if err != nil || parsed == 0 {
+			return storage.EventCursor{}, 0, fmt.Errorf("invalid limit: must be a positive integer")
+		}
+		limit = uint(parsed)
+	}
+
+	return cursor, limit, nil
+}
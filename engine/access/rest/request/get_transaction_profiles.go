@@ -0,0 +1,47 @@
+package request
+
+import (
+	"fmt"
+
+	"github.com/onflow/flow-go/model/flow"
+)
+
+type GetTransactionProfile struct {
+	GetByIDRequest
+	BlockID flow.Identifier
+}
+
+func (g *GetTransactionProfile) Build(r *Request) error {
+	err := g.GetByIDRequest.Build(r)
+	if err != nil {
+		return err
+	}
+
+	g.BlockID, err = parseRequiredBlockID(r.GetQueryParam(blockIDQueryParam))
+	return err
+}
+
+type GetTransactionProfiles struct {
+	BlockID flow.Identifier
+}
+
+func (g *GetTransactionProfiles) Build(r *Request) error {
+	var err error
+	g.BlockID, err = parseRequiredBlockID(r.GetQueryParam(blockIDQueryParam))
+	return err
+}
+
+// parseRequiredBlockID parses a block ID which must be provided, since transaction
+// profiles are only stored for the block the transaction was executed in.
+func parseRequiredBlockID(raw string) (flow.Identifier, error) {
+	if raw == "" {
+		return flow.ZeroID, fmt.Errorf("%s must be provided", blockIDQueryParam)
+	}
+
+	var blockID ID
+	err := blockID.Parse(raw)
+	if err != nil {
+		return flow.ZeroID, fmt.Errorf("invalid %s: %w", blockIDQueryParam, err)
+	}
+	return blockID.Flow(), nil
+}
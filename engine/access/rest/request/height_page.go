@@ -0,0 +1,108 @@
+package request
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+const limitQuery = "limit"
+
+// HeightPage is a page of a paginated height range request. The first page is requested with a start
+// height, an optional end height and a limit, and the following pages with the cursor returned with
+// the previous page.
+type HeightPage struct {
+	StartHeight uint64
+	// EndHeight is the last height of the range. It may be FinalHeight or SealedHeight, in which case
+	// it is resolved by the handler.
+	EndHeight uint64
+	// Limit is the maximum number of heights in the page.
+	Limit uint64
+}
+
+// IsHeightPageRequest returns true if the request uses pagination.
+func IsHeightPageRequest(r *Request) bool {
+	return r.GetQueryParam(cursorQuery) != "" || r.GetQueryParam(limitQuery) != ""
+}
+
+func (p *HeightPage) Build(r *Request, maxLimit uint64) error {
+	return p.Parse(
+		r.GetQueryParam(startHeightQuery),
+		r.GetQueryParam(endHeightQuery),
+		r.GetQueryParam(cursorQuery),
+		r.GetQueryParam(limitQuery),
+		maxLimit,
+	)
+}
+
+func (p *HeightPage) Parse(rawStart string, rawEnd string, rawCursor string, rawLimit string, maxLimit uint64) error {
+	if rawCursor != "" {
+		if rawStart != "" || rawEnd != "" {
+			return fmt.Errorf("can only provide either cursor or start and end height range")
+		}
+
+		cursor, err := DecodeHeightCursor(rawCursor)
+		if err != nil {
+			return err
+		}
+		p.StartHeight = cursor.Height
+		p.EndHeight = cursor.EndHeight
+	} else {
+		var height Height
+		err := height.Parse(rawStart)
+		if err != nil {
+			return fmt.Errorf("invalid start height: %w", err)
+		}
+		p.StartHeight = height.Flow()
+		if p.StartHeight == EmptyHeight {
+			return fmt.Errorf("must provide either cursor or start height")
+		}
+		if p.StartHeight == FinalHeight || p.StartHeight == SealedHeight {
+			return fmt.Errorf("invalid start height: must be a block height")
+		}
+
+		err = height.Parse(rawEnd)
+		if err != nil {
+			return fmt.Errorf("invalid end height: %w", err)
+		}
+		p.EndHeight = height.Flow()
+		// default to the last sealed block
+		if p.EndHeight == EmptyHeight {
+			p.EndHeight = SealedHeight
+		}
+
+		if p.EndHeight != FinalHeight && p.EndHeight != SealedHeight && p.StartHeight > p.EndHeight {
+			return fmt.Errorf("start height must be less than or equal to end height")
+		}
+	}
+
+	p.Limit = maxLimit
+	if rawLimit != "" {
+		limit, err := strconv.ParseUint(rawLimit, 10, 64)
+		if err != nil || limit == 0 || limit > maxLimit {
+			return fmt.Errorf("invalid limit: must be between 1 and %d", maxLimit)
+		}
+		p.Limit = limit
+	}
+
+	return nil
+}
+
+// PageEnd returns the last height of the page, given the resolved end height of the range.
+func (p *HeightPage) PageEnd(endHeight uint64) uint64 {
+	if endHeight-p.StartHeight >= p.Limit {
+		return p.StartHeight + p.Limit - 1
+	}
+	return endHeight
+}
+
+// NextPageQuery returns the query parameters of the request for the page at the given cursor. The height
+// range parameters are replaced by the cursor, while the other parameters, such as the limit or the fields
+// to expand and select, are kept.
+func (rd *Request) NextPageQuery(cursor HeightCursor) url.Values {
+	query := rd.Request.URL.Query()
+	query.Del(startHeightQuery)
+	query.Del(endHeightQuery)
+	query.Set(cursorQuery, EncodeHeightCursor(cursor))
+	return query
+}
@@ -0,0 +1,70 @@
+package request
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeightPage_InvalidParse(t *testing.T) {
+	var page HeightPage
+
+	cursor := EncodeHeightCursor(HeightCursor{Height: 10, EndHeight: 20})
+	// the cursor must not be past the end of the range
+	pastEnd := EncodeHeightCursor(HeightCursor{Height: 21, EndHeight: 20})
+
+	tests := []struct {
+		start  string
+		end    string
+		cursor string
+		limit  string
+		err    string
+	}{
+		{"", "", "", "10", "must provide either cursor or start height"},
+		{"", "20", "", "", "must provide either cursor or start height"},
+		{"10", "", cursor, "", "can only provide either cursor or start and end height range"},
+		{"", "20", cursor, "", "can only provide either cursor or start and end height range"},
+		{"sealed", "", "", "10", "invalid start height: must be a block height"},
+		{"foo", "", "", "10", "invalid start height: invalid height format"},
+		{"10", "foo", "", "10", "invalid end height: invalid height format"},
+		{"20", "10", "", "10", "start height must be less than or equal to end height"},
+		{"", "", "foo", "", "invalid cursor"},
+		{"", "", pastEnd, "", "invalid cursor"},
+		{"10", "", "", "0", "invalid limit: must be between 1 and 50"},
+		{"10", "", "", "51", "invalid limit: must be between 1 and 50"},
+		{"10", "", "", "foo", "invalid limit: must be between 1 and 50"},
+	}
+
+	for i, test := range tests {
+		err := page.Parse(test.start, test.end, test.cursor, test.limit, 50)
+		assert.EqualError(t, err, test.err, fmt.Sprintf("test #%d failed", i))
+	}
+}
+
+func TestHeightPage_ValidParse(t *testing.T) {
+	var page HeightPage
+
+	err := page.Parse("10", "", "", "", 50)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), page.StartHeight)
+	assert.Equal(t, SealedHeight, page.EndHeight)
+	assert.Equal(t, uint64(50), page.Limit)
+	assert.Equal(t, uint64(59), page.PageEnd(100))
+	assert.Equal(t, uint64(30), page.PageEnd(30))
+
+	err = page.Parse("10", "final", "", "5", 50)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(10), page.StartHeight)
+	assert.Equal(t, FinalHeight, page.EndHeight)
+	assert.Equal(t, uint64(5), page.Limit)
+
+	cursor := EncodeHeightCursor(HeightCursor{Height: 15, EndHeight: 20})
+	err = page.Parse("", "", cursor, "10", 50)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(15), page.StartHeight)
+	assert.Equal(t, uint64(20), page.EndHeight)
+	assert.Equal(t, uint64(10), page.Limit)
+	assert.Equal(t, uint64(20), page.PageEnd(page.EndHeight))
+}
@@ -54,6 +54,12 @@ func (rd *Request) GetAccountRequest() (GetAccount, error) {
 	return req, err
 }
 
+func (rd *Request) GetAccountTransactionsRequest() (GetAccountTransactions, error) {
+	var req GetAccountTransactions
+	err := req.Build(rd)
+	return req, err
+}
+
 func (rd *Request) GetAccountKeyRequest() (GetAccountKey, error) {
 	var req GetAccountKey
 	err := req.Build(rd)
@@ -84,18 +90,54 @@ func (rd *Request) GetTransactionResultRequest() (GetTransactionResult, error) {
 	return req, err
 }
 
+func (rd *Request) GetTransactionProfileRequest() (GetTransactionProfile, error) {
+	var req GetTransactionProfile
+	err := req.Build(rd)
+	return req, err
+}
+
+func (rd *Request) GetTransactionProfilesRequest() (GetTransactionProfiles, error) {
+	var req GetTransactionProfiles
+	err := req.Build(rd)
+	return req, err
+}
+
 func (rd *Request) GetEventsRequest() (GetEvents, error) {
 	var req GetEvents
 	err := req.Build(rd)
 	return req, err
 }
 
+func (rd *Request) GetEventsByEventTypeRequest() (GetEventsByEventType, error) {
+	var req GetEventsByEventType
+	err := req.Build(rd)
+	return req, err
+}
+
+func (rd *Request) GetEventsByContractAddressRequest() (GetEventsByContractAddress, error) {
+	var req GetEventsByContractAddress
+	err := req.Build(rd)
+	return req, err
+}
+
 func (rd *Request) CreateTransactionRequest() (CreateTransaction, error) {
 	var req CreateTransaction
 	err := req.Build(rd)
 	return req, err
 }
 
+func (rd *Request) ExecuteScriptWithTransactionsRequest() (ExecuteScriptWithTransactions, error) {
+	var req ExecuteScriptWithTransactions
+	err := req.Build(rd)
+	return req, err
+}
+
+func (rd *Request) SimulateTransactionRequest() (SimulateTransaction, error) {
+	var req SimulateTransaction
+	err := req.Build(rd)
+	return req, err
+}
+
 func (rd *Request) SubscribeEventsRequest() (SubscribeEvents, error) {
 	var req SubscribeEvents
 	err := req.Build(rd)
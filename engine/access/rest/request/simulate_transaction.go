@@ -0,0 +1,40 @@
+package request
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/onflow/flow-go/model/flow"
+)
+
+const skipSignatureChecksQuery = "skip_signature_checks"
+
+type SimulateTransaction struct {
+	Transaction         flow.TransactionBody
+	SkipSignatureChecks bool
+}
+
+func (s *SimulateTransaction) Build(r *Request) error {
+	if raw := r.GetQueryParam(skipSignatureChecksQuery); raw != "" {
+		skip, err := strconv.ParseBool(raw)
+		if err != nil {
+			return fmt.Errorf("invalid %s: must be a boolean", skipSignatureChecksQuery)
+		}
+		s.SkipSignatureChecks = skip
+	}
+
+	// signatures are not required if they are not checked
+	var tx Transaction
+	var err error
+	if s.SkipSignatureChecks {
+		err = tx.ParseWithoutSignatures(r.Body, r.Chain)
+	} else {
+		err = tx.Parse(r.Body, r.Chain)
+	}
+	if err != nil {
+		return err
+	}
+
+	s.Transaction = tx.Flow()
+	return nil
+}
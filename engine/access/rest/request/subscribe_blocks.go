@@ -0,0 +1,145 @@
+package request
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/onflow/flow-go/model/flow"
+)
+
+const finalizedBlockStatus = "finalized"
+const sealedBlockStatus = "sealed"
+
+// SubscribeBlocks holds the arguments of a blocks, block headers or block digests subscription.
+type SubscribeBlocks struct {
+	// StartBlockID is flow.ZeroID if the subscription does not start at a block ID.
+	StartBlockID flow.Identifier
+	// StartHeight is EmptyHeight if the subscription does not start at a height.
+	StartHeight uint64
+	BlockStatus flow.BlockStatus
+}
+
+func (s *SubscribeBlocks) Parse(
+	rawStartBlockID string,
+	rawStartHeight string,
+	rawBlockStatus string,
+) error {
+	var err error
+	s.StartBlockID, s.StartHeight, err = parseStartBlock(rawStartBlockID, rawStartHeight)
+	if err != nil {
+		return err
+	}
+
+	switch rawBlockStatus {
+	case finalizedBlockStatus:
+		s.BlockStatus = flow.BlockStatusFinalized
+	case sealedBlockStatus:
+		s.BlockStatus = flow.BlockStatusSealed
+	case "":
+		return fmt.Errorf("block status must be provided")
+	default:
+		return fmt.Errorf("invalid block status, must be either %s or %s", finalizedBlockStatus, sealedBlockStatus)
+	}
+
+	return nil
+}
+
+// SubscribeAccountStatuses holds the arguments of an account statuses subscription.
+type SubscribeAccountStatuses struct {
+	// StartBlockID is flow.ZeroID if the subscription does not start at a block ID.
+	StartBlockID flow.Identifier
+	// StartHeight is EmptyHeight if the subscription does not start at a height.
+	StartHeight uint64
+
+	EventTypes       []string
+	AccountAddresses []string
+
+	HeartbeatInterval uint64
+}
+
+func (s *SubscribeAccountStatuses) Parse(
+	rawStartBlockID string,
+	rawStartHeight string,
+	rawTypes []string,
+	rawAddresses []string,
+	rawHeartbeatInterval string,
+) error {
+	var err error
+	s.StartBlockID, s.StartHeight, err = parseStartBlock(rawStartBlockID, rawStartHeight)
+	if err != nil {
+		return err
+	}
+
+	var eventTypes EventTypes
+	err = eventTypes.Parse(rawTypes)
+	if err != nil {
+		return err
+	}
+	s.EventTypes = eventTypes.Flow()
+	s.AccountAddresses = rawAddresses
+
+	s.HeartbeatInterval, err = parseHeartbeatInterval(rawHeartbeatInterval)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SubscribeTransactionStatuses holds the arguments of a transaction statuses subscription.
+type SubscribeTransactionStatuses struct {
+	TransactionID flow.Identifier
+}
+
+func (s *SubscribeTransactionStatuses) Parse(rawTransactionID string) error {
+	var id ID
+	err := id.Parse(rawTransactionID)
+	if err != nil {
+		return err
+	}
+	if id.Flow() == flow.ZeroID {
+		return fmt.Errorf("transaction ID must be provided")
+	}
+	s.TransactionID = id.Flow()
+
+	return nil
+}
+
+// parseStartBlock parses the optional start block ID and start height of a subscription.
+// At most one of them may be provided. The start height is EmptyHeight if it was not provided.
+func parseStartBlock(rawStartBlockID string, rawStartHeight string) (flow.Identifier, uint64, error) {
+	var startBlockID ID
+	err := startBlockID.Parse(rawStartBlockID)
+	if err != nil {
+		return flow.ZeroID, 0, err
+	}
+
+	var height Height
+	err = height.Parse(rawStartHeight)
+	if err != nil {
+		return flow.ZeroID, 0, fmt.Errorf("invalid start height: %w", err)
+	}
+	if height.Flow() == SealedHeight || height.Flow() == FinalHeight {
+		return flow.ZeroID, 0, fmt.Errorf("invalid start height: must be a block height")
+	}
+
+	if startBlockID.Flow() != flow.ZeroID && height.Flow() != EmptyHeight {
+		return flow.ZeroID, 0, fmt.Errorf("can only provide either block ID or start height")
+	}
+
+	return startBlockID.Flow(), height.Flow(), nil
+}
+
+// parseHeartbeatInterval parses the optional heartbeat interval of a subscription, returning
+// zero if it was not provided.
+func parseHeartbeatInterval(raw string) (uint64, error) {
+	if raw == "" {
+		return 0, nil
+	}
+
+	interval, err := strconv.ParseUint(raw, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid heartbeat interval format")
+	}
+	return interval, nil
+}
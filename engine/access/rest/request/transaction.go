@@ -16,12 +16,26 @@ const maxAllowedScriptArguments = 100
 type Transaction flow.TransactionBody
 
 func (t *Transaction) Parse(raw io.Reader, chain flow.Chain) error {
+	return t.parse(raw, chain, true)
+}
+
+// ParseWithoutSignatures parses a transaction which is not required to have envelope signatures,
+// e.g. for a transaction which is only simulated.
+func (t *Transaction) ParseWithoutSignatures(raw io.Reader, chain flow.Chain) error {
+	return t.parse(raw, chain, false)
+}
+
+func (t *Transaction) parse(raw io.Reader, chain flow.Chain, requireSignatures bool) error {
 	var tx models.TransactionsBody
 	err := parseBody(raw, &tx)
 	if err != nil {
 		return err
 	}
 
+	return t.build(tx, chain, requireSignatures)
+}
+
+func (t *Transaction) build(tx models.TransactionsBody, chain flow.Chain, requireSignatures bool) error {
 	if tx.ProposalKey == nil {
 		return fmt.Errorf("proposal key not provided")
 	}
@@ -40,12 +54,12 @@ func (t *Transaction) Parse(raw io.Reader, chain flow.Chain) error {
 	if tx.ReferenceBlockId == "" {
 		return fmt.Errorf("reference block not provided")
 	}
-	if len(tx.EnvelopeSignatures) == 0 {
+	if requireSignatures && len(tx.EnvelopeSignatures) == 0 {
 		return fmt.Errorf("envelope signatures not provided")
 	}
 
 	var args Arguments
-	err = args.Parse(tx.Arguments)
+	err := args.Parse(tx.Arguments)
 	if err != nil {
 		return err
 	}
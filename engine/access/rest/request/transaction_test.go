@@ -135,3 +135,17 @@ func TestTransaction_ValidParse(t *testing.T) {
 	assert.Equal(t, tx["gas_limit"], fmt.Sprint(transaction.Flow().GasLimit))
 	assert.Equal(t, len(tx["authorizers"].([]string)), len(transaction.Flow().Authorizers))
 }
+
+func TestTransaction_ParseWithoutSignatures(t *testing.T) {
+	tx := buildTransaction()
+	delete(tx, "envelope_signatures")
+
+	var transaction Transaction
+	err := transaction.Parse(transactionToReader(tx), flow.Testnet.Chain())
+	assert.EqualError(t, err, "envelope signatures not provided")
+
+	err = transaction.ParseWithoutSignatures(transactionToReader(tx), flow.Testnet.Chain())
+	assert.NoError(t, err)
+	assert.Equal(t, tx["payer"], transaction.Flow().Payer.String())
+	assert.Empty(t, transaction.Flow().EnvelopeSignatures)
+}
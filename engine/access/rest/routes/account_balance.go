@@ -0,0 +1,39 @@
+package routes
+
+import (
+	"github.com/onflow/flow-go/access"
+	"github.com/onflow/flow-go/engine/access/rest/models"
+	"github.com/onflow/flow-go/engine/access/rest/request"
+)
+
+// GetAccountBalance handler retrieves the balance and available balance of an account by address
+// and returns the response
+func GetAccountBalance(r *request.Request, backend access.API, _ models.LinkGenerator) (interface{}, error) {
+	req, err := r.GetAccountRequest()
+	if err != nil {
+		return nil, models.NewBadRequestError(err)
+	}
+
+	// in case we receive special height values 'final' and 'sealed', fetch that height and overwrite request with it
+	if req.Height == request.FinalHeight || req.Height == request.SealedHeight {
+		header, _, err := backend.GetLatestBlockHeader(r.Context(), req.Height == request.SealedHeight)
+		if err != nil {
+			return nil, err
+		}
+		req.Height = header.Height
+	}
+
+	balance, err := backend.GetAccountBalanceAtBlockHeight(r.Context(), req.Address, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	availableBalance, err := backend.GetAccountAvailableBalanceAtBlockHeight(r.Context(), req.Address, req.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.AccountBalance
+	response.Build(balance, availableBalance)
+	return response, nil
+}
@@ -0,0 +1,33 @@
+package routes
+
+import (
+	"github.com/onflow/flow-go/access"
+	"github.com/onflow/flow-go/engine/access/rest/models"
+	"github.com/onflow/flow-go/engine/access/rest/request"
+)
+
+// GetAccountTransactions handler retrieves a page of transactions the account was involved in
+// and returns the response
+func GetAccountTransactions(r *request.Request, backend access.API, link models.LinkGenerator) (interface{}, error) {
+	req, err := r.GetAccountTransactionsRequest()
+	if err != nil {
+		return nil, models.NewBadRequestError(err)
+	}
+
+	page, err := backend.GetTransactionsByAddress(r.Context(), req.Address, req.Cursor, req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	var nextCursor string
+	if page.NextCursor != nil {
+		nextCursor = request.EncodeAccountTransactionCursor(*page.NextCursor)
+	}
+
+	var response models.AccountTransactions
+	err = response.Build(page.Transactions, nextCursor, link)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
@@ -0,0 +1,163 @@
+package routes
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	mocktestify "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/access"
+	"github.com/onflow/flow-go/access/mock"
+	"github.com/onflow/flow-go/engine/access/rest/request"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestGetAccountTransactions tests local getAccountTransactions request.
+//
+// Runs the following tests:
+// 1. Get account transactions from a start height.
+// 2. Get account transactions from a cursor returned by a previous page.
+// 3. Get account transactions with the default limit.
+// 4. Get account transactions with a limit above the maximum page size.
+// 5. Get invalid account transactions.
+func TestGetAccountTransactions(t *testing.T) {
+	backend := mock.NewAPI(t)
+	address := flow.Testnet.Chain().ServiceAddress()
+
+	transactions := []storage.AccountTransaction{
+		{
+			Address:          address,
+			BlockID:          unittest.IdentifierFixture(),
+			BlockHeight:      100,
+			TransactionID:    unittest.IdentifierFixture(),
+			TransactionIndex: 0,
+			Roles:            storage.AccountTransactionRolePayer | storage.AccountTransactionRoleProposer,
+		},
+		{
+			Address:          address,
+			BlockID:          unittest.IdentifierFixture(),
+			BlockHeight:      101,
+			TransactionID:    unittest.IdentifierFixture(),
+			TransactionIndex: 2,
+			Roles:            storage.AccountTransactionRoleAuthorizer,
+		},
+	}
+	next := &storage.AccountTransactionCursor{Height: 101, TransactionIndex: 3}
+	nextCursor := request.EncodeAccountTransactionCursor(*next)
+
+	t.Run("get transactions from start height", func(t *testing.T) {
+		req := getAccountTransactionsRequest(t, address.String(), map[string]string{"start_height": "100", "limit": "2"})
+
+		backend.Mock.
+			On("GetTransactionsByAddress", mocktestify.Anything, address, storage.AccountTransactionCursor{Height: 100}, uint(2)).
+			Return(&access.AccountTransactionsPage{Transactions: transactions, NextCursor: next}, nil).
+			Once()
+
+		assertOKResponse(t, req, expectedAccountTransactionsResponse(transactions, nextCursor), backend)
+	})
+
+	t.Run("get transactions from cursor", func(t *testing.T) {
+		req := getAccountTransactionsRequest(t, address.String(), map[string]string{"cursor": nextCursor, "limit": "2"})
+
+		backend.Mock.
+			On("GetTransactionsByAddress", mocktestify.Anything, address, *next, uint(2)).
+			Return(&access.AccountTransactionsPage{Transactions: transactions[1:]}, nil).
+			Once()
+
+		assertOKResponse(t, req, expectedAccountTransactionsResponse(transactions[1:], ""), backend)
+	})
+
+	t.Run("get transactions with default limit", func(t *testing.T) {
+		req := getAccountTransactionsRequest(t, address.String(), map[string]string{"start_height": "100"})
+
+		backend.Mock.
+			On("GetTransactionsByAddress", mocktestify.Anything, address, storage.AccountTransactionCursor{Height: 100}, uint(request.DefaultAccountTransactionsLimit)).
+			Return(&access.AccountTransactionsPage{Transactions: []storage.AccountTransaction{}}, nil).
+			Once()
+
+		assertOKResponse(t, req, expectedAccountTransactionsResponse(nil, ""), backend)
+	})
+
+	t.Run("get transactions with limit above maximum", func(t *testing.T) {
+		req := getAccountTransactionsRequest(t, address.String(), map[string]string{"start_height": "100", "limit": "5000"})
+
+		backend.Mock.
+			On("GetTransactionsByAddress", mocktestify.Anything, address, storage.AccountTransactionCursor{Height: 100}, uint(5000)).
+			Return(nil, status.Error(codes.InvalidArgument, "limit must be between 1 and 1000")).
+			Once()
+
+		out := `{"code":400, "message":"Invalid Flow argument: limit must be between 1 and 1000"}`
+		assertResponse(t, req, http.StatusBadRequest, out, backend)
+	})
+
+	t.Run("get invalid", func(t *testing.T) {
+		tests := []struct {
+			url string
+			out string
+		}{
+			{accountTransactionsURL(t, "123", map[string]string{"start_height": "100"}), `{"code":400, "message":"invalid address"}`},
+			{accountTransactionsURL(t, address.String(), nil), `{"code":400, "message":"must provide either start height or cursor"}`},
+			{accountTransactionsURL(t, address.String(), map[string]string{"start_height": "100", "cursor": nextCursor}), `{"code":400, "message":"can only provide either start height or cursor"}`},
+			{accountTransactionsURL(t, address.String(), map[string]string{"start_height": "sealed"}), `{"code":400, "message":"invalid start height: must be a block height"}`},
+			{accountTransactionsURL(t, address.String(), map[string]string{"cursor": "foo"}), `{"code":400, "message":"invalid cursor"}`},
+			{accountTransactionsURL(t, address.String(), map[string]string{"start_height": "100", "limit": "0"}), `{"code":400, "message":"invalid limit: must be a positive integer"}`},
+		}
+
+		for i, test := range tests {
+			req, _ := http.NewRequest("GET", test.url, nil)
+			rr := executeRequest(req, backend)
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			assert.JSONEq(t, test.out, rr.Body.String(), fmt.Sprintf("test #%d failed: %v", i, test))
+		}
+	})
+}
+
+func accountTransactionsURL(t *testing.T, address string, params map[string]string) string {
+	u, err := url.ParseRequestURI(fmt.Sprintf("/v1/accounts/%s/transactions", address))
+	require.NoError(t, err)
+	q := u.Query()
+
+	for key, value := range params {
+		q.Add(key, value)
+	}
+
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+func getAccountTransactionsRequest(t *testing.T, address string, params map[string]string) *http.Request {
+	req, err := http.NewRequest("GET", accountTransactionsURL(t, address, params), nil)
+	require.NoError(t, err)
+	return req
+}
+
+func expectedAccountTransactionsResponse(transactions []storage.AccountTransaction, nextCursor string) string {
+	txs := make([]string, len(transactions))
+	for i, tx := range transactions {
+		txs[i] = fmt.Sprintf(`{
+			"block_id": "%s",
+			"block_height": "%d",
+			"transaction_id": "%s",
+			"transaction_index": "%d",
+			"roles": ["%s"],
+			"_links": {"_self": "/v1/transactions/%s"}
+		}`, tx.BlockID, tx.BlockHeight, tx.TransactionID, tx.TransactionIndex, strings.Join(tx.Roles.Strings(), `","`), tx.TransactionID)
+	}
+
+	next := ""
+	if nextCursor != "" {
+		next = fmt.Sprintf(`, "next_cursor": "%s"`, nextCursor)
+	}
+
+	return fmt.Sprintf(`{"transactions": [%s]%s}`, strings.Join(txs, ","), next)
+}
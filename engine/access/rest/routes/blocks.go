@@ -41,6 +41,11 @@ func GetBlocksByHeight(r *request.Request, backend access.API, link models.LinkG
 		return nil, models.NewBadRequestError(err)
 	}
 
+	// if the request is paginated then return the blocks of the page of heights
+	if req.Page != nil {
+		return getBlocksPage(r, backend, link, req.Page)
+	}
+
 	if req.FinalHeight || req.SealedHeight {
 		block, err := getBlock(forFinalized(req.Heights[0]), r, backend, link)
 		if err != nil {
@@ -91,6 +96,37 @@ func GetBlocksByHeight(r *request.Request, backend access.API, link models.LinkG
 	return blocks, nil
 }
 
+// getBlocksPage returns the blocks of a page of heights, with the link to the next page.
+// The blocks are retrieved one at a time, as the items of the page are produced.
+func getBlocksPage(r *request.Request, backend access.API, link models.LinkGenerator, page *request.HeightPage) (interface{}, error) {
+	pageEnd, endHeight, err := heightPageRange(r, backend, page)
+	if err != nil {
+		return nil, err
+	}
+
+	next, err := nextHeightPageLink(r, pageEnd, endHeight, link.BlocksLink)
+	if err != nil {
+		return nil, err
+	}
+
+	items := func(yield func(item interface{}) error) error {
+		for i := page.StartHeight; i <= pageEnd; i++ {
+			block, err := getBlock(forHeight(i), r, backend, link)
+			if err != nil {
+				return err
+			}
+
+			err = yield(block)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	return models.Page{Items: items, Next: next}, nil
+}
+
 // GetBlockPayloadByID gets block payload by ID
 func GetBlockPayloadByID(r *request.Request, backend access.API, _ models.LinkGenerator) (interface{}, error) {
 	req, err := r.GetBlockPayloadRequest()
@@ -1,8 +1,11 @@
 package routes
 
 import (
+	"bufio"
 	"fmt"
+	"io"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"strings"
 	"testing"
@@ -19,6 +22,7 @@ import (
 	"github.com/onflow/flow-go/access/mock"
 	"github.com/onflow/flow-go/engine/access/rest/middleware"
 	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/metrics"
 	"github.com/onflow/flow-go/utils/unittest"
 )
 
@@ -294,3 +298,163 @@ func expectedBlockResponse(block *flow.Block, execResult *flow.ExecutionResult,
 	}`, id, block.Header.ParentID.String(), block.Header.Height, timestamp,
 		util.ToBase64(block.Header.ParentVoterSigData), payloadLink, execLink, blockLink, blockStatus)
 }
+
+// TestAccessGetBlocksPaginated tests walking blocks by pages of heights, following the next page links.
+func TestAccessGetBlocksPaginated(t *testing.T) {
+	backend := &mock.API{}
+
+	blkCnt := 10
+	_, _, blocks, executionResults := generateMocks(backend, blkCnt)
+
+	u, _ := url.Parse("/v1/blocks")
+	q := u.Query()
+	q.Add(startHeightQueryParam, "0")
+	q.Add(endHeightQueryParam, fmt.Sprint(blkCnt-1))
+	q.Add(limitQueryParam, "4")
+	q.Add(middleware.ExpandQueryParam, strings.Join([]string{ExpandableFieldPayload, ExpandableExecutionResult}, ","))
+	u.RawQuery = q.Encode()
+	link := u.String()
+
+	for start := 0; start < blkCnt; start += 4 {
+		req, err := http.NewRequest("GET", link, nil)
+		require.NoError(t, err)
+
+		rr := executeRequest(req, backend)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+		end := start + 4
+		if end > blkCnt {
+			end = blkCnt
+		}
+		expected := expectedBlockResponsesExpanded(blocks[start:end], executionResults[start:end], true, flow.BlockStatusSealed)
+		require.JSONEq(t, expected, rr.Body.String())
+
+		link = nextPageLink(t, rr)
+		if end == blkCnt {
+			require.Empty(t, link)
+			break
+		}
+
+		// the next link keeps the fields to expand
+		nextURL, err := url.Parse(link)
+		require.NoError(t, err)
+		require.Equal(t, "/v1/blocks", nextURL.Path)
+		require.Equal(t, q.Get(middleware.ExpandQueryParam), nextURL.Query().Get(middleware.ExpandQueryParam))
+	}
+
+	t.Run("newline delimited JSON", func(t *testing.T) {
+		req := getByStartEndHeightExpandedURL(t, "0", "1")
+		query := req.URL.Query()
+		query.Add(limitQueryParam, "2")
+		req.URL.RawQuery = query.Encode()
+		req.Header.Set("Accept", NDJSONContentType)
+
+		rr := executeRequest(req, backend)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.Equal(t, NDJSONContentType, rr.Header().Get("Content-Type"))
+
+		expected := expectedBlockResponsesExpanded(blocks[:2], executionResults[:2], true, flow.BlockStatusSealed)
+		require.JSONEq(t, expected, ndjsonToJSONList(t, rr.Body.String()))
+	})
+
+	t.Run("newline delimited JSON list", func(t *testing.T) {
+		req := getByHeightsExpandedURL(t, "0", "1")
+		req.Header.Set("Accept", NDJSONContentType)
+
+		rr := executeRequest(req, backend)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.Equal(t, NDJSONContentType, rr.Header().Get("Content-Type"))
+
+		expected := expectedBlockResponsesExpanded(blocks[:2], executionResults[:2], true, flow.BlockStatusSealed)
+		require.JSONEq(t, expected, ndjsonToJSONList(t, rr.Body.String()))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		req := getByHeightsExpandedURL(t, "1", "2")
+		query := req.URL.Query()
+		query.Add(limitQueryParam, "2")
+		req.URL.RawQuery = query.Encode()
+
+		assertResponse(t, req, http.StatusBadRequest, `{"code":400, "message":"can only paginate blocks requested by height range"}`, backend)
+	})
+}
+
+// TestAccessGetBlocksPaginated_NDJSONStreaming tests that the blocks of a page are written to the client as they
+// are retrieved when the response is newline delimited JSON, and that the response is aborted if retrieving a
+// block fails after the first block was written.
+func TestAccessGetBlocksPaginated_NDJSONStreaming(t *testing.T) {
+	backend := mock.NewAPI(t)
+	block0 := unittest.BlockFixture()
+	block0.Header.Height = 0
+	block1 := unittest.BlockFixture()
+	block1.Header.Height = 1
+
+	// the second block is only returned once the client received the first one
+	release := make(chan struct{})
+	backend.Mock.On("GetBlockByHeight", mocks.Anything, uint64(0)).Return(&block0, flow.BlockStatusSealed, nil)
+	backend.Mock.On("GetBlockByHeight", mocks.Anything, uint64(1)).
+		Run(func(mocks.Arguments) { <-release }).
+		Return(&block1, flow.BlockStatusSealed, nil).
+		Once()
+	backend.Mock.On("GetBlockByHeight", mocks.Anything, uint64(1)).
+		Return(nil, flow.BlockStatusUnknown, status.Error(codes.NotFound, "not found")).
+		Once()
+	backend.Mock.On("GetExecutionResultForBlockID", mocks.Anything, mocks.Anything).
+		Return(unittest.ExecutionResultFixture(), nil).
+		Maybe()
+
+	router := NewRouterBuilder(unittest.Logger(), metrics.NewNoopCollector()).
+		AddRestRoutes(backend, flow.Testnet.Chain()).
+		Build()
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	u, err := url.Parse(server.URL + "/v1/blocks")
+	require.NoError(t, err)
+	q := u.Query()
+	q.Add(startHeightQueryParam, "0")
+	q.Add(endHeightQueryParam, "1")
+	q.Add(limitQueryParam, "2")
+	u.RawQuery = q.Encode()
+
+	request := func() (*http.Response, *bufio.Reader) {
+		req, err := http.NewRequest("GET", u.String(), nil)
+		require.NoError(t, err)
+		req.Header.Set("Accept", NDJSONContentType)
+
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		require.Equal(t, NDJSONContentType, resp.Header.Get("Content-Type"))
+		return resp, bufio.NewReader(resp.Body)
+	}
+
+	t.Run("items are written as they are retrieved", func(t *testing.T) {
+		resp, body := request()
+		defer resp.Body.Close()
+
+		line, err := body.ReadString('\n')
+		require.NoError(t, err)
+		require.Contains(t, line, block0.ID().String())
+
+		close(release)
+		line, err = body.ReadString('\n')
+		require.NoError(t, err)
+		require.Contains(t, line, block1.ID().String())
+
+		_, err = body.ReadByte()
+		require.ErrorIs(t, err, io.EOF)
+	})
+
+	t.Run("response is aborted on error", func(t *testing.T) {
+		resp, body := request()
+		defer resp.Body.Close()
+
+		line, err := body.ReadString('\n')
+		require.NoError(t, err)
+		require.Contains(t, line, block0.ID().String())
+
+		_, err = io.ReadAll(body)
+		require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+	})
+}
@@ -14,12 +14,17 @@ const BlockQueryParam = "block_ids"
 const EventTypeQuery = "type"
 
 // GetEvents for the provided block range or list of block IDs filtered by type.
-func GetEvents(r *request.Request, backend access.API, _ models.LinkGenerator) (interface{}, error) {
+func GetEvents(r *request.Request, backend access.API, link models.LinkGenerator) (interface{}, error) {
 	req, err := r.GetEventsRequest()
 	if err != nil {
 		return nil, models.NewBadRequestError(err)
 	}
 
+	// if the request is paginated then return events for the page of heights
+	if req.Page != nil {
+		return getEventsPage(r, backend, link, req.Type, req.Page)
+	}
+
 	// if the request has block IDs provided then return events for block IDs
 	var blocksEvents models.BlocksEvents
 	if len(req.BlockIDs) > 0 {
@@ -66,3 +71,81 @@ func GetEvents(r *request.Request, backend access.API, _ models.LinkGenerator) (
 	blocksEvents.Build(events)
 	return blocksEvents, nil
 }
+
+// getEventsPage returns the events of the given type for a page of heights, with the link to the next page.
+func getEventsPage(
+	r *request.Request,
+	backend access.API,
+	link models.LinkGenerator,
+	eventType string,
+	page *request.HeightPage,
+) (interface{}, error) {
+	pageEnd, endHeight, err := heightPageRange(r, backend, page)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := backend.GetEventsForHeightRange(
+		r.Context(),
+		eventType,
+		page.StartHeight,
+		pageEnd,
+		entitiesproto.EventEncodingVersion_JSON_CDC_V0,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var blocksEvents models.BlocksEvents
+	blocksEvents.Build(events)
+
+	next, err := nextHeightPageLink(r, pageEnd, endHeight, link.EventsLink)
+	if err != nil {
+		return nil, err
+	}
+
+	return models.Page{Items: sliceItems(blocksEvents), Next: next}, nil
+}
+
+// GetEventsByEventType handler retrieves a page of events of the given type from the event index of the node,
+// starting at a height or at the cursor returned with the previous page.
+func GetEventsByEventType(r *request.Request, backend access.API, _ models.LinkGenerator) (interface{}, error) {
+	req, err := r.GetEventsByEventTypeRequest()
+	if err != nil {
+		return nil, models.NewBadRequestError(err)
+	}
+
+	page, err := backend.GetEventsByEventType(r.Context(), req.Type, req.Cursor, req.Limit, entitiesproto.EventEncodingVersion_JSON_CDC_V0)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildIndexedEventsPage(page), nil
+}
+
+// GetEventsByContractAddress handler retrieves a page of events emitted by the contracts deployed to the given address
+// from the event index of the node, starting at a height or at the cursor returned with the previous page.
+func GetEventsByContractAddress(r *request.Request, backend access.API, _ models.LinkGenerator) (interface{}, error) {
+	req, err := r.GetEventsByContractAddressRequest()
+	if err != nil {
+		return nil, models.NewBadRequestError(err)
+	}
+
+	page, err := backend.GetEventsByContractAddress(r.Context(), req.Address, req.Cursor, req.Limit, entitiesproto.EventEncodingVersion_JSON_CDC_V0)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildIndexedEventsPage(page), nil
+}
+
+func buildIndexedEventsPage(page *access.EventsPage) models.EventsPage {
+	var nextCursor string
+	if page.NextCursor != nil {
+		nextCursor = request.EncodeEventCursor(*page.NextCursor)
+	}
+
+	var response models.EventsPage
+	response.Build(page.Events, nextCursor)
+	return response
+}
@@ -262,3 +262,124 @@ func testBlockEventResponse(t *testing.T, events []flow.BlockEvents) string {
 
 	return string(data)
 }
+
+// TestGetEventsPaginated tests walking events by pages of heights, following the next page links.
+func TestGetEventsPaginated(t *testing.T) {
+	backend := mock.NewAPI(t)
+	eventType := "A.179b6b1cb6755e31.Foo.Bar"
+
+	events := make([]flow.BlockEvents, 5)
+	for i := range events {
+		header := unittest.BlockHeaderFixture(unittest.WithHeaderHeight(uint64(i)))
+		events[i] = unittest.BlockEventsFixture(header, 2)
+	}
+
+	for start := 0; start < len(events); start += 2 {
+		end := start + 1
+		if end >= len(events) {
+			end = len(events) - 1
+		}
+		backend.Mock.
+			On("GetEventsForHeightRange", mocks.Anything, eventType, uint64(start), uint64(end), entities.EventEncodingVersion_JSON_CDC_V0).
+			Return(events[start:end+1], nil)
+	}
+
+	t.Run("walk pages", func(t *testing.T) {
+		req := getEventPageReq(t, eventType, map[string]string{startHeightQueryParam: "0", endHeightQueryParam: "4", limitQueryParam: "2"})
+
+		for start := 0; start < len(events); start += 2 {
+			rr := executeRequest(req, backend)
+			require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+
+			end := start + 2
+			if end > len(events) {
+				end = len(events)
+			}
+			require.JSONEq(t, testBlockEventResponse(t, events[start:end]), rr.Body.String())
+
+			next := nextPageLink(t, rr)
+			if end == len(events) {
+				require.Empty(t, next)
+				break
+			}
+
+			// the next link keeps the type and limit, and replaces the height range with a cursor
+			nextURL, err := url.Parse(next)
+			require.NoError(t, err)
+			require.Equal(t, "/v1/events", nextURL.Path)
+			require.Equal(t, eventType, nextURL.Query().Get(EventTypeQuery))
+			require.Equal(t, "2", nextURL.Query().Get(limitQueryParam))
+			require.Empty(t, nextURL.Query().Get(startHeightQueryParam))
+			require.NotEmpty(t, nextURL.Query().Get(cursorQueryParam))
+
+			req, err = http.NewRequest("GET", next, nil)
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("range ending at sealed block", func(t *testing.T) {
+		sealed := unittest.BlockHeaderFixture(unittest.WithHeaderHeight(1))
+		backend.Mock.
+			On("GetLatestBlockHeader", mocks.Anything, true).
+			Return(sealed, flow.BlockStatusSealed, nil).
+			Once()
+
+		req := getEventPageReq(t, eventType, map[string]string{startHeightQueryParam: "0", limitQueryParam: "2"})
+		rr := executeRequest(req, backend)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.JSONEq(t, testBlockEventResponse(t, events[:2]), rr.Body.String())
+		require.Empty(t, nextPageLink(t, rr))
+	})
+
+	t.Run("newline delimited JSON", func(t *testing.T) {
+		req := getEventPageReq(t, eventType, map[string]string{startHeightQueryParam: "0", endHeightQueryParam: "1", limitQueryParam: "2"})
+		req.Header.Set("Accept", NDJSONContentType)
+
+		rr := executeRequest(req, backend)
+		require.Equal(t, http.StatusOK, rr.Code, rr.Body.String())
+		require.Equal(t, NDJSONContentType, rr.Header().Get("Content-Type"))
+		require.JSONEq(t, testBlockEventResponse(t, events[:2]), ndjsonToJSONList(t, rr.Body.String()))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		tests := []struct {
+			params map[string]string
+			out    string
+		}{
+			{
+				map[string]string{BlockQueryParam: events[0].BlockID.String(), limitQueryParam: "2"},
+				`{"code":400,"message":"can only paginate events requested by height range"}`,
+			},
+			{
+				map[string]string{startHeightQueryParam: "0", limitQueryParam: "500"},
+				`{"code":400,"message":"invalid limit: must be between 1 and 250"}`,
+			},
+			{
+				map[string]string{cursorQueryParam: "foo"},
+				`{"code":400,"message":"invalid cursor"}`,
+			},
+		}
+
+		for _, test := range tests {
+			req := getEventPageReq(t, eventType, test.params)
+			assertResponse(t, req, http.StatusBadRequest, test.out, backend)
+		}
+	})
+}
+
+func getEventPageReq(t *testing.T, eventType string, params map[string]string) *http.Request {
+	u, _ := url.Parse("/v1/events")
+	q := u.Query()
+
+	for key, value := range params {
+		q.Add(key, value)
+	}
+	q.Add(EventTypeQuery, eventType)
+
+	u.RawQuery = q.Encode()
+
+	req, err := http.NewRequest("GET", u.String(), nil)
+	require.NoError(t, err)
+
+	return req
+}
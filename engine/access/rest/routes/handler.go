@@ -1,7 +1,10 @@
 package routes
 
 import (
+	"fmt"
 	"net/http"
+	"reflect"
+	"strings"
 
 	"github.com/rs/zerolog"
 
@@ -66,6 +69,20 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// paginated responses are written as the list of items, with the link to the next page in the Link header
+	if page, ok := response.(models.Page); ok {
+		h.pageResponse(w, decoratedRequest, page, errLog)
+		return
+	}
+
+	// lists are written as newline delimited JSON if the client accepts it
+	if acceptsNDJSON(r) {
+		if items, ok := listItems(response); ok {
+			h.pageResponse(w, decoratedRequest, models.Page{Items: items}, errLog)
+			return
+		}
+	}
+
 	// apply the select filter if any select fields have been specified
 	response, err = util.SelectFilter(response, decoratedRequest.Selects())
 	if err != nil {
@@ -76,3 +93,86 @@ func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	// write response to response stream
 	h.jsonResponse(w, http.StatusOK, response, errLog)
 }
+
+// pageResponse writes the items of the page as a JSON list, or as newline delimited JSON if the client
+// accepts it, and links the next page in the Link header.
+// A JSON list is only written once all items are produced. Newline delimited JSON is written item by item as
+// the items are produced, see ndjsonResponse.
+func (h *Handler) pageResponse(w http.ResponseWriter, r *request.Request, page models.Page, errLog zerolog.Logger) {
+	if !acceptsNDJSON(r.Request) {
+		items := make([]interface{}, 0)
+		err := page.Items(func(item interface{}) error {
+			items = append(items, item)
+			return nil
+		})
+		if err != nil {
+			h.errorHandler(w, err, errLog)
+			return
+		}
+
+		response, err := util.SelectFilter(items, r.Selects())
+		if err != nil {
+			h.errorHandler(w, err, errLog)
+			return
+		}
+
+		setNextPageLink(w, page.Next)
+		h.jsonResponse(w, http.StatusOK, response, errLog)
+		return
+	}
+
+	setNextPageLink(w, page.Next)
+	filtered := func(yield func(item interface{}) error) error {
+		return page.Items(func(item interface{}) error {
+			filteredItem, err := util.SelectFilter(item, r.Selects())
+			if err != nil {
+				return err
+			}
+			return yield(filteredItem)
+		})
+	}
+
+	err := h.ndjsonResponse(w, http.StatusOK, filtered, errLog)
+	if err != nil {
+		w.Header().Del("Link")
+		h.errorHandler(w, err, errLog)
+	}
+}
+
+// setNextPageLink links the next page in the Link header, if there is a next page.
+func setNextPageLink(w http.ResponseWriter, next string) {
+	if next != "" {
+		w.Header().Set("Link", fmt.Sprintf(`<%s>; rel="next"`, next))
+	}
+}
+
+// listItems returns a producer of the items of the response, if the response is a list.
+func listItems(response interface{}) (func(yield func(item interface{}) error) error, bool) {
+	list := reflect.ValueOf(response)
+	if list.Kind() != reflect.Slice {
+		return nil, false
+	}
+
+	return func(yield func(item interface{}) error) error {
+		for i := 0; i < list.Len(); i++ {
+			err := yield(list.Index(i).Interface())
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}, true
+}
+
+// acceptsNDJSON returns true if the client accepts newline delimited JSON responses.
+func acceptsNDJSON(r *http.Request) bool {
+	for _, accept := range r.Header.Values("Accept") {
+		for _, mediaType := range strings.Split(accept, ",") {
+			mediaType, _, _ = strings.Cut(mediaType, ";")
+			if strings.TrimSpace(mediaType) == NDJSONContentType {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -18,6 +18,9 @@ import (
 
 const MaxRequestSize = 2 << 20 // 2MB
 
+// NDJSONContentType is the content type of newline delimited JSON responses.
+const NDJSONContentType = "application/x-ndjson"
+
 // HttpHandler is custom http handler implementing custom handler function.
 // HttpHandler function allows easier handling of errors and responses as it
 // wraps functionality for handling error and responses outside of endpoint handling.
@@ -53,49 +56,48 @@ func (h *HttpHandler) VerifyRequest(w http.ResponseWriter, r *http.Request) erro
 }
 
 func (h *HttpHandler) errorHandler(w http.ResponseWriter, err error, errorLogger zerolog.Logger) {
+	returnCode, msg := errorToStatus(err, errorLogger)
+	h.errorResponse(w, returnCode, msg, errorLogger)
+}
+
+// errorToStatus returns the HTTP status code and the user facing message for an error returned
+// by an endpoint handler.
+func errorToStatus(err error, errorLogger zerolog.Logger) (int, string) {
 	// rest status type error should be returned with status and user message provided
 	var statusErr models.StatusError
 	if errors.As(err, &statusErr) {
-		h.errorResponse(w, statusErr.Status(), statusErr.UserMessage(), errorLogger)
-		return
+		return statusErr.Status(), statusErr.UserMessage()
 	}
 
 	// handle cadence errors
 	cadenceError := fvmErrors.Find(err, fvmErrors.ErrCodeCadenceRunTimeError)
 	if cadenceError != nil {
-		msg := fmt.Sprintf("Cadence error: %s", cadenceError.Error())
-		h.errorResponse(w, http.StatusBadRequest, msg, errorLogger)
-		return
+		return http.StatusBadRequest, fmt.Sprintf("Cadence error: %s", cadenceError.Error())
 	}
 
 	// handle grpc status error returned from the backend calls, we are forwarding the message to the client
 	if se, ok := status.FromError(err); ok {
 		if se.Code() == codes.NotFound {
-			msg := fmt.Sprintf("Flow resource not found: %s", se.Message())
-			h.errorResponse(w, http.StatusNotFound, msg, errorLogger)
-			return
+			return http.StatusNotFound, fmt.Sprintf("Flow resource not found: %s", se.Message())
 		}
 		if se.Code() == codes.InvalidArgument {
-			msg := fmt.Sprintf("Invalid Flow argument: %s", se.Message())
-			h.errorResponse(w, http.StatusBadRequest, msg, errorLogger)
-			return
+			return http.StatusBadRequest, fmt.Sprintf("Invalid Flow argument: %s", se.Message())
+		}
+		if se.Code() == codes.OutOfRange {
+			return http.StatusBadRequest, fmt.Sprintf("Requested height is out of the indexed range: %s", se.Message())
 		}
 		if se.Code() == codes.Internal {
-			msg := fmt.Sprintf("Invalid Flow request: %s", se.Message())
-			h.errorResponse(w, http.StatusBadRequest, msg, errorLogger)
-			return
+			return http.StatusBadRequest, fmt.Sprintf("Invalid Flow request: %s", se.Message())
 		}
 		if se.Code() == codes.Unavailable {
-			msg := fmt.Sprintf("Failed to process request: %s", se.Message())
-			h.errorResponse(w, http.StatusServiceUnavailable, msg, errorLogger)
-			return
+			return http.StatusServiceUnavailable, fmt.Sprintf("Failed to process request: %s", se.Message())
 		}
 	}
 
 	// stop going further - catch all error
 	msg := "internal server error"
 	errorLogger.Error().Err(err).Msg(msg)
-	h.errorResponse(w, http.StatusInternalServerError, msg, errorLogger)
+	return http.StatusInternalServerError, msg
 }
 
 // jsonResponse builds a JSON response and send it to the client
@@ -118,6 +120,56 @@ func (h *HttpHandler) jsonResponse(w http.ResponseWriter, code int, response int
 	}
 }
 
+// ndjsonResponse sends the items to the client as newline delimited JSON, writing and flushing each item
+// to the client as soon as it is produced. The status and headers are written with the first item.
+//
+// If producing the items fails before the first item is written, the error is returned so that the caller
+// can send an error response instead. Once an item was written the status can no longer be changed, hence
+// the response is aborted on errors, so that the client does not mistake the partial response for a complete one.
+func (h *HttpHandler) ndjsonResponse(
+	w http.ResponseWriter,
+	code int,
+	items func(yield func(item interface{}) error) error,
+	errLogger zerolog.Logger,
+) error {
+	flusher, _ := w.(http.Flusher)
+	encoder := json.NewEncoder(w)
+	written := false
+
+	err := items(func(item interface{}) error {
+		if !written {
+			w.Header().Set("Content-Type", NDJSONContentType)
+			w.WriteHeader(code)
+			written = true
+		}
+
+		// the encoder terminates each item with a newline
+		err := encoder.Encode(item)
+		if err != nil {
+			return fmt.Errorf("failed to write http response item: %w", err)
+		}
+
+		if flusher != nil {
+			flusher.Flush()
+		}
+		return nil
+	})
+	if err == nil {
+		if !written {
+			// an empty list
+			w.Header().Set("Content-Type", NDJSONContentType)
+			w.WriteHeader(code)
+		}
+		return nil
+	}
+	if !written {
+		return err
+	}
+
+	errLogger.Error().Err(err).Msg("aborting newline delimited JSON response")
+	panic(http.ErrAbortHandler)
+}
+
 // errorResponse sends an HTTP error response to the client with the given return code
 // and a model error with the given response message in the response body
 func (h *HttpHandler) errorResponse(
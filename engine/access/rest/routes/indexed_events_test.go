@@ -0,0 +1,212 @@
+package routes
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/onflow/flow/protobuf/go/flow/entities"
+	"github.com/stretchr/testify/assert"
+	mocktestify "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/access"
+	"github.com/onflow/flow-go/access/mock"
+	"github.com/onflow/flow-go/engine/access/rest/request"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestGetEventsByEventType tests local getEventsByEventType request.
+//
+// Runs the following tests:
+// 1. Get events from a start height.
+// 2. Get events from a cursor returned by a previous page.
+// 3. Get events with the default limit.
+// 4. Get events when the start height is not indexed.
+// 5. Get invalid events.
+func TestGetEventsByEventType(t *testing.T) {
+	backend := mock.NewAPI(t)
+	eventType := "A.179b6b1cb6755e31.Foo.Bar"
+
+	events := indexedEventsFixture(3)
+	next := &storage.EventCursor{Height: 103, TransactionIndex: 1, EventIndex: 4}
+	nextCursor := request.EncodeEventCursor(*next)
+
+	t.Run("get events from start height", func(t *testing.T) {
+		req := getIndexedEventsRequest(t, eventTypeEventsURL(eventType), map[string]string{"start_height": "100", "limit": "6"})
+
+		backend.Mock.
+			On("GetEventsByEventType", mocktestify.Anything, eventType, storage.EventCursor{Height: 100}, uint(6), entities.EventEncodingVersion_JSON_CDC_V0).
+			Return(&access.EventsPage{Events: events, NextCursor: next}, nil).
+			Once()
+
+		assertOKResponse(t, req, expectedIndexedEventsResponse(t, events, nextCursor), backend)
+	})
+
+	t.Run("get events from cursor", func(t *testing.T) {
+		req := getIndexedEventsRequest(t, eventTypeEventsURL(eventType), map[string]string{"cursor": nextCursor, "limit": "6"})
+
+		backend.Mock.
+			On("GetEventsByEventType", mocktestify.Anything, eventType, *next, uint(6), entities.EventEncodingVersion_JSON_CDC_V0).
+			Return(&access.EventsPage{Events: events[1:]}, nil).
+			Once()
+
+		assertOKResponse(t, req, expectedIndexedEventsResponse(t, events[1:], ""), backend)
+	})
+
+	t.Run("get events with default limit", func(t *testing.T) {
+		req := getIndexedEventsRequest(t, eventTypeEventsURL(eventType), map[string]string{"start_height": "100"})
+
+		backend.Mock.
+			On("GetEventsByEventType", mocktestify.Anything, eventType, storage.EventCursor{Height: 100}, uint(request.DefaultIndexedEventsLimit), entities.EventEncodingVersion_JSON_CDC_V0).
+			Return(&access.EventsPage{Events: []flow.BlockEvents{}}, nil).
+			Once()
+
+		assertOKResponse(t, req, expectedIndexedEventsResponse(t, nil, ""), backend)
+	})
+
+	t.Run("get events below the indexed range", func(t *testing.T) {
+		req := getIndexedEventsRequest(t, eventTypeEventsURL(eventType), map[string]string{"start_height": "1"})
+
+		backend.Mock.
+			On("GetEventsByEventType", mocktestify.Anything, eventType, storage.EventCursor{Height: 1}, uint(request.DefaultIndexedEventsLimit), entities.EventEncodingVersion_JSON_CDC_V0).
+			Return(nil, status.Error(codes.OutOfRange, "block is before lowest indexed height")).
+			Once()
+
+		out := `{"code":400, "message":"Requested height is out of the indexed range: block is before lowest indexed height"}`
+		assertResponse(t, req, http.StatusBadRequest, out, backend)
+	})
+
+	t.Run("get invalid", func(t *testing.T) {
+		tests := []struct {
+			url string
+			out string
+		}{
+			{indexedEventsURL(t, eventTypeEventsURL("foo"), map[string]string{"start_height": "100"}), `{"code":400, "message":"invalid event type format"}`},
+			{indexedEventsURL(t, eventTypeEventsURL(eventType), nil), `{"code":400, "message":"must provide either start height or cursor"}`},
+			{indexedEventsURL(t, eventTypeEventsURL(eventType), map[string]string{"start_height": "100", "cursor": nextCursor}), `{"code":400, "message":"can only provide either start height or cursor"}`},
+			{indexedEventsURL(t, eventTypeEventsURL(eventType), map[string]string{"start_height": "final"}), `{"code":400, "message":"invalid start height: must be a block height"}`},
+			{indexedEventsURL(t, eventTypeEventsURL(eventType), map[string]string{"cursor": request.EncodeAccountTransactionCursor(storage.AccountTransactionCursor{})}), `{"code":400, "message":"invalid cursor"}`},
+			{indexedEventsURL(t, eventTypeEventsURL(eventType), map[string]string{"start_height": "100", "limit": "0"}), `{"code":400, "message":"invalid limit: must be a positive integer"}`},
+		}
+
+		for i, test := range tests {
+			req, _ := http.NewRequest("GET", test.url, nil)
+			rr := executeRequest(req, backend)
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			assert.JSONEq(t, test.out, rr.Body.String(), fmt.Sprintf("test #%d failed: %v", i, test))
+		}
+	})
+}
+
+// TestGetEventsByContractAddress tests local getEventsByContractAddress request.
+//
+// Runs the following tests:
+// 1. Get events from a start height.
+// 2. Get events with a limit above the maximum page size.
+// 3. Get invalid events.
+func TestGetEventsByContractAddress(t *testing.T) {
+	backend := mock.NewAPI(t)
+	address := flow.Testnet.Chain().ServiceAddress()
+
+	events := indexedEventsFixture(2)
+	next := &storage.EventCursor{Height: 102}
+
+	t.Run("get events from start height", func(t *testing.T) {
+		req := getIndexedEventsRequest(t, contractAddressEventsURL(address.String()), map[string]string{"start_height": "100", "limit": "4"})
+
+		backend.Mock.
+			On("GetEventsByContractAddress", mocktestify.Anything, address, storage.EventCursor{Height: 100}, uint(4), entities.EventEncodingVersion_JSON_CDC_V0).
+			Return(&access.EventsPage{Events: events, NextCursor: next}, nil).
+			Once()
+
+		assertOKResponse(t, req, expectedIndexedEventsResponse(t, events, request.EncodeEventCursor(*next)), backend)
+	})
+
+	t.Run("get events with limit above maximum", func(t *testing.T) {
+		req := getIndexedEventsRequest(t, contractAddressEventsURL(address.String()), map[string]string{"start_height": "100", "limit": "5000"})
+
+		backend.Mock.
+			On("GetEventsByContractAddress", mocktestify.Anything, address, storage.EventCursor{Height: 100}, uint(5000), entities.EventEncodingVersion_JSON_CDC_V0).
+			Return(nil, status.Error(codes.InvalidArgument, "limit must be between 1 and 1000")).
+			Once()
+
+		out := `{"code":400, "message":"Invalid Flow argument: limit must be between 1 and 1000"}`
+		assertResponse(t, req, http.StatusBadRequest, out, backend)
+	})
+
+	t.Run("get invalid", func(t *testing.T) {
+		tests := []struct {
+			url string
+			out string
+		}{
+			{indexedEventsURL(t, contractAddressEventsURL("123"), map[string]string{"start_height": "100"}), `{"code":400, "message":"invalid address"}`},
+			{indexedEventsURL(t, contractAddressEventsURL(address.String()), nil), `{"code":400, "message":"must provide either start height or cursor"}`},
+			{indexedEventsURL(t, contractAddressEventsURL(address.String()), map[string]string{"cursor": "foo"}), `{"code":400, "message":"invalid cursor"}`},
+		}
+
+		for i, test := range tests {
+			req, _ := http.NewRequest("GET", test.url, nil)
+			rr := executeRequest(req, backend)
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			assert.JSONEq(t, test.out, rr.Body.String(), fmt.Sprintf("test #%d failed: %v", i, test))
+		}
+	})
+}
+
+// indexedEventsFixture returns the events of n consecutive blocks starting at height 100.
+func indexedEventsFixture(n int) []flow.BlockEvents {
+	events := make([]flow.BlockEvents, n)
+	for i := range events {
+		header := unittest.BlockHeaderFixture(unittest.WithHeaderHeight(uint64(100 + i)))
+		events[i] = unittest.BlockEventsFixture(header, 2)
+	}
+	return events
+}
+
+func eventTypeEventsURL(eventType string) string {
+	return fmt.Sprintf("/v1/event_types/%s/events", eventType)
+}
+
+func contractAddressEventsURL(address string) string {
+	return fmt.Sprintf("/v1/accounts/%s/events", address)
+}
+
+func indexedEventsURL(t *testing.T, path string, params map[string]string) string {
+	u, err := url.ParseRequestURI(path)
+	require.NoError(t, err)
+	q := u.Query()
+
+	for key, value := range params {
+		q.Add(key, value)
+	}
+
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+func getIndexedEventsRequest(t *testing.T, path string, params map[string]string) *http.Request {
+	req, err := http.NewRequest("GET", indexedEventsURL(t, path, params), nil)
+	require.NoError(t, err)
+	return req
+}
+
+func expectedIndexedEventsResponse(t *testing.T, events []flow.BlockEvents, nextCursor string) string {
+	if events == nil {
+		events = []flow.BlockEvents{}
+	}
+
+	next := ""
+	if nextCursor != "" {
+		next = fmt.Sprintf(`, "next_cursor": "%s"`, nextCursor)
+	}
+
+	return fmt.Sprintf(`{"events": %s%s}`, testBlockEventResponse(t, events), next)
+}
@@ -0,0 +1,61 @@
+package routes
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/onflow/flow-go/access"
+	"github.com/onflow/flow-go/engine/access/rest/models"
+	"github.com/onflow/flow-go/engine/access/rest/request"
+)
+
+// heightPageRange resolves the end height of the paginated range, and returns the last height of the page
+// together with the end height of the range.
+func heightPageRange(r *request.Request, backend access.API, page *request.HeightPage) (uint64, uint64, error) {
+	endHeight := page.EndHeight
+	if endHeight == request.FinalHeight || endHeight == request.SealedHeight {
+		latest, _, err := backend.GetLatestBlockHeader(r.Context(), endHeight == request.SealedHeight)
+		if err != nil {
+			return 0, 0, err
+		}
+		endHeight = latest.Height
+	}
+
+	if page.StartHeight > endHeight {
+		return 0, 0, models.NewBadRequestError(fmt.Errorf("current retrieved end height value is lower than start height"))
+	}
+
+	return page.PageEnd(endHeight), endHeight, nil
+}
+
+// nextHeightPageLink returns the link to the page following the page ending at pageEnd, or an empty link if
+// the page is the last one of the range. The cursor carries the end height of the range, so that all pages
+// cover the range resolved for the first page.
+func nextHeightPageLink(
+	r *request.Request,
+	pageEnd uint64,
+	endHeight uint64,
+	linkFunc func(query url.Values) (string, error),
+) (string, error) {
+	if pageEnd >= endHeight {
+		return "", nil
+	}
+
+	return linkFunc(r.NextPageQuery(request.HeightCursor{
+		Height:    pageEnd + 1,
+		EndHeight: endHeight,
+	}))
+}
+
+// sliceItems returns a producer of the given items, for pages whose items are retrieved at once.
+func sliceItems[T any](items []T) func(yield func(item interface{}) error) error {
+	return func(yield func(item interface{}) error) error {
+		for _, item := range items {
+			err := yield(item)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+}
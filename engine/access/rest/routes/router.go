@@ -8,6 +8,7 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/rs/zerolog"
+	"go.uber.org/atomic"
 
 	"github.com/onflow/flow-go/access"
 	"github.com/onflow/flow-go/engine/access/rest/middleware"
@@ -23,6 +24,10 @@ type RouterBuilder struct {
 	logger      zerolog.Logger
 	router      *mux.Router
 	v1SubRouter *mux.Router
+
+	// activeStreamCount is the number of streams open across all WebSocket routes of the router, it is shared
+	// between the routes so that the global stream limit of the state stream config applies to all of them.
+	activeStreamCount *atomic.Int32
 }
 
 // NewRouterBuilder creates a new RouterBuilder instance with common middleware and a v1 sub-router.
@@ -39,9 +44,10 @@ func NewRouterBuilder(
 	v1SubRouter.Use(middleware.MetricsMiddleware(restCollector))
 
 	return &RouterBuilder{
-		logger:      logger,
-		router:      router,
-		v1SubRouter: v1SubRouter,
+		logger:            logger,
+		router:            router,
+		v1SubRouter:       v1SubRouter,
+		activeStreamCount: atomic.NewInt32(0),
 	}
 }
 
@@ -67,7 +73,7 @@ func (b *RouterBuilder) AddWsRoutes(
 ) *RouterBuilder {
 
 	for _, r := range WSRoutes {
-		h := NewWSHandler(b.logger, stateStreamApi, r.Handler, chain, stateStreamConfig)
+		h := NewWSHandler(b.logger, stateStreamApi, r.Handler, chain, stateStreamConfig, b.activeStreamCount)
 		b.v1SubRouter.
 			Methods(r.Method).
 			Path(r.Pattern).
@@ -78,6 +84,37 @@ func (b *RouterBuilder) AddWsRoutes(
 	return b
 }
 
+// AddMultiplexedWsRoute adds the multiplexed WebSocket route to the router. A single connection to this route
+// can subscribe to several topics at the same time.
+// stateStreamApi may be nil if execution state streaming is disabled, the events and account statuses topics
+// are then rejected with a not enabled error.
+func (b *RouterBuilder) AddMultiplexedWsRoute(
+	serverAPI access.API,
+	stateStreamApi state_stream.API,
+	chain flow.Chain,
+	stateStreamConfig backend.Config,
+	maxSubscriptionsPerConnection uint64,
+) *RouterBuilder {
+	linkGenerator := models.NewLinkGeneratorImpl(b.v1SubRouter)
+	h := NewMultiplexedWSHandler(
+		b.logger,
+		serverAPI,
+		stateStreamApi,
+		linkGenerator,
+		chain,
+		stateStreamConfig,
+		maxSubscriptionsPerConnection,
+		b.activeStreamCount,
+	)
+	b.v1SubRouter.
+		Methods(http.MethodGet).
+		Path(MultiplexedWSPattern).
+		Name(MultiplexedWSName).
+		Handler(h)
+
+	return b
+}
+
 func (b *RouterBuilder) Build() *mux.Router {
 	return b.router
 }
@@ -111,6 +148,21 @@ var Routes = []route{{
 	Pattern: "/transaction_results/{id}",
 	Name:    "getTransactionResultByID",
 	Handler: GetTransactionResultByID,
+}, {
+	Method:  http.MethodGet,
+	Pattern: "/transaction_profiles/{id}",
+	Name:    "getTransactionProfileByID",
+	Handler: GetTransactionProfileByID,
+}, {
+	Method:  http.MethodGet,
+	Pattern: "/transaction_profiles",
+	Name:    "getTransactionProfiles",
+	Handler: GetTransactionProfiles,
+}, {
+	Method:  http.MethodPost,
+	Pattern: "/transaction_simulations",
+	Name:    "simulateTransaction",
+	Handler: SimulateTransaction,
 }, {
 	Method:  http.MethodGet,
 	Pattern: "/blocks/{id}",
@@ -146,6 +198,11 @@ var Routes = []route{{
 	Pattern: "/scripts",
 	Name:    "executeScript",
 	Handler: ExecuteScript,
+}, {
+	Method:  http.MethodPost,
+	Pattern: "/scripts_with_transactions",
+	Name:    "executeScriptWithTransactions",
+	Handler: ExecuteScriptWithTransactions,
 }, {
 	Method:  http.MethodGet,
 	Pattern: "/accounts/{address}",
@@ -156,11 +213,31 @@ var Routes = []route{{
 	Pattern: "/accounts/{address}/keys/{index}",
 	Name:    "getAccountKeyByIndex",
 	Handler: GetAccountKeyByIndex,
+}, {
+	Method:  http.MethodGet,
+	Pattern: "/accounts/{address}/balance",
+	Name:    "getAccountBalance",
+	Handler: GetAccountBalance,
+}, {
+	Method:  http.MethodGet,
+	Pattern: "/accounts/{address}/transactions",
+	Name:    "getAccountTransactions",
+	Handler: GetAccountTransactions,
 }, {
 	Method:  http.MethodGet,
 	Pattern: "/events",
 	Name:    "getEvents",
 	Handler: GetEvents,
+}, {
+	Method:  http.MethodGet,
+	Pattern: "/event_types/{type}/events",
+	Name:    "getEventsByEventType",
+	Handler: GetEventsByEventType,
+}, {
+	Method:  http.MethodGet,
+	Pattern: "/accounts/{address}/events",
+	Name:    "getEventsByContractAddress",
+	Handler: GetEventsByContractAddress,
 }, {
 	Method:  http.MethodGet,
 	Pattern: "/network/parameters",
@@ -180,9 +257,18 @@ var WSRoutes = []wsroute{{
 	Handler: SubscribeEvents,
 }}
 
+const (
+	MultiplexedWSPattern = "/ws"
+	MultiplexedWSName    = "subscribe"
+)
+
 var routeUrlMap = map[string]string{}
 var routeRE = regexp.MustCompile(`(?i)/v1/(\w+)(/(\w+)(/(\w+))?)?`)
 
+// eventTypeRouteRE matches the routes of event type based resources. Event types contain dots, hence they are
+// not matched by routeRE.
+var eventTypeRouteRE = regexp.MustCompile(`(?i)^/v1/event_types/[\w.]+/(\w+)`)
+
 func init() {
 	for _, r := range Routes {
 		routeUrlMap[r.Pattern] = r.Name
@@ -190,6 +276,7 @@ func init() {
 	for _, r := range WSRoutes {
 		routeUrlMap[r.Pattern] = r.Name
 	}
+	routeUrlMap[MultiplexedWSPattern] = MultiplexedWSName
 }
 
 func URLToRoute(url string) (string, error) {
@@ -206,6 +293,11 @@ func URLToRoute(url string) (string, error) {
 }
 
 func normalizeURL(url string) (string, error) {
+	// event type based resource. e.g. /v1/event_types/A.1234567890abcdef.Contract.Event/events
+	if matches := eventTypeRouteRE.FindStringSubmatch(url); matches != nil {
+		return "/event_types/{type}/" + matches[1], nil
+	}
+
 	matches := routeRE.FindAllStringSubmatch(url, -1)
 	if len(matches) != 1 || len(matches[0]) != 6 {
 		return "", fmt.Errorf("invalid url")
@@ -230,8 +322,15 @@ func normalizeURL(url string) (string, error) {
 	case 16:
 		// address based resource. e.g. /v1/accounts/1234567890abcdef
 		parts = append(parts, "{address}")
-		if matches[0][5] == "keys" {
+		switch matches[0][5] {
+		case "keys":
 			parts = append(parts, "keys", "{index}")
+		case "balance":
+			parts = append(parts, "balance")
+		case "transactions":
+			parts = append(parts, "transactions")
+		case "events":
+			parts = append(parts, "events")
 		}
 	default:
 		// named resource. e.g. /v1/network/parameters
@@ -29,6 +29,21 @@ func TestParseURL(t *testing.T) {
 			url:      "/v1/transaction_results/53730d3f3d2d2f46cb910b16db817d3a62adaaa72fdb3a92ee373c37c5b55a76",
 			expected: "getTransactionResultByID",
 		},
+		{
+			name:     "/v1/transaction_profiles/{id}",
+			url:      "/v1/transaction_profiles/53730d3f3d2d2f46cb910b16db817d3a62adaaa72fdb3a92ee373c37c5b55a76",
+			expected: "getTransactionProfileByID",
+		},
+		{
+			name:     "/v1/transaction_profiles",
+			url:      "/v1/transaction_profiles",
+			expected: "getTransactionProfiles",
+		},
+		{
+			name:     "/v1/transaction_simulations",
+			url:      "/v1/transaction_simulations",
+			expected: "simulateTransaction",
+		},
 		{
 			name:     "/v1/blocks",
 			url:      "/v1/blocks",
@@ -64,6 +79,11 @@ func TestParseURL(t *testing.T) {
 			url:      "/v1/scripts",
 			expected: "executeScript",
 		},
+		{
+			name:     "/v1/scripts_with_transactions",
+			url:      "/v1/scripts_with_transactions",
+			expected: "executeScriptWithTransactions",
+		},
 		{
 			name:     "/v1/accounts/{address}",
 			url:      "/v1/accounts/6a587be304c1224c",
@@ -74,6 +94,26 @@ func TestParseURL(t *testing.T) {
 			url:      "/v1/accounts/6a587be304c1224c/keys/0",
 			expected: "getAccountKeyByIndex",
 		},
+		{
+			name:     "/v1/accounts/{address}/balance",
+			url:      "/v1/accounts/6a587be304c1224c/balance",
+			expected: "getAccountBalance",
+		},
+		{
+			name:     "/v1/accounts/{address}/transactions",
+			url:      "/v1/accounts/6a587be304c1224c/transactions",
+			expected: "getAccountTransactions",
+		},
+		{
+			name:     "/v1/accounts/{address}/events",
+			url:      "/v1/accounts/6a587be304c1224c/events",
+			expected: "getEventsByContractAddress",
+		},
+		{
+			name:     "/v1/event_types/{type}/events",
+			url:      "/v1/event_types/A.6a587be304c1224c.FlowToken.TokensDeposited/events",
+			expected: "getEventsByEventType",
+		},
 		{
 			name:     "/v1/events",
 			url:      "/v1/events",
@@ -94,6 +134,11 @@ func TestParseURL(t *testing.T) {
 			url:      "/v1/subscribe_events",
 			expected: "subscribeEvents",
 		},
+		{
+			name:     "/v1/ws",
+			url:      "/v1/ws",
+			expected: "subscribe",
+		},
 	}
 
 	for _, tt := range tests {
@@ -126,6 +171,21 @@ func TestBenchmarkParseURL(t *testing.T) {
 			url:      "/v1/transaction_results/53730d3f3d2d2f46cb910b16db817d3a62adaaa72fdb3a92ee373c37c5b55a76",
 			expected: "getTransactionResultByID",
 		},
+		{
+			name:     "/v1/transaction_profiles/{id}",
+			url:      "/v1/transaction_profiles/53730d3f3d2d2f46cb910b16db817d3a62adaaa72fdb3a92ee373c37c5b55a76",
+			expected: "getTransactionProfileByID",
+		},
+		{
+			name:     "/v1/transaction_profiles",
+			url:      "/v1/transaction_profiles",
+			expected: "getTransactionProfiles",
+		},
+		{
+			name:     "/v1/transaction_simulations",
+			url:      "/v1/transaction_simulations",
+			expected: "simulateTransaction",
+		},
 		{
 			name:     "/v1/blocks",
 			url:      "/v1/blocks",
@@ -161,6 +221,11 @@ func TestBenchmarkParseURL(t *testing.T) {
 			url:      "/v1/scripts",
 			expected: "executeScript",
 		},
+		{
+			name:     "/v1/scripts_with_transactions",
+			url:      "/v1/scripts_with_transactions",
+			expected: "executeScriptWithTransactions",
+		},
 		{
 			name:     "/v1/accounts/{address}",
 			url:      "/v1/accounts/6a587be304c1224c",
@@ -171,6 +236,26 @@ func TestBenchmarkParseURL(t *testing.T) {
 			url:      "/v1/accounts/6a587be304c1224c/keys/0",
 			expected: "getAccountKeyByIndex",
 		},
+		{
+			name:     "/v1/accounts/{address}/balance",
+			url:      "/v1/accounts/6a587be304c1224c/balance",
+			expected: "getAccountBalance",
+		},
+		{
+			name:     "/v1/accounts/{address}/transactions",
+			url:      "/v1/accounts/6a587be304c1224c/transactions",
+			expected: "getAccountTransactions",
+		},
+		{
+			name:     "/v1/accounts/{address}/events",
+			url:      "/v1/accounts/6a587be304c1224c/events",
+			expected: "getEventsByContractAddress",
+		},
+		{
+			name:     "/v1/event_types/{type}/events",
+			url:      "/v1/event_types/A.6a587be304c1224c.FlowToken.TokensDeposited/events",
+			expected: "getEventsByEventType",
+		},
 		{
 			name:     "/v1/events",
 			url:      "/v1/events",
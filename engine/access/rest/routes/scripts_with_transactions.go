@@ -0,0 +1,55 @@
+package routes
+
+import (
+	"github.com/onflow/flow-go/access"
+	"github.com/onflow/flow-go/engine/access/rest/models"
+	"github.com/onflow/flow-go/engine/access/rest/request"
+	"github.com/onflow/flow-go/engine/common/rpc/convert"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// ExecuteScriptWithTransactions executes the script from the request on top of the state of the
+// requested block, after applying the transactions from the request without committing them.
+func ExecuteScriptWithTransactions(r *request.Request, backend access.API, _ models.LinkGenerator) (interface{}, error) {
+	req, err := r.ExecuteScriptWithTransactionsRequest()
+	if err != nil {
+		return nil, models.NewBadRequestError(err)
+	}
+
+	if req.BlockHeight == request.SealedHeight || req.BlockHeight == request.FinalHeight {
+		latest, _, err := backend.GetLatestBlockHeader(r.Context(), req.BlockHeight == request.SealedHeight)
+		if err != nil {
+			return nil, err
+		}
+		req.BlockHeight = latest.Height
+	}
+
+	transactions := make([]*flow.TransactionBody, len(req.Transactions))
+	for i := range req.Transactions {
+		transactions[i] = &req.Transactions[i]
+	}
+
+	value, results, err := backend.ExecuteScriptWithTransactionsAtBlockHeight(
+		r.Context(),
+		req.BlockHeight,
+		req.Script.Source,
+		req.Script.Args,
+		transactions,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// events are returned CCF encoded by the execution environment, REST uses JSON-CDC
+	for i := range results {
+		events, err := convert.CcfEventsToJsonEvents(results[i].Events)
+		if err != nil {
+			return nil, err
+		}
+		results[i].Events = events
+	}
+
+	var response models.ScriptWithTransactionsResult
+	response.Build(value, results)
+	return response, nil
+}
@@ -0,0 +1,147 @@
+package routes
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	mocktestify "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/access/mock"
+	"github.com/onflow/flow-go/engine/access/rest/util"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestExecuteScriptWithTransactions tests local executeScriptWithTransactions requests.
+//
+// Runs the following tests:
+// 1. Execute at the latest sealed block with unsigned transactions.
+// 2. Execute at a block height.
+// 3. Execute with an invalid transaction.
+// 4. Execute with an invalid script encoding.
+// 5. Execute at a height which is not indexed.
+func TestExecuteScriptWithTransactions(t *testing.T) {
+	backend := mock.NewAPI(t)
+
+	script := []byte("access(all) fun main(): Int { return 1 }")
+	arguments := [][]byte{[]byte(`{"type":"Int","value":"1"}`)}
+	value := []byte(`{"type":"Int","value":"1"}`)
+
+	tx := unittest.TransactionBodyFixture()
+	tx.PayloadSignatures = []flow.TransactionSignature{unittest.TransactionSignatureFixture()}
+	tx.Arguments = [][]uint8{}
+
+	unsigned := tx
+	unsigned.PayloadSignatures = nil
+	unsigned.EnvelopeSignatures = nil
+
+	unsignedBody := unittest.CreateSendTxHttpPayload(tx)
+	delete(unsignedBody, "payload_signatures")
+	delete(unsignedBody, "envelope_signatures")
+
+	body := map[string]interface{}{
+		"script":       util.ToBase64(script),
+		"arguments":    []string{util.ToBase64(arguments[0])},
+		"transactions": []interface{}{unsignedBody},
+	}
+
+	matchTransactions := mocktestify.MatchedBy(func(actual []*flow.TransactionBody) bool {
+		return len(actual) == 1 && actual[0].ID() == unsigned.ID()
+	})
+
+	results := []flow.SpeculativeTransactionResult{{
+		TransactionID:   unsigned.ID(),
+		Events:          []flow.Event{},
+		ErrorMessage:    "",
+		ComputationUsed: 20,
+	}}
+
+	expected := fmt.Sprintf(`{
+		"value": "%s",
+		"transactions": [
+			{"transaction_id": "%s", "computation_used": "20", "events": [], "error_message": ""}
+		]
+	}`, util.ToBase64(value), unsigned.ID())
+
+	t.Run("latest sealed block", func(t *testing.T) {
+		header := unittest.BlockHeaderFixture()
+		req := scriptWithTransactionsRequest(t, body, "")
+
+		backend.Mock.
+			On("GetLatestBlockHeader", mocktestify.Anything, true).
+			Return(header, flow.BlockStatusSealed, nil).
+			Once()
+		backend.Mock.
+			On("ExecuteScriptWithTransactionsAtBlockHeight", mocktestify.Anything, header.Height, script, arguments, matchTransactions).
+			Return(value, results, nil).
+			Once()
+
+		assertOKResponse(t, req, expected, backend)
+	})
+
+	t.Run("block height", func(t *testing.T) {
+		req := scriptWithTransactionsRequest(t, body, "1337")
+
+		backend.Mock.
+			On("ExecuteScriptWithTransactionsAtBlockHeight", mocktestify.Anything, uint64(1337), script, arguments, matchTransactions).
+			Return(value, results, nil).
+			Once()
+
+		assertOKResponse(t, req, expected, backend)
+	})
+
+	t.Run("invalid transaction", func(t *testing.T) {
+		invalidBody := unittest.CreateSendTxHttpPayload(tx)
+		delete(invalidBody, "payer")
+		req := scriptWithTransactionsRequest(t, map[string]interface{}{
+			"script":       util.ToBase64(script),
+			"transactions": []interface{}{invalidBody},
+		}, "1337")
+
+		assertResponse(t, req, http.StatusBadRequest, `{"code":400, "message":"invalid transaction 0: payer not provided"}`, backend)
+	})
+
+	t.Run("invalid script encoding", func(t *testing.T) {
+		req := scriptWithTransactionsRequest(t, map[string]interface{}{
+			"script": "not base64!",
+		}, "1337")
+
+		assertResponse(t, req, http.StatusBadRequest, `{"code":400, "message":"invalid script source encoding"}`, backend)
+	})
+
+	t.Run("height not indexed", func(t *testing.T) {
+		req := scriptWithTransactionsRequest(t, body, "1337")
+
+		backend.Mock.
+			On("ExecuteScriptWithTransactionsAtBlockHeight", mocktestify.Anything, uint64(1337), script, arguments, matchTransactions).
+			Return(nil, nil, status.Error(codes.OutOfRange, "height 1337 is not indexed")).
+			Once()
+
+		assertResponse(t, req, http.StatusBadRequest, `{"code":400, "message":"Requested height is out of the indexed range: height 1337 is not indexed"}`, backend)
+	})
+}
+
+func scriptWithTransactionsRequest(t *testing.T, body interface{}, height string) *http.Request {
+	u, err := url.Parse("/v1/scripts_with_transactions")
+	require.NoError(t, err)
+
+	if height != "" {
+		q := u.Query()
+		q.Add("block_height", height)
+		u.RawQuery = q.Encode()
+	}
+
+	jsonBody, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPost, u.String(), bytes.NewBuffer(jsonBody))
+	require.NoError(t, err)
+	return req
+}
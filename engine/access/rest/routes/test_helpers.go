@@ -37,6 +37,8 @@ const (
 	addressesQueryParams        = "addresses"
 	contractsQueryParams        = "contracts"
 	heartbeatIntervalQueryParam = "heartbeat_interval"
+	cursorQueryParam            = "cursor"
+	limitQueryParam             = "limit"
 )
 
 // fakeNetConn implements a mocked ws connection that can be injected in testing logic.
@@ -162,3 +164,25 @@ func assertResponse(t *testing.T, req *http.Request, status int, expectedRespBod
 	)
 	require.Equal(t, status, rr.Code)
 }
+
+// nextPageLink returns the link to the next page from the Link header of a paginated response,
+// or an empty string if the response is the last page.
+func nextPageLink(t *testing.T, rr *httptest.ResponseRecorder) string {
+	header := rr.Header().Get("Link")
+	if header == "" {
+		return ""
+	}
+
+	link, found := strings.CutSuffix(header, `>; rel="next"`)
+	require.True(t, found, "invalid Link header: %s", header)
+	link, found = strings.CutPrefix(link, "<")
+	require.True(t, found, "invalid Link header: %s", header)
+	return link
+}
+
+// ndjsonToJSONList converts a newline delimited JSON response body to a JSON list.
+func ndjsonToJSONList(t *testing.T, body string) string {
+	require.True(t, strings.HasSuffix(body, "\n"), "response must be terminated by a newline")
+	items := strings.Split(strings.TrimSuffix(body, "\n"), "\n")
+	return fmt.Sprintf("[%s]", strings.Join(items, ","))
+}
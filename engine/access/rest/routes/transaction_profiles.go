@@ -0,0 +1,47 @@
+package routes
+
+import (
+	"github.com/onflow/flow-go/access"
+	"github.com/onflow/flow-go/engine/access/rest/models"
+	"github.com/onflow/flow-go/engine/access/rest/request"
+)
+
+// GetTransactionProfileByID retrieves the resource profile of a transaction executed in the requested block.
+func GetTransactionProfileByID(r *request.Request, backend access.API, link models.LinkGenerator) (interface{}, error) {
+	req, err := r.GetTransactionProfileRequest()
+	if err != nil {
+		return nil, models.NewBadRequestError(err)
+	}
+
+	profile, err := backend.GetTransactionProfile(r.Context(), req.BlockID, req.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.TransactionProfile
+	err = response.Build(req.BlockID, *profile, link)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetTransactionProfiles retrieves the resource profiles of all transactions executed in the requested block.
+func GetTransactionProfiles(r *request.Request, backend access.API, link models.LinkGenerator) (interface{}, error) {
+	req, err := r.GetTransactionProfilesRequest()
+	if err != nil {
+		return nil, models.NewBadRequestError(err)
+	}
+
+	profiles, err := backend.GetTransactionProfilesByBlockID(r.Context(), req.BlockID)
+	if err != nil {
+		return nil, err
+	}
+
+	var response models.TransactionProfiles
+	err = response.Build(req.BlockID, profiles, link)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
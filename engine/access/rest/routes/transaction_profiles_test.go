@@ -0,0 +1,183 @@
+package routes
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	mocktestify "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/access/mock"
+	"github.com/onflow/flow-go/engine/common/rpc/convert"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestGetTransactionProfiles tests local getTransactionProfileByID and getTransactionProfiles requests.
+//
+// Runs the following tests:
+// 1. Get the profile of a transaction.
+// 2. Get the profiles of all transactions of a block.
+// 3. Get a profile which is not available.
+// 4. Get invalid profiles.
+func TestGetTransactionProfiles(t *testing.T) {
+	backend := mock.NewAPI(t)
+	blockID := unittest.IdentifierFixture()
+
+	profiles := []flow.TransactionProfile{
+		{
+			TransactionID:          unittest.IdentifierFixture(),
+			ComputationUsed:        10,
+			ComputationIntensities: map[uint]uint{1004: 2, 1001: 7},
+			MemoryEstimate:         100,
+			MemoryIntensities:      map[uint]uint{3: 4},
+			RegistersRead:          3,
+			RegistersWritten:       1,
+			BytesRead:              300,
+			BytesWritten:           50,
+			EventsSize:             25,
+			ExecutionTime:          2 * time.Millisecond,
+		},
+		{
+			TransactionID:          unittest.IdentifierFixture(),
+			ComputationIntensities: map[uint]uint{},
+			MemoryIntensities:      map[uint]uint{},
+		},
+	}
+
+	t.Run("get by ID", func(t *testing.T) {
+		req := getTransactionProfilesRequest(t, profiles[0].TransactionID.String(), map[string]string{"block_id": blockID.String()})
+
+		backend.Mock.
+			On("GetTransactionProfile", mocktestify.Anything, blockID, profiles[0].TransactionID).
+			Return(&profiles[0], nil).
+			Once()
+
+		assertOKResponse(t, req, expectedTransactionProfileResponse(blockID, profiles[0]), backend)
+	})
+
+	t.Run("get by block ID", func(t *testing.T) {
+		req := getTransactionProfilesRequest(t, "", map[string]string{"block_id": blockID.String()})
+
+		backend.Mock.
+			On("GetTransactionProfilesByBlockID", mocktestify.Anything, blockID).
+			Return(profiles, nil).
+			Once()
+
+		expected := fmt.Sprintf("[%s,%s]",
+			expectedTransactionProfileResponse(blockID, profiles[0]),
+			expectedTransactionProfileResponse(blockID, profiles[1]))
+		assertOKResponse(t, req, expected, backend)
+	})
+
+	t.Run("get not found", func(t *testing.T) {
+		txID := unittest.IdentifierFixture()
+		req := getTransactionProfilesRequest(t, txID.String(), map[string]string{"block_id": blockID.String()})
+
+		backend.Mock.
+			On("GetTransactionProfile", mocktestify.Anything, blockID, txID).
+			Return(nil, status.Error(codes.NotFound, "not found")).
+			Once()
+
+		assertResponse(t, req, http.StatusNotFound, `{"code":404, "message":"Flow resource not found: not found"}`, backend)
+	})
+
+	t.Run("get invalid", func(t *testing.T) {
+		txID := profiles[0].TransactionID.String()
+		tests := []struct {
+			url string
+			out string
+		}{
+			{transactionProfilesURL(t, txID, nil), `{"code":400, "message":"block_id must be provided"}`},
+			{transactionProfilesURL(t, "", nil), `{"code":400, "message":"block_id must be provided"}`},
+			{transactionProfilesURL(t, txID, map[string]string{"block_id": "foo"}), `{"code":400, "message":"invalid block_id: invalid ID format"}`},
+			{transactionProfilesURL(t, "foo", map[string]string{"block_id": blockID.String()}), `{"code":400, "message":"invalid ID format"}`},
+		}
+
+		for i, test := range tests {
+			req, _ := http.NewRequest("GET", test.url, nil)
+			rr := executeRequest(req, backend)
+
+			assert.Equal(t, http.StatusBadRequest, rr.Code)
+			assert.JSONEq(t, test.out, rr.Body.String(), fmt.Sprintf("test #%d failed: %v", i, test))
+		}
+	})
+}
+
+func transactionProfilesURL(t *testing.T, txID string, params map[string]string) string {
+	path := "/v1/transaction_profiles"
+	if txID != "" {
+		path = fmt.Sprintf("%s/%s", path, txID)
+	}
+	u, err := url.ParseRequestURI(path)
+	require.NoError(t, err)
+	q := u.Query()
+
+	for key, value := range params {
+		q.Add(key, value)
+	}
+
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+func getTransactionProfilesRequest(t *testing.T, txID string, params map[string]string) *http.Request {
+	req, err := http.NewRequest("GET", transactionProfilesURL(t, txID, params), nil)
+	require.NoError(t, err)
+	return req
+}
+
+func expectedTransactionProfileResponse(blockID flow.Identifier, profile flow.TransactionProfile) string {
+	intensities := func(values map[uint]uint, name func(uint) string) string {
+		result := make([]string, 0, len(values))
+		// the test profiles use at most two kinds, which are sorted here
+		kinds := make([]uint, 0, len(values))
+		for kind := range values {
+			kinds = append(kinds, kind)
+		}
+		if len(kinds) == 2 && kinds[0] > kinds[1] {
+			kinds[0], kinds[1] = kinds[1], kinds[0]
+		}
+		for _, kind := range kinds {
+			result = append(result, fmt.Sprintf(`{"kind": "%s", "intensity": "%d"}`, name(kind), values[kind]))
+		}
+		return strings.Join(result, ",")
+	}
+
+	return fmt.Sprintf(`{
+		"block_id": "%s",
+		"transaction_id": "%s",
+		"computation_used": "%d",
+		"computation_intensities": [%s],
+		"memory_estimate": "%d",
+		"memory_intensities": [%s],
+		"registers_read": "%d",
+		"registers_written": "%d",
+		"bytes_read": "%d",
+		"bytes_written": "%d",
+		"events_size": "%d",
+		"execution_time": "%d",
+		"_links": {"_self": "/v1/transactions/%s"}
+	}`,
+		blockID,
+		profile.TransactionID,
+		profile.ComputationUsed,
+		intensities(profile.ComputationIntensities, convert.ComputationKindName),
+		profile.MemoryEstimate,
+		intensities(profile.MemoryIntensities, convert.MemoryKindName),
+		profile.RegistersRead,
+		profile.RegistersWritten,
+		profile.BytesRead,
+		profile.BytesWritten,
+		profile.EventsSize,
+		profile.ExecutionTime.Nanoseconds(),
+		profile.TransactionID,
+	)
+}
@@ -0,0 +1,33 @@
+package routes
+
+import (
+	"github.com/onflow/flow-go/access"
+	"github.com/onflow/flow-go/engine/access/rest/models"
+	"github.com/onflow/flow-go/engine/access/rest/request"
+	"github.com/onflow/flow-go/engine/common/rpc/convert"
+)
+
+// SimulateTransaction executes the provided transaction on top of the latest sealed state,
+// without submitting it.
+func SimulateTransaction(r *request.Request, backend access.API, _ models.LinkGenerator) (interface{}, error) {
+	req, err := r.SimulateTransactionRequest()
+	if err != nil {
+		return nil, models.NewBadRequestError(err)
+	}
+
+	result, err := backend.SimulateTransaction(r.Context(), &req.Transaction, req.SkipSignatureChecks)
+	if err != nil {
+		return nil, err
+	}
+
+	// events are returned CCF encoded by the execution environment, REST uses JSON-CDC
+	events, err := convert.CcfEventsToJsonEvents(result.Events)
+	if err != nil {
+		return nil, err
+	}
+	result.Events = events
+
+	var response models.TransactionSimulation
+	response.Build(result)
+	return response, nil
+}
@@ -0,0 +1,148 @@
+package routes
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+
+	mocktestify "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/access/mock"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestSimulateTransaction tests local simulateTransaction requests.
+//
+// Runs the following tests:
+// 1. Simulate a signed transaction.
+// 2. Simulate an unsigned transaction with signature checks skipped.
+// 3. Simulate an unsigned transaction with signature checks.
+// 4. Simulate with an invalid skip_signature_checks parameter.
+// 5. Simulate a transaction rejected by the backend.
+func TestSimulateTransaction(t *testing.T) {
+	backend := mock.NewAPI(t)
+
+	tx := unittest.TransactionBodyFixture()
+	tx.PayloadSignatures = []flow.TransactionSignature{unittest.TransactionSignatureFixture()}
+	tx.Arguments = [][]uint8{}
+
+	result := &flow.TransactionSimulationResult{
+		TransactionID:   tx.ID(),
+		ComputationUsed: 20,
+		Fees: flow.TransactionFeeEstimate{
+			SurgeFactor:         100_000_000,
+			InclusionEffortCost: 1_000,
+			ExecutionEffortCost: 2_000,
+			InclusionEffort:     100_000_000,
+			ExecutionEffort:     20,
+			InclusionFee:        1_000,
+			ExecutionFee:        0,
+			TotalFee:            1_000,
+		},
+		Events: []flow.Event{},
+		StorageChanges: []flow.StorageUsedChange{
+			{Address: tx.Payer, StorageUsedBefore: 100, StorageUsedAfter: 120},
+		},
+	}
+
+	expected := fmt.Sprintf(`{
+		"transaction_id": "%s",
+		"computation_used": "20",
+		"fees": {
+			"surge_factor": "100000000",
+			"inclusion_effort_cost": "1000",
+			"execution_effort_cost": "2000",
+			"inclusion_effort": "100000000",
+			"execution_effort": "20",
+			"inclusion_fee": "1000",
+			"execution_fee": "0",
+			"total_fee": "1000"
+		},
+		"events": [],
+		"error_message": "",
+		"storage_changes": [
+			{"address": "%s", "storage_used_before": "100", "storage_used_after": "120"}
+		]
+	}`, tx.ID(), tx.Payer)
+
+	t.Run("simulate signed transaction", func(t *testing.T) {
+		req := simulateTransactionRequest(t, unittest.CreateSendTxHttpPayload(tx), "")
+
+		backend.Mock.
+			On("SimulateTransaction", mocktestify.Anything, &tx, false).
+			Return(result, nil).
+			Once()
+
+		assertOKResponse(t, req, expected, backend)
+	})
+
+	t.Run("simulate unsigned transaction", func(t *testing.T) {
+		unsigned := tx
+		unsigned.PayloadSignatures = nil
+		unsigned.EnvelopeSignatures = nil
+
+		body := unittest.CreateSendTxHttpPayload(tx)
+		delete(body, "payload_signatures")
+		delete(body, "envelope_signatures")
+		req := simulateTransactionRequest(t, body, "true")
+
+		backend.Mock.
+			On("SimulateTransaction", mocktestify.Anything, mocktestify.MatchedBy(func(actual *flow.TransactionBody) bool {
+				return actual.ID() == unsigned.ID() && len(actual.EnvelopeSignatures) == 0
+			}), true).
+			Return(result, nil).
+			Once()
+
+		assertOKResponse(t, req, expected, backend)
+	})
+
+	t.Run("unsigned transaction with signature checks", func(t *testing.T) {
+		body := unittest.CreateSendTxHttpPayload(tx)
+		delete(body, "envelope_signatures")
+		req := simulateTransactionRequest(t, body, "false")
+
+		assertResponse(t, req, http.StatusBadRequest, `{"code":400, "message":"envelope signatures not provided"}`, backend)
+	})
+
+	t.Run("invalid skip_signature_checks", func(t *testing.T) {
+		req := simulateTransactionRequest(t, unittest.CreateSendTxHttpPayload(tx), "maybe")
+
+		assertResponse(t, req, http.StatusBadRequest, `{"code":400, "message":"invalid skip_signature_checks: must be a boolean"}`, backend)
+	})
+
+	t.Run("transaction rejected by backend", func(t *testing.T) {
+		req := simulateTransactionRequest(t, unittest.CreateSendTxHttpPayload(tx), "")
+
+		backend.Mock.
+			On("SimulateTransaction", mocktestify.Anything, &tx, false).
+			Return(nil, status.Error(codes.InvalidArgument, "invalid transaction")).
+			Once()
+
+		assertResponse(t, req, http.StatusBadRequest, `{"code":400, "message":"Invalid Flow argument: invalid transaction"}`, backend)
+	})
+}
+
+func simulateTransactionRequest(t *testing.T, body interface{}, skipSignatureChecks string) *http.Request {
+	u, err := url.Parse("/v1/transaction_simulations")
+	require.NoError(t, err)
+
+	if skipSignatureChecks != "" {
+		q := u.Query()
+		q.Add("skip_signature_checks", skipSignatureChecks)
+		u.RawQuery = q.Encode()
+	}
+
+	jsonBody, err := json.Marshal(body)
+	require.NoError(t, err)
+
+	req, err := http.NewRequest(http.MethodPost, u.String(), bytes.NewBuffer(jsonBody))
+	require.NoError(t, err)
+	return req
+}
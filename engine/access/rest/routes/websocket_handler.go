@@ -253,6 +253,7 @@ func NewWSHandler(
 	subscribeFunc SubscribeHandlerFunc,
 	chain flow.Chain,
 	stateStreamConfig backend.Config,
+	activeStreamCount *atomic.Int32,
 ) *WSHandler {
 	handler := &WSHandler{
 		subscribeFunc:            subscribeFunc,
@@ -260,7 +261,7 @@ func NewWSHandler(
 		eventFilterConfig:        stateStreamConfig.EventFilterConfig,
 		maxStreams:               int32(stateStreamConfig.MaxGlobalStreams),
 		defaultHeartbeatInterval: stateStreamConfig.HeartbeatInterval,
-		activeStreamCount:        atomic.NewInt32(0),
+		activeStreamCount:        activeStreamCount,
 		HttpHandler:              NewHttpHandler(logger, chain),
 	}
 
@@ -0,0 +1,461 @@
+package routes
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/gorilla/websocket"
+	"github.com/rs/zerolog"
+	"go.uber.org/atomic"
+
+	"github.com/onflow/flow-go/access"
+	"github.com/onflow/flow-go/engine/access/rest/models"
+	"github.com/onflow/flow-go/engine/access/state_stream"
+	"github.com/onflow/flow-go/engine/access/state_stream/backend"
+	"github.com/onflow/flow-go/engine/access/subscription"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// outboundBufferSize is the number of messages which may be queued for writing on a multiplexed
+// WebSocket connection before subscriptions block.
+const outboundBufferSize = 64
+
+// MultiplexedWSHandler serves a WebSocket endpoint where a single connection can open and close several
+// typed subscriptions by sending subscribe and unsubscribe messages.
+//
+// Every subscription counts towards the global maximum number of streams, and the number of subscriptions
+// a single connection may have open at the same time is limited by maxSubscriptionsPerConnection.
+//
+// The handler is served even if execution state streaming is disabled. In that case stateStreamApi is nil,
+// and subscriptions to the topics which depend on it are rejected with a not enabled error.
+type MultiplexedWSHandler struct {
+	*HttpHandler
+
+	api                           access.API
+	stateStreamApi                state_stream.API
+	linkGenerator                 models.LinkGenerator
+	eventFilterConfig             state_stream.EventFilterConfig
+	defaultHeartbeatInterval      uint64
+	maxStreams                    int32
+	maxSubscriptionsPerConnection uint64
+	activeStreamCount             *atomic.Int32
+}
+
+var _ http.Handler = (*MultiplexedWSHandler)(nil)
+
+// NewMultiplexedWSHandler creates a new MultiplexedWSHandler.
+// A maxSubscriptionsPerConnection of 0 disables the per connection limit, the global stream limit
+// from the state stream config still applies. activeStreamCount is the stream counter shared with the
+// other WebSocket handlers of the router, so that the global limit covers the streams of all of them.
+func NewMultiplexedWSHandler(
+	logger zerolog.Logger,
+	api access.API,
+	stateStreamApi state_stream.API,
+	linkGenerator models.LinkGenerator,
+	chain flow.Chain,
+	stateStreamConfig backend.Config,
+	maxSubscriptionsPerConnection uint64,
+	activeStreamCount *atomic.Int32,
+) *MultiplexedWSHandler {
+	return &MultiplexedWSHandler{
+		HttpHandler:                   NewHttpHandler(logger, chain),
+		api:                           api,
+		stateStreamApi:                stateStreamApi,
+		linkGenerator:                 linkGenerator,
+		eventFilterConfig:             stateStreamConfig.EventFilterConfig,
+		defaultHeartbeatInterval:      stateStreamConfig.HeartbeatInterval,
+		maxStreams:                    int32(stateStreamConfig.MaxGlobalStreams),
+		maxSubscriptionsPerConnection: maxSubscriptionsPerConnection,
+		activeStreamCount:             activeStreamCount,
+	}
+}
+
+// ServeHTTP upgrades the connection to a WebSocket connection and serves subscription requests
+// on it until the connection is closed.
+func (h *MultiplexedWSHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	logger := h.Logger.With().Str("subscribe_url", r.URL.String()).Logger()
+
+	err := h.VerifyRequest(w, r)
+	if err != nil {
+		// VerifyRequest sets the response error before returning
+		return
+	}
+
+	upgrader := websocket.Upgrader{
+		// allow all origins by default, operators can override using a proxy
+		CheckOrigin: func(r *http.Request) bool {
+			return true
+		},
+	}
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		h.errorHandler(w, models.NewRestError(http.StatusInternalServerError, "webSocket upgrade error: ", err), logger)
+		return
+	}
+	defer conn.Close()
+
+	newMultiplexedWSController(logger, conn, h).run()
+}
+
+// multiplexedSubscription is an active subscription of a multiplexed WebSocket connection.
+type multiplexedSubscription struct {
+	id     string
+	topic  string
+	cancel context.CancelFunc
+}
+
+// multiplexedWSController handles a single multiplexed WebSocket connection.
+//
+// Client messages are read and handled on the goroutine calling run. Every subscription forwards its
+// responses from a dedicated goroutine, and all messages are written to the connection by a single
+// writer goroutine, as the WebSocket connection does not support concurrent writers.
+type multiplexedWSController struct {
+	logger  zerolog.Logger
+	conn    *websocket.Conn
+	handler *MultiplexedWSHandler
+
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	outbound chan models.WebSocketResponse
+
+	mu            sync.Mutex
+	subscriptions map[string]*multiplexedSubscription
+	wg            sync.WaitGroup
+}
+
+func newMultiplexedWSController(
+	logger zerolog.Logger,
+	conn *websocket.Conn,
+	handler *MultiplexedWSHandler,
+) *multiplexedWSController {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &multiplexedWSController{
+		logger:        logger,
+		conn:          conn,
+		handler:       handler,
+		ctx:           ctx,
+		cancel:        cancel,
+		outbound:      make(chan models.WebSocketResponse, outboundBufferSize),
+		subscriptions: make(map[string]*multiplexedSubscription),
+	}
+}
+
+// run serves the connection until it is closed by the client or an error occurs. All subscriptions
+// of the connection are closed before run returns.
+func (c *multiplexedWSController) run() {
+	defer c.cancel()
+
+	err := c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	if err != nil {
+		c.logger.Debug().Err(err).Msg("failed to set the initial read deadline")
+		return
+	}
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	writerDone := make(chan struct{})
+	go func() {
+		defer close(writerDone)
+		c.writeMessages()
+	}()
+
+	c.readMessages()
+
+	// cancelling the context stops all subscriptions, and ensures the goroutines set up by the backend
+	// are cleaned up.
+	c.cancel()
+	c.wg.Wait()
+	<-writerDone
+}
+
+// readMessages reads and handles client messages until the connection is closed.
+func (c *multiplexedWSController) readMessages() {
+	for {
+		_, msg, err := c.conn.ReadMessage()
+		if err != nil {
+			if c.ctx.Err() == nil && !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				c.logger.Debug().Err(err).Msg("failed to read message from the client, closing connection")
+			}
+			return
+		}
+
+		var req models.WebSocketRequest
+		decoder := json.NewDecoder(bytes.NewReader(msg))
+		decoder.UseNumber()
+		err = decoder.Decode(&req)
+		if err != nil {
+			c.sendError(models.WebSocketResponse{}, models.NewBadRequestError(fmt.Errorf("invalid message: %w", err)))
+			continue
+		}
+
+		switch req.Action {
+		case models.SubscribeAction:
+			c.subscribe(req)
+		case models.UnsubscribeAction:
+			c.unsubscribe(req)
+		case models.ListSubscriptionsAction:
+			c.listSubscriptions()
+		default:
+			c.sendError(
+				models.WebSocketResponse{Action: req.Action, SubscriptionID: req.SubscriptionID},
+				models.NewBadRequestError(fmt.Errorf("unknown action: %q", req.Action)),
+			)
+		}
+	}
+}
+
+// writeMessages writes the queued messages and periodic pings to the connection until the connection
+// context is cancelled or writing fails.
+func (c *multiplexedWSController) writeMessages() {
+	ticker := time.NewTicker(pingPeriod)
+	defer func() {
+		ticker.Stop()
+		// make sure the reader is unblocked if writing failed
+		c.cancel()
+		_ = c.conn.Close()
+	}()
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case msg := <-c.outbound:
+			err := c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err != nil {
+				c.logger.Debug().Err(err).Msg("failed to set the write deadline")
+				return
+			}
+			err = c.conn.WriteJSON(msg)
+			if err != nil {
+				c.logger.Debug().Err(err).Msg("failed to write message to the client")
+				return
+			}
+		case <-ticker.C:
+			err := c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err != nil {
+				c.logger.Debug().Err(err).Msg("failed to set the write deadline")
+				return
+			}
+			err = c.conn.WriteMessage(websocket.PingMessage, nil)
+			if err != nil {
+				c.logger.Debug().Err(err).Msg("failed to write ping to the client")
+				return
+			}
+		}
+	}
+}
+
+// subscribe opens a new subscription for the requested topic and acknowledges it to the client.
+func (c *multiplexedWSController) subscribe(req models.WebSocketRequest) {
+	resp := models.WebSocketResponse{
+		Action:         models.SubscribeAction,
+		SubscriptionID: req.SubscriptionID,
+		Topic:          req.Topic,
+	}
+
+	subscribeFunc, ok := websocketTopics[req.Topic]
+	if !ok {
+		c.sendError(resp, models.NewBadRequestError(fmt.Errorf("unknown topic: %q", req.Topic)))
+		return
+	}
+
+	ctx, cancel := context.WithCancel(c.ctx)
+	entry, err := c.addSubscription(req.SubscriptionID, req.Topic, cancel)
+	if err != nil {
+		cancel()
+		c.sendError(resp, err)
+		return
+	}
+	resp.SubscriptionID = entry.id
+
+	sub, convert, err := subscribeFunc(ctx, c.handler, req.Arguments)
+	if err != nil {
+		cancel()
+		c.removeSubscription(entry)
+		c.sendError(resp, err)
+		return
+	}
+
+	// the acknowledgement is queued before any data of the subscription
+	c.send(resp)
+
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		defer c.removeSubscription(entry)
+		defer cancel()
+
+		c.forward(ctx, entry, sub, convert)
+	}()
+}
+
+// forward sends the responses of a subscription to the client until the subscription ends or is cancelled.
+// If the subscription ends on the server side, the client is notified with an unsubscribe message, which
+// includes the error if the subscription failed.
+func (c *multiplexedWSController) forward(
+	ctx context.Context,
+	entry *multiplexedSubscription,
+	sub subscription.Subscription,
+	convert responseConverter,
+) {
+	closed := models.WebSocketResponse{
+		Action:         models.UnsubscribeAction,
+		SubscriptionID: entry.id,
+		Topic:          entry.topic,
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case response, ok := <-sub.Channel():
+			if !ok {
+				if ctx.Err() != nil {
+					return
+				}
+				if sub.Err() != nil {
+					c.sendError(closed, fmt.Errorf("stream encountered an error: %w", sub.Err()))
+					return
+				}
+				c.send(closed)
+				return
+			}
+
+			payload, ok, err := convert(response)
+			if err != nil {
+				c.sendError(closed, err)
+				return
+			}
+			if !ok {
+				continue
+			}
+
+			c.send(models.WebSocketResponse{
+				SubscriptionID: entry.id,
+				Topic:          entry.topic,
+				Payload:        payload,
+			})
+		}
+	}
+}
+
+// unsubscribe closes the requested subscription and acknowledges it to the client.
+func (c *multiplexedWSController) unsubscribe(req models.WebSocketRequest) {
+	resp := models.WebSocketResponse{
+		Action:         models.UnsubscribeAction,
+		SubscriptionID: req.SubscriptionID,
+	}
+
+	c.mu.Lock()
+	entry, ok := c.subscriptions[req.SubscriptionID]
+	if ok {
+		delete(c.subscriptions, req.SubscriptionID)
+	}
+	c.mu.Unlock()
+
+	if !ok {
+		err := fmt.Errorf("subscription %q not found", req.SubscriptionID)
+		c.sendError(resp, models.NewNotFoundError(err.Error(), err))
+		return
+	}
+
+	entry.cancel()
+	resp.Topic = entry.topic
+	c.send(resp)
+}
+
+// listSubscriptions sends the active subscriptions of the connection to the client.
+func (c *multiplexedWSController) listSubscriptions() {
+	c.mu.Lock()
+	subscriptions := make([]models.WebSocketSubscription, 0, len(c.subscriptions))
+	for _, entry := range c.subscriptions {
+		subscriptions = append(subscriptions, models.WebSocketSubscription{
+			SubscriptionID: entry.id,
+			Topic:          entry.topic,
+		})
+	}
+	c.mu.Unlock()
+
+	sort.Slice(subscriptions, func(i, j int) bool {
+		return subscriptions[i].SubscriptionID < subscriptions[j].SubscriptionID
+	})
+
+	c.send(models.WebSocketResponse{
+		Action:        models.ListSubscriptionsAction,
+		Subscriptions: subscriptions,
+	})
+}
+
+// addSubscription registers a new subscription of the connection, enforcing the per connection and
+// global stream limits. If id is empty, a new random ID is assigned.
+func (c *multiplexedWSController) addSubscription(id string, topic string, cancel context.CancelFunc) (*multiplexedSubscription, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if id == "" {
+		id = uuid.New().String()
+	}
+	if _, ok := c.subscriptions[id]; ok {
+		return nil, models.NewBadRequestError(fmt.Errorf("subscription ID %q is already in use", id))
+	}
+
+	maxSubscriptions := c.handler.maxSubscriptionsPerConnection
+	if maxSubscriptions > 0 && uint64(len(c.subscriptions)) >= maxSubscriptions {
+		err := fmt.Errorf("maximum number of subscriptions per connection reached")
+		return nil, models.NewRestError(http.StatusTooManyRequests, err.Error(), err)
+	}
+
+	if c.handler.activeStreamCount.Inc() > c.handler.maxStreams {
+		c.handler.activeStreamCount.Dec()
+		err := fmt.Errorf("maximum number of streams reached")
+		return nil, models.NewRestError(http.StatusServiceUnavailable, err.Error(), err)
+	}
+
+	entry := &multiplexedSubscription{
+		id:     id,
+		topic:  topic,
+		cancel: cancel,
+	}
+	c.subscriptions[id] = entry
+
+	return entry, nil
+}
+
+// removeSubscription releases the stream held by the subscription, and removes it from the active
+// subscriptions of the connection if it was not already removed by an unsubscribe request.
+func (c *multiplexedWSController) removeSubscription(entry *multiplexedSubscription) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if current, ok := c.subscriptions[entry.id]; ok && current == entry {
+		delete(c.subscriptions, entry.id)
+	}
+	c.handler.activeStreamCount.Dec()
+}
+
+// send queues a message for writing to the client. Messages are dropped once the connection is closed.
+func (c *multiplexedWSController) send(msg models.WebSocketResponse) {
+	select {
+	case c.outbound <- msg:
+	case <-c.ctx.Done():
+	}
+}
+
+// sendError sends the given error to the client as part of resp.
+func (c *multiplexedWSController) sendError(resp models.WebSocketResponse, err error) {
+	code, msg := errorToStatus(err, c.logger)
+	resp.Error = &models.WebSocketError{
+		Code:    code,
+		Message: msg,
+	}
+	c.send(resp)
+}
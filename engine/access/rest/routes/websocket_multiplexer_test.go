@@ -0,0 +1,378 @@
+package routes
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	mocks "github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/onflow/flow-go/access/mock"
+	"github.com/onflow/flow-go/engine/access/rest/models"
+	"github.com/onflow/flow-go/engine/access/state_stream"
+	"github.com/onflow/flow-go/engine/access/state_stream/backend"
+	mockstatestream "github.com/onflow/flow-go/engine/access/state_stream/mock"
+	"github.com/onflow/flow-go/engine/access/subscription"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/metrics"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+type MultiplexedWSSuite struct {
+	suite.Suite
+
+	api            *mock.API
+	stateStreamApi *mockstatestream.API
+	server         *httptest.Server
+	conn           *websocket.Conn
+}
+
+func TestMultiplexedWSSuite(t *testing.T) {
+	suite.Run(t, new(MultiplexedWSSuite))
+}
+
+func (s *MultiplexedWSSuite) SetupTest() {
+	s.api = mock.NewAPI(s.T())
+	s.stateStreamApi = mockstatestream.NewAPI(s.T())
+	s.startServer(2, subscription.DefaultMaxGlobalStreams)
+}
+
+func (s *MultiplexedWSSuite) TearDownTest() {
+	if s.conn != nil {
+		_ = s.conn.Close()
+	}
+	s.server.Close()
+}
+
+// startServer starts a REST server with the WebSocket routes and connects to the multiplexed one.
+func (s *MultiplexedWSSuite) startServer(maxSubscriptionsPerConnection uint64, maxGlobalStreams uint32) {
+	config := backend.Config{
+		EventFilterConfig: state_stream.DefaultEventFilterConfig,
+		MaxGlobalStreams:  maxGlobalStreams,
+		HeartbeatInterval: subscription.DefaultHeartbeatInterval,
+	}
+
+	router := NewRouterBuilder(unittest.Logger(), metrics.NewNoopCollector()).
+		AddWsRoutes(s.stateStreamApi, flow.Testnet.Chain(), config).
+		AddMultiplexedWsRoute(s.api, s.stateStreamApi, flow.Testnet.Chain(), config, maxSubscriptionsPerConnection).
+		Build()
+	s.server = httptest.NewServer(router)
+	s.conn = s.dial(MultiplexedWSPattern)
+}
+
+// dial opens a WebSocket connection to the given route of the server.
+func (s *MultiplexedWSSuite) dial(pattern string) *websocket.Conn {
+	url := "ws" + strings.TrimPrefix(s.server.URL, "http") + "/v1" + pattern
+	conn, resp, err := websocket.DefaultDialer.Dial(url, nil)
+	s.Require().NoError(err)
+	s.Require().NoError(resp.Body.Close())
+	return conn
+}
+
+func (s *MultiplexedWSSuite) send(req models.WebSocketRequest) {
+	s.Require().NoError(s.conn.WriteJSON(req))
+}
+
+func (s *MultiplexedWSSuite) receive() models.WebSocketResponse {
+	s.Require().NoError(s.conn.SetReadDeadline(time.Now().Add(5 * time.Second)))
+
+	var resp models.WebSocketResponse
+	s.Require().NoError(s.conn.ReadJSON(&resp))
+	return resp
+}
+
+// TestSubscribeAndUnsubscribe tests opening several subscriptions of different topics on the same connection,
+// receiving their data, listing them and closing them.
+func (s *MultiplexedWSSuite) TestSubscribeAndUnsubscribe() {
+	header := unittest.BlockHeaderFixture()
+	headers := subscription.NewSubscription(1)
+	s.api.On("SubscribeBlockHeadersFromLatest", mocks.Anything, flow.BlockStatusSealed).
+		Return(headers).Once()
+
+	digest := flow.NewBlockDigest(header.ID(), header.Height, header.Timestamp)
+	digests := subscription.NewSubscription(1)
+	s.api.On("SubscribeBlockDigestsFromStartHeight", mocks.Anything, header.Height, flow.BlockStatusFinalized).
+		Return(digests).Once()
+
+	s.send(models.WebSocketRequest{
+		Action:         models.SubscribeAction,
+		SubscriptionID: "headers",
+		Topic:          BlockHeadersTopic,
+		Arguments:      models.WebSocketArguments{"block_status": "sealed"},
+	})
+	resp := s.receive()
+	s.Require().Nil(resp.Error)
+	s.Require().Equal(models.SubscribeAction, resp.Action)
+	s.Require().Equal("headers", resp.SubscriptionID)
+	s.Require().Equal(BlockHeadersTopic, resp.Topic)
+
+	s.send(models.WebSocketRequest{
+		Action: models.SubscribeAction,
+		Topic:  BlockDigestsTopic,
+		Arguments: models.WebSocketArguments{
+			"block_status":       "finalized",
+			"start_block_height": header.Height,
+		},
+	})
+	resp = s.receive()
+	s.Require().Nil(resp.Error)
+	s.Require().Equal(BlockDigestsTopic, resp.Topic)
+	s.Require().NotEmpty(resp.SubscriptionID)
+	digestsID := resp.SubscriptionID
+
+	// data is delivered for each subscription
+	s.Require().NoError(headers.Send(context.Background(), header, time.Second))
+	resp = s.receive()
+	s.Require().Equal("headers", resp.SubscriptionID)
+	s.Require().Equal(BlockHeadersTopic, resp.Topic)
+	payload, ok := resp.Payload.(map[string]interface{})
+	s.Require().True(ok)
+	s.Require().Equal(header.ID().String(), payload["id"])
+
+	s.Require().NoError(digests.Send(context.Background(), digest, time.Second))
+	resp = s.receive()
+	s.Require().Equal(digestsID, resp.SubscriptionID)
+	payload, ok = resp.Payload.(map[string]interface{})
+	s.Require().True(ok)
+	s.Require().Equal(header.ID().String(), payload["block_id"])
+
+	s.send(models.WebSocketRequest{Action: models.ListSubscriptionsAction})
+	resp = s.receive()
+	s.Require().Equal(models.ListSubscriptionsAction, resp.Action)
+	s.Require().ElementsMatch([]models.WebSocketSubscription{
+		{SubscriptionID: "headers", Topic: BlockHeadersTopic},
+		{SubscriptionID: digestsID, Topic: BlockDigestsTopic},
+	}, resp.Subscriptions)
+
+	s.send(models.WebSocketRequest{Action: models.UnsubscribeAction, SubscriptionID: "headers"})
+	resp = s.receive()
+	s.Require().Nil(resp.Error)
+	s.Require().Equal(models.UnsubscribeAction, resp.Action)
+	s.Require().Equal("headers", resp.SubscriptionID)
+	s.Require().Equal(BlockHeadersTopic, resp.Topic)
+
+	s.send(models.WebSocketRequest{Action: models.ListSubscriptionsAction})
+	resp = s.receive()
+	s.Require().Equal([]models.WebSocketSubscription{
+		{SubscriptionID: digestsID, Topic: BlockDigestsTopic},
+	}, resp.Subscriptions)
+}
+
+// TestSubscriptionEnds tests that the client is notified when a subscription ends on the server side.
+func (s *MultiplexedWSSuite) TestSubscriptionEnds() {
+	sub := subscription.NewSubscription(1)
+	s.api.On("SubscribeBlocksFromLatest", mocks.Anything, flow.BlockStatusFinalized).
+		Return(sub).Once()
+
+	s.send(models.WebSocketRequest{
+		Action:         models.SubscribeAction,
+		SubscriptionID: "blocks",
+		Topic:          BlocksTopic,
+		Arguments:      models.WebSocketArguments{"block_status": "finalized"},
+	})
+	resp := s.receive()
+	s.Require().Nil(resp.Error)
+
+	sub.Fail(subscription.ErrBlockNotReady)
+	resp = s.receive()
+	s.Require().Equal(models.UnsubscribeAction, resp.Action)
+	s.Require().Equal("blocks", resp.SubscriptionID)
+	s.Require().NotNil(resp.Error)
+
+	// the subscription ID can be reused once the subscription ended
+	sub = subscription.NewSubscription(1)
+	s.api.On("SubscribeBlocksFromLatest", mocks.Anything, flow.BlockStatusFinalized).
+		Return(sub).Once()
+
+	s.Require().Eventually(func() bool {
+		s.send(models.WebSocketRequest{
+			Action:         models.SubscribeAction,
+			SubscriptionID: "blocks",
+			Topic:          BlocksTopic,
+			Arguments:      models.WebSocketArguments{"block_status": "finalized"},
+		})
+		return s.receive().Error == nil
+	}, time.Second, 10*time.Millisecond)
+}
+
+// TestMaxSubscriptionsPerConnection tests that subscriptions exceeding the per connection limit are rejected.
+func (s *MultiplexedWSSuite) TestMaxSubscriptionsPerConnection() {
+	s.api.On("SubscribeBlockHeadersFromLatest", mocks.Anything, flow.BlockStatusSealed).
+		Return(subscription.NewSubscription(1)).Twice()
+
+	for i := 0; i < 2; i++ {
+		s.send(models.WebSocketRequest{
+			Action:    models.SubscribeAction,
+			Topic:     BlockHeadersTopic,
+			Arguments: models.WebSocketArguments{"block_status": "sealed"},
+		})
+		s.Require().Nil(s.receive().Error)
+	}
+
+	s.send(models.WebSocketRequest{
+		Action:    models.SubscribeAction,
+		Topic:     BlockHeadersTopic,
+		Arguments: models.WebSocketArguments{"block_status": "sealed"},
+	})
+	resp := s.receive()
+	s.Require().NotNil(resp.Error)
+	s.Require().Equal(http.StatusTooManyRequests, resp.Error.Code)
+}
+
+// TestGlobalStreamLimit tests that the subscriptions of the multiplexed route and the streams of the
+// /subscribe_events route count towards the same global stream limit.
+func (s *MultiplexedWSSuite) TestGlobalStreamLimit() {
+	s.TearDownTest()
+	s.startServer(2, 1)
+
+	// a subscription of the multiplexed route uses the only stream, hence /subscribe_events is rejected.
+	s.api.On("SubscribeBlocksFromLatest", mocks.Anything, flow.BlockStatusFinalized).
+		Return(subscription.NewSubscription(1)).Once()
+	s.send(models.WebSocketRequest{
+		Action:    models.SubscribeAction,
+		Topic:     BlocksTopic,
+		Arguments: models.WebSocketArguments{"block_status": "finalized"},
+	})
+	s.Require().Nil(s.receive().Error)
+
+	events := s.dial("/subscribe_events")
+	s.Require().NoError(events.SetReadDeadline(time.Now().Add(5 * time.Second)))
+	_, _, err := events.ReadMessage()
+	s.Require().True(websocket.IsCloseError(err, websocket.CloseTryAgainLater), "unexpected error: %v", err)
+	s.Require().NoError(events.Close())
+
+	// a stream of /subscribe_events uses the only stream, hence the multiplexed subscription is rejected.
+	s.TearDownTest()
+	s.startServer(2, 1)
+
+	subscribed := make(chan struct{})
+	s.stateStreamApi.On("SubscribeEvents", mocks.Anything, mocks.Anything, mocks.Anything, mocks.Anything).
+		Run(func(mocks.Arguments) { close(subscribed) }).
+		Return(subscription.NewSubscription(1)).Once()
+	events = s.dial("/subscribe_events")
+	defer events.Close()
+	unittest.RequireCloseBefore(s.T(), subscribed, time.Second, "events subscription was not opened")
+
+	s.send(models.WebSocketRequest{
+		Action:    models.SubscribeAction,
+		Topic:     BlocksTopic,
+		Arguments: models.WebSocketArguments{"block_status": "finalized"},
+	})
+	resp := s.receive()
+	s.Require().NotNil(resp.Error)
+	s.Require().Equal(http.StatusServiceUnavailable, resp.Error.Code)
+}
+
+// TestStateStreamNotEnabled tests that the multiplexed route is served when execution state streaming is
+// disabled, and that only the topics served by the state stream API are rejected.
+func (s *MultiplexedWSSuite) TestStateStreamNotEnabled() {
+	s.TearDownTest()
+
+	config := backend.Config{
+		EventFilterConfig: state_stream.DefaultEventFilterConfig,
+		MaxGlobalStreams:  subscription.DefaultMaxGlobalStreams,
+		HeartbeatInterval: subscription.DefaultHeartbeatInterval,
+	}
+	router := NewRouterBuilder(unittest.Logger(), metrics.NewNoopCollector()).
+		AddMultiplexedWsRoute(s.api, nil, flow.Testnet.Chain(), config, 0).
+		Build()
+	s.server = httptest.NewServer(router)
+	s.conn = s.dial(MultiplexedWSPattern)
+
+	for _, topic := range []string{EventsTopic, AccountStatusesTopic} {
+		s.send(models.WebSocketRequest{
+			Action: models.SubscribeAction,
+			Topic:  topic,
+		})
+		resp := s.receive()
+		s.Require().NotNil(resp.Error)
+		s.Require().Equal(http.StatusNotImplemented, resp.Error.Code)
+		s.Require().Contains(resp.Error.Message, "not enabled")
+	}
+
+	s.api.On("SubscribeBlocksFromLatest", mocks.Anything, flow.BlockStatusFinalized).
+		Return(subscription.NewSubscription(1)).Once()
+
+	s.send(models.WebSocketRequest{
+		Action:    models.SubscribeAction,
+		Topic:     BlocksTopic,
+		Arguments: models.WebSocketArguments{"block_status": "finalized"},
+	})
+	s.Require().Nil(s.receive().Error)
+}
+
+// TestInvalidRequests tests that invalid requests are rejected without closing the connection.
+func (s *MultiplexedWSSuite) TestInvalidRequests() {
+	tests := []struct {
+		name string
+		req  models.WebSocketRequest
+		code int
+	}{
+		{
+			name: "unknown action",
+			req:  models.WebSocketRequest{Action: "foo"},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "unknown topic",
+			req:  models.WebSocketRequest{Action: models.SubscribeAction, Topic: "foo"},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "missing block status",
+			req:  models.WebSocketRequest{Action: models.SubscribeAction, Topic: BlocksTopic},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "start block ID and height",
+			req: models.WebSocketRequest{
+				Action: models.SubscribeAction,
+				Topic:  BlocksTopic,
+				Arguments: models.WebSocketArguments{
+					"block_status":       "sealed",
+					"start_block_id":     unittest.IdentifierFixture().String(),
+					"start_block_height": 1,
+				},
+			},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "invalid event types",
+			req: models.WebSocketRequest{
+				Action:    models.SubscribeAction,
+				Topic:     EventsTopic,
+				Arguments: models.WebSocketArguments{"event_types": 1},
+			},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "missing transaction ID",
+			req:  models.WebSocketRequest{Action: models.SubscribeAction, Topic: TransactionStatusesTopic},
+			code: http.StatusBadRequest,
+		},
+		{
+			name: "unknown subscription",
+			req:  models.WebSocketRequest{Action: models.UnsubscribeAction, SubscriptionID: "foo"},
+			code: http.StatusNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.send(test.req)
+			resp := s.receive()
+			s.Require().NotNil(resp.Error)
+			s.Require().Equal(test.code, resp.Error.Code)
+		})
+	}
+
+	s.send(models.WebSocketRequest{Action: models.ListSubscriptionsAction})
+	resp := s.receive()
+	s.Require().Nil(resp.Error)
+	s.Require().Empty(resp.Subscriptions)
+}
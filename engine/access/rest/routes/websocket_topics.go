@@ -0,0 +1,476 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/onflow/flow/protobuf/go/flow/entities"
+
+	"github.com/onflow/flow-go/access"
+	"github.com/onflow/flow-go/engine/access/rest/models"
+	"github.com/onflow/flow-go/engine/access/rest/request"
+	"github.com/onflow/flow-go/engine/access/state_stream"
+	"github.com/onflow/flow-go/engine/access/state_stream/backend"
+	"github.com/onflow/flow-go/engine/access/subscription"
+	"github.com/onflow/flow-go/engine/common/rpc/convert"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// Topics which can be subscribed to on the multiplexed WebSocket endpoint.
+const (
+	BlocksTopic              = "blocks"
+	BlockHeadersTopic        = "block_headers"
+	BlockDigestsTopic        = "block_digests"
+	EventsTopic              = "events"
+	AccountStatusesTopic     = "account_statuses"
+	TransactionStatusesTopic = "transaction_statuses"
+)
+
+// Arguments of subscribe requests.
+const (
+	startBlockIdArgument      = "start_block_id"
+	startBlockHeightArgument  = "start_block_height"
+	blockStatusArgument       = "block_status"
+	eventTypesArgument        = "event_types"
+	addressesArgument         = "addresses"
+	contractsArgument         = "contracts"
+	accountAddressesArgument  = "account_addresses"
+	heartbeatIntervalArgument = "heartbeat_interval"
+	txIdArgument              = "tx_id"
+)
+
+// responseConverter converts a response of a subscription into the payload sent to the client.
+// It returns false if nothing should be sent for the response.
+type responseConverter func(response interface{}) (interface{}, bool, error)
+
+// topicSubscribeFunc creates a subscription for a topic using the arguments provided by the client.
+type topicSubscribeFunc func(
+	ctx context.Context,
+	h *MultiplexedWSHandler,
+	args models.WebSocketArguments,
+) (subscription.Subscription, responseConverter, error)
+
+var websocketTopics = map[string]topicSubscribeFunc{
+	BlocksTopic:              subscribeBlocks,
+	BlockHeadersTopic:        subscribeBlockHeaders,
+	BlockDigestsTopic:        subscribeBlockDigests,
+	EventsTopic:              subscribeEventsTopic,
+	AccountStatusesTopic:     subscribeAccountStatuses,
+	TransactionStatusesTopic: subscribeTransactionStatuses,
+}
+
+// subscribeBlocks subscribes to finalized or sealed blocks, including their payloads.
+func subscribeBlocks(
+	ctx context.Context,
+	h *MultiplexedWSHandler,
+	args models.WebSocketArguments,
+) (subscription.Subscription, responseConverter, error) {
+	sub, blockStatus, err := subscribeBlockData(
+		ctx,
+		args,
+		h.api.SubscribeBlocksFromStartBlockID,
+		h.api.SubscribeBlocksFromStartHeight,
+		h.api.SubscribeBlocksFromLatest,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// blocks are always sent with their payload
+	expand := map[string]bool{"payload": true}
+
+	return sub, func(response interface{}) (interface{}, bool, error) {
+		block, ok := response.(*flow.Block)
+		if !ok {
+			return nil, false, fmt.Errorf("unexpected response type: %T", response)
+		}
+
+		var payload models.Block
+		err := payload.Build(block, nil, h.linkGenerator, blockStatus, expand)
+		if err != nil {
+			return nil, false, err
+		}
+		return payload, true, nil
+	}, nil
+}
+
+// subscribeBlockHeaders subscribes to finalized or sealed block headers.
+func subscribeBlockHeaders(
+	ctx context.Context,
+	h *MultiplexedWSHandler,
+	args models.WebSocketArguments,
+) (subscription.Subscription, responseConverter, error) {
+	sub, _, err := subscribeBlockData(
+		ctx,
+		args,
+		h.api.SubscribeBlockHeadersFromStartBlockID,
+		h.api.SubscribeBlockHeadersFromStartHeight,
+		h.api.SubscribeBlockHeadersFromLatest,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sub, func(response interface{}) (interface{}, bool, error) {
+		header, ok := response.(*flow.Header)
+		if !ok {
+			return nil, false, fmt.Errorf("unexpected response type: %T", response)
+		}
+
+		var payload models.BlockHeader
+		payload.Build(header)
+		return payload, true, nil
+	}, nil
+}
+
+// subscribeBlockDigests subscribes to finalized or sealed block digests.
+func subscribeBlockDigests(
+	ctx context.Context,
+	h *MultiplexedWSHandler,
+	args models.WebSocketArguments,
+) (subscription.Subscription, responseConverter, error) {
+	sub, _, err := subscribeBlockData(
+		ctx,
+		args,
+		h.api.SubscribeBlockDigestsFromStartBlockID,
+		h.api.SubscribeBlockDigestsFromStartHeight,
+		h.api.SubscribeBlockDigestsFromLatest,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sub, func(response interface{}) (interface{}, bool, error) {
+		digest, ok := response.(*flow.BlockDigest)
+		if !ok {
+			return nil, false, fmt.Errorf("unexpected response type: %T", response)
+		}
+
+		var payload models.BlockDigest
+		payload.Build(digest)
+		return payload, true, nil
+	}, nil
+}
+
+// subscribeBlockData parses the arguments shared by the block topics, and subscribes using the
+// backend method matching the requested start block.
+func subscribeBlockData(
+	ctx context.Context,
+	args models.WebSocketArguments,
+	fromStartBlockID func(context.Context, flow.Identifier, flow.BlockStatus) subscription.Subscription,
+	fromStartHeight func(context.Context, uint64, flow.BlockStatus) subscription.Subscription,
+	fromLatest func(context.Context, flow.BlockStatus) subscription.Subscription,
+) (subscription.Subscription, flow.BlockStatus, error) {
+	var req request.SubscribeBlocks
+	err := parseArguments(args, func(a *arguments) error {
+		return req.Parse(
+			a.string(startBlockIdArgument),
+			a.string(startBlockHeightArgument),
+			a.string(blockStatusArgument),
+		)
+	})
+	if err != nil {
+		return nil, flow.BlockStatusUnknown, err
+	}
+
+	switch {
+	case req.StartBlockID != flow.ZeroID:
+		return fromStartBlockID(ctx, req.StartBlockID, req.BlockStatus), req.BlockStatus, nil
+	case req.StartHeight != request.EmptyHeight:
+		return fromStartHeight(ctx, req.StartHeight, req.BlockStatus), req.BlockStatus, nil
+	default:
+		return fromLatest(ctx, req.BlockStatus), req.BlockStatus, nil
+	}
+}
+
+// errStateStreamNotEnabled returns the error for subscriptions to a topic served by the state stream API
+// on a node which does not run it.
+func errStateStreamNotEnabled(topic string) error {
+	err := fmt.Errorf("the %s topic is not enabled: execution state streaming is disabled on this node", topic)
+	return models.NewRestError(http.StatusNotImplemented, err.Error(), err)
+}
+
+// subscribeEventsTopic subscribes to events matching the provided filter. Responses are converted to
+// JSON-CDC encoded events, matching the events subscription of the single topic endpoint.
+func subscribeEventsTopic(
+	ctx context.Context,
+	h *MultiplexedWSHandler,
+	args models.WebSocketArguments,
+) (subscription.Subscription, responseConverter, error) {
+	if h.stateStreamApi == nil {
+		return nil, nil, errStateStreamNotEnabled(EventsTopic)
+	}
+
+	var req request.SubscribeEvents
+	var rawStartHeight string
+	err := parseArguments(args, func(a *arguments) error {
+		rawStartHeight = a.string(startBlockHeightArgument)
+		return req.Parse(
+			a.string(startBlockIdArgument),
+			rawStartHeight,
+			a.strings(eventTypesArgument),
+			a.strings(addressesArgument),
+			a.strings(contractsArgument),
+			a.string(heartbeatIntervalArgument),
+		)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	filter, err := state_stream.NewEventFilter(
+		h.eventFilterConfig,
+		h.Chain,
+		req.EventTypes,
+		req.Addresses,
+		req.Contracts,
+	)
+	if err != nil {
+		return nil, nil, models.NewBadRequestError(err)
+	}
+
+	var sub subscription.Subscription
+	switch {
+	case req.StartBlockID != flow.ZeroID:
+		sub = h.stateStreamApi.SubscribeEventsFromStartBlockID(ctx, req.StartBlockID, filter)
+	case rawStartHeight != "":
+		sub = h.stateStreamApi.SubscribeEventsFromStartHeight(ctx, req.StartHeight, filter)
+	default:
+		sub = h.stateStreamApi.SubscribeEventsFromLatest(ctx, filter)
+	}
+
+	heartbeat := newHeartbeat(h.defaultHeartbeatInterval, req.HeartbeatInterval)
+
+	return sub, func(response interface{}) (interface{}, bool, error) {
+		resp, ok := response.(*backend.EventsResponse)
+		if !ok {
+			return nil, false, fmt.Errorf("unexpected response type: %T", response)
+		}
+
+		if !heartbeat.shouldSend(len(resp.Events) == 0) {
+			return nil, false, nil
+		}
+
+		err := convertEventPayloads(resp.Events)
+		if err != nil {
+			return nil, false, err
+		}
+		return resp, true, nil
+	}, nil
+}
+
+// subscribeAccountStatuses subscribes to the account status changes matching the provided filter.
+func subscribeAccountStatuses(
+	ctx context.Context,
+	h *MultiplexedWSHandler,
+	args models.WebSocketArguments,
+) (subscription.Subscription, responseConverter, error) {
+	if h.stateStreamApi == nil {
+		return nil, nil, errStateStreamNotEnabled(AccountStatusesTopic)
+	}
+
+	var req request.SubscribeAccountStatuses
+	err := parseArguments(args, func(a *arguments) error {
+		return req.Parse(
+			a.string(startBlockIdArgument),
+			a.string(startBlockHeightArgument),
+			a.strings(eventTypesArgument),
+			a.strings(accountAddressesArgument),
+			a.string(heartbeatIntervalArgument),
+		)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	filter, err := state_stream.NewAccountStatusFilter(
+		h.eventFilterConfig,
+		h.Chain,
+		req.EventTypes,
+		req.AccountAddresses,
+	)
+	if err != nil {
+		return nil, nil, models.NewBadRequestError(err)
+	}
+
+	var sub subscription.Subscription
+	switch {
+	case req.StartBlockID != flow.ZeroID:
+		sub = h.stateStreamApi.SubscribeAccountStatusesFromStartBlockID(ctx, req.StartBlockID, filter)
+	case req.StartHeight != request.EmptyHeight:
+		sub = h.stateStreamApi.SubscribeAccountStatusesFromStartHeight(ctx, req.StartHeight, filter)
+	default:
+		sub = h.stateStreamApi.SubscribeAccountStatusesFromLatestBlock(ctx, filter)
+	}
+
+	heartbeat := newHeartbeat(h.defaultHeartbeatInterval, req.HeartbeatInterval)
+
+	return sub, func(response interface{}) (interface{}, bool, error) {
+		resp, ok := response.(*backend.AccountStatusesResponse)
+		if !ok {
+			return nil, false, fmt.Errorf("unexpected response type: %T", response)
+		}
+
+		if !heartbeat.shouldSend(len(resp.AccountEvents) == 0) {
+			return nil, false, nil
+		}
+
+		for _, events := range resp.AccountEvents {
+			err := convertEventPayloads(events)
+			if err != nil {
+				return nil, false, err
+			}
+		}
+		return resp, true, nil
+	}, nil
+}
+
+// subscribeTransactionStatuses subscribes to the status changes of a transaction which was already sent
+// to the network. The subscription ends once the transaction is sealed or expired.
+func subscribeTransactionStatuses(
+	ctx context.Context,
+	h *MultiplexedWSHandler,
+	args models.WebSocketArguments,
+) (subscription.Subscription, responseConverter, error) {
+	var req request.SubscribeTransactionStatuses
+	err := parseArguments(args, func(a *arguments) error {
+		return req.Parse(a.string(txIdArgument))
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tx, err := h.api.GetTransaction(ctx, req.TransactionID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sub := h.api.SubscribeTransactionStatuses(ctx, tx, entities.EventEncodingVersion_JSON_CDC_V0)
+
+	return sub, func(response interface{}) (interface{}, bool, error) {
+		results, ok := response.([]*access.TransactionResult)
+		if !ok {
+			return nil, false, fmt.Errorf("unexpected response type: %T", response)
+		}
+
+		payload := make([]models.TransactionResult, len(results))
+		for i, result := range results {
+			payload[i].Build(result, result.TransactionID, h.linkGenerator)
+		}
+		return payload, true, nil
+	}, nil
+}
+
+// convertEventPayloads converts the CCF encoded payloads of the events into JSON-CDC payloads in place.
+func convertEventPayloads(events flow.EventsList) error {
+	for i, e := range events {
+		payload, err := convert.CcfPayloadToJsonPayload(e.Payload)
+		if err != nil {
+			return fmt.Errorf("could not convert event payload from CCF to Json: %w", err)
+		}
+		events[i].Payload = payload
+	}
+	return nil
+}
+
+// heartbeat tracks the number of consecutive empty responses of a subscription, so that an empty
+// response is only sent to the client once every interval blocks.
+type heartbeat struct {
+	interval               uint64
+	blocksSinceLastMessage uint64
+}
+
+func newHeartbeat(defaultInterval uint64, requestedInterval uint64) *heartbeat {
+	interval := defaultInterval
+	if requestedInterval > 0 {
+		interval = requestedInterval
+	}
+	return &heartbeat{interval: interval}
+}
+
+// shouldSend returns true if a response should be sent to the client.
+func (h *heartbeat) shouldSend(empty bool) bool {
+	if empty {
+		h.blocksSinceLastMessage++
+		if h.blocksSinceLastMessage < h.interval {
+			return false
+		}
+	}
+	h.blocksSinceLastMessage = 0
+	return true
+}
+
+// arguments provides typed access to the arguments of a subscribe request. The first invalid argument
+// is recorded and reported by parseArguments.
+type arguments struct {
+	args models.WebSocketArguments
+	err  error
+}
+
+// parseArguments calls parse with the arguments of a subscribe request, and returns a bad request error
+// if any argument had an invalid type, or if parse failed.
+func parseArguments(args models.WebSocketArguments, parse func(a *arguments) error) error {
+	a := &arguments{args: args}
+	err := parse(a)
+	if a.err != nil {
+		return models.NewBadRequestError(a.err)
+	}
+	if err != nil {
+		return models.NewBadRequestError(err)
+	}
+	return nil
+}
+
+// string returns the string value of the named argument, or an empty string if it is not provided.
+// Numbers are accepted and converted to strings.
+func (a *arguments) string(name string) string {
+	value, ok := a.args[name]
+	if !ok || value == nil {
+		return ""
+	}
+
+	switch v := value.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	default:
+		a.setErr(fmt.Errorf("invalid %s argument: must be a string", name))
+		return ""
+	}
+}
+
+// strings returns the string list value of the named argument, or nil if it is not provided.
+func (a *arguments) strings(name string) []string {
+	value, ok := a.args[name]
+	if !ok || value == nil {
+		return nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []interface{}:
+		values := make([]string, len(v))
+		for i, item := range v {
+			s, ok := item.(string)
+			if !ok {
+				a.setErr(fmt.Errorf("invalid %s argument: must be a list of strings", name))
+				return nil
+			}
+			values[i] = s
+		}
+		return values
+	default:
+		a.setErr(fmt.Errorf("invalid %s argument: must be a list of strings", name))
+		return nil
+	}
+}
+
+func (a *arguments) setErr(err error) {
+	if a.err == nil {
+		a.err = err
+	}
+}
@@ -24,6 +24,10 @@ const (
 
 	// DefaultIdleTimeout is the default idle timeout for the HTTP server
 	DefaultIdleTimeout = time.Second * 60
+
+	// DefaultMaxWebSocketSubscriptionsPerConnection is the default maximum number of subscriptions a single
+	// connection to the multiplexed WebSocket endpoint may have open at the same time
+	DefaultMaxWebSocketSubscriptionsPerConnection = 20
 )
 
 type Config struct {
@@ -31,9 +35,15 @@ type Config struct {
 	WriteTimeout  time.Duration
 	ReadTimeout   time.Duration
 	IdleTimeout   time.Duration
+
+	// MaxWebSocketSubscriptionsPerConnection is the maximum number of subscriptions a single connection to
+	// the multiplexed WebSocket endpoint may have open at the same time. 0 means no per connection limit.
+	MaxWebSocketSubscriptionsPerConnection uint64
 }
 
-// NewServer returns an HTTP server initialized with the REST API handler
+// NewServer returns an HTTP server initialized with the REST API handler.
+// stateStreamApi is nil if execution state streaming is disabled. The multiplexed WebSocket route is served
+// regardless, the single topic WebSocket routes are only served if the state stream API is available.
 func NewServer(serverAPI access.API,
 	config Config,
 	logger zerolog.Logger,
@@ -46,6 +56,7 @@ func NewServer(serverAPI access.API,
 	if stateStreamApi != nil {
 		builder.AddWsRoutes(stateStreamApi, chain, stateStreamConfig)
 	}
+	builder.AddMultiplexedWsRoute(serverAPI, stateStreamApi, chain, stateStreamConfig, config.MaxWebSocketSubscriptionsPerConnection)
 
 	c := cors.New(cors.Options{
 		AllowedOrigins: []string{"*"},
@@ -0,0 +1,90 @@
+package rpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/access"
+	"github.com/onflow/flow-go/engine/access/rpc/accounttransactions"
+	"github.com/onflow/flow-go/engine/common/rpc/convert"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+)
+
+// accountTransactionsHandler implements the AccountTransactionsAPI.
+type accountTransactionsHandler struct {
+	accounttransactions.UnimplementedAccountTransactionsAPIServer
+
+	api   access.API
+	chain flow.Chain
+}
+
+var _ accounttransactions.AccountTransactionsAPIServer = (*accountTransactionsHandler)(nil)
+
+// GetTransactionsByAddress returns a page of the transactions the account with the given address was
+// involved in, in execution order.
+func (h *accountTransactionsHandler) GetTransactionsByAddress(
+	ctx context.Context,
+	req *accounttransactions.GetTransactionsByAddressRequest,
+) (*accounttransactions.AccountTransactionsPageResponse, error) {
+	address, err := convert.Address(req.GetAddress(), h.chain)
+	if err != nil {
+		return nil, err
+	}
+
+	// requests must provide a cursor, since there is no default position to start a query at.
+	if req.GetCursor() == nil {
+		return nil, status.Error(codes.InvalidArgument, "cursor is required")
+	}
+	cursor := storage.AccountTransactionCursor{
+		Height:           req.GetCursor().GetHeight(),
+		TransactionIndex: req.GetCursor().GetTransactionIndex(),
+	}
+
+	page, err := h.api.GetTransactionsByAddress(ctx, address, cursor, uint(req.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+
+	return accountTransactionsPageToMessage(page), nil
+}
+
+func accountTransactionsPageToMessage(page *access.AccountTransactionsPage) *accounttransactions.AccountTransactionsPageResponse {
+	transactions := make([]*accounttransactions.AccountTransaction, len(page.Transactions))
+	for i, tx := range page.Transactions {
+		transactions[i] = &accounttransactions.AccountTransaction{
+			Address:          tx.Address.Bytes(),
+			BlockId:          convert.IdentifierToMessage(tx.BlockID),
+			BlockHeight:      tx.BlockHeight,
+			TransactionId:    convert.IdentifierToMessage(tx.TransactionID),
+			TransactionIndex: tx.TransactionIndex,
+			Roles:            accountTransactionRolesToMessage(tx.Roles),
+		}
+	}
+
+	response := &accounttransactions.AccountTransactionsPageResponse{Transactions: transactions}
+	if page.NextCursor != nil {
+		response.NextCursor = &accounttransactions.AccountTransactionCursor{
+			Height:           page.NextCursor.Height,
+			TransactionIndex: page.NextCursor.TransactionIndex,
+		}
+	}
+
+	return response
+}
+
+func accountTransactionRolesToMessage(roles storage.AccountTransactionRoles) []accounttransactions.AccountTransactionRole {
+	messages := make([]accounttransactions.AccountTransactionRole, 0)
+	if roles.Has(storage.AccountTransactionRolePayer) {
+		messages = append(messages, accounttransactions.AccountTransactionRole_ACCOUNT_TRANSACTION_ROLE_PAYER)
+	}
+	if roles.Has(storage.AccountTransactionRoleProposer) {
+		messages = append(messages, accounttransactions.AccountTransactionRole_ACCOUNT_TRANSACTION_ROLE_PROPOSER)
+	}
+	if roles.Has(storage.AccountTransactionRoleAuthorizer) {
+		messages = append(messages, accounttransactions.AccountTransactionRole_ACCOUNT_TRANSACTION_ROLE_AUTHORIZER)
+	}
+	return messages
+}
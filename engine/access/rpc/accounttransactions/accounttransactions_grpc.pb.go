@@ -0,0 +1,110 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: accounttransactions/accounttransactions.proto
+
+package accounttransactions
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// AccountTransactionsAPIClient is the client API for AccountTransactionsAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AccountTransactionsAPIClient interface {
+	// GetTransactionsByAddress returns a page of the transactions the account with the given address was
+	// involved in, in execution order.
+	GetTransactionsByAddress(ctx context.Context, in *GetTransactionsByAddressRequest, opts ...grpc.CallOption) (*AccountTransactionsPageResponse, error)
+}
+
+type accountTransactionsAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAccountTransactionsAPIClient(cc grpc.ClientConnInterface) AccountTransactionsAPIClient {
+	return &accountTransactionsAPIClient{cc}
+}
+
+func (c *accountTransactionsAPIClient) GetTransactionsByAddress(ctx context.Context, in *GetTransactionsByAddressRequest, opts ...grpc.CallOption) (*AccountTransactionsPageResponse, error) {
+	out := new(AccountTransactionsPageResponse)
+	err := c.cc.Invoke(ctx, "/flow.access.accounttransactions.AccountTransactionsAPI/GetTransactionsByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AccountTransactionsAPIServer is the server API for AccountTransactionsAPI service.
+// All implementations must embed UnimplementedAccountTransactionsAPIServer
+// for forward compatibility
+type AccountTransactionsAPIServer interface {
+	// GetTransactionsByAddress returns a page of the transactions the account with the given address was
+	// involved in, in execution order.
+	GetTransactionsByAddress(context.Context, *GetTransactionsByAddressRequest) (*AccountTransactionsPageResponse, error)
+	mustEmbedUnimplementedAccountTransactionsAPIServer()
+}
+
+// UnimplementedAccountTransactionsAPIServer must be embedded to have forward compatible implementations.
+type UnimplementedAccountTransactionsAPIServer struct {
+}
+
+func (UnimplementedAccountTransactionsAPIServer) GetTransactionsByAddress(context.Context, *GetTransactionsByAddressRequest) (*AccountTransactionsPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionsByAddress not implemented")
+}
+func (UnimplementedAccountTransactionsAPIServer) mustEmbedUnimplementedAccountTransactionsAPIServer() {
+}
+
+// UnsafeAccountTransactionsAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AccountTransactionsAPIServer will
+// result in compilation errors.
+type UnsafeAccountTransactionsAPIServer interface {
+	mustEmbedUnimplementedAccountTransactionsAPIServer()
+}
+
+func RegisterAccountTransactionsAPIServer(s grpc.ServiceRegistrar, srv AccountTransactionsAPIServer) {
+	s.RegisterService(&AccountTransactionsAPI_ServiceDesc, srv)
+}
+
+func _AccountTransactionsAPI_GetTransactionsByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionsByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountTransactionsAPIServer).GetTransactionsByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flow.access.accounttransactions.AccountTransactionsAPI/GetTransactionsByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountTransactionsAPIServer).GetTransactionsByAddress(ctx, req.(*GetTransactionsByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AccountTransactionsAPI_ServiceDesc is the grpc.ServiceDesc for AccountTransactionsAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AccountTransactionsAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "flow.access.accounttransactions.AccountTransactionsAPI",
+	HandlerType: (*AccountTransactionsAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTransactionsByAddress",
+			Handler:    _AccountTransactionsAPI_GetTransactionsByAddress_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "accounttransactions/accounttransactions.proto",
+}
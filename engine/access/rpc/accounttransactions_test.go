@@ -0,0 +1,122 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/access"
+	accessmock "github.com/onflow/flow-go/access/mock"
+	"github.com/onflow/flow-go/engine/access/rpc/accounttransactions"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestAccountTransactions tests that account transaction queries are forwarded to the access API, and that
+// the pages are converted with their next cursor.
+func TestAccountTransactions(t *testing.T) {
+	chain := flow.Testnet.Chain()
+	address := unittest.AddressFixture()
+	ctx := context.Background()
+
+	header := unittest.BlockHeaderFixture()
+	tx := storage.AccountTransaction{
+		Address:          address,
+		BlockID:          header.ID(),
+		BlockHeight:      header.Height,
+		TransactionID:    unittest.IdentifierFixture(),
+		TransactionIndex: 1,
+		Roles:            storage.AccountTransactionRolePayer | storage.AccountTransactionRoleAuthorizer,
+	}
+	page := &access.AccountTransactionsPage{
+		Transactions: []storage.AccountTransaction{tx},
+		NextCursor:   &storage.AccountTransactionCursor{Height: header.Height + 1, TransactionIndex: 2},
+	}
+	cursor := storage.AccountTransactionCursor{Height: header.Height}
+	cursorMsg := &accounttransactions.AccountTransactionCursor{Height: header.Height}
+
+	t.Run("transactions by address", func(t *testing.T) {
+		api := accessmock.NewAPI(t)
+		h := &accountTransactionsHandler{api: api, chain: chain}
+
+		api.On("GetTransactionsByAddress", ctx, address, cursor, uint(10)).Return(page, nil)
+
+		resp, err := h.GetTransactionsByAddress(ctx, &accounttransactions.GetTransactionsByAddressRequest{
+			Address: address.Bytes(),
+			Cursor:  cursorMsg,
+			Limit:   10,
+		})
+		require.NoError(t, err)
+
+		require.Len(t, resp.GetTransactions(), 1)
+		actual := resp.GetTransactions()[0]
+		require.Equal(t, address.Bytes(), actual.GetAddress())
+		require.Equal(t, tx.BlockID[:], actual.GetBlockId())
+		require.Equal(t, tx.BlockHeight, actual.GetBlockHeight())
+		require.Equal(t, tx.TransactionID[:], actual.GetTransactionId())
+		require.Equal(t, tx.TransactionIndex, actual.GetTransactionIndex())
+		require.Equal(t, []accounttransactions.AccountTransactionRole{
+			accounttransactions.AccountTransactionRole_ACCOUNT_TRANSACTION_ROLE_PAYER,
+			accounttransactions.AccountTransactionRole_ACCOUNT_TRANSACTION_ROLE_AUTHORIZER,
+		}, actual.GetRoles())
+
+		require.Equal(t, page.NextCursor.Height, resp.GetNextCursor().GetHeight())
+		require.Equal(t, page.NextCursor.TransactionIndex, resp.GetNextCursor().GetTransactionIndex())
+	})
+
+	t.Run("last page has no next cursor", func(t *testing.T) {
+		api := accessmock.NewAPI(t)
+		h := &accountTransactionsHandler{api: api, chain: chain}
+
+		api.On("GetTransactionsByAddress", ctx, address, cursor, uint(10)).
+			Return(&access.AccountTransactionsPage{Transactions: page.Transactions}, nil)
+
+		resp, err := h.GetTransactionsByAddress(ctx, &accounttransactions.GetTransactionsByAddressRequest{
+			Address: address.Bytes(),
+			Cursor:  cursorMsg,
+			Limit:   10,
+		})
+		require.NoError(t, err)
+		require.Len(t, resp.GetTransactions(), 1)
+		require.Nil(t, resp.GetNextCursor())
+	})
+
+	t.Run("backend error", func(t *testing.T) {
+		api := accessmock.NewAPI(t)
+		h := &accountTransactionsHandler{api: api, chain: chain}
+
+		expected := status.Error(codes.OutOfRange, "block not indexed yet")
+		api.On("GetTransactionsByAddress", ctx, address, cursor, uint(10)).Return(nil, expected)
+
+		_, err := h.GetTransactionsByAddress(ctx, &accounttransactions.GetTransactionsByAddressRequest{
+			Address: address.Bytes(),
+			Cursor:  cursorMsg,
+			Limit:   10,
+		})
+		require.Equal(t, codes.OutOfRange, status.Code(err))
+	})
+
+	t.Run("missing cursor", func(t *testing.T) {
+		h := &accountTransactionsHandler{api: accessmock.NewAPI(t), chain: chain}
+
+		_, err := h.GetTransactionsByAddress(ctx, &accounttransactions.GetTransactionsByAddressRequest{
+			Address: address.Bytes(),
+			Limit:   10,
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("invalid address", func(t *testing.T) {
+		h := &accountTransactionsHandler{api: accessmock.NewAPI(t), chain: chain}
+
+		_, err := h.GetTransactionsByAddress(ctx, &accounttransactions.GetTransactionsByAddressRequest{
+			Cursor: cursorMsg,
+			Limit:  10,
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
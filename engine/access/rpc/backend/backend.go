@@ -22,6 +22,7 @@ import (
 	"github.com/onflow/flow-go/module"
 	"github.com/onflow/flow-go/module/counters"
 	"github.com/onflow/flow-go/module/execution"
+	"github.com/onflow/flow-go/module/state_synchronization"
 	"github.com/onflow/flow-go/state/protocol"
 	"github.com/onflow/flow-go/storage"
 )
@@ -35,6 +36,12 @@ const maxAttemptsForExecutionReceipt = 3
 // DefaultMaxHeightRange is the default maximum size of range requests.
 const DefaultMaxHeightRange = 250
 
+// MaxEventsPageSize is the maximum number of events returned by a single event index query.
+const MaxEventsPageSize = 1000
+
+// MaxAccountTransactionsPageSize is the maximum number of transactions returned by a single account transactions query.
+const MaxAccountTransactionsPageSize = 1000
+
 // DefaultSnapshotHistoryLimit the amount of blocks to look back in state
 // when recursively searching for a valid snapshot
 const DefaultSnapshotHistoryLimit = 500
@@ -115,9 +122,14 @@ type Params struct {
 	SubscriptionHandler       *subscription.SubscriptionHandler
 
 	EventsIndex         *index.EventsIndex
+	EventLookupIndex    *index.EventLookupIndex
 	TxResultQueryMode   IndexQueryMode
 	TxResultsIndex      *index.TransactionResultsIndex
+	AccountTxsIndex     *index.AccountTransactionsIndex
 	LastFullBlockHeight *counters.PersistentStrictMonotonicCounter
+	IndexReporter       state_synchronization.IndexReporter
+
+	CheckPayerBalanceMode access.PayerBalanceMode
 }
 
 var _ TransactionErrorMessage = (*Backend)(nil)
@@ -174,6 +186,19 @@ func New(params Params) (*Backend, error) {
 		lastFullBlockHeight: params.LastFullBlockHeight,
 	}
 
+	txValidator, err := configureTransactionValidator(
+		params.Log,
+		params.State,
+		params.ChainID,
+		params.AccessMetrics,
+		params.IndexReporter,
+		params.ScriptExecutor,
+		params.CheckPayerBalanceMode,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not create transaction validator: %w", err)
+	}
+
 	b := &Backend{
 		state:        params.State,
 		BlockTracker: params.BlockTracker,
@@ -201,6 +226,7 @@ func New(params Params) (*Backend, error) {
 			nodeCommunicator:  params.Communicator,
 			queryMode:         params.EventQueryMode,
 			eventsIndex:       params.EventsIndex,
+			eventLookupIndex:  params.EventLookupIndex,
 		},
 		backendBlockHeaders: backendBlockHeaders{
 			headers: params.Headers,
@@ -252,7 +278,7 @@ func New(params Params) (*Backend, error) {
 		chainID:                       params.ChainID,
 		transactions:                  params.Transactions,
 		executionReceipts:             params.ExecutionReceipts,
-		transactionValidator:          configureTransactionValidator(params.State, params.ChainID),
+		transactionValidator:          txValidator,
 		transactionMetrics:            params.AccessMetrics,
 		retry:                         retry,
 		connFactory:                   params.ConnFactory,
@@ -261,6 +287,7 @@ func New(params Params) (*Backend, error) {
 		txResultCache:                 txResCache,
 		txErrorMessagesCache:          txErrorMessagesCache,
 		txResultQueryMode:             params.TxResultQueryMode,
+		accountTxsIndex:               params.AccountTxsIndex,
 		systemTx:                      systemTx,
 		systemTxID:                    systemTxID,
 	}
@@ -304,10 +331,20 @@ func identifierList(ids []string) (flow.IdentifierList, error) {
 	return idList, nil
 }
 
-func configureTransactionValidator(state protocol.State, chainID flow.ChainID) *access.TransactionValidator {
+func configureTransactionValidator(
+	log zerolog.Logger,
+	state protocol.State,
+	chainID flow.ChainID,
+	transactionValidationMetrics module.TransactionValidationMetrics,
+	indexReporter state_synchronization.IndexReporter,
+	executor execution.ScriptExecutor,
+	checkPayerBalanceMode access.PayerBalanceMode,
+) (*access.TransactionValidator, error) {
 	return access.NewTransactionValidator(
-		access.NewProtocolStateBlocks(state),
+		log,
+		access.NewProtocolStateBlocks(state, indexReporter),
 		chainID.Chain(),
+		transactionValidationMetrics,
 		access.TransactionValidationOptions{
 			Expiry:                       flow.DefaultTransactionExpiry,
 			ExpiryBuffer:                 flow.DefaultTransactionExpiryBuffer,
@@ -317,7 +354,9 @@ func configureTransactionValidator(state protocol.State, chainID flow.ChainID) *
 			MaxGasLimit:                  flow.DefaultMaxTransactionGasLimit,
 			MaxTransactionByteSize:       flow.DefaultMaxTransactionByteSize,
 			MaxCollectionByteSize:        flow.DefaultMaxCollectionByteSize,
+			CheckPayerBalanceMode:        checkPayerBalanceMode,
 		},
+		executor,
 	)
 }
 
@@ -6,6 +6,8 @@ import (
 	"errors"
 	"time"
 
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
 	"github.com/rs/zerolog"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -23,6 +25,14 @@ import (
 	"github.com/onflow/flow-go/storage"
 )
 
+// accountAvailableBalanceScript is executed on execution nodes to get the available balance of an account,
+// as execution nodes do not expose the available balance through a dedicated API.
+var accountAvailableBalanceScript = []byte(`
+access(all) fun main(address: Address): UFix64 {
+	return getAccount(address).availableBalance
+}
+`)
+
 type backendAccounts struct {
 	log               zerolog.Logger
 	state             protocol.State
@@ -80,6 +90,88 @@ func (b *backendAccounts) GetAccountAtBlockHeight(
 	return account, nil
 }
 
+// GetAccountBalanceAtLatestBlock returns the account balance at the latest sealed block.
+func (b *backendAccounts) GetAccountBalanceAtLatestBlock(ctx context.Context, address flow.Address) (uint64, error) {
+	sealed, err := b.state.Sealed().Head()
+	if err != nil {
+		err := irrecoverable.NewExceptionf("failed to lookup sealed header: %w", err)
+		irrecoverable.Throw(ctx, err)
+		return 0, err
+	}
+
+	sealedBlockID := sealed.ID()
+
+	balance, err := b.getAccountBalanceAtBlock(ctx, address, sealedBlockID, sealed.Height)
+	if err != nil {
+		b.log.Debug().Err(err).Msgf("failed to get account balance at blockID: %v", sealedBlockID)
+		return 0, err
+	}
+
+	return balance, nil
+}
+
+// GetAccountBalanceAtBlockHeight returns the account balance at the given block height
+func (b *backendAccounts) GetAccountBalanceAtBlockHeight(
+	ctx context.Context,
+	address flow.Address,
+	height uint64,
+) (uint64, error) {
+	blockID, err := b.headers.BlockIDByHeight(height)
+	if err != nil {
+		return 0, rpc.ConvertStorageError(err)
+	}
+
+	balance, err := b.getAccountBalanceAtBlock(ctx, address, blockID, height)
+	if err != nil {
+		b.log.Debug().Err(err).Msgf("failed to get account balance at height: %d", height)
+		return 0, err
+	}
+
+	return balance, nil
+}
+
+// GetAccountAvailableBalanceAtLatestBlock returns the account available balance at the latest sealed block.
+// The available balance is the part of the account balance which is not reserved for storage.
+func (b *backendAccounts) GetAccountAvailableBalanceAtLatestBlock(ctx context.Context, address flow.Address) (uint64, error) {
+	sealed, err := b.state.Sealed().Head()
+	if err != nil {
+		err := irrecoverable.NewExceptionf("failed to lookup sealed header: %w", err)
+		irrecoverable.Throw(ctx, err)
+		return 0, err
+	}
+
+	sealedBlockID := sealed.ID()
+
+	balance, err := b.getAccountAvailableBalanceAtBlock(ctx, address, sealedBlockID, sealed.Height)
+	if err != nil {
+		b.log.Debug().Err(err).Msgf("failed to get account available balance at blockID: %v", sealedBlockID)
+		return 0, err
+	}
+
+	return balance, nil
+}
+
+// GetAccountAvailableBalanceAtBlockHeight returns the account available balance at the given block height.
+// The available balance is the part of the account balance which is not reserved for storage.
+func (b *backendAccounts) GetAccountAvailableBalanceAtBlockHeight(
+	ctx context.Context,
+	address flow.Address,
+	height uint64,
+) (uint64, error) {
+	blockID, err := b.headers.BlockIDByHeight(height)
+	if err != nil {
+		return 0, rpc.ConvertStorageError(err)
+	}
+
+	balance, err := b.getAccountAvailableBalanceAtBlock(ctx, address, blockID, height)
+	if err != nil {
+		b.log.Debug().Err(err).Msgf("failed to get account available balance at height: %d", height)
+		return 0, err
+	}
+
+	return balance, nil
+}
+
 // getAccountAtBlock returns the account details at the given block
 //
 // The data may be sourced from the local storage or from an execution node depending on the nodes's
@@ -126,6 +218,109 @@ func (b *backendAccounts) getAccountAtBlock(
 	}
 }
 
+// getAccountBalanceAtBlock returns the account balance at the given block
+//
+// The data may be sourced from the local storage or from an execution node depending on the nodes's
+// configuration and the availability of the data.
+func (b *backendAccounts) getAccountBalanceAtBlock(
+	ctx context.Context,
+	address flow.Address,
+	blockID flow.Identifier,
+	height uint64,
+) (uint64, error) {
+	return b.getBalanceAtBlock(
+		ctx,
+		address,
+		blockID,
+		func() (uint64, error) {
+			balance, err := b.scriptExecutor.GetAccountBalance(ctx, address, height)
+			if err != nil {
+				return 0, convertAccountError(err, address, height)
+			}
+			return balance, nil
+		},
+		func() (uint64, error) {
+			account, err := b.getAccountFromAnyExeNode(ctx, address, blockID)
+			if err != nil {
+				return 0, err
+			}
+			return account.Balance, nil
+		},
+	)
+}
+
+// getAccountAvailableBalanceAtBlock returns the account available balance at the given block
+//
+// The data may be sourced from the local storage or from an execution node depending on the nodes's
+// configuration and the availability of the data.
+func (b *backendAccounts) getAccountAvailableBalanceAtBlock(
+	ctx context.Context,
+	address flow.Address,
+	blockID flow.Identifier,
+	height uint64,
+) (uint64, error) {
+	return b.getBalanceAtBlock(
+		ctx,
+		address,
+		blockID,
+		func() (uint64, error) {
+			balance, err := b.scriptExecutor.GetAccountAvailableBalance(ctx, address, height)
+			if err != nil {
+				return 0, convertAccountError(err, address, height)
+			}
+			return balance, nil
+		},
+		func() (uint64, error) {
+			return b.getAccountAvailableBalanceFromAnyExeNode(ctx, address, blockID)
+		},
+	)
+}
+
+// getBalanceAtBlock returns a balance using either the local lookup or the execution node lookup,
+// depending on the configured script execution mode.
+func (b *backendAccounts) getBalanceAtBlock(
+	ctx context.Context,
+	address flow.Address,
+	blockID flow.Identifier,
+	getFromLocalStorage func() (uint64, error),
+	getFromAnyExeNode func() (uint64, error),
+) (uint64, error) {
+	switch b.scriptExecMode {
+	case IndexQueryModeExecutionNodesOnly:
+		return getFromAnyExeNode()
+
+	case IndexQueryModeLocalOnly:
+		return getFromLocalStorage()
+
+	case IndexQueryModeFailover:
+		localResult, localErr := getFromLocalStorage()
+		if localErr == nil {
+			return localResult, nil
+		}
+		execResult, execErr := getFromAnyExeNode()
+
+		b.compareBalanceResults(execResult, execErr, localResult, localErr, blockID, address)
+
+		return execResult, execErr
+
+	case IndexQueryModeCompare:
+		execResult, execErr := getFromAnyExeNode()
+		// Only compare actual get account errors from the EN, not system errors
+		if execErr != nil && !isInvalidArgumentError(execErr) {
+			return 0, execErr
+		}
+		localResult, localErr := getFromLocalStorage()
+
+		b.compareBalanceResults(execResult, execErr, localResult, localErr, blockID, address)
+
+		// always return EN results
+		return execResult, execErr
+
+	default:
+		return 0, status.Errorf(codes.Internal, "unknown execution mode: %v", b.scriptExecMode)
+	}
+}
+
 // getAccountFromLocalStorage retrieves the given account from the local storage.
 func (b *backendAccounts) getAccountFromLocalStorage(
 	ctx context.Context,
@@ -200,6 +395,90 @@ func (b *backendAccounts) getAccountFromAnyExeNode(
 	return account, nil
 }
 
+// getAccountAvailableBalanceFromAnyExeNode retrieves the available balance of the given account
+// from any EN which executed the block, by executing a script which reads the available balance.
+func (b *backendAccounts) getAccountAvailableBalanceFromAnyExeNode(
+	ctx context.Context,
+	address flow.Address,
+	blockID flow.Identifier,
+) (uint64, error) {
+	encodedAddress, err := jsoncdc.Encode(cadence.NewAddress(address))
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to encode address argument: %v", err)
+	}
+
+	req := &execproto.ExecuteScriptAtBlockIDRequest{
+		BlockId:   blockID[:],
+		Script:    accountAvailableBalanceScript,
+		Arguments: [][]byte{encodedAddress},
+	}
+
+	execNodes, err := executionNodesForBlockID(ctx, blockID, b.executionReceipts, b.state, b.log)
+	if err != nil {
+		return 0, rpc.ConvertError(err, "failed to find execution node to query", codes.Internal)
+	}
+
+	var resp *execproto.ExecuteScriptAtBlockIDResponse
+	errToReturn := b.nodeCommunicator.CallAvailableNode(
+		execNodes,
+		func(node *flow.IdentitySkeleton) error {
+			var err error
+			start := time.Now()
+
+			resp, err = b.tryGetAccountAvailableBalance(ctx, node, req)
+			duration := time.Since(start)
+
+			lg := b.log.With().
+				Str("execution_node", node.String()).
+				Hex("block_id", req.GetBlockId()).
+				Str("address", address.String()).
+				Int64("rtt_ms", duration.Milliseconds()).
+				Logger()
+
+			if err != nil {
+				lg.Err(err).Msg("failed to get account available balance")
+				return err
+			}
+
+			// return if any execution node replied successfully
+			lg.Debug().Msg("Successfully got account available balance")
+			return nil
+		},
+		nil,
+	)
+
+	if errToReturn != nil {
+		return 0, rpc.ConvertError(errToReturn, "failed to get account available balance from the execution node", codes.Internal)
+	}
+
+	value, err := jsoncdc.Decode(nil, resp.GetValue())
+	if err != nil {
+		return 0, status.Errorf(codes.Internal, "failed to decode account available balance: %v", err)
+	}
+
+	balance, ok := value.(cadence.UFix64)
+	if !ok {
+		return 0, status.Errorf(codes.Internal, "unexpected account available balance type: %T", value)
+	}
+
+	return uint64(balance), nil
+}
+
+// tryGetAccountAvailableBalance attempts to get the account available balance from the given execution node.
+func (b *backendAccounts) tryGetAccountAvailableBalance(
+	ctx context.Context,
+	execNode *flow.IdentitySkeleton,
+	req *execproto.ExecuteScriptAtBlockIDRequest,
+) (*execproto.ExecuteScriptAtBlockIDResponse, error) {
+	execRPCClient, closer, err := b.connFactory.GetExecutionAPIClient(execNode.Address)
+	if err != nil {
+		return nil, err
+	}
+	defer closer.Close()
+
+	return execRPCClient.ExecuteScriptAtBlockID(ctx, req)
+}
+
 // tryGetAccount attempts to get the account from the given execution node.
 func (b *backendAccounts) tryGetAccount(
 	ctx context.Context,
@@ -254,6 +533,45 @@ func (b *backendAccounts) compareAccountResults(
 	}
 }
 
+// compareBalanceResults compares the result and error returned from local and remote balance lookups
+// and logs the results if they are different
+func (b *backendAccounts) compareBalanceResults(
+	execNodeResult uint64,
+	execErr error,
+	localResult uint64,
+	localErr error,
+	blockID flow.Identifier,
+	address flow.Address,
+) {
+	if b.log.GetLevel() > zerolog.DebugLevel {
+		return
+	}
+
+	lgCtx := b.log.With().
+		Hex("block_id", blockID[:]).
+		Str("address", address.String())
+
+	// errors are different
+	if execErr != localErr {
+		lgCtx = lgCtx.
+			AnErr("execution_node_error", execErr).
+			AnErr("local_error", localErr)
+
+		lg := lgCtx.Logger()
+		lg.Debug().Msg("errors from getting account balance on local and EN do not match")
+		return
+	}
+
+	// both errors are nil, compare the balances
+	if execErr == nil && execNodeResult != localResult {
+		lg := lgCtx.
+			Uint64("exec_node_balance", execNodeResult).
+			Uint64("local_balance", localResult).
+			Logger()
+		lg.Debug().Msg("account balances from local and EN do not match")
+	}
+}
+
 // compareAccountsLogger compares accounts produced by the execution node and local storage and
 // return a logger configured to log the differences
 func compareAccountsLogger(exec, local *flow.Account, lgCtx zerolog.Context) (zerolog.Context, bool) {
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
@@ -119,6 +121,27 @@ func (s *BackendAccountsSuite) setupENSuccessResponse(blockID flow.Identifier) {
 		}, nil)
 }
 
+// setupENAvailableBalanceResponse configures the execution node client to return the given available
+// balance when executing the available balance script
+func (s *BackendAccountsSuite) setupENAvailableBalanceResponse(blockID flow.Identifier, availableBalance uint64) {
+	encodedAddress, err := jsoncdc.Encode(cadence.NewAddress(s.account.Address))
+	s.Require().NoError(err)
+
+	expectedExecRequest := &execproto.ExecuteScriptAtBlockIDRequest{
+		BlockId:   blockID[:],
+		Script:    accountAvailableBalanceScript,
+		Arguments: [][]byte{encodedAddress},
+	}
+
+	encodedBalance, err := jsoncdc.Encode(cadence.UFix64(availableBalance))
+	s.Require().NoError(err)
+
+	s.execClient.On("ExecuteScriptAtBlockID", mock.Anything, expectedExecRequest).
+		Return(&execproto.ExecuteScriptAtBlockIDResponse{
+			Value: encodedBalance,
+		}, nil)
+}
+
 // setupENFailingResponse configures the execution node client to return an error
 func (s *BackendAccountsSuite) setupENFailingResponse(blockID flow.Identifier, err error) {
 	failingRequest := &execproto.GetAccountAtBlockIDRequest{
@@ -341,6 +364,157 @@ func (s *BackendAccountsSuite) TestGetAccountAtLatestBlockFromStorage_Inconsiste
 	})
 }
 
+// TestGetAccountBalanceFromExecutionNode_HappyPath tests successfully getting account balances from execution nodes
+func (s *BackendAccountsSuite) TestGetAccountBalanceFromExecutionNode_HappyPath() {
+	ctx := context.Background()
+
+	s.setupExecutionNodes(s.block)
+	s.setupENSuccessResponse(s.block.ID())
+	s.setupENAvailableBalanceResponse(s.block.ID(), s.account.Balance-1)
+
+	backend := s.defaultBackend()
+	backend.scriptExecMode = IndexQueryModeExecutionNodesOnly
+
+	s.Run("GetAccountBalanceAtLatestBlock - happy path", func() {
+		s.testGetAccountBalanceAtLatestBlock(ctx, backend, s.account.Balance)
+	})
+
+	s.Run("GetAccountBalanceAtBlockHeight - happy path", func() {
+		s.testGetAccountBalanceAtBlockHeight(ctx, backend, s.account.Balance)
+	})
+
+	s.Run("GetAccountAvailableBalanceAtLatestBlock - happy path", func() {
+		s.testGetAccountAvailableBalanceAtLatestBlock(ctx, backend, s.account.Balance-1)
+	})
+
+	s.Run("GetAccountAvailableBalanceAtBlockHeight - happy path", func() {
+		s.testGetAccountAvailableBalanceAtBlockHeight(ctx, backend, s.account.Balance-1)
+	})
+}
+
+// TestGetAccountBalanceFromStorage_HappyPath tests successfully getting account balances from local storage
+func (s *BackendAccountsSuite) TestGetAccountBalanceFromStorage_HappyPath() {
+	ctx := context.Background()
+
+	scriptExecutor := execmock.NewScriptExecutor(s.T())
+	scriptExecutor.On("GetAccountBalance", mock.Anything, s.account.Address, s.block.Header.Height).
+		Return(s.account.Balance, nil)
+	scriptExecutor.On("GetAccountAvailableBalance", mock.Anything, s.account.Address, s.block.Header.Height).
+		Return(s.account.Balance-1, nil)
+
+	backend := s.defaultBackend()
+	backend.scriptExecMode = IndexQueryModeLocalOnly
+	backend.scriptExecutor = scriptExecutor
+
+	s.Run("GetAccountBalanceAtLatestBlock - happy path", func() {
+		s.testGetAccountBalanceAtLatestBlock(ctx, backend, s.account.Balance)
+	})
+
+	s.Run("GetAccountBalanceAtBlockHeight - happy path", func() {
+		s.testGetAccountBalanceAtBlockHeight(ctx, backend, s.account.Balance)
+	})
+
+	s.Run("GetAccountAvailableBalanceAtLatestBlock - happy path", func() {
+		s.testGetAccountAvailableBalanceAtLatestBlock(ctx, backend, s.account.Balance-1)
+	})
+
+	s.Run("GetAccountAvailableBalanceAtBlockHeight - happy path", func() {
+		s.testGetAccountAvailableBalanceAtBlockHeight(ctx, backend, s.account.Balance-1)
+	})
+}
+
+// TestGetAccountBalanceFromStorage_Fails tests that errors received from local storage are handled
+// and converted to the appropriate status code
+func (s *BackendAccountsSuite) TestGetAccountBalanceFromStorage_Fails() {
+	ctx := context.Background()
+
+	scriptExecutor := execmock.NewScriptExecutor(s.T())
+
+	backend := s.defaultBackend()
+	backend.scriptExecMode = IndexQueryModeLocalOnly
+	backend.scriptExecutor = scriptExecutor
+
+	testCases := []struct {
+		err        error
+		statusCode codes.Code
+	}{
+		{
+			err:        storage.ErrHeightNotIndexed,
+			statusCode: codes.OutOfRange,
+		},
+		{
+			err:        storage.ErrNotFound,
+			statusCode: codes.NotFound,
+		},
+		{
+			err:        fmt.Errorf("system error"),
+			statusCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range testCases {
+		scriptExecutor.On("GetAccountBalance", mock.Anything, s.failingAddress, s.block.Header.Height).
+			Return(uint64(0), tt.err).Once()
+		scriptExecutor.On("GetAccountAvailableBalance", mock.Anything, s.failingAddress, s.block.Header.Height).
+			Return(uint64(0), tt.err).Once()
+
+		s.Run(fmt.Sprintf("GetAccountBalanceAtBlockHeight - fails with %v", tt.err), func() {
+			s.headers.On("BlockIDByHeight", s.block.Header.Height).Return(s.block.ID(), nil).Once()
+
+			_, err := backend.GetAccountBalanceAtBlockHeight(ctx, s.failingAddress, s.block.Header.Height)
+			s.Require().Error(err)
+			s.Require().Equal(tt.statusCode, status.Code(err))
+		})
+
+		s.Run(fmt.Sprintf("GetAccountAvailableBalanceAtBlockHeight - fails with %v", tt.err), func() {
+			s.headers.On("BlockIDByHeight", s.block.Header.Height).Return(s.block.ID(), nil).Once()
+
+			_, err := backend.GetAccountAvailableBalanceAtBlockHeight(ctx, s.failingAddress, s.block.Header.Height)
+			s.Require().Error(err)
+			s.Require().Equal(tt.statusCode, status.Code(err))
+		})
+	}
+}
+
+// TestGetAccountBalanceFromFailover_HappyPath tests that when an error is returned getting an account balance
+// from local storage, the backend will attempt to get the balance from an execution node
+func (s *BackendAccountsSuite) TestGetAccountBalanceFromFailover_HappyPath() {
+	ctx := context.Background()
+
+	s.setupExecutionNodes(s.block)
+	s.setupENSuccessResponse(s.block.ID())
+	s.setupENAvailableBalanceResponse(s.block.ID(), s.account.Balance-1)
+
+	scriptExecutor := execmock.NewScriptExecutor(s.T())
+
+	backend := s.defaultBackend()
+	backend.scriptExecMode = IndexQueryModeFailover
+	backend.scriptExecutor = scriptExecutor
+
+	for _, errToReturn := range []error{storage.ErrHeightNotIndexed, storage.ErrNotFound} {
+		scriptExecutor.On("GetAccountBalance", mock.Anything, s.account.Address, s.block.Header.Height).
+			Return(uint64(0), errToReturn).Times(2)
+		scriptExecutor.On("GetAccountAvailableBalance", mock.Anything, s.account.Address, s.block.Header.Height).
+			Return(uint64(0), errToReturn).Times(2)
+
+		s.Run(fmt.Sprintf("GetAccountBalanceAtLatestBlock - happy path - recovers %v", errToReturn), func() {
+			s.testGetAccountBalanceAtLatestBlock(ctx, backend, s.account.Balance)
+		})
+
+		s.Run(fmt.Sprintf("GetAccountBalanceAtBlockHeight - happy path - recovers %v", errToReturn), func() {
+			s.testGetAccountBalanceAtBlockHeight(ctx, backend, s.account.Balance)
+		})
+
+		s.Run(fmt.Sprintf("GetAccountAvailableBalanceAtLatestBlock - happy path - recovers %v", errToReturn), func() {
+			s.testGetAccountAvailableBalanceAtLatestBlock(ctx, backend, s.account.Balance-1)
+		})
+
+		s.Run(fmt.Sprintf("GetAccountAvailableBalanceAtBlockHeight - happy path - recovers %v", errToReturn), func() {
+			s.testGetAccountAvailableBalanceAtBlockHeight(ctx, backend, s.account.Balance-1)
+		})
+	}
+}
+
 func (s *BackendAccountsSuite) testGetAccount(ctx context.Context, backend *backendAccounts, statusCode codes.Code) {
 	s.state.On("Sealed").Return(s.snapshot, nil).Once()
 	s.snapshot.On("Head").Return(s.block.Header, nil).Once()
@@ -388,3 +562,39 @@ func (s *BackendAccountsSuite) testGetAccountAtBlockHeight(ctx context.Context,
 		s.Require().Nil(actual)
 	}
 }
+
+func (s *BackendAccountsSuite) testGetAccountBalanceAtLatestBlock(ctx context.Context, backend *backendAccounts, expected uint64) {
+	s.state.On("Sealed").Return(s.snapshot, nil).Once()
+	s.snapshot.On("Head").Return(s.block.Header, nil).Once()
+
+	actual, err := backend.GetAccountBalanceAtLatestBlock(ctx, s.account.Address)
+	s.Require().NoError(err)
+	s.Require().Equal(expected, actual)
+}
+
+func (s *BackendAccountsSuite) testGetAccountBalanceAtBlockHeight(ctx context.Context, backend *backendAccounts, expected uint64) {
+	height := s.block.Header.Height
+	s.headers.On("BlockIDByHeight", height).Return(s.block.Header.ID(), nil).Once()
+
+	actual, err := backend.GetAccountBalanceAtBlockHeight(ctx, s.account.Address, height)
+	s.Require().NoError(err)
+	s.Require().Equal(expected, actual)
+}
+
+func (s *BackendAccountsSuite) testGetAccountAvailableBalanceAtLatestBlock(ctx context.Context, backend *backendAccounts, expected uint64) {
+	s.state.On("Sealed").Return(s.snapshot, nil).Once()
+	s.snapshot.On("Head").Return(s.block.Header, nil).Once()
+
+	actual, err := backend.GetAccountAvailableBalanceAtLatestBlock(ctx, s.account.Address)
+	s.Require().NoError(err)
+	s.Require().Equal(expected, actual)
+}
+
+func (s *BackendAccountsSuite) testGetAccountAvailableBalanceAtBlockHeight(ctx context.Context, backend *backendAccounts, expected uint64) {
+	height := s.block.Header.Height
+	s.headers.On("BlockIDByHeight", height).Return(s.block.Header.ID(), nil).Once()
+
+	actual, err := backend.GetAccountAvailableBalanceAtBlockHeight(ctx, s.account.Address, height)
+	s.Require().NoError(err)
+	s.Require().Equal(expected, actual)
+}
@@ -15,6 +15,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/onflow/flow-go/access"
 	"github.com/onflow/flow-go/engine/access/index"
 	"github.com/onflow/flow-go/engine/access/rpc/connection"
 	"github.com/onflow/flow-go/engine/common/rpc"
@@ -38,6 +39,7 @@ type backendEvents struct {
 	nodeCommunicator  Communicator
 	queryMode         IndexQueryMode
 	eventsIndex       *index.EventsIndex
+	eventLookupIndex  *index.EventLookupIndex
 }
 
 // blockMetadata is used to capture information about requested blocks to avoid repeated blockID
@@ -151,6 +153,117 @@ func (b *backendEvents) GetEventsForBlockIDs(
 	return b.getBlockEvents(ctx, blockHeaders, eventType, requiredEventEncodingVersion)
 }
 
+// GetEventsByEventType retrieves up to limit events of the given type from the local event index,
+// starting at the given cursor. Events are grouped by block in ascending height order, and the returned
+// page contains the cursor to use to retrieve the next page.
+func (b *backendEvents) GetEventsByEventType(
+	ctx context.Context,
+	eventType string,
+	cursor storage.EventCursor,
+	limit uint,
+	requiredEventEncodingVersion entities.EventEncodingVersion,
+) (*access.EventsPage, error) {
+	target := flow.EventType(eventType)
+	if _, err := events.ValidateEvent(target, b.chain); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid event type: %v", err)
+	}
+
+	if err := b.validateEventLookup(limit); err != nil {
+		return nil, err
+	}
+
+	indexed, next, err := b.eventLookupIndex.ByEventType(target, cursor, limit)
+	if err != nil {
+		return nil, rpc.ConvertIndexError(err, cursor.Height, "failed to get events by type")
+	}
+
+	return b.buildEventsPage(indexed, next, requiredEventEncodingVersion)
+}
+
+// GetEventsByContractAddress retrieves up to limit events emitted by contracts deployed to the given address
+// from the local event index, starting at the given cursor. Events are grouped by block in ascending height order,
+// and the returned page contains the cursor to use to retrieve the next page.
+func (b *backendEvents) GetEventsByContractAddress(
+	ctx context.Context,
+	address flow.Address,
+	cursor storage.EventCursor,
+	limit uint,
+	requiredEventEncodingVersion entities.EventEncodingVersion,
+) (*access.EventsPage, error) {
+	if !b.chain.IsValid(address) {
+		return nil, status.Errorf(codes.InvalidArgument, "address %s is invalid on chain %s", address, b.chain.ChainID())
+	}
+
+	if err := b.validateEventLookup(limit); err != nil {
+		return nil, err
+	}
+
+	indexed, next, err := b.eventLookupIndex.ByContractAddress(address, cursor, limit)
+	if err != nil {
+		return nil, rpc.ConvertIndexError(err, cursor.Height, "failed to get events by contract address")
+	}
+
+	return b.buildEventsPage(indexed, next, requiredEventEncodingVersion)
+}
+
+// validateEventLookup checks that event index queries are supported by the node, and that the page size is valid.
+// Event index queries are only served from local storage, since execution nodes do not maintain the index.
+func (b *backendEvents) validateEventLookup(limit uint) error {
+	if b.queryMode == IndexQueryModeExecutionNodesOnly || b.eventLookupIndex == nil {
+		return status.Error(codes.FailedPrecondition, "event index queries require execution data indexing to be enabled")
+	}
+
+	if limit == 0 || limit > MaxEventsPageSize {
+		return status.Errorf(codes.InvalidArgument, "limit must be between 1 and %d", MaxEventsPageSize)
+	}
+
+	return nil
+}
+
+// buildEventsPage groups the indexed events by block, and converts their payloads to the required encoding.
+func (b *backendEvents) buildEventsPage(
+	indexed []storage.IndexedEvent,
+	next *storage.EventCursor,
+	requiredEventEncodingVersion entities.EventEncodingVersion,
+) (*access.EventsPage, error) {
+	resp := make([]flow.BlockEvents, 0)
+
+	for _, e := range indexed {
+		if len(resp) == 0 || resp[len(resp)-1].BlockID != e.BlockID {
+			header, err := b.headers.ByBlockID(e.BlockID)
+			if err != nil {
+				return nil, rpc.ConvertStorageError(fmt.Errorf("failed to get block header for %s: %w", e.BlockID, err))
+			}
+
+			resp = append(resp, flow.BlockEvents{
+				BlockID:        e.BlockID,
+				BlockHeight:    e.BlockHeight,
+				BlockTimestamp: header.Timestamp,
+				Events:         make([]flow.Event, 0),
+			})
+		}
+
+		event := e.Event
+		// events are encoded in CCF format in storage. convert to JSON-CDC if requested
+		if requiredEventEncodingVersion == entities.EventEncodingVersion_JSON_CDC_V0 {
+			payload, err := convert.CcfPayloadToJsonPayload(event.Payload)
+			if err != nil {
+				err = fmt.Errorf("failed to convert event payload for block %s: %w", e.BlockID, err)
+				return nil, rpc.ConvertError(err, "failed to convert event payload", codes.Internal)
+			}
+			event.Payload = payload
+		}
+
+		blockEvents := &resp[len(resp)-1]
+		blockEvents.Events = append(blockEvents.Events, event)
+	}
+
+	return &access.EventsPage{
+		Events:     resp,
+		NextCursor: next,
+	}, nil
+}
+
 // getBlockEvents retrieves events for all the specified blocks that have the given type
 // It gets all events available in storage, and requests the rest from an execution node.
 func (b *backendEvents) getBlockEvents(
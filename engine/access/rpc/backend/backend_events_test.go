@@ -18,6 +18,7 @@ import (
 	"github.com/onflow/flow/protobuf/go/flow/entities"
 	execproto "github.com/onflow/flow/protobuf/go/flow/execution"
 
+	accessapi "github.com/onflow/flow-go/access"
 	"github.com/onflow/flow-go/engine/access/index"
 	access "github.com/onflow/flow-go/engine/access/mock"
 	connectionmock "github.com/onflow/flow-go/engine/access/rpc/connection/mock"
@@ -468,6 +469,185 @@ func (s *BackendEventsSuite) TestGetEventsForBlockIDs_HandlesErrors() {
 	})
 }
 
+// TestGetEventsByEventType tests retrieving pages of events from the event lookup index
+func (s *BackendEventsSuite) TestGetEventsByEventType() {
+	ctx := context.Background()
+
+	lowestHeight := s.blocks[0].Header.Height
+	highestHeight := s.sealedHead.Height
+	cursor := storage.EventCursor{Height: lowestHeight}
+	next := &storage.EventCursor{Height: highestHeight, TransactionIndex: 1}
+
+	// return 2 events for each block
+	indexed := make([]storage.IndexedEvent, 0)
+	for _, block := range s.blocks {
+		for _, event := range s.blockEvents[:2] {
+			indexed = append(indexed, storage.IndexedEvent{
+				BlockID:     block.ID(),
+				BlockHeight: block.Header.Height,
+				Event:       event,
+			})
+		}
+	}
+	limit := uint(len(indexed))
+
+	lookups := storagemock.NewEventLookups(s.T())
+	lookups.On("ByEventType", flow.EventType(targetEvent), cursor, highestHeight, limit).Return(indexed, next, nil)
+	lookups.On("ByContractAddress", s.chainID.Chain().ServiceAddress(), cursor, highestHeight, limit).Return(indexed, next, nil)
+	lookups.On("FirstIndexedHeight").Return(lowestHeight, nil)
+
+	reporter := syncmock.NewIndexReporter(s.T())
+	reporter.On("LowestIndexedHeight").Return(lowestHeight, nil)
+	reporter.On("HighestIndexedHeight").Return(highestHeight, nil)
+
+	eventLookupIndex := index.NewEventLookupIndex(lookups)
+	err := eventLookupIndex.Initialize(reporter)
+	s.Require().NoError(err)
+
+	for _, encoding := range []entities.EventEncodingVersion{
+		entities.EventEncodingVersion_CCF_V0,
+		entities.EventEncodingVersion_JSON_CDC_V0,
+	} {
+		backend := s.defaultBackend()
+		backend.queryMode = IndexQueryModeLocalOnly
+		backend.eventLookupIndex = eventLookupIndex
+
+		s.Run(fmt.Sprintf("by event type - %s", encoding.String()), func() {
+			page, err := backend.GetEventsByEventType(ctx, targetEvent, cursor, limit, encoding)
+			s.Require().NoError(err)
+			s.assertEventsPage(page, next, encoding)
+		})
+
+		s.Run(fmt.Sprintf("by contract address - %s", encoding.String()), func() {
+			page, err := backend.GetEventsByContractAddress(ctx, s.chainID.Chain().ServiceAddress(), cursor, limit, encoding)
+			s.Require().NoError(err)
+			s.assertEventsPage(page, next, encoding)
+		})
+	}
+}
+
+// TestGetEventsByEventType_HandlesErrors tests the error handling of event lookup index queries
+func (s *BackendEventsSuite) TestGetEventsByEventType_HandlesErrors() {
+	ctx := context.Background()
+
+	lowestHeight := s.blocks[1].Header.Height
+	highestHeight := s.sealedHead.Height
+	cursor := storage.EventCursor{Height: lowestHeight}
+	encoding := entities.EventEncodingVersion_CCF_V0
+
+	reporter := syncmock.NewIndexReporter(s.T())
+	reporter.On("LowestIndexedHeight").Return(lowestHeight, nil).Maybe()
+	reporter.On("HighestIndexedHeight").Return(highestHeight, nil).Maybe()
+
+	lookups := storagemock.NewEventLookups(s.T())
+	lookups.On("FirstIndexedHeight").Return(lowestHeight, nil).Maybe()
+
+	eventLookupIndex := index.NewEventLookupIndex(lookups)
+	err := eventLookupIndex.Initialize(reporter)
+	s.Require().NoError(err)
+
+	s.Run("returns error when index queries are not supported", func() {
+		backend := s.defaultBackend()
+		backend.eventLookupIndex = eventLookupIndex
+
+		page, err := backend.GetEventsByEventType(ctx, targetEvent, cursor, 10, encoding)
+		s.Assert().Equal(codes.FailedPrecondition, status.Code(err))
+		s.Assert().Nil(page)
+	})
+
+	s.Run("returns error for invalid limit", func() {
+		backend := s.defaultBackend()
+		backend.queryMode = IndexQueryModeLocalOnly
+		backend.eventLookupIndex = eventLookupIndex
+
+		for _, limit := range []uint{0, MaxEventsPageSize + 1} {
+			page, err := backend.GetEventsByEventType(ctx, targetEvent, cursor, limit, encoding)
+			s.Assert().Equal(codes.InvalidArgument, status.Code(err))
+			s.Assert().Nil(page)
+		}
+	})
+
+	s.Run("returns error for invalid event type and address", func() {
+		backend := s.defaultBackend()
+		backend.queryMode = IndexQueryModeLocalOnly
+		backend.eventLookupIndex = eventLookupIndex
+
+		page, err := backend.GetEventsByEventType(ctx, "invalid", cursor, 10, encoding)
+		s.Assert().Equal(codes.InvalidArgument, status.Code(err))
+		s.Assert().Nil(page)
+
+		page, err = backend.GetEventsByContractAddress(ctx, flow.Mainnet.Chain().ServiceAddress(), cursor, 10, encoding)
+		s.Assert().Equal(codes.InvalidArgument, status.Code(err))
+		s.Assert().Nil(page)
+	})
+
+	s.Run("returns error when index is not initialized", func() {
+		backend := s.defaultBackend()
+		backend.queryMode = IndexQueryModeLocalOnly
+		backend.eventLookupIndex = index.NewEventLookupIndex(storagemock.NewEventLookups(s.T()))
+
+		page, err := backend.GetEventsByEventType(ctx, targetEvent, cursor, 10, encoding)
+		s.Assert().Equal(codes.FailedPrecondition, status.Code(err))
+		s.Assert().Nil(page)
+	})
+
+	s.Run("returns error for cursor outside of indexed range", func() {
+		backend := s.defaultBackend()
+		backend.queryMode = IndexQueryModeLocalOnly
+		backend.eventLookupIndex = eventLookupIndex
+
+		for _, height := range []uint64{lowestHeight - 1, highestHeight + 1} {
+			page, err := backend.GetEventsByEventType(ctx, targetEvent, storage.EventCursor{Height: height}, 10, encoding)
+			s.Assert().Equal(codes.OutOfRange, status.Code(err))
+			s.Assert().Nil(page)
+		}
+	})
+
+	s.Run("returns error for cursor below the first height of the lookup indexes", func() {
+		// the lookup indexes were populated after the execution state indexer started, and are not backfilled
+		lookups := storagemock.NewEventLookups(s.T())
+		lookups.On("FirstIndexedHeight").Return(lowestHeight+1, nil).Once()
+
+		backend := s.defaultBackend()
+		backend.queryMode = IndexQueryModeLocalOnly
+		backend.eventLookupIndex = index.NewEventLookupIndex(lookups)
+		s.Require().NoError(backend.eventLookupIndex.Initialize(reporter))
+
+		page, err := backend.GetEventsByEventType(ctx, targetEvent, cursor, 10, encoding)
+		s.Assert().Equal(codes.OutOfRange, status.Code(err))
+		s.Assert().Nil(page)
+	})
+
+	s.Run("returns error when the lookup indexes are empty", func() {
+		lookups := storagemock.NewEventLookups(s.T())
+		lookups.On("FirstIndexedHeight").Return(uint64(0), storage.ErrNotFound).Once()
+
+		backend := s.defaultBackend()
+		backend.queryMode = IndexQueryModeLocalOnly
+		backend.eventLookupIndex = index.NewEventLookupIndex(lookups)
+		s.Require().NoError(backend.eventLookupIndex.Initialize(reporter))
+
+		page, err := backend.GetEventsByContractAddress(ctx, s.chainID.Chain().ServiceAddress(), cursor, 10, encoding)
+		s.Assert().Equal(codes.OutOfRange, status.Code(err))
+		s.Assert().Nil(page)
+	})
+}
+
+func (s *BackendEventsSuite) assertEventsPage(page *accessapi.EventsPage, next *storage.EventCursor, encoding entities.EventEncodingVersion) {
+	s.Require().Len(page.Events, len(s.blocks))
+	for i, block := range s.blocks {
+		s.Assert().Equal(block.Header.Height, page.Events[i].BlockHeight)
+		s.Assert().Equal(block.ID(), page.Events[i].BlockID)
+		s.Assert().Equal(block.Header.Timestamp, page.Events[i].BlockTimestamp)
+		s.Require().Len(page.Events[i].Events, 2)
+
+		for j := range page.Events[i].Events {
+			s.assertEncoding(&page.Events[i].Events[j], encoding)
+		}
+	}
+	s.Assert().Equal(next, page.NextCursor)
+}
+
 func (s *BackendEventsSuite) assertResponse(response []flow.BlockEvents, encoding entities.EventEncodingVersion) {
 	s.Assert().Len(response, len(s.blocks))
 	for i, block := range s.blocks {
@@ -110,6 +110,52 @@ func (b *backendScripts) ExecuteScriptAtBlockHeight(
 	return b.executeScript(ctx, newScriptExecutionRequest(header.ID(), blockHeight, script, arguments))
 }
 
+// ExecuteScriptWithTransactionsAtBlockHeight executes provided script at the provided block height, after
+// applying the provided transactions in order on top of the block's state. The transactions are never
+// committed. The script result is returned together with the result of each transaction.
+//
+// The transactions are applied to the local execution state, it is not available if scripts are only
+// executed on execution nodes.
+func (b *backendScripts) ExecuteScriptWithTransactionsAtBlockHeight(
+	ctx context.Context,
+	blockHeight uint64,
+	script []byte,
+	arguments [][]byte,
+	transactions []*flow.TransactionBody,
+) ([]byte, []flow.SpeculativeTransactionResult, error) {
+	if b.scriptExecMode == IndexQueryModeExecutionNodesOnly {
+		return nil, nil, status.Errorf(codes.Unimplemented,
+			"executing scripts with transactions requires the local execution state index, which is not enabled")
+	}
+
+	header, err := b.headers.ByHeight(blockHeight)
+	if err != nil {
+		return nil, nil, rpc.ConvertStorageError(err)
+	}
+
+	execStartTime := time.Now()
+
+	result, txResults, err := b.scriptExecutor.ExecuteWithTransactionsAtBlockHeight(ctx, script, arguments, transactions, blockHeight)
+	if err != nil {
+		b.log.Debug().Err(err).
+			Hex("block_id", logging.ID(header.ID())).
+			Uint64("height", blockHeight).
+			Int("transactions", len(transactions)).
+			Msg("script with transactions failed to execute locally")
+
+		return nil, nil, convertScriptExecutionError(err, blockHeight)
+	}
+
+	b.log.Debug().
+		Hex("block_id", logging.ID(header.ID())).
+		Uint64("height", blockHeight).
+		Int("transactions", len(transactions)).
+		Dur("execution_dur_ms", time.Since(execStartTime)).
+		Msg("executed script with transactions")
+
+	return result, txResults, nil
+}
+
 // executeScript executes the provided script using either the local execution state or the execution
 // nodes depending on the node's configuration and the availability of the data.
 func (b *backendScripts) executeScript(
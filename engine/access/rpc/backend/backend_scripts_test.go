@@ -426,6 +426,111 @@ func (s *BackendScriptsSuite) TestExecuteScriptAtLatestBlockFromStorage_Inconsis
 	})
 }
 
+// TestSimulateTransaction tests that transactions are simulated against the local execution state
+// at the latest sealed block, and that simulation is rejected if only execution nodes are used.
+func (s *BackendScriptsSuite) TestSimulateTransaction() {
+	ctx := context.Background()
+	tx := unittest.TransactionBodyFixture()
+	expected := &flow.TransactionSimulationResult{
+		TransactionID:   tx.ID(),
+		ComputationUsed: 10,
+	}
+
+	s.Run("happy path", func() {
+		scriptExecutor := execmock.NewScriptExecutor(s.T())
+		scriptExecutor.On("SimulateTransactionAtBlockHeight", mock.Anything, &tx, true, s.block.Header.Height).
+			Return(expected, nil).Once()
+
+		backend := s.defaultBackend()
+		backend.scriptExecMode = IndexQueryModeFailover
+		backend.scriptExecutor = scriptExecutor
+
+		s.state.On("Sealed").Return(s.snapshot, nil).Once()
+		s.snapshot.On("Head").Return(s.block.Header, nil).Once()
+
+		actual, err := backend.SimulateTransaction(ctx, &tx, true)
+		s.Require().NoError(err)
+		s.Require().Equal(expected, actual)
+	})
+
+	s.Run("data not indexed", func() {
+		scriptExecutor := execmock.NewScriptExecutor(s.T())
+		scriptExecutor.On("SimulateTransactionAtBlockHeight", mock.Anything, &tx, false, s.block.Header.Height).
+			Return(nil, storage.ErrHeightNotIndexed).Once()
+
+		backend := s.defaultBackend()
+		backend.scriptExecMode = IndexQueryModeLocalOnly
+		backend.scriptExecutor = scriptExecutor
+
+		s.state.On("Sealed").Return(s.snapshot, nil).Once()
+		s.snapshot.On("Head").Return(s.block.Header, nil).Once()
+
+		_, err := backend.SimulateTransaction(ctx, &tx, false)
+		s.Require().Equal(codes.OutOfRange, status.Code(err))
+	})
+
+	s.Run("execution nodes only", func() {
+		backend := s.defaultBackend()
+		backend.scriptExecMode = IndexQueryModeExecutionNodesOnly
+
+		_, err := backend.SimulateTransaction(ctx, &tx, false)
+		s.Require().Equal(codes.Unimplemented, status.Code(err))
+	})
+}
+
+// TestExecuteScriptWithTransactionsAtBlockHeight tests that scripts with transactions are executed against
+// the local execution state, and that they are rejected if only execution nodes are used.
+func (s *BackendScriptsSuite) TestExecuteScriptWithTransactionsAtBlockHeight() {
+	ctx := context.Background()
+	height := s.block.Header.Height
+	tx := unittest.TransactionBodyFixture()
+	transactions := []*flow.TransactionBody{&tx}
+	expectedTxResults := []flow.SpeculativeTransactionResult{{
+		TransactionID:   tx.ID(),
+		ComputationUsed: 10,
+	}}
+
+	s.Run("happy path", func() {
+		scriptExecutor := execmock.NewScriptExecutor(s.T())
+		scriptExecutor.On("ExecuteWithTransactionsAtBlockHeight", mock.Anything, s.script, s.arguments, transactions, height).
+			Return(expectedResponse, expectedTxResults, nil).Once()
+
+		backend := s.defaultBackend()
+		backend.scriptExecMode = IndexQueryModeFailover
+		backend.scriptExecutor = scriptExecutor
+
+		s.headers.On("ByHeight", height).Return(s.block.Header, nil).Once()
+
+		result, txResults, err := backend.ExecuteScriptWithTransactionsAtBlockHeight(ctx, height, s.script, s.arguments, transactions)
+		s.Require().NoError(err)
+		s.Require().Equal(expectedResponse, result)
+		s.Require().Equal(expectedTxResults, txResults)
+	})
+
+	s.Run("data not indexed", func() {
+		scriptExecutor := execmock.NewScriptExecutor(s.T())
+		scriptExecutor.On("ExecuteWithTransactionsAtBlockHeight", mock.Anything, s.script, s.arguments, transactions, height).
+			Return(nil, nil, storage.ErrHeightNotIndexed).Once()
+
+		backend := s.defaultBackend()
+		backend.scriptExecMode = IndexQueryModeLocalOnly
+		backend.scriptExecutor = scriptExecutor
+
+		s.headers.On("ByHeight", height).Return(s.block.Header, nil).Once()
+
+		_, _, err := backend.ExecuteScriptWithTransactionsAtBlockHeight(ctx, height, s.script, s.arguments, transactions)
+		s.Require().Equal(codes.OutOfRange, status.Code(err))
+	})
+
+	s.Run("execution nodes only", func() {
+		backend := s.defaultBackend()
+		backend.scriptExecMode = IndexQueryModeExecutionNodesOnly
+
+		_, _, err := backend.ExecuteScriptWithTransactionsAtBlockHeight(ctx, height, s.script, s.arguments, transactions)
+		s.Require().Equal(codes.Unimplemented, status.Code(err))
+	})
+}
+
 func (s *BackendScriptsSuite) testExecuteScriptAtLatestBlock(ctx context.Context, backend *backendScripts, statusCode codes.Code) {
 	s.state.On("Sealed").Return(s.snapshot, nil).Once()
 	s.snapshot.On("Head").Return(s.block.Header, nil).Once()
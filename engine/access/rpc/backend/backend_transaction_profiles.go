@@ -0,0 +1,125 @@
+package backend
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/engine/common/rpc"
+	"github.com/onflow/flow-go/engine/common/rpc/convert"
+	"github.com/onflow/flow-go/engine/execution/rpc/profiles"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// GetTransactionProfile returns the resource profile of a transaction executed in the given block.
+// Profiles are not part of the execution result, so the profile is returned by the first execution node
+// with a receipt for the block which can serve it.
+// Expected errors during normal operation:
+//   - status.Error[codes.NotFound] - the block has no execution receipts, or no execution node has the profile.
+//   - status.Error - remote GRPC call to EN has failed.
+func (b *backendTransactions) GetTransactionProfile(
+	ctx context.Context,
+	blockID flow.Identifier,
+	txID flow.Identifier,
+) (*flow.TransactionProfile, error) {
+	execNodes, err := b.executionNodesForTransactionProfiles(ctx, blockID)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &profiles.GetTransactionProfileRequest{
+		BlockId:       convert.IdentifierToMessage(blockID),
+		TransactionId: convert.IdentifierToMessage(txID),
+	}
+
+	var resp *profiles.GetTransactionProfileResponse
+	err = b.nodeCommunicator.CallAvailableNode(
+		execNodes,
+		func(node *flow.IdentitySkeleton) error {
+			client, closer, err := b.connFactory.GetTransactionProfilesAPIClient(node.Address)
+			if err != nil {
+				return err
+			}
+			defer closer.Close()
+
+			resp, err = client.GetTransactionProfile(ctx, req)
+			return err
+		},
+		nil,
+	)
+	if err != nil {
+		return nil, rpc.ConvertError(err, "failed to retrieve transaction profile from execution nodes", codes.Internal)
+	}
+
+	profile, err := convert.MessageToTransactionProfile(resp.GetProfile())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert transaction profile: %v", err)
+	}
+
+	return profile, nil
+}
+
+// GetTransactionProfilesByBlockID returns the resource profiles of all transactions executed in the given block,
+// ordered by transaction index.
+// Expected errors during normal operation:
+//   - status.Error[codes.NotFound] - the block has no execution receipts, or no execution node has the profiles.
+//   - status.Error - remote GRPC call to EN has failed.
+func (b *backendTransactions) GetTransactionProfilesByBlockID(
+	ctx context.Context,
+	blockID flow.Identifier,
+) ([]flow.TransactionProfile, error) {
+	execNodes, err := b.executionNodesForTransactionProfiles(ctx, blockID)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &profiles.GetTransactionProfilesByBlockIDRequest{
+		BlockId: convert.IdentifierToMessage(blockID),
+	}
+
+	var resp *profiles.GetTransactionProfilesByBlockIDResponse
+	err = b.nodeCommunicator.CallAvailableNode(
+		execNodes,
+		func(node *flow.IdentitySkeleton) error {
+			client, closer, err := b.connFactory.GetTransactionProfilesAPIClient(node.Address)
+			if err != nil {
+				return err
+			}
+			defer closer.Close()
+
+			resp, err = client.GetTransactionProfilesByBlockID(ctx, req)
+			return err
+		},
+		nil,
+	)
+	if err != nil {
+		return nil, rpc.ConvertError(err, "failed to retrieve transaction profiles from execution nodes", codes.Internal)
+	}
+
+	txProfiles, err := convert.MessagesToTransactionProfiles(resp.GetProfiles())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert transaction profiles: %v", err)
+	}
+
+	return txProfiles, nil
+}
+
+// executionNodesForTransactionProfiles returns the execution nodes which have executed the given block.
+func (b *backendTransactions) executionNodesForTransactionProfiles(
+	ctx context.Context,
+	blockID flow.Identifier,
+) (flow.IdentitySkeletonList, error) {
+	execNodes, err := executionNodesForBlockID(ctx, blockID, b.executionReceipts, b.state, b.log)
+	if err != nil {
+		if IsInsufficientExecutionReceipts(err) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, rpc.ConvertError(err, "failed to select execution nodes", codes.Internal)
+	}
+	if len(execNodes) == 0 {
+		return nil, errors.New("zero execution nodes")
+	}
+	return execNodes, nil
+}
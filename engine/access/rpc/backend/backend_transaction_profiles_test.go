@@ -0,0 +1,96 @@
+package backend
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	accessmock "github.com/onflow/flow-go/engine/access/mock"
+	connectionmock "github.com/onflow/flow-go/engine/access/rpc/connection/mock"
+	"github.com/onflow/flow-go/engine/common/rpc/convert"
+	"github.com/onflow/flow-go/engine/execution/rpc/profiles"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestGetTransactionProfile tests getting the profile of a transaction from the execution nodes.
+func (suite *Suite) TestGetTransactionProfile() {
+	block := unittest.BlockFixture()
+	blockID := block.ID()
+	profile := unittest.TransactionProfilesFixture(1)[0]
+
+	_, fixedENIDs := suite.setupReceipts(&block)
+	suite.state.On("Final").Return(suite.snapshot, nil).Maybe()
+	suite.snapshot.On("Identities", mock.Anything).Return(fixedENIDs, nil)
+
+	profilesClient := accessmock.NewTransactionProfilesAPIClient(suite.T())
+	connFactory := connectionmock.NewConnectionFactory(suite.T())
+	connFactory.On("GetTransactionProfilesAPIClient", mock.Anything).Return(profilesClient, &mockCloser{}, nil)
+
+	params := suite.defaultBackendParams()
+	params.ConnFactory = connFactory
+	params.FixedExecutionNodeIDs = fixedENIDs.NodeIDs().Strings()
+
+	backend, err := New(params)
+	suite.Require().NoError(err)
+
+	req := &profiles.GetTransactionProfileRequest{
+		BlockId:       blockID[:],
+		TransactionId: profile.TransactionID[:],
+	}
+
+	suite.Run("happy path", func() {
+		profilesClient.
+			On("GetTransactionProfile", mock.Anything, req).
+			Return(&profiles.GetTransactionProfileResponse{Profile: convert.TransactionProfileToMessage(profile)}, nil).
+			Once()
+
+		actual, err := backend.GetTransactionProfile(context.Background(), blockID, profile.TransactionID)
+		suite.Require().NoError(err)
+		suite.Require().Equal(profile, *actual)
+	})
+
+	suite.Run("execution nodes unavailable", func() {
+		// each of the 2 ENs in fixedENIDs is tried
+		profilesClient.
+			On("GetTransactionProfile", mock.Anything, req).
+			Return(nil, status.Error(codes.Unavailable, "")).
+			Twice()
+
+		_, err := backend.GetTransactionProfile(context.Background(), blockID, profile.TransactionID)
+		suite.Require().Error(err)
+		suite.Require().Equal(codes.Unavailable, status.Code(err))
+	})
+}
+
+// TestGetTransactionProfilesByBlockID tests getting the profiles of all transactions of a block from the execution nodes.
+func (suite *Suite) TestGetTransactionProfilesByBlockID() {
+	block := unittest.BlockFixture()
+	blockID := block.ID()
+	expected := unittest.TransactionProfilesFixture(3)
+
+	_, fixedENIDs := suite.setupReceipts(&block)
+	suite.state.On("Final").Return(suite.snapshot, nil).Maybe()
+	suite.snapshot.On("Identities", mock.Anything).Return(fixedENIDs, nil)
+
+	profilesClient := accessmock.NewTransactionProfilesAPIClient(suite.T())
+	connFactory := connectionmock.NewConnectionFactory(suite.T())
+	connFactory.On("GetTransactionProfilesAPIClient", mock.Anything).Return(profilesClient, &mockCloser{}, nil)
+
+	params := suite.defaultBackendParams()
+	params.ConnFactory = connFactory
+	params.FixedExecutionNodeIDs = fixedENIDs.NodeIDs().Strings()
+
+	backend, err := New(params)
+	suite.Require().NoError(err)
+
+	profilesClient.
+		On("GetTransactionProfilesByBlockID", mock.Anything, &profiles.GetTransactionProfilesByBlockIDRequest{BlockId: blockID[:]}).
+		Return(&profiles.GetTransactionProfilesByBlockIDResponse{Profiles: convert.TransactionProfilesToMessages(expected)}, nil).
+		Once()
+
+	actual, err := backend.GetTransactionProfilesByBlockID(context.Background(), blockID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expected, actual)
+}
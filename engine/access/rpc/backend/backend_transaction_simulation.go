@@ -0,0 +1,59 @@
+package backend
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/irrecoverable"
+	"github.com/onflow/flow-go/utils/logging"
+)
+
+// SimulateTransaction executes the provided transaction on top of the local execution state at the
+// latest sealed block, without committing or submitting it. Signature checks are skipped if
+// skipSignatureChecks is set.
+//
+// Simulation requires the local execution state index, it is not available if scripts are only
+// executed on execution nodes.
+func (b *backendScripts) SimulateTransaction(
+	ctx context.Context,
+	tx *flow.TransactionBody,
+	skipSignatureChecks bool,
+) (*flow.TransactionSimulationResult, error) {
+	if b.scriptExecMode == IndexQueryModeExecutionNodesOnly {
+		return nil, status.Errorf(codes.Unimplemented,
+			"transaction simulation requires the local execution state index, which is not enabled")
+	}
+
+	latestHeader, err := b.state.Sealed().Head()
+	if err != nil {
+		// the latest sealed header MUST be available
+		err := irrecoverable.NewExceptionf("failed to lookup sealed header: %w", err)
+		irrecoverable.Throw(ctx, err)
+		return nil, err
+	}
+
+	execStartTime := time.Now()
+
+	result, err := b.scriptExecutor.SimulateTransactionAtBlockHeight(ctx, tx, skipSignatureChecks, latestHeader.Height)
+	if err != nil {
+		b.log.Debug().Err(err).
+			Hex("tx_id", logging.ID(tx.ID())).
+			Hex("block_id", logging.ID(latestHeader.ID())).
+			Uint64("height", latestHeader.Height).
+			Msg("transaction simulation failed")
+
+		return nil, convertScriptExecutionError(err, latestHeader.Height)
+	}
+
+	b.log.Debug().
+		Hex("tx_id", logging.ID(tx.ID())).
+		Uint64("height", latestHeader.Height).
+		Dur("execution_dur_ms", time.Since(execStartTime)).
+		Msg("simulated transaction")
+
+	return result, nil
+}
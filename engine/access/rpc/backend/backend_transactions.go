@@ -15,6 +15,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/onflow/flow-go/access"
+	"github.com/onflow/flow-go/engine/access/index"
 	"github.com/onflow/flow-go/engine/access/rpc/connection"
 	"github.com/onflow/flow-go/engine/common/rpc"
 	"github.com/onflow/flow-go/engine/common/rpc/convert"
@@ -42,6 +43,7 @@ type backendTransactions struct {
 	txResultCache        *lru.Cache[flow.Identifier, *access.TransactionResult]
 	txErrorMessagesCache *lru.Cache[flow.Identifier, string] // cache for transactions error messages, indexed by hash(block_id, tx_id).
 	txResultQueryMode    IndexQueryMode
+	accountTxsIndex      *index.AccountTransactionsIndex
 
 	systemTxID flow.Identifier
 	systemTx   *flow.TransactionBody
@@ -56,7 +58,7 @@ func (b *backendTransactions) SendTransaction(
 ) error {
 	now := time.Now().UTC()
 
-	err := b.transactionValidator.Validate(tx)
+	err := b.transactionValidator.Validate(ctx, tx)
 	if err != nil {
 		return status.Errorf(codes.InvalidArgument, "invalid transaction: %s", err.Error())
 	}
@@ -221,6 +223,41 @@ func (b *backendTransactions) GetTransactionsByBlockID(
 	return transactions, nil
 }
 
+// GetTransactionsByAddress retrieves up to limit transactions the account with the given address was involved in
+// from the local account transactions index, starting at the given cursor. Transactions are returned in execution
+// order, and the returned page contains the cursor to use to retrieve the next page.
+func (b *backendTransactions) GetTransactionsByAddress(
+	_ context.Context,
+	address flow.Address,
+	cursor storage.AccountTransactionCursor,
+	limit uint,
+) (*access.AccountTransactionsPage, error) {
+	chain := b.chainID.Chain()
+	if !chain.IsValid(address) {
+		return nil, status.Errorf(codes.InvalidArgument, "address %s is invalid on chain %s", address, b.chainID)
+	}
+
+	// the index is only maintained locally, since execution nodes do not index transactions by account.
+	// it is therefore used regardless of the transaction result query mode.
+	if b.accountTxsIndex == nil {
+		return nil, status.Error(codes.FailedPrecondition, "account transaction queries require execution data indexing to be enabled")
+	}
+
+	if limit == 0 || limit > MaxAccountTransactionsPageSize {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must be between 1 and %d", MaxAccountTransactionsPageSize)
+	}
+
+	transactions, next, err := b.accountTxsIndex.ByAddress(address, cursor, limit)
+	if err != nil {
+		return nil, rpc.ConvertIndexError(err, cursor.Height, "failed to get transactions by address")
+	}
+
+	return &access.AccountTransactionsPage{
+		Transactions: transactions,
+		NextCursor:   next,
+	}, nil
+}
+
 func (b *backendTransactions) GetTransactionResult(
 	ctx context.Context,
 	txID flow.Identifier,
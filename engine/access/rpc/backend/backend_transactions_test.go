@@ -27,6 +27,7 @@ import (
 	bprotocol "github.com/onflow/flow-go/state/protocol/badger"
 	"github.com/onflow/flow-go/state/protocol/util"
 	"github.com/onflow/flow-go/storage"
+	storagemock "github.com/onflow/flow-go/storage/mock"
 	"github.com/onflow/flow-go/utils/unittest"
 	"github.com/onflow/flow-go/utils/unittest/generator"
 )
@@ -1422,3 +1423,146 @@ func (suite *Suite) TestTransactionResultsByBlockIDFromStorage() {
 		suite.assertTransactionResultResponse(err, responseResult, block, lightTx.TransactionID, lightTx.Failed, eventsForTx)
 	}
 }
+
+// TestGetTransactionsByAddress tests retrieving pages of transactions from the account transactions index.
+func (suite *Suite) TestGetTransactionsByAddress() {
+	address := suite.chainID.Chain().ServiceAddress()
+	lowestHeight := uint64(10)
+	highestHeight := uint64(20)
+	cursor := storage.AccountTransactionCursor{Height: lowestHeight}
+	next := &storage.AccountTransactionCursor{Height: highestHeight, TransactionIndex: 1}
+
+	transactions := make([]storage.AccountTransaction, 0)
+	for height := lowestHeight; height < highestHeight; height++ {
+		transactions = append(transactions, storage.AccountTransaction{
+			Address:          address,
+			BlockID:          unittest.IdentifierFixture(),
+			BlockHeight:      height,
+			TransactionID:    unittest.IdentifierFixture(),
+			TransactionIndex: 0,
+			Roles:            storage.AccountTransactionRolePayer | storage.AccountTransactionRoleAuthorizer,
+		})
+	}
+	limit := uint(len(transactions))
+
+	accountTxs := storagemock.NewAccountTransactions(suite.T())
+	accountTxs.On("ByAddress", address, cursor, highestHeight, limit).Return(transactions, next, nil)
+	accountTxs.On("FirstIndexedHeight").Return(lowestHeight, nil)
+
+	reporter := syncmock.NewIndexReporter(suite.T())
+	reporter.On("LowestIndexedHeight").Return(lowestHeight, nil)
+	reporter.On("HighestIndexedHeight").Return(highestHeight, nil)
+
+	params := suite.defaultBackendParams()
+	params.AccountTxsIndex = index.NewAccountTransactionsIndex(accountTxs)
+	err := params.AccountTxsIndex.Initialize(reporter)
+	suite.Require().NoError(err)
+
+	backend, err := New(params)
+	suite.Require().NoError(err)
+
+	page, err := backend.GetTransactionsByAddress(context.Background(), address, cursor, limit)
+	suite.Require().NoError(err)
+	suite.Assert().Equal(transactions, page.Transactions)
+	suite.Assert().Equal(next, page.NextCursor)
+}
+
+// TestGetTransactionsByAddress_HandlesErrors tests the error handling of account transactions queries.
+func (suite *Suite) TestGetTransactionsByAddress_HandlesErrors() {
+	ctx := context.Background()
+	address := suite.chainID.Chain().ServiceAddress()
+	lowestHeight := uint64(10)
+	highestHeight := uint64(20)
+	cursor := storage.AccountTransactionCursor{Height: lowestHeight}
+
+	reporter := syncmock.NewIndexReporter(suite.T())
+	reporter.On("LowestIndexedHeight").Return(lowestHeight, nil).Maybe()
+	reporter.On("HighestIndexedHeight").Return(highestHeight, nil).Maybe()
+
+	accountTxs := storagemock.NewAccountTransactions(suite.T())
+	accountTxs.On("FirstIndexedHeight").Return(lowestHeight, nil).Maybe()
+
+	accountTxsIndex := index.NewAccountTransactionsIndex(accountTxs)
+	err := accountTxsIndex.Initialize(reporter)
+	suite.Require().NoError(err)
+
+	newBackend := func(accountTxsIndex *index.AccountTransactionsIndex) *Backend {
+		params := suite.defaultBackendParams()
+		params.AccountTxsIndex = accountTxsIndex
+
+		backend, err := New(params)
+		suite.Require().NoError(err)
+		return backend
+	}
+
+	suite.Run("returns error when index is not available", func() {
+		backend := newBackend(nil)
+
+		page, err := backend.GetTransactionsByAddress(ctx, address, cursor, 10)
+		suite.Assert().Equal(codes.FailedPrecondition, status.Code(err))
+		suite.Assert().Nil(page)
+	})
+
+	suite.Run("returns error for invalid address", func() {
+		backend := newBackend(accountTxsIndex)
+
+		page, err := backend.GetTransactionsByAddress(ctx, flow.Mainnet.Chain().ServiceAddress(), cursor, 10)
+		suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+		suite.Assert().Nil(page)
+	})
+
+	suite.Run("returns error for invalid limit", func() {
+		backend := newBackend(accountTxsIndex)
+
+		for _, limit := range []uint{0, MaxAccountTransactionsPageSize + 1} {
+			page, err := backend.GetTransactionsByAddress(ctx, address, cursor, limit)
+			suite.Assert().Equal(codes.InvalidArgument, status.Code(err))
+			suite.Assert().Nil(page)
+		}
+	})
+
+	suite.Run("returns error when index is not initialized", func() {
+		backend := newBackend(index.NewAccountTransactionsIndex(storagemock.NewAccountTransactions(suite.T())))
+
+		page, err := backend.GetTransactionsByAddress(ctx, address, cursor, 10)
+		suite.Assert().Equal(codes.FailedPrecondition, status.Code(err))
+		suite.Assert().Nil(page)
+	})
+
+	suite.Run("returns error for cursor outside of indexed range", func() {
+		backend := newBackend(accountTxsIndex)
+
+		for _, height := range []uint64{lowestHeight - 1, highestHeight + 1} {
+			page, err := backend.GetTransactionsByAddress(ctx, address, storage.AccountTransactionCursor{Height: height}, 10)
+			suite.Assert().Equal(codes.OutOfRange, status.Code(err))
+			suite.Assert().Nil(page)
+		}
+	})
+
+	suite.Run("returns error for cursor below the first height of the index", func() {
+		// the index was populated after the execution state indexer started, and is not backfilled
+		accountTxs := storagemock.NewAccountTransactions(suite.T())
+		accountTxs.On("FirstIndexedHeight").Return(lowestHeight+1, nil).Once()
+
+		accountTxsIndex := index.NewAccountTransactionsIndex(accountTxs)
+		suite.Require().NoError(accountTxsIndex.Initialize(reporter))
+		backend := newBackend(accountTxsIndex)
+
+		page, err := backend.GetTransactionsByAddress(ctx, address, cursor, 10)
+		suite.Assert().Equal(codes.OutOfRange, status.Code(err))
+		suite.Assert().Nil(page)
+	})
+
+	suite.Run("returns error when the index is empty", func() {
+		accountTxs := storagemock.NewAccountTransactions(suite.T())
+		accountTxs.On("FirstIndexedHeight").Return(uint64(0), storage.ErrNotFound).Once()
+
+		accountTxsIndex := index.NewAccountTransactionsIndex(accountTxs)
+		suite.Require().NoError(accountTxsIndex.Initialize(reporter))
+		backend := newBackend(accountTxsIndex)
+
+		page, err := backend.GetTransactionsByAddress(ctx, address, cursor, 10)
+		suite.Assert().Equal(codes.OutOfRange, status.Code(err))
+		suite.Assert().Nil(page)
+	})
+}
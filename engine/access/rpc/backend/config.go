@@ -19,6 +19,7 @@ type Config struct {
 	ScriptExecutionMode       string                          // the mode in which scripts are executed
 	EventQueryMode            string                          // the mode in which events are queried
 	TxResultQueryMode         string                          // the mode in which tx results are queried
+	CheckPayerBalanceMode     string                          // the mode in which the payer balance of transactions is checked
 }
 
 type IndexQueryMode int
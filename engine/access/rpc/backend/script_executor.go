@@ -88,6 +88,28 @@ func (s *ScriptExecutor) ExecuteAtBlockHeight(ctx context.Context, script []byte
 	return s.scriptExecutor.ExecuteAtBlockHeight(ctx, script, arguments, height)
 }
 
+// ExecuteWithTransactionsAtBlockHeight executes provided script at the provided block height against a
+// local execution state, after applying the provided transactions in order. The transactions are never
+// committed.
+//
+// Expected errors:
+//   - storage.ErrNotFound if the register or block height is not found
+//   - storage.ErrHeightNotIndexed if the data for the block height is not available. this could be because
+//     the height is not within the index block range, or the index is not ready.
+func (s *ScriptExecutor) ExecuteWithTransactionsAtBlockHeight(
+	ctx context.Context,
+	script []byte,
+	arguments [][]byte,
+	transactions []*flow.TransactionBody,
+	height uint64,
+) ([]byte, []flow.SpeculativeTransactionResult, error) {
+	if err := s.checkDataAvailable(height); err != nil {
+		return nil, nil, err
+	}
+
+	return s.scriptExecutor.ExecuteWithTransactionsAtBlockHeight(ctx, script, arguments, transactions, height)
+}
+
 // GetAccountAtBlockHeight returns the account at the provided block height from a local execution state.
 //
 // Expected errors:
@@ -102,6 +124,54 @@ func (s *ScriptExecutor) GetAccountAtBlockHeight(ctx context.Context, address fl
 	return s.scriptExecutor.GetAccountAtBlockHeight(ctx, address, height)
 }
 
+// GetAccountBalance returns the account balance at the provided block height from a local execution state.
+//
+// Expected errors:
+//   - storage.ErrNotFound if the account or block height is not found
+//   - storage.ErrHeightNotIndexed if the data for the block height is not available. this could be because
+//     the height is not within the index block range, or the index is not ready.
+func (s *ScriptExecutor) GetAccountBalance(ctx context.Context, address flow.Address, height uint64) (uint64, error) {
+	if err := s.checkDataAvailable(height); err != nil {
+		return 0, err
+	}
+
+	return s.scriptExecutor.GetAccountBalance(ctx, address, height)
+}
+
+// GetAccountAvailableBalance returns the account available balance at the provided block height from a local execution state.
+//
+// Expected errors:
+//   - storage.ErrNotFound if the account or block height is not found
+//   - storage.ErrHeightNotIndexed if the data for the block height is not available. this could be because
+//     the height is not within the index block range, or the index is not ready.
+func (s *ScriptExecutor) GetAccountAvailableBalance(ctx context.Context, address flow.Address, height uint64) (uint64, error) {
+	if err := s.checkDataAvailable(height); err != nil {
+		return 0, err
+	}
+
+	return s.scriptExecutor.GetAccountAvailableBalance(ctx, address, height)
+}
+
+// SimulateTransactionAtBlockHeight executes provided transaction at the provided block height against a
+// local execution state, without committing it.
+//
+// Expected errors:
+//   - storage.ErrNotFound if the register or block height is not found
+//   - storage.ErrHeightNotIndexed if the data for the block height is not available. this could be because
+//     the height is not within the index block range, or the index is not ready.
+func (s *ScriptExecutor) SimulateTransactionAtBlockHeight(
+	ctx context.Context,
+	tx *flow.TransactionBody,
+	skipSignatureChecks bool,
+	height uint64,
+) (*flow.TransactionSimulationResult, error) {
+	if err := s.checkDataAvailable(height); err != nil {
+		return nil, err
+	}
+
+	return s.scriptExecutor.SimulateTransactionAtBlockHeight(ctx, tx, skipSignatureChecks, height)
+}
+
 func (s *ScriptExecutor) checkDataAvailable(height uint64) error {
 	if !s.initialized.Load() {
 		return fmt.Errorf("%w: script executor not initialized", storage.ErrHeightNotIndexed)
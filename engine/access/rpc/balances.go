@@ -0,0 +1,94 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/onflow/flow-go/access"
+	"github.com/onflow/flow-go/engine/access/rpc/balances"
+	"github.com/onflow/flow-go/engine/common/rpc/convert"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// accountBalancesHandler implements the AccountBalancesAPI.
+type accountBalancesHandler struct {
+	balances.UnimplementedAccountBalancesAPIServer
+
+	api   access.API
+	chain flow.Chain
+}
+
+var _ balances.AccountBalancesAPIServer = (*accountBalancesHandler)(nil)
+
+// GetAccountBalanceAtLatestBlock returns the balance of an account at the latest sealed block.
+func (h *accountBalancesHandler) GetAccountBalanceAtLatestBlock(
+	ctx context.Context,
+	req *balances.GetAccountBalanceAtLatestBlockRequest,
+) (*balances.AccountBalanceResponse, error) {
+	address, err := convert.Address(req.GetAddress(), h.chain)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := h.api.GetAccountBalanceAtLatestBlock(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &balances.AccountBalanceResponse{Balance: balance}, nil
+}
+
+// GetAccountBalanceAtBlockHeight returns the balance of an account at the given block height.
+func (h *accountBalancesHandler) GetAccountBalanceAtBlockHeight(
+	ctx context.Context,
+	req *balances.GetAccountBalanceAtBlockHeightRequest,
+) (*balances.AccountBalanceResponse, error) {
+	address, err := convert.Address(req.GetAddress(), h.chain)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := h.api.GetAccountBalanceAtBlockHeight(ctx, address, req.GetBlockHeight())
+	if err != nil {
+		return nil, err
+	}
+
+	return &balances.AccountBalanceResponse{Balance: balance}, nil
+}
+
+// GetAccountAvailableBalanceAtLatestBlock returns the balance of an account at the latest sealed block,
+// which is not reserved for its storage.
+func (h *accountBalancesHandler) GetAccountAvailableBalanceAtLatestBlock(
+	ctx context.Context,
+	req *balances.GetAccountBalanceAtLatestBlockRequest,
+) (*balances.AccountBalanceResponse, error) {
+	address, err := convert.Address(req.GetAddress(), h.chain)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := h.api.GetAccountAvailableBalanceAtLatestBlock(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &balances.AccountBalanceResponse{Balance: balance}, nil
+}
+
+// GetAccountAvailableBalanceAtBlockHeight returns the balance of an account at the given block height,
+// which is not reserved for its storage.
+func (h *accountBalancesHandler) GetAccountAvailableBalanceAtBlockHeight(
+	ctx context.Context,
+	req *balances.GetAccountBalanceAtBlockHeightRequest,
+) (*balances.AccountBalanceResponse, error) {
+	address, err := convert.Address(req.GetAddress(), h.chain)
+	if err != nil {
+		return nil, err
+	}
+
+	balance, err := h.api.GetAccountAvailableBalanceAtBlockHeight(ctx, address, req.GetBlockHeight())
+	if err != nil {
+		return nil, err
+	}
+
+	return &balances.AccountBalanceResponse{Balance: balance}, nil
+}
@@ -0,0 +1,225 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: balances/balances.proto
+
+package balances
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// AccountBalancesAPIClient is the client API for AccountBalancesAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AccountBalancesAPIClient interface {
+	// GetAccountBalanceAtLatestBlock returns the balance of an account at the latest sealed block.
+	GetAccountBalanceAtLatestBlock(ctx context.Context, in *GetAccountBalanceAtLatestBlockRequest, opts ...grpc.CallOption) (*AccountBalanceResponse, error)
+	// GetAccountBalanceAtBlockHeight returns the balance of an account at the given block height.
+	GetAccountBalanceAtBlockHeight(ctx context.Context, in *GetAccountBalanceAtBlockHeightRequest, opts ...grpc.CallOption) (*AccountBalanceResponse, error)
+	// GetAccountAvailableBalanceAtLatestBlock returns the balance of an account at the latest sealed block,
+	// which is not reserved for its storage.
+	GetAccountAvailableBalanceAtLatestBlock(ctx context.Context, in *GetAccountBalanceAtLatestBlockRequest, opts ...grpc.CallOption) (*AccountBalanceResponse, error)
+	// GetAccountAvailableBalanceAtBlockHeight returns the balance of an account at the given block height,
+	// which is not reserved for its storage.
+	GetAccountAvailableBalanceAtBlockHeight(ctx context.Context, in *GetAccountBalanceAtBlockHeightRequest, opts ...grpc.CallOption) (*AccountBalanceResponse, error)
+}
+
+type accountBalancesAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAccountBalancesAPIClient(cc grpc.ClientConnInterface) AccountBalancesAPIClient {
+	return &accountBalancesAPIClient{cc}
+}
+
+func (c *accountBalancesAPIClient) GetAccountBalanceAtLatestBlock(ctx context.Context, in *GetAccountBalanceAtLatestBlockRequest, opts ...grpc.CallOption) (*AccountBalanceResponse, error) {
+	out := new(AccountBalanceResponse)
+	err := c.cc.Invoke(ctx, "/flow.access.balances.AccountBalancesAPI/GetAccountBalanceAtLatestBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountBalancesAPIClient) GetAccountBalanceAtBlockHeight(ctx context.Context, in *GetAccountBalanceAtBlockHeightRequest, opts ...grpc.CallOption) (*AccountBalanceResponse, error) {
+	out := new(AccountBalanceResponse)
+	err := c.cc.Invoke(ctx, "/flow.access.balances.AccountBalancesAPI/GetAccountBalanceAtBlockHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountBalancesAPIClient) GetAccountAvailableBalanceAtLatestBlock(ctx context.Context, in *GetAccountBalanceAtLatestBlockRequest, opts ...grpc.CallOption) (*AccountBalanceResponse, error) {
+	out := new(AccountBalanceResponse)
+	err := c.cc.Invoke(ctx, "/flow.access.balances.AccountBalancesAPI/GetAccountAvailableBalanceAtLatestBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *accountBalancesAPIClient) GetAccountAvailableBalanceAtBlockHeight(ctx context.Context, in *GetAccountBalanceAtBlockHeightRequest, opts ...grpc.CallOption) (*AccountBalanceResponse, error) {
+	out := new(AccountBalanceResponse)
+	err := c.cc.Invoke(ctx, "/flow.access.balances.AccountBalancesAPI/GetAccountAvailableBalanceAtBlockHeight", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AccountBalancesAPIServer is the server API for AccountBalancesAPI service.
+// All implementations must embed UnimplementedAccountBalancesAPIServer
+// for forward compatibility
+type AccountBalancesAPIServer interface {
+	// GetAccountBalanceAtLatestBlock returns the balance of an account at the latest sealed block.
+	GetAccountBalanceAtLatestBlock(context.Context, *GetAccountBalanceAtLatestBlockRequest) (*AccountBalanceResponse, error)
+	// GetAccountBalanceAtBlockHeight returns the balance of an account at the given block height.
+	GetAccountBalanceAtBlockHeight(context.Context, *GetAccountBalanceAtBlockHeightRequest) (*AccountBalanceResponse, error)
+	// GetAccountAvailableBalanceAtLatestBlock returns the balance of an account at the latest sealed block,
+	// which is not reserved for its storage.
+	GetAccountAvailableBalanceAtLatestBlock(context.Context, *GetAccountBalanceAtLatestBlockRequest) (*AccountBalanceResponse, error)
+	// GetAccountAvailableBalanceAtBlockHeight returns the balance of an account at the given block height,
+	// which is not reserved for its storage.
+	GetAccountAvailableBalanceAtBlockHeight(context.Context, *GetAccountBalanceAtBlockHeightRequest) (*AccountBalanceResponse, error)
+	mustEmbedUnimplementedAccountBalancesAPIServer()
+}
+
+// UnimplementedAccountBalancesAPIServer must be embedded to have forward compatible implementations.
+type UnimplementedAccountBalancesAPIServer struct {
+}
+
+func (UnimplementedAccountBalancesAPIServer) GetAccountBalanceAtLatestBlock(context.Context, *GetAccountBalanceAtLatestBlockRequest) (*AccountBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountBalanceAtLatestBlock not implemented")
+}
+func (UnimplementedAccountBalancesAPIServer) GetAccountBalanceAtBlockHeight(context.Context, *GetAccountBalanceAtBlockHeightRequest) (*AccountBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountBalanceAtBlockHeight not implemented")
+}
+func (UnimplementedAccountBalancesAPIServer) GetAccountAvailableBalanceAtLatestBlock(context.Context, *GetAccountBalanceAtLatestBlockRequest) (*AccountBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountAvailableBalanceAtLatestBlock not implemented")
+}
+func (UnimplementedAccountBalancesAPIServer) GetAccountAvailableBalanceAtBlockHeight(context.Context, *GetAccountBalanceAtBlockHeightRequest) (*AccountBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountAvailableBalanceAtBlockHeight not implemented")
+}
+func (UnimplementedAccountBalancesAPIServer) mustEmbedUnimplementedAccountBalancesAPIServer() {}
+
+// UnsafeAccountBalancesAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AccountBalancesAPIServer will
+// result in compilation errors.
+type UnsafeAccountBalancesAPIServer interface {
+	mustEmbedUnimplementedAccountBalancesAPIServer()
+}
+
+func RegisterAccountBalancesAPIServer(s grpc.ServiceRegistrar, srv AccountBalancesAPIServer) {
+	s.RegisterService(&AccountBalancesAPI_ServiceDesc, srv)
+}
+
+func _AccountBalancesAPI_GetAccountBalanceAtLatestBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountBalanceAtLatestBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountBalancesAPIServer).GetAccountBalanceAtLatestBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flow.access.balances.AccountBalancesAPI/GetAccountBalanceAtLatestBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountBalancesAPIServer).GetAccountBalanceAtLatestBlock(ctx, req.(*GetAccountBalanceAtLatestBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountBalancesAPI_GetAccountBalanceAtBlockHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountBalanceAtBlockHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountBalancesAPIServer).GetAccountBalanceAtBlockHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flow.access.balances.AccountBalancesAPI/GetAccountBalanceAtBlockHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountBalancesAPIServer).GetAccountBalanceAtBlockHeight(ctx, req.(*GetAccountBalanceAtBlockHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountBalancesAPI_GetAccountAvailableBalanceAtLatestBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountBalanceAtLatestBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountBalancesAPIServer).GetAccountAvailableBalanceAtLatestBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flow.access.balances.AccountBalancesAPI/GetAccountAvailableBalanceAtLatestBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountBalancesAPIServer).GetAccountAvailableBalanceAtLatestBlock(ctx, req.(*GetAccountBalanceAtLatestBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AccountBalancesAPI_GetAccountAvailableBalanceAtBlockHeight_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountBalanceAtBlockHeightRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AccountBalancesAPIServer).GetAccountAvailableBalanceAtBlockHeight(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flow.access.balances.AccountBalancesAPI/GetAccountAvailableBalanceAtBlockHeight",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AccountBalancesAPIServer).GetAccountAvailableBalanceAtBlockHeight(ctx, req.(*GetAccountBalanceAtBlockHeightRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AccountBalancesAPI_ServiceDesc is the grpc.ServiceDesc for AccountBalancesAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AccountBalancesAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "flow.access.balances.AccountBalancesAPI",
+	HandlerType: (*AccountBalancesAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAccountBalanceAtLatestBlock",
+			Handler:    _AccountBalancesAPI_GetAccountBalanceAtLatestBlock_Handler,
+		},
+		{
+			MethodName: "GetAccountBalanceAtBlockHeight",
+			Handler:    _AccountBalancesAPI_GetAccountBalanceAtBlockHeight_Handler,
+		},
+		{
+			MethodName: "GetAccountAvailableBalanceAtLatestBlock",
+			Handler:    _AccountBalancesAPI_GetAccountAvailableBalanceAtLatestBlock_Handler,
+		},
+		{
+			MethodName: "GetAccountAvailableBalanceAtBlockHeight",
+			Handler:    _AccountBalancesAPI_GetAccountAvailableBalanceAtBlockHeight_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "balances/balances.proto",
+}
@@ -0,0 +1,88 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	accessmock "github.com/onflow/flow-go/access/mock"
+	"github.com/onflow/flow-go/engine/access/rpc/balances"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestAccountBalances tests that the balance queries are forwarded to the access API.
+func TestAccountBalances(t *testing.T) {
+	chain := flow.Testnet.Chain()
+	address := unittest.AddressFixture()
+	height := uint64(42)
+	ctx := context.Background()
+
+	latestReq := &balances.GetAccountBalanceAtLatestBlockRequest{Address: address.Bytes()}
+	heightReq := &balances.GetAccountBalanceAtBlockHeightRequest{Address: address.Bytes(), BlockHeight: height}
+
+	t.Run("balance at latest block", func(t *testing.T) {
+		api := accessmock.NewAPI(t)
+		h := &accountBalancesHandler{api: api, chain: chain}
+
+		api.On("GetAccountBalanceAtLatestBlock", ctx, address).Return(uint64(100), nil)
+
+		resp, err := h.GetAccountBalanceAtLatestBlock(ctx, latestReq)
+		require.NoError(t, err)
+		require.Equal(t, uint64(100), resp.GetBalance())
+	})
+
+	t.Run("balance at block height", func(t *testing.T) {
+		api := accessmock.NewAPI(t)
+		h := &accountBalancesHandler{api: api, chain: chain}
+
+		api.On("GetAccountBalanceAtBlockHeight", ctx, address, height).Return(uint64(200), nil)
+
+		resp, err := h.GetAccountBalanceAtBlockHeight(ctx, heightReq)
+		require.NoError(t, err)
+		require.Equal(t, uint64(200), resp.GetBalance())
+	})
+
+	t.Run("available balance at latest block", func(t *testing.T) {
+		api := accessmock.NewAPI(t)
+		h := &accountBalancesHandler{api: api, chain: chain}
+
+		api.On("GetAccountAvailableBalanceAtLatestBlock", ctx, address).Return(uint64(50), nil)
+
+		resp, err := h.GetAccountAvailableBalanceAtLatestBlock(ctx, latestReq)
+		require.NoError(t, err)
+		require.Equal(t, uint64(50), resp.GetBalance())
+	})
+
+	t.Run("available balance at block height", func(t *testing.T) {
+		api := accessmock.NewAPI(t)
+		h := &accountBalancesHandler{api: api, chain: chain}
+
+		api.On("GetAccountAvailableBalanceAtBlockHeight", ctx, address, height).Return(uint64(150), nil)
+
+		resp, err := h.GetAccountAvailableBalanceAtBlockHeight(ctx, heightReq)
+		require.NoError(t, err)
+		require.Equal(t, uint64(150), resp.GetBalance())
+	})
+
+	t.Run("backend error", func(t *testing.T) {
+		api := accessmock.NewAPI(t)
+		h := &accountBalancesHandler{api: api, chain: chain}
+
+		expected := status.Error(codes.NotFound, "block not found")
+		api.On("GetAccountBalanceAtBlockHeight", ctx, address, height).Return(uint64(0), expected)
+
+		_, err := h.GetAccountBalanceAtBlockHeight(ctx, heightReq)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("invalid address", func(t *testing.T) {
+		h := &accountBalancesHandler{api: accessmock.NewAPI(t), chain: chain}
+
+		_, err := h.GetAccountBalanceAtLatestBlock(ctx, &balances.GetAccountBalanceAtLatestBlockRequest{})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/onflow/flow/protobuf/go/flow/execution"
 	"github.com/rs/zerolog"
 
+	"github.com/onflow/flow-go/engine/execution/rpc/profiles"
 	"github.com/onflow/flow-go/module"
 )
 
@@ -27,6 +28,9 @@ type ConnectionFactory interface {
 	// GetExecutionAPIClient gets an execution API client for the specified address using the default ExecutionGRPCPort.
 	// The returned io.Closer should close the connection after the call if no error occurred during client creation.
 	GetExecutionAPIClient(address string) (execution.ExecutionAPIClient, io.Closer, error)
+	// GetTransactionProfilesAPIClient gets a transaction profiles API client for the specified address using the default ExecutionGRPCPort.
+	// The returned io.Closer should close the connection after the call if no error occurred during client creation.
+	GetTransactionProfilesAPIClient(address string) (profiles.TransactionProfilesAPIClient, io.Closer, error)
 }
 
 // ProxyConnectionFactory wraps an existing ConnectionFactory and allows getting API clients for a target address.
@@ -48,6 +52,12 @@ func (p *ProxyConnectionFactory) GetExecutionAPIClient(address string) (executio
 	return p.ConnectionFactory.GetExecutionAPIClient(p.targetAddress)
 }
 
+// GetTransactionProfilesAPIClient gets a transaction profiles API client for a target address using the default ExecutionGRPCPort.
+// The returned io.Closer should close the connection after the call if no error occurred during client creation.
+func (p *ProxyConnectionFactory) GetTransactionProfilesAPIClient(address string) (profiles.TransactionProfilesAPIClient, io.Closer, error) {
+	return p.ConnectionFactory.GetTransactionProfilesAPIClient(p.targetAddress)
+}
+
 var _ ConnectionFactory = (*ConnectionFactoryImpl)(nil)
 
 type ConnectionFactoryImpl struct {
@@ -99,6 +109,22 @@ func (cf *ConnectionFactoryImpl) GetExecutionAPIClient(address string) (executio
 	return execution.NewExecutionAPIClient(conn), closer, nil
 }
 
+// GetTransactionProfilesAPIClient gets a transaction profiles API client for the specified address using the ExecutionGRPCPort.
+// The returned io.Closer should close the connection after the call if no error occurred during client creation.
+func (cf *ConnectionFactoryImpl) GetTransactionProfilesAPIClient(address string) (profiles.TransactionProfilesAPIClient, io.Closer, error) {
+	grpcAddress, err := getGRPCAddress(address, cf.ExecutionGRPCPort)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	conn, closer, err := cf.Manager.GetConnection(grpcAddress, cf.ExecutionNodeGRPCTimeout, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return profiles.NewTransactionProfilesAPIClient(conn), closer, nil
+}
+
 // getGRPCAddress translates the flow.Identity address to the GRPC address of the node by switching the port to the
 // GRPC port from the libp2p port.
 func getGRPCAddress(address string, grpcPort uint) (string, error) {
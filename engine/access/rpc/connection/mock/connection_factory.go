@@ -12,6 +12,8 @@ import (
 	io "io"
 
 	mock "github.com/stretchr/testify/mock"
+
+	profiles "github.com/onflow/flow-go/engine/execution/rpc/profiles"
 )
 
 // ConnectionFactory is an autogenerated mock type for the ConnectionFactory type
@@ -124,6 +126,41 @@ func (_m *ConnectionFactory) GetExecutionAPIClient(address string) (execution.Ex
 	return r0, r1, r2
 }
 
+// GetTransactionProfilesAPIClient provides a mock function with given fields: address
+func (_m *ConnectionFactory) GetTransactionProfilesAPIClient(address string) (profiles.TransactionProfilesAPIClient, io.Closer, error) {
+	ret := _m.Called(address)
+
+	var r0 profiles.TransactionProfilesAPIClient
+	var r1 io.Closer
+	var r2 error
+	if rf, ok := ret.Get(0).(func(string) (profiles.TransactionProfilesAPIClient, io.Closer, error)); ok {
+		return rf(address)
+	}
+	if rf, ok := ret.Get(0).(func(string) profiles.TransactionProfilesAPIClient); ok {
+		r0 = rf(address)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(profiles.TransactionProfilesAPIClient)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) io.Closer); ok {
+		r1 = rf(address)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(io.Closer)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(string) error); ok {
+		r2 = rf(address)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 type mockConstructorTestingTNewConnectionFactory interface {
 	mock.TestingT
 	Cleanup(func())
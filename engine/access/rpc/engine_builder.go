@@ -8,6 +8,9 @@ import (
 	"github.com/onflow/flow-go/access"
 	legacyaccess "github.com/onflow/flow-go/access/legacy"
 	"github.com/onflow/flow-go/consensus/hotstuff"
+	"github.com/onflow/flow-go/engine/access/rpc/accounttransactions"
+	"github.com/onflow/flow-go/engine/access/rpc/balances"
+	"github.com/onflow/flow-go/engine/access/rpc/eventindex"
 	"github.com/onflow/flow-go/module"
 
 	accessproto "github.com/onflow/flow/protobuf/go/flow/access"
@@ -106,5 +109,17 @@ func (builder *RPCEngineBuilder) Build() (*Engine, error) {
 	}
 	accessproto.RegisterAccessAPIServer(builder.unsecureGrpcServer.Server, rpcHandler)
 	accessproto.RegisterAccessAPIServer(builder.secureGrpcServer.Server, rpcHandler)
+
+	balancesHandler := &accountBalancesHandler{api: builder.Engine.backend, chain: builder.Engine.chain}
+	balances.RegisterAccountBalancesAPIServer(builder.unsecureGrpcServer.Server, balancesHandler)
+	balances.RegisterAccountBalancesAPIServer(builder.secureGrpcServer.Server, balancesHandler)
+
+	eventIndexHandler := &eventIndexHandler{api: builder.Engine.backend, chain: builder.Engine.chain}
+	eventindex.RegisterEventIndexAPIServer(builder.unsecureGrpcServer.Server, eventIndexHandler)
+	eventindex.RegisterEventIndexAPIServer(builder.secureGrpcServer.Server, eventIndexHandler)
+
+	accountTxsHandler := &accountTransactionsHandler{api: builder.Engine.backend, chain: builder.Engine.chain}
+	accounttransactions.RegisterAccountTransactionsAPIServer(builder.unsecureGrpcServer.Server, accountTxsHandler)
+	accounttransactions.RegisterAccountTransactionsAPIServer(builder.secureGrpcServer.Server, accountTxsHandler)
 	return builder.Engine, nil
 }
@@ -0,0 +1,103 @@
+package rpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/access"
+	"github.com/onflow/flow-go/engine/access/rpc/eventindex"
+	"github.com/onflow/flow-go/engine/common/rpc/convert"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+)
+
+// eventIndexHandler implements the EventIndexAPI.
+type eventIndexHandler struct {
+	eventindex.UnimplementedEventIndexAPIServer
+
+	api   access.API
+	chain flow.Chain
+}
+
+var _ eventindex.EventIndexAPIServer = (*eventIndexHandler)(nil)
+
+// GetEventsByEventType returns a page of events of the given type, in the order they were emitted.
+func (h *eventIndexHandler) GetEventsByEventType(
+	ctx context.Context,
+	req *eventindex.GetEventsByEventTypeRequest,
+) (*eventindex.EventsPageResponse, error) {
+	eventType, err := convert.EventType(req.GetType())
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := messageToEventCursor(req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := h.api.GetEventsByEventType(ctx, eventType, cursor, uint(req.GetLimit()), req.GetEventEncodingVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	return eventsPageToMessage(page)
+}
+
+// GetEventsByContractAddress returns a page of events emitted by the contracts deployed to the given
+// address, in the order they were emitted.
+func (h *eventIndexHandler) GetEventsByContractAddress(
+	ctx context.Context,
+	req *eventindex.GetEventsByContractAddressRequest,
+) (*eventindex.EventsPageResponse, error) {
+	address, err := convert.Address(req.GetAddress(), h.chain)
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := messageToEventCursor(req.GetCursor())
+	if err != nil {
+		return nil, err
+	}
+
+	page, err := h.api.GetEventsByContractAddress(ctx, address, cursor, uint(req.GetLimit()), req.GetEventEncodingVersion())
+	if err != nil {
+		return nil, err
+	}
+
+	return eventsPageToMessage(page)
+}
+
+// messageToEventCursor converts the cursor of a request. Requests must provide a cursor, since there
+// is no default position to start a query at.
+func messageToEventCursor(m *eventindex.EventCursor) (storage.EventCursor, error) {
+	if m == nil {
+		return storage.EventCursor{}, status.Error(codes.InvalidArgument, "cursor is required")
+	}
+
+	return storage.EventCursor{
+		Height:           m.GetHeight(),
+		TransactionIndex: m.GetTransactionIndex(),
+		EventIndex:       m.GetEventIndex(),
+	}, nil
+}
+
+func eventsPageToMessage(page *access.EventsPage) (*eventindex.EventsPageResponse, error) {
+	results, err := convert.BlockEventsToMessages(page.Events)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert events: %v", err)
+	}
+
+	response := &eventindex.EventsPageResponse{Results: results}
+	if page.NextCursor != nil {
+		response.NextCursor = &eventindex.EventCursor{
+			Height:           page.NextCursor.Height,
+			TransactionIndex: page.NextCursor.TransactionIndex,
+			EventIndex:       page.NextCursor.EventIndex,
+		}
+	}
+
+	return response, nil
+}
@@ -0,0 +1,147 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: eventindex/eventindex.proto
+
+package eventindex
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// EventIndexAPIClient is the client API for EventIndexAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type EventIndexAPIClient interface {
+	// GetEventsByEventType returns a page of events of the given type, in the order they were emitted.
+	GetEventsByEventType(ctx context.Context, in *GetEventsByEventTypeRequest, opts ...grpc.CallOption) (*EventsPageResponse, error)
+	// GetEventsByContractAddress returns a page of events emitted by the contracts deployed to the given
+	// address, in the order they were emitted.
+	GetEventsByContractAddress(ctx context.Context, in *GetEventsByContractAddressRequest, opts ...grpc.CallOption) (*EventsPageResponse, error)
+}
+
+type eventIndexAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEventIndexAPIClient(cc grpc.ClientConnInterface) EventIndexAPIClient {
+	return &eventIndexAPIClient{cc}
+}
+
+func (c *eventIndexAPIClient) GetEventsByEventType(ctx context.Context, in *GetEventsByEventTypeRequest, opts ...grpc.CallOption) (*EventsPageResponse, error) {
+	out := new(EventsPageResponse)
+	err := c.cc.Invoke(ctx, "/flow.access.eventindex.EventIndexAPI/GetEventsByEventType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *eventIndexAPIClient) GetEventsByContractAddress(ctx context.Context, in *GetEventsByContractAddressRequest, opts ...grpc.CallOption) (*EventsPageResponse, error) {
+	out := new(EventsPageResponse)
+	err := c.cc.Invoke(ctx, "/flow.access.eventindex.EventIndexAPI/GetEventsByContractAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// EventIndexAPIServer is the server API for EventIndexAPI service.
+// All implementations must embed UnimplementedEventIndexAPIServer
+// for forward compatibility
+type EventIndexAPIServer interface {
+	// GetEventsByEventType returns a page of events of the given type, in the order they were emitted.
+	GetEventsByEventType(context.Context, *GetEventsByEventTypeRequest) (*EventsPageResponse, error)
+	// GetEventsByContractAddress returns a page of events emitted by the contracts deployed to the given
+	// address, in the order they were emitted.
+	GetEventsByContractAddress(context.Context, *GetEventsByContractAddressRequest) (*EventsPageResponse, error)
+	mustEmbedUnimplementedEventIndexAPIServer()
+}
+
+// UnimplementedEventIndexAPIServer must be embedded to have forward compatible implementations.
+type UnimplementedEventIndexAPIServer struct {
+}
+
+func (UnimplementedEventIndexAPIServer) GetEventsByEventType(context.Context, *GetEventsByEventTypeRequest) (*EventsPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventsByEventType not implemented")
+}
+func (UnimplementedEventIndexAPIServer) GetEventsByContractAddress(context.Context, *GetEventsByContractAddressRequest) (*EventsPageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEventsByContractAddress not implemented")
+}
+func (UnimplementedEventIndexAPIServer) mustEmbedUnimplementedEventIndexAPIServer() {}
+
+// UnsafeEventIndexAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EventIndexAPIServer will
+// result in compilation errors.
+type UnsafeEventIndexAPIServer interface {
+	mustEmbedUnimplementedEventIndexAPIServer()
+}
+
+func RegisterEventIndexAPIServer(s grpc.ServiceRegistrar, srv EventIndexAPIServer) {
+	s.RegisterService(&EventIndexAPI_ServiceDesc, srv)
+}
+
+func _EventIndexAPI_GetEventsByEventType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventsByEventTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventIndexAPIServer).GetEventsByEventType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flow.access.eventindex.EventIndexAPI/GetEventsByEventType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventIndexAPIServer).GetEventsByEventType(ctx, req.(*GetEventsByEventTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EventIndexAPI_GetEventsByContractAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventsByContractAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EventIndexAPIServer).GetEventsByContractAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flow.access.eventindex.EventIndexAPI/GetEventsByContractAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EventIndexAPIServer).GetEventsByContractAddress(ctx, req.(*GetEventsByContractAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// EventIndexAPI_ServiceDesc is the grpc.ServiceDesc for EventIndexAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EventIndexAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "flow.access.eventindex.EventIndexAPI",
+	HandlerType: (*EventIndexAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetEventsByEventType",
+			Handler:    _EventIndexAPI_GetEventsByEventType_Handler,
+		},
+		{
+			MethodName: "GetEventsByContractAddress",
+			Handler:    _EventIndexAPI_GetEventsByContractAddress_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "eventindex/eventindex.proto",
+}
@@ -0,0 +1,136 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onflow/flow/protobuf/go/flow/entities"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/access"
+	accessmock "github.com/onflow/flow-go/access/mock"
+	"github.com/onflow/flow-go/engine/access/rpc/eventindex"
+	"github.com/onflow/flow-go/engine/common/rpc/convert"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestEventIndex tests that the event index queries are forwarded to the access API, and that the
+// pages are converted with their next cursor.
+func TestEventIndex(t *testing.T) {
+	chain := flow.Testnet.Chain()
+	address := unittest.AddressFixture()
+	eventType := flow.EventType("A.0000000000000001.Contract.Event")
+	encoding := entities.EventEncodingVersion_CCF_V0
+	ctx := context.Background()
+
+	header := unittest.BlockHeaderFixture()
+	page := &access.EventsPage{
+		Events: []flow.BlockEvents{{
+			BlockID:        header.ID(),
+			BlockHeight:    header.Height,
+			BlockTimestamp: header.Timestamp,
+			Events:         unittest.EventsFixture(2),
+		}},
+		NextCursor: &storage.EventCursor{Height: header.Height + 1, TransactionIndex: 2, EventIndex: 3},
+	}
+	cursor := storage.EventCursor{Height: header.Height}
+	cursorMsg := &eventindex.EventCursor{Height: header.Height}
+
+	requirePage := func(t *testing.T, resp *eventindex.EventsPageResponse) {
+		expected, err := convert.BlockEventsToMessages(page.Events)
+		require.NoError(t, err)
+		require.Equal(t, expected, resp.GetResults())
+		require.Equal(t, page.NextCursor.Height, resp.GetNextCursor().GetHeight())
+		require.Equal(t, page.NextCursor.TransactionIndex, resp.GetNextCursor().GetTransactionIndex())
+		require.Equal(t, page.NextCursor.EventIndex, resp.GetNextCursor().GetEventIndex())
+	}
+
+	t.Run("events by event type", func(t *testing.T) {
+		api := accessmock.NewAPI(t)
+		h := &eventIndexHandler{api: api, chain: chain}
+
+		api.On("GetEventsByEventType", ctx, string(eventType), cursor, uint(10), encoding).Return(page, nil)
+
+		resp, err := h.GetEventsByEventType(ctx, &eventindex.GetEventsByEventTypeRequest{
+			Type:                 string(eventType),
+			Cursor:               cursorMsg,
+			Limit:                10,
+			EventEncodingVersion: encoding,
+		})
+		require.NoError(t, err)
+		requirePage(t, resp)
+	})
+
+	t.Run("events by contract address", func(t *testing.T) {
+		api := accessmock.NewAPI(t)
+		h := &eventIndexHandler{api: api, chain: chain}
+
+		api.On("GetEventsByContractAddress", ctx, address, cursor, uint(10), encoding).Return(page, nil)
+
+		resp, err := h.GetEventsByContractAddress(ctx, &eventindex.GetEventsByContractAddressRequest{
+			Address:              address.Bytes(),
+			Cursor:               cursorMsg,
+			Limit:                10,
+			EventEncodingVersion: encoding,
+		})
+		require.NoError(t, err)
+		requirePage(t, resp)
+	})
+
+	t.Run("last page has no next cursor", func(t *testing.T) {
+		api := accessmock.NewAPI(t)
+		h := &eventIndexHandler{api: api, chain: chain}
+
+		api.On("GetEventsByEventType", ctx, string(eventType), cursor, uint(10), encoding).
+			Return(&access.EventsPage{Events: page.Events}, nil)
+
+		resp, err := h.GetEventsByEventType(ctx, &eventindex.GetEventsByEventTypeRequest{
+			Type:                 string(eventType),
+			Cursor:               cursorMsg,
+			Limit:                10,
+			EventEncodingVersion: encoding,
+		})
+		require.NoError(t, err)
+		require.Nil(t, resp.GetNextCursor())
+	})
+
+	t.Run("backend error", func(t *testing.T) {
+		api := accessmock.NewAPI(t)
+		h := &eventIndexHandler{api: api, chain: chain}
+
+		expected := status.Error(codes.FailedPrecondition, "event index queries require execution data indexing to be enabled")
+		api.On("GetEventsByEventType", ctx, string(eventType), cursor, uint(10), encoding).Return(nil, expected)
+
+		_, err := h.GetEventsByEventType(ctx, &eventindex.GetEventsByEventTypeRequest{
+			Type:                 string(eventType),
+			Cursor:               cursorMsg,
+			Limit:                10,
+			EventEncodingVersion: encoding,
+		})
+		require.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("missing cursor", func(t *testing.T) {
+		h := &eventIndexHandler{api: accessmock.NewAPI(t), chain: chain}
+
+		_, err := h.GetEventsByEventType(ctx, &eventindex.GetEventsByEventTypeRequest{
+			Type:  string(eventType),
+			Limit: 10,
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("invalid address", func(t *testing.T) {
+		h := &eventIndexHandler{api: accessmock.NewAPI(t), chain: chain}
+
+		_, err := h.GetEventsByContractAddress(ctx, &eventindex.GetEventsByContractAddressRequest{
+			Cursor: cursorMsg,
+			Limit:  10,
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
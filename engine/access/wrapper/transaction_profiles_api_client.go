@@ -0,0 +1,11 @@
+package wrapper
+
+import (
+	"github.com/onflow/flow-go/engine/execution/rpc/profiles"
+)
+
+// TransactionProfilesAPIClient allows for generation of a mock (via mockery) for the generated
+// TransactionProfilesAPIClient of the execution node
+type TransactionProfilesAPIClient interface {
+	profiles.TransactionProfilesAPIClient
+}
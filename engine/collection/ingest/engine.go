@@ -61,7 +61,7 @@ func New(
 	logger := log.With().Str("engine", "ingest").Logger()
 
 	transactionValidator := access.NewTransactionValidatorWithLimiter(
-		access.NewProtocolStateBlocks(state),
+		access.NewProtocolStateBlocks(state, nil),
 		chain,
 		access.TransactionValidationOptions{
 			Expiry:                 flow.DefaultTransactionExpiry,
@@ -356,7 +356,8 @@ func (e *Engine) ingestTransaction(
 	}
 
 	// check if the transaction is valid
-	err = e.transactionValidator.Validate(tx)
+	// the context is only used by the payer balance check, which is not performed by collection nodes
+	err = e.transactionValidator.Validate(context.Background(), tx)
 	if err != nil {
 		return engine.NewInvalidInputErrorf("invalid transaction (%x): %w", txID, err)
 	}
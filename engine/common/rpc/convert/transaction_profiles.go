@@ -0,0 +1,112 @@
+package convert
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/onflow/cadence/runtime/common"
+
+	"github.com/onflow/flow-go/engine/execution/rpc/profiles"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// TransactionProfileToMessage converts a flow.TransactionProfile to a protobuf message
+func TransactionProfileToMessage(p flow.TransactionProfile) *profiles.TransactionProfile {
+	return &profiles.TransactionProfile{
+		TransactionId:          IdentifierToMessage(p.TransactionID),
+		ComputationUsed:        p.ComputationUsed,
+		ComputationIntensities: intensitiesToMessages(p.ComputationIntensities, ComputationKindName),
+		MemoryEstimate:         p.MemoryEstimate,
+		MemoryIntensities:      intensitiesToMessages(p.MemoryIntensities, MemoryKindName),
+		RegistersRead:          p.RegistersRead,
+		RegistersWritten:       p.RegistersWritten,
+		BytesRead:              p.BytesRead,
+		BytesWritten:           p.BytesWritten,
+		EventsSize:             p.EventsSize,
+		ExecutionTimeNanos:     uint64(p.ExecutionTime.Nanoseconds()),
+	}
+}
+
+// TransactionProfilesToMessages converts a slice of flow.TransactionProfile to a slice of protobuf messages
+func TransactionProfilesToMessages(ps []flow.TransactionProfile) []*profiles.TransactionProfile {
+	messages := make([]*profiles.TransactionProfile, len(ps))
+	for i, p := range ps {
+		messages[i] = TransactionProfileToMessage(p)
+	}
+	return messages
+}
+
+// MessageToTransactionProfile converts a protobuf message to a flow.TransactionProfile
+func MessageToTransactionProfile(m *profiles.TransactionProfile) (*flow.TransactionProfile, error) {
+	if m == nil {
+		return nil, ErrEmptyMessage
+	}
+
+	txID, err := TransactionID(m.GetTransactionId())
+	if err != nil {
+		return nil, fmt.Errorf("invalid transaction id: %w", err)
+	}
+
+	return &flow.TransactionProfile{
+		TransactionID:          txID,
+		ComputationUsed:        m.GetComputationUsed(),
+		ComputationIntensities: messagesToIntensities(m.GetComputationIntensities()),
+		MemoryEstimate:         m.GetMemoryEstimate(),
+		MemoryIntensities:      messagesToIntensities(m.GetMemoryIntensities()),
+		RegistersRead:          m.GetRegistersRead(),
+		RegistersWritten:       m.GetRegistersWritten(),
+		BytesRead:              m.GetBytesRead(),
+		BytesWritten:           m.GetBytesWritten(),
+		EventsSize:             m.GetEventsSize(),
+		ExecutionTime:          time.Duration(m.GetExecutionTimeNanos()),
+	}, nil
+}
+
+// MessagesToTransactionProfiles converts a slice of protobuf messages to a slice of flow.TransactionProfile
+func MessagesToTransactionProfiles(ms []*profiles.TransactionProfile) ([]flow.TransactionProfile, error) {
+	ps := make([]flow.TransactionProfile, len(ms))
+	for i, m := range ms {
+		p, err := MessageToTransactionProfile(m)
+		if err != nil {
+			return nil, fmt.Errorf("invalid transaction profile at index %d: %w", i, err)
+		}
+		ps[i] = *p
+	}
+	return ps, nil
+}
+
+// ComputationKindName returns the name of the given Cadence computation kind
+func ComputationKindName(kind uint) string {
+	return common.ComputationKind(kind).String()
+}
+
+// MemoryKindName returns the name of the given Cadence memory kind
+func MemoryKindName(kind uint) string {
+	return common.MemoryKind(kind).String()
+}
+
+// intensitiesToMessages converts the intensities of computation or memory kinds
+// to protobuf messages, ordered by kind.
+func intensitiesToMessages(intensities map[uint]uint, name func(uint) string) []*profiles.Intensity {
+	messages := make([]*profiles.Intensity, 0, len(intensities))
+	for kind, intensity := range intensities {
+		messages = append(messages, &profiles.Intensity{
+			Kind:      uint32(kind),
+			Name:      name(kind),
+			Intensity: uint64(intensity),
+		})
+	}
+	sort.Slice(messages, func(i, j int) bool {
+		return messages[i].Kind < messages[j].Kind
+	})
+	return messages
+}
+
+func messagesToIntensities(messages []*profiles.Intensity) map[uint]uint {
+	intensities := make(map[uint]uint, len(messages))
+	for _, m := range messages {
+		intensities[uint(m.GetKind())] = uint(m.GetIntensity())
+	}
+	return intensities
+}
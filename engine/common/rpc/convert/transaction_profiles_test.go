@@ -0,0 +1,33 @@
+package convert_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/engine/common/rpc/convert"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestConvertTransactionProfiles tests converting transaction profiles to and from protobuf messages.
+func TestConvertTransactionProfiles(t *testing.T) {
+	t.Parallel()
+
+	profiles := unittest.TransactionProfilesFixture(5)
+
+	messages := convert.TransactionProfilesToMessages(profiles)
+	require.Len(t, messages, len(profiles))
+
+	// intensities are ordered by kind, and named
+	intensities := messages[2].GetComputationIntensities()
+	require.Len(t, intensities, 2)
+	assert.Equal(t, uint32(1), intensities[0].GetKind())
+	assert.Equal(t, convert.ComputationKindName(1), intensities[0].GetName())
+	assert.Equal(t, uint64(2), intensities[0].GetIntensity())
+	assert.Equal(t, uint32(3), intensities[1].GetKind())
+
+	converted, err := convert.MessagesToTransactionProfiles(messages)
+	require.NoError(t, err)
+	assert.Equal(t, profiles, converted)
+}
@@ -59,8 +59,41 @@ func (interceptor *rateLimiterInterceptor) UnaryServerInterceptor(ctx context.Co
 	info *grpc.UnaryServerInfo,
 	handler grpc.UnaryHandler) (resp interface{}, err error) {
 
+	// check if request within limit
+	if !interceptor.allow(info.FullMethod, req) {
+		// reject the request
+		return nil, status.Errorf(codes.ResourceExhausted, "%s rate limit reached, please retry later.",
+			info.FullMethod)
+	}
+
+	// call the handler
+	h, err := handler(ctx, req)
+
+	return h, err
+}
+
+// StreamServerInterceptor rate limits the given stream based on the limits defined when creating the rateLimiterInterceptor.
+// The limit is applied once per stream, when the stream is opened.
+func (interceptor *rateLimiterInterceptor) StreamServerInterceptor(srv interface{},
+	stream grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+
+	// check if stream within limit
+	if !interceptor.allow(info.FullMethod, nil) {
+		// reject the stream
+		return status.Errorf(codes.ResourceExhausted, "%s rate limit reached, please retry later.",
+			info.FullMethod)
+	}
+
+	// call the handler
+	return handler(srv, stream)
+}
+
+// allow returns true if a call of the given method is within the rate limit of the method.
+func (interceptor *rateLimiterInterceptor) allow(fullMethod string, req interface{}) bool {
 	// remove the package name (e.g. "/flow.access.AccessAPI/Ping" to "Ping")
-	methodName := filepath.Base(info.FullMethod)
+	methodName := filepath.Base(fullMethod)
 
 	// look up the limiter
 	limiter := interceptor.methodLimiterMap[methodName]
@@ -73,23 +106,16 @@ func (interceptor *rateLimiterInterceptor) UnaryServerInterceptor(ctx context.Co
 		limiter = interceptor.defaultLimiter
 	}
 
-	// check if request within limit
-	if !limiter.Allow() {
-
-		// log the limit violation
-		interceptor.log.Trace().
-			Str("method", methodName).
-			Interface("request", req).
-			Float64("limit", float64(limiter.Limit())).
-			Msg("rate limit exceeded")
-
-		// reject the request
-		return nil, status.Errorf(codes.ResourceExhausted, "%s rate limit reached, please retry later.",
-			info.FullMethod)
+	if limiter.Allow() {
+		return true
 	}
 
-	// call the handler
-	h, err := handler(ctx, req)
+	// log the limit violation
+	interceptor.log.Trace().
+		Str("method", methodName).
+		Interface("request", req).
+		Float64("limit", float64(limiter.Limit())).
+		Msg("rate limit exceeded")
 
-	return h, err
+	return false
 }
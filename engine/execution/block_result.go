@@ -75,6 +75,17 @@ func (er *BlockExecutionResult) AllTransactionResults() flow.TransactionResults
 	return res
 }
 
+// AllTransactionProfiles returns the resource profiles of all executed transactions, ordered by transaction index
+func (er *BlockExecutionResult) AllTransactionProfiles() []flow.TransactionProfile {
+	res := make([]flow.TransactionProfile, 0)
+	for _, ce := range er.collectionExecutionResults {
+		if len(ce.transactionProfiles) > 0 {
+			res = append(res, ce.transactionProfiles...)
+		}
+	}
+	return res
+}
+
 func (er *BlockExecutionResult) AllExecutionSnapshots() []*snapshot.ExecutionSnapshot {
 	res := make([]*snapshot.ExecutionSnapshot, 0)
 	for _, ce := range er.collectionExecutionResults {
@@ -12,6 +12,7 @@ type CollectionExecutionResult struct {
 	serviceEvents          flow.EventsList
 	convertedServiceEvents flow.ServiceEventList
 	transactionResults     flow.TransactionResults
+	transactionProfiles    []flow.TransactionProfile
 	executionSnapshot      *snapshot.ExecutionSnapshot
 }
 
@@ -22,6 +23,7 @@ func NewEmptyCollectionExecutionResult() *CollectionExecutionResult {
 		serviceEvents:          make(flow.EventsList, 0),
 		convertedServiceEvents: make(flow.ServiceEventList, 0),
 		transactionResults:     make(flow.TransactionResults, 0),
+		transactionProfiles:    make([]flow.TransactionProfile, 0),
 	}
 }
 
@@ -37,6 +39,11 @@ func (c *CollectionExecutionResult) AppendTransactionResults(
 	c.transactionResults = append(c.transactionResults, transactionResult)
 }
 
+// AppendTransactionProfile appends the resource profile of an executed transaction
+func (c *CollectionExecutionResult) AppendTransactionProfile(profile flow.TransactionProfile) {
+	c.transactionProfiles = append(c.transactionProfiles, profile)
+}
+
 func (c *CollectionExecutionResult) UpdateExecutionSnapshot(
 	executionSnapshot *snapshot.ExecutionSnapshot,
 ) {
@@ -63,6 +70,10 @@ func (c *CollectionExecutionResult) TransactionResults() flow.TransactionResults
 	return c.transactionResults
 }
 
+func (c *CollectionExecutionResult) TransactionProfiles() []flow.TransactionProfile {
+	return c.transactionProfiles
+}
+
 // CollectionAttestationResult holds attestations generated during post-processing
 // phase of collect execution.
 type CollectionAttestationResult struct {
@@ -218,6 +218,7 @@ func (e *blockComputer) queueTransactionRequests(
 	collectionCtx := fvm.NewContextFromParent(
 		e.vmCtx,
 		fvm.WithBlockHeader(blockHeader),
+		fvm.WithEVMTracer(e.vmCtx.EVMTracer.WithBlockID(blockId)),
 		// `protocol.Snapshot` implements `EntropyProvider` interface
 		// Note that `Snapshot` possible errors for RandomSource() are:
 		// - storage.ErrNotFound if the QC is unknown.
@@ -259,6 +260,7 @@ func (e *blockComputer) queueTransactionRequests(
 	systemCtx := fvm.NewContextFromParent(
 		e.systemChunkCtx,
 		fvm.WithBlockHeader(blockHeader),
+		fvm.WithEVMTracer(e.systemChunkCtx.EVMTracer.WithBlockID(blockId)),
 		// `protocol.Snapshot` implements `EntropyProvider` interface
 		// Note that `Snapshot` possible errors for RandomSource() are:
 		// - storage.ErrNotFound if the QC is unknown.
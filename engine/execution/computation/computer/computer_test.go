@@ -577,6 +577,15 @@ func TestBlockExecutor_ExecuteBlock(t *testing.T) {
 		txResults := result.AllTransactionResults()
 		assert.ElementsMatch(t, expectedResults, txResults[0:len(txResults)-1]) // strip system chunk
 
+		// every transaction has a profile, in the same order as the transaction results
+		txProfiles := result.AllTransactionProfiles()
+		require.Len(t, txProfiles, len(txResults))
+		for i, profile := range txProfiles {
+			assert.Equal(t, txResults[i].TransactionID, profile.TransactionID)
+			assert.Equal(t, txResults[i].ComputationUsed, profile.ComputationUsed)
+			assert.Equal(t, txResults[i].MemoryUsed, profile.MemoryEstimate)
+		}
+
 		assertEventHashesMatch(t, collectionCount+1, result)
 
 		assert.GreaterOrEqual(t, vm.CallCount(), totalTransactionCount)
@@ -1535,6 +1544,28 @@ func (testVM) GetAccount(
 	panic("not implemented")
 }
 
+func (testVM) GetAccountBalance(
+	_ fvm.Context,
+	_ flow.Address,
+	_ snapshot.StorageSnapshot,
+) (
+	uint64,
+	error,
+) {
+	panic("not implemented")
+}
+
+func (testVM) GetAccountAvailableBalance(
+	_ fvm.Context,
+	_ flow.Address,
+	_ snapshot.StorageSnapshot,
+) (
+	uint64,
+	error,
+) {
+	panic("not implemented")
+}
+
 func generateEvents(eventCount int, txIndex uint32) []flow.Event {
 	events := make([]flow.Event, eventCount)
 	for i := 0; i < eventCount; i++ {
@@ -1611,6 +1642,28 @@ func (errorVM) GetAccount(
 	panic("not implemented")
 }
 
+func (errorVM) GetAccountBalance(
+	ctx fvm.Context,
+	addr flow.Address,
+	storageSnapshot snapshot.StorageSnapshot,
+) (
+	uint64,
+	error,
+) {
+	panic("not implemented")
+}
+
+func (errorVM) GetAccountAvailableBalance(
+	ctx fvm.Context,
+	addr flow.Address,
+	storageSnapshot snapshot.StorageSnapshot,
+) (
+	uint64,
+	error,
+) {
+	panic("not implemented")
+}
+
 func getSetAProgram(
 	t *testing.T,
 	txnState storage.TransactionPreparer,
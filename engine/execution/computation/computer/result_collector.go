@@ -297,6 +297,11 @@ func (collector *resultCollector) processTransactionResult(
 			txnResult,
 		)
 
+	collector.result.
+		CollectionExecutionResultAt(txn.collectionIndex).
+		AppendTransactionProfile(
+			newTransactionProfile(txn.ID, txnExecutionSnapshot, output, timeSpent))
+
 	err := collector.currentCollectionState.Merge(txnExecutionSnapshot)
 	if err != nil {
 		return fmt.Errorf("failed to merge into collection view: %w", err)
@@ -316,6 +321,47 @@ func (collector *resultCollector) processTransactionResult(
 		collector.currentCollectionState.Finalize())
 }
 
+// newTransactionProfile builds the resource profile of an executed transaction
+// from its execution snapshot and output.
+func newTransactionProfile(
+	txnID flow.Identifier,
+	txnExecutionSnapshot *snapshot.ExecutionSnapshot,
+	output fvm.ProcedureOutput,
+	timeSpent time.Duration,
+) flow.TransactionProfile {
+	profile := flow.TransactionProfile{
+		TransactionID:          txnID,
+		ComputationUsed:        output.ComputationUsed,
+		ComputationIntensities: make(map[uint]uint, len(output.ComputationIntensities)),
+		MemoryEstimate:         output.MemoryEstimate,
+		MemoryIntensities:      make(map[uint]uint),
+		EventsSize:             uint64(output.Events.ByteSize()),
+		ExecutionTime:          timeSpent,
+	}
+
+	for kind, intensity := range output.ComputationIntensities {
+		profile.ComputationIntensities[uint(kind)] = intensity
+	}
+
+	if txnExecutionSnapshot == nil {
+		return profile
+	}
+
+	profile.RegistersRead = uint64(len(txnExecutionSnapshot.ReadSet))
+	profile.RegistersWritten = uint64(len(txnExecutionSnapshot.WriteSet))
+
+	// the meter may be nil if the snapshot does not support metering
+	if txnExecutionSnapshot.Meter != nil {
+		for kind, intensity := range txnExecutionSnapshot.MemoryIntensities() {
+			profile.MemoryIntensities[uint(kind)] = intensity
+		}
+		profile.BytesRead = txnExecutionSnapshot.TotalBytesReadFromStorage()
+		profile.BytesWritten = txnExecutionSnapshot.TotalBytesWrittenToStorage()
+	}
+
+	return profile
+}
+
 func (collector *resultCollector) AddTransactionResult(
 	request TransactionRequest,
 	snapshot *snapshot.ExecutionSnapshot,
@@ -50,6 +50,28 @@ func (testCoordinatorVM) GetAccount(
 	panic("not implemented")
 }
 
+func (testCoordinatorVM) GetAccountBalance(
+	_ fvm.Context,
+	_ flow.Address,
+	_ snapshot.StorageSnapshot,
+) (
+	uint64,
+	error,
+) {
+	panic("not implemented")
+}
+
+func (testCoordinatorVM) GetAccountAvailableBalance(
+	_ fvm.Context,
+	_ flow.Address,
+	_ snapshot.StorageSnapshot,
+) (
+	uint64,
+	error,
+) {
+	panic("not implemented")
+}
+
 type testCoordinatorExecutor struct {
 	executionTime logical.Time
 }
@@ -561,6 +561,28 @@ func (p *PanickingVM) GetAccount(
 	panic("not expected")
 }
 
+func (p *PanickingVM) GetAccountBalance(
+	ctx fvm.Context,
+	address flow.Address,
+	storageSnapshot snapshot.StorageSnapshot,
+) (
+	uint64,
+	error,
+) {
+	panic("not expected")
+}
+
+func (p *PanickingVM) GetAccountAvailableBalance(
+	ctx fvm.Context,
+	address flow.Address,
+	storageSnapshot snapshot.StorageSnapshot,
+) (
+	uint64,
+	error,
+) {
+	panic("not expected")
+}
+
 type LongRunningExecutor struct {
 	duration time.Duration
 }
@@ -625,6 +647,28 @@ func (l *LongRunningVM) GetAccount(
 	panic("not expected")
 }
 
+func (l *LongRunningVM) GetAccountBalance(
+	ctx fvm.Context,
+	address flow.Address,
+	storageSnapshot snapshot.StorageSnapshot,
+) (
+	uint64,
+	error,
+) {
+	panic("not expected")
+}
+
+func (l *LongRunningVM) GetAccountAvailableBalance(
+	ctx fvm.Context,
+	address flow.Address,
+	storageSnapshot snapshot.StorageSnapshot,
+) (
+	uint64,
+	error,
+) {
+	panic("not expected")
+}
+
 type FakeBlockComputer struct {
 	computationResult *execution.ComputationResult
 }
@@ -41,6 +41,36 @@ type Executor interface {
 		error,
 	)
 
+	// ExecuteScriptWithTransactions executes the script on top of the state of the given block
+	// after applying the given transactions in order. The transactions are executed in a
+	// throwaway snapshot and their changes are never committed.
+	ExecuteScriptWithTransactions(
+		ctx context.Context,
+		script []byte,
+		arguments [][]byte,
+		transactions []*flow.TransactionBody,
+		blockHeader *flow.Header,
+		snapshot snapshot.StorageSnapshot,
+	) (
+		[]byte,
+		[]flow.SpeculativeTransactionResult,
+		uint64,
+		error,
+	)
+
+	// SimulateTransaction executes the transaction on top of the state of the given block without
+	// committing it, and returns its computation used, fee estimate, events, error and storage changes.
+	SimulateTransaction(
+		ctx context.Context,
+		tx *flow.TransactionBody,
+		skipSignatureChecks bool,
+		blockHeader *flow.Header,
+		snapshot snapshot.StorageSnapshot,
+	) (
+		*flow.TransactionSimulationResult,
+		error,
+	)
+
 	GetAccount(
 		ctx context.Context,
 		addr flow.Address,
@@ -50,6 +80,26 @@ type Executor interface {
 		*flow.Account,
 		error,
 	)
+
+	GetAccountBalance(
+		ctx context.Context,
+		addr flow.Address,
+		header *flow.Header,
+		snapshot snapshot.StorageSnapshot,
+	) (
+		uint64,
+		error,
+	)
+
+	GetAccountAvailableBalance(
+		ctx context.Context,
+		addr flow.Address,
+		header *flow.Header,
+		snapshot snapshot.StorageSnapshot,
+	) (
+		uint64,
+		error,
+	)
 }
 
 type QueryConfig struct {
@@ -116,6 +166,140 @@ func (e *QueryExecutor) ExecuteScript(
 	computationUsed uint64,
 	err error,
 ) {
+	return e.executeScript(
+		ctx,
+		script,
+		arguments,
+		blockHeader,
+		e.derivedChainData.NewDerivedBlockDataForScript(blockHeader.ID()),
+		snapshot)
+}
+
+// ExecuteScriptWithTransactions executes the script on top of the state of the given block
+// after applying the given transactions in order.
+//
+// The transactions are executed as if they were the only transactions of a child block, without
+// authorization and sequence number checks, so they do not need to be signed. Their changes are
+// only kept in a throwaway snapshot tree, and the derived data (e.g. programs) they update is
+// never written to the chain's derived data.
+//
+// A failed transaction does not fail the call, its error is returned in its result instead.
+func (e *QueryExecutor) ExecuteScriptWithTransactions(
+	ctx context.Context,
+	script []byte,
+	arguments [][]byte,
+	transactions []*flow.TransactionBody,
+	blockHeader *flow.Header,
+	storageSnapshot snapshot.StorageSnapshot,
+) (
+	encodedValue []byte,
+	results []flow.SpeculativeTransactionResult,
+	computationUsed uint64,
+	err error,
+) {
+	derivedBlockData := e.derivedChainData.NewDerivedBlockDataForScript(blockHeader.ID())
+
+	results, speculativeSnapshot, err := e.applyTransactions(
+		ctx,
+		transactions,
+		blockHeader,
+		derivedBlockData,
+		storageSnapshot)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	encodedValue, computationUsed, err = e.executeScript(
+		ctx,
+		script,
+		arguments,
+		blockHeader,
+		derivedBlockData,
+		speculativeSnapshot)
+	if err != nil {
+		return nil, nil, 0, err
+	}
+
+	return encodedValue, results, computationUsed, nil
+}
+
+// applyTransactions executes the transactions in order on top of the given snapshot, and returns
+// their results together with a snapshot tree containing their changes.
+func (e *QueryExecutor) applyTransactions(
+	ctx context.Context,
+	transactions []*flow.TransactionBody,
+	blockHeader *flow.Header,
+	derivedBlockData *derived.DerivedBlockData,
+	storageSnapshot snapshot.StorageSnapshot,
+) (
+	results []flow.SpeculativeTransactionResult,
+	tree snapshot.SnapshotTree,
+	err error,
+) {
+	defer func() {
+		if r := recover(); r != nil {
+			e.logger.Error().
+				Interface("recovered", r).
+				Msg("speculative transaction execution caused runtime panic")
+
+			err = fmt.Errorf("cadence runtime error: %s", r)
+		}
+	}()
+
+	txCtx := fvm.NewContextFromParent(
+		e.vmCtx,
+		fvm.WithBlockHeader(blockHeader),
+		fvm.WithEntropyProvider(e.entropyPerBlock.AtBlockID(blockHeader.ID())),
+		fvm.WithDerivedBlockData(derivedBlockData),
+		fvm.WithAuthorizationChecksEnabled(false),
+		fvm.WithSequenceNumberCheckAndIncrementEnabled(false))
+
+	tree = snapshot.NewSnapshotTree(storageSnapshot)
+	results = make([]flow.SpeculativeTransactionResult, 0, len(transactions))
+
+	for i, tx := range transactions {
+		if err := ctx.Err(); err != nil {
+			return nil, tree, fmt.Errorf("speculative transaction execution aborted: %w", err)
+		}
+
+		executionSnapshot, output, err := e.vm.Run(
+			txCtx,
+			fvm.Transaction(tx, uint32(i)),
+			tree)
+		if err != nil {
+			return nil, tree, fmt.Errorf(
+				"failed to execute transaction %d (%s) (internal error): %w", i, tx.ID(), err)
+		}
+
+		result := flow.SpeculativeTransactionResult{
+			TransactionID:   tx.ID(),
+			Events:          output.Events,
+			ComputationUsed: output.ComputationUsed,
+		}
+		if output.Err != nil {
+			result.ErrorMessage = summarizeLog(output.Err.Error(), e.config.MaxErrorMessageSize)
+		}
+		results = append(results, result)
+
+		// like in block execution, the changes of failed transactions (e.g. fee deduction) are kept
+		tree = tree.Append(executionSnapshot)
+	}
+
+	return results, tree, nil
+}
+
+func (e *QueryExecutor) executeScript(
+	ctx context.Context,
+	script []byte,
+	arguments [][]byte,
+	blockHeader *flow.Header,
+	derivedBlockData *derived.DerivedBlockData,
+	snapshot snapshot.StorageSnapshot,
+) (
+	encodedValue []byte,
+	computationUsed uint64,
+	err error,
+) {
 
 	startedAt := time.Now()
 	memAllocBefore := debug.GetHeapAllocsBytes()
@@ -175,8 +359,7 @@ func (e *QueryExecutor) ExecuteScript(
 			e.vmCtx,
 			fvm.WithBlockHeader(blockHeader),
 			fvm.WithEntropyProvider(e.entropyPerBlock.AtBlockID(blockHeader.ID())),
-			fvm.WithDerivedBlockData(
-				e.derivedChainData.NewDerivedBlockDataForScript(blockHeader.ID()))),
+			fvm.WithDerivedBlockData(derivedBlockData)),
 		fvm.NewScriptWithContextAndArgs(script, requestCtx, arguments...),
 		snapshot)
 	if err != nil {
@@ -248,3 +431,61 @@ func (e *QueryExecutor) GetAccount(
 
 	return account, nil
 }
+
+func (e *QueryExecutor) GetAccountBalance(
+	ctx context.Context,
+	address flow.Address,
+	blockHeader *flow.Header,
+	snapshot snapshot.StorageSnapshot,
+) (
+	uint64,
+	error,
+) {
+	balance, err := e.vm.GetAccountBalance(
+		e.newAccountQueryContext(blockHeader),
+		address,
+		snapshot)
+	if err != nil {
+		return 0, fmt.Errorf(
+			"failed to get account balance (%s) at block (%s): %w",
+			address.String(),
+			blockHeader.ID(),
+			err)
+	}
+
+	return balance, nil
+}
+
+func (e *QueryExecutor) GetAccountAvailableBalance(
+	ctx context.Context,
+	address flow.Address,
+	blockHeader *flow.Header,
+	snapshot snapshot.StorageSnapshot,
+) (
+	uint64,
+	error,
+) {
+	balance, err := e.vm.GetAccountAvailableBalance(
+		e.newAccountQueryContext(blockHeader),
+		address,
+		snapshot)
+	if err != nil {
+		return 0, fmt.Errorf(
+			"failed to get account available balance (%s) at block (%s): %w",
+			address.String(),
+			blockHeader.ID(),
+			err)
+	}
+
+	return balance, nil
+}
+
+// newAccountQueryContext returns the fvm context used to read account
+// information at the given block.
+func (e *QueryExecutor) newAccountQueryContext(blockHeader *flow.Header) fvm.Context {
+	return fvm.NewContextFromParent(
+		e.vmCtx,
+		fvm.WithBlockHeader(blockHeader),
+		fvm.WithDerivedBlockData(
+			e.derivedChainData.NewDerivedBlockDataForScript(blockHeader.ID())))
+}
@@ -49,6 +49,48 @@ func (_m *Executor) ExecuteScript(ctx context.Context, script []byte, arguments
 	return r0, r1, r2
 }
 
+// ExecuteScriptWithTransactions provides a mock function with given fields: ctx, script, arguments, transactions, blockHeader, _a5
+func (_m *Executor) ExecuteScriptWithTransactions(ctx context.Context, script []byte, arguments [][]byte, transactions []*flow.TransactionBody, blockHeader *flow.Header, _a5 snapshot.StorageSnapshot) ([]byte, []flow.SpeculativeTransactionResult, uint64, error) {
+	ret := _m.Called(ctx, script, arguments, transactions, blockHeader, _a5)
+
+	var r0 []byte
+	var r1 []flow.SpeculativeTransactionResult
+	var r2 uint64
+	var r3 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, [][]byte, []*flow.TransactionBody, *flow.Header, snapshot.StorageSnapshot) ([]byte, []flow.SpeculativeTransactionResult, uint64, error)); ok {
+		return rf(ctx, script, arguments, transactions, blockHeader, _a5)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, [][]byte, []*flow.TransactionBody, *flow.Header, snapshot.StorageSnapshot) []byte); ok {
+		r0 = rf(ctx, script, arguments, transactions, blockHeader, _a5)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, [][]byte, []*flow.TransactionBody, *flow.Header, snapshot.StorageSnapshot) []flow.SpeculativeTransactionResult); ok {
+		r1 = rf(ctx, script, arguments, transactions, blockHeader, _a5)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]flow.SpeculativeTransactionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, []byte, [][]byte, []*flow.TransactionBody, *flow.Header, snapshot.StorageSnapshot) uint64); ok {
+		r2 = rf(ctx, script, arguments, transactions, blockHeader, _a5)
+	} else {
+		r2 = ret.Get(2).(uint64)
+	}
+
+	if rf, ok := ret.Get(3).(func(context.Context, []byte, [][]byte, []*flow.TransactionBody, *flow.Header, snapshot.StorageSnapshot) error); ok {
+		r3 = rf(ctx, script, arguments, transactions, blockHeader, _a5)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
 // GetAccount provides a mock function with given fields: ctx, addr, header, _a3
 func (_m *Executor) GetAccount(ctx context.Context, addr flow.Address, header *flow.Header, _a3 snapshot.StorageSnapshot) (*flow.Account, error) {
 	ret := _m.Called(ctx, addr, header, _a3)
@@ -75,6 +117,80 @@ func (_m *Executor) GetAccount(ctx context.Context, addr flow.Address, header *f
 	return r0, r1
 }
 
+// GetAccountAvailableBalance provides a mock function with given fields: ctx, addr, header, _a3
+func (_m *Executor) GetAccountAvailableBalance(ctx context.Context, addr flow.Address, header *flow.Header, _a3 snapshot.StorageSnapshot) (uint64, error) {
+	ret := _m.Called(ctx, addr, header, _a3)
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Address, *flow.Header, snapshot.StorageSnapshot) (uint64, error)); ok {
+		return rf(ctx, addr, header, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Address, *flow.Header, snapshot.StorageSnapshot) uint64); ok {
+		r0 = rf(ctx, addr, header, _a3)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, flow.Address, *flow.Header, snapshot.StorageSnapshot) error); ok {
+		r1 = rf(ctx, addr, header, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAccountBalance provides a mock function with given fields: ctx, addr, header, _a3
+func (_m *Executor) GetAccountBalance(ctx context.Context, addr flow.Address, header *flow.Header, _a3 snapshot.StorageSnapshot) (uint64, error) {
+	ret := _m.Called(ctx, addr, header, _a3)
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Address, *flow.Header, snapshot.StorageSnapshot) (uint64, error)); ok {
+		return rf(ctx, addr, header, _a3)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Address, *flow.Header, snapshot.StorageSnapshot) uint64); ok {
+		r0 = rf(ctx, addr, header, _a3)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, flow.Address, *flow.Header, snapshot.StorageSnapshot) error); ok {
+		r1 = rf(ctx, addr, header, _a3)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimulateTransaction provides a mock function with given fields: ctx, tx, skipSignatureChecks, blockHeader, _a4
+func (_m *Executor) SimulateTransaction(ctx context.Context, tx *flow.TransactionBody, skipSignatureChecks bool, blockHeader *flow.Header, _a4 snapshot.StorageSnapshot) (*flow.TransactionSimulationResult, error) {
+	ret := _m.Called(ctx, tx, skipSignatureChecks, blockHeader, _a4)
+
+	var r0 *flow.TransactionSimulationResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *flow.TransactionBody, bool, *flow.Header, snapshot.StorageSnapshot) (*flow.TransactionSimulationResult, error)); ok {
+		return rf(ctx, tx, skipSignatureChecks, blockHeader, _a4)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *flow.TransactionBody, bool, *flow.Header, snapshot.StorageSnapshot) *flow.TransactionSimulationResult); ok {
+		r0 = rf(ctx, tx, skipSignatureChecks, blockHeader, _a4)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flow.TransactionSimulationResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *flow.TransactionBody, bool, *flow.Header, snapshot.StorageSnapshot) error); ok {
+		r1 = rf(ctx, tx, skipSignatureChecks, blockHeader, _a4)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewExecutor interface {
 	mock.TestingT
 	Cleanup(func())
@@ -0,0 +1,180 @@
+package query
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+
+	"github.com/onflow/flow-go/fvm"
+	"github.com/onflow/flow-go/fvm/storage/snapshot"
+	"github.com/onflow/flow-go/fvm/systemcontracts"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// feeEstimateScript returns the fee parameters of the FlowFees contract, together with the
+// inclusion, execution and total fees for the given efforts.
+const feeEstimateScript = `
+import FlowFees from 0x%s
+
+access(all) fun main(inclusionEffort: UFix64, executionEffort: UFix64): [UFix64] {
+	let params = FlowFees.getFeeParameters()
+	return [
+		params.surgeFactor,
+		params.inclusionEffortCost,
+		params.executionEffortCost,
+		params.surgeFactor * (inclusionEffort * params.inclusionEffortCost),
+		params.surgeFactor * (executionEffort * params.executionEffortCost),
+		FlowFees.computeFees(inclusionEffort: inclusionEffort, executionEffort: executionEffort)
+	]
+}
+`
+
+// SimulateTransaction executes the transaction on top of the state of the given block, as if it was
+// the only transaction of a child block, without committing it. Signature checks are skipped if
+// skipSignatureChecks is set, the proposal key sequence number is always checked.
+//
+// The fee estimate is computed from the fee parameters at the given block, whether or not the
+// transaction fees are enabled. A failed transaction does not fail the call, its error is returned
+// in the result instead.
+func (e *QueryExecutor) SimulateTransaction(
+	ctx context.Context,
+	tx *flow.TransactionBody,
+	skipSignatureChecks bool,
+	blockHeader *flow.Header,
+	storageSnapshot snapshot.StorageSnapshot,
+) (
+	result *flow.TransactionSimulationResult,
+	err error,
+) {
+	defer func() {
+		if r := recover(); r != nil {
+			e.logger.Error().
+				Str("tx_id", tx.ID().String()).
+				Interface("recovered", r).
+				Msg("transaction simulation caused runtime panic")
+
+			err = fmt.Errorf("cadence runtime error: %s", r)
+		}
+	}()
+
+	derivedBlockData := e.derivedChainData.NewDerivedBlockDataForScript(blockHeader.ID())
+
+	txCtx := fvm.NewContextFromParent(
+		e.vmCtx,
+		fvm.WithBlockHeader(blockHeader),
+		fvm.WithEntropyProvider(e.entropyPerBlock.AtBlockID(blockHeader.ID())),
+		fvm.WithDerivedBlockData(derivedBlockData),
+		fvm.WithAuthorizationChecksEnabled(!skipSignatureChecks))
+
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("transaction simulation aborted: %w", err)
+	}
+
+	proc := fvm.Transaction(tx, 0)
+	executionSnapshot, output, err := e.vm.Run(txCtx, proc, storageSnapshot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to simulate transaction %s (internal error): %w", tx.ID(), err)
+	}
+
+	storageChanges, err := fvm.TransactionStorageLimiter{}.StorageUsedChanges(
+		txCtx,
+		storageSnapshot,
+		executionSnapshot,
+		tx.Payer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get storage changes of transaction %s: %w", tx.ID(), err)
+	}
+
+	// the execution effort is capped at the computation limit of the transaction, as when fees are deducted
+	executionEffort := output.ComputationUsed
+	if computationLimit := proc.ComputationLimit(txCtx); executionEffort > computationLimit {
+		executionEffort = computationLimit
+	}
+
+	fees, err := e.estimateFees(
+		ctx,
+		blockHeader,
+		tx.InclusionEffort(),
+		executionEffort,
+		storageSnapshot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to estimate fees of transaction %s: %w", tx.ID(), err)
+	}
+
+	result = &flow.TransactionSimulationResult{
+		TransactionID:   tx.ID(),
+		ComputationUsed: output.ComputationUsed,
+		Fees:            fees,
+		Events:          output.Events,
+		StorageChanges:  storageChanges,
+	}
+	if output.Err != nil {
+		result.ErrorMessage = summarizeLog(output.Err.Error(), e.config.MaxErrorMessageSize)
+	}
+
+	return result, nil
+}
+
+// estimateFees computes the fees of a transaction with the given efforts, using the fee
+// parameters of the FlowFees contract at the given block.
+func (e *QueryExecutor) estimateFees(
+	ctx context.Context,
+	blockHeader *flow.Header,
+	inclusionEffort uint64,
+	executionEffort uint64,
+	storageSnapshot snapshot.StorageSnapshot,
+) (flow.TransactionFeeEstimate, error) {
+	sc := systemcontracts.SystemContractsForChain(e.vmCtx.Chain.ChainID())
+	script := []byte(fmt.Sprintf(feeEstimateScript, sc.FlowFees.Address.Hex()))
+
+	arguments := make([][]byte, 0, 2)
+	for _, effort := range []uint64{inclusionEffort, executionEffort} {
+		arg, err := jsoncdc.Encode(cadence.UFix64(effort))
+		if err != nil {
+			return flow.TransactionFeeEstimate{}, fmt.Errorf("failed to encode effort: %w", err)
+		}
+		arguments = append(arguments, arg)
+	}
+
+	_, output, err := e.vm.Run(
+		fvm.NewContextFromParent(
+			e.vmCtx,
+			fvm.WithBlockHeader(blockHeader),
+			fvm.WithDerivedBlockData(
+				e.derivedChainData.NewDerivedBlockDataForScript(blockHeader.ID()))),
+		fvm.NewScriptWithContextAndArgs(script, ctx, arguments...),
+		storageSnapshot)
+	if err != nil {
+		return flow.TransactionFeeEstimate{}, fmt.Errorf("failed to execute fee script (internal error): %w", err)
+	}
+	if output.Err != nil {
+		return flow.TransactionFeeEstimate{}, fmt.Errorf("failed to execute fee script: %w", output.Err)
+	}
+
+	values, ok := output.Value.(cadence.Array)
+	if !ok || len(values.Values) != 6 {
+		return flow.TransactionFeeEstimate{}, fmt.Errorf("unexpected fee script result: %v", output.Value)
+	}
+
+	fees := make([]uint64, len(values.Values))
+	for i, value := range values.Values {
+		fee, ok := value.(cadence.UFix64)
+		if !ok {
+			return flow.TransactionFeeEstimate{}, fmt.Errorf("unexpected fee script result: %v", output.Value)
+		}
+		fees[i] = uint64(fee)
+	}
+
+	return flow.TransactionFeeEstimate{
+		SurgeFactor:         fees[0],
+		InclusionEffortCost: fees[1],
+		ExecutionEffortCost: fees[2],
+		InclusionEffort:     inclusionEffort,
+		ExecutionEffort:     executionEffort,
+		InclusionFee:        fees[3],
+		ExecutionFee:        fees[4],
+		TotalFee:            fees[5],
+	}, nil
+}
@@ -35,6 +35,9 @@ type ExecutedCollection interface {
 	// TransactionResults returns a list of transaction results
 	TransactionResults() flow.TransactionResults
 
+	// TransactionProfiles returns the resource profiles of the executed transactions
+	TransactionProfiles() []flow.TransactionProfile
+
 	// ExecutionSnapshot returns the execution snapshot
 	ExecutionSnapshot() *snapshot.ExecutionSnapshot
 }
@@ -13,6 +13,17 @@ type ScriptExecutor interface {
 	// it returns the value, the computation used and the error (if any)
 	ExecuteScriptAtBlockID(ctx context.Context, script []byte, arguments [][]byte, blockID flow.Identifier) ([]byte, uint64, error)
 
+	// ExecuteScriptWithTransactionsAtBlockID executes a script at the given Block id, after applying the
+	// given transactions in order on top of the block's state. The transactions are never committed.
+	// it returns the value, the result of each transaction, the computation used by the script and the error (if any)
+	ExecuteScriptWithTransactionsAtBlockID(
+		ctx context.Context,
+		script []byte,
+		arguments [][]byte,
+		transactions []*flow.TransactionBody,
+		blockID flow.Identifier,
+	) ([]byte, []flow.SpeculativeTransactionResult, uint64, error)
+
 	// GetAccount returns the Account details at the given Block id
 	GetAccount(ctx context.Context, address flow.Address, blockID flow.Identifier) (*flow.Account, error)
 
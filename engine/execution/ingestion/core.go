@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -49,8 +50,17 @@ type Core struct {
 	blockExecutors chan *entity.ExecutableBlock // blocks that are ready to be executed
 	stopControl    *stop.StopControl            // decide whether to execute a block or not and when to stop the execution
 
+	// blocks skipped because of the stop control are processed again once execution is resumed.
+	// blocks skipped before being enqueued are not kept, since their number is only bounded by the duration
+	// of the stop. they are loaded again from storage by the loader instead.
+	skippedLock        sync.Mutex
+	skippedExecutables map[flow.Identifier]*entity.ExecutableBlock // blocks skipped when ready to be executed
+	resumed            chan struct{}                               // notifies the block handling worker that execution is resumed
+	loader             BlockLoader                                 // loads the unexecuted blocks once execution is resumed
+
 	// data storage
 	execState   state.ExecutionState
+	headers     storage.Headers
 	blocks      storage.Blocks
 	collections storage.Collections
 
@@ -74,25 +84,31 @@ func NewCore(
 	throttle Throttle,
 	execState state.ExecutionState,
 	stopControl *stop.StopControl,
+	headers storage.Headers,
 	blocks storage.Blocks,
 	collections storage.Collections,
 	executor BlockExecutor,
 	collectionFetcher CollectionFetcher,
 	eventConsumer EventConsumer,
+	loader BlockLoader,
 ) (*Core, error) {
 	e := &Core{
-		log:               logger.With().Str("engine", "ingestion_core").Logger(),
-		processables:      make(chan BlockIDHeight, MaxProcessableBlocks),
-		blockExecutors:    make(chan *entity.ExecutableBlock),
-		throttle:          throttle,
-		execState:         execState,
-		blockQueue:        block_queue.NewBlockQueue(logger),
-		stopControl:       stopControl,
-		blocks:            blocks,
-		collections:       collections,
-		executor:          executor,
-		collectionFetcher: collectionFetcher,
-		eventConsumer:     eventConsumer,
+		log:                logger.With().Str("engine", "ingestion_core").Logger(),
+		processables:       make(chan BlockIDHeight, MaxProcessableBlocks),
+		blockExecutors:     make(chan *entity.ExecutableBlock),
+		throttle:           throttle,
+		execState:          execState,
+		blockQueue:         block_queue.NewBlockQueue(logger),
+		stopControl:        stopControl,
+		skippedExecutables: make(map[flow.Identifier]*entity.ExecutableBlock),
+		resumed:            make(chan struct{}, 1),
+		loader:             loader,
+		headers:            headers,
+		blocks:             blocks,
+		collections:        collections,
+		executor:           executor,
+		collectionFetcher:  collectionFetcher,
+		eventConsumer:      eventConsumer,
 	}
 
 	err := e.throttle.Init(e.processables, DefaultCatchUpThreshold)
@@ -102,6 +118,8 @@ func NewCore(
 
 	e.log.Info().Msgf("throttle engine initialized")
 
+	stopControl.AddResumeConsumer(e.onExecutionResumed)
+
 	builder := component.NewComponentManagerBuilder().AddWorker(e.launchWorkerToHandleBlocks)
 
 	for w := 0; w < MaxConcurrentBlockExecutor; w++ {
@@ -120,10 +138,8 @@ func (e *Core) launchWorkerToHandleBlocks(ctx irrecoverable.SignalerContext, rea
 
 	ready()
 
-	if executionStopped {
-		return
-	}
-
+	// blocks are consumed even if execution is stopped, they are skipped
+	// and processed again once execution is resumed
 	e.launchWorkerToConsumeThrottledBlocks(ctx)
 }
 
@@ -185,13 +201,73 @@ func (e *Core) launchWorkerToConsumeThrottledBlocks(ctx irrecoverable.SignalerCo
 					blockIDHeight.ID, blockIDHeight.Height, err))
 				return
 			}
+
+		case <-e.resumed:
+			err := e.processSkippedBlocks(ctx)
+			if err != nil {
+				ctx.Throw(fmt.Errorf("execution ingestion engine fail to process skipped blocks: %w", err))
+				return
+			}
 		}
 	}
 
 }
 
+// onExecutionResumed is called by the stop control when execution is resumed.
+func (e *Core) onExecutionResumed() {
+	select {
+	case e.resumed <- struct{}{}:
+	default:
+		// the worker has not yet processed the previous notification
+	}
+}
+
+// processSkippedBlocks processes the blocks which were skipped because of the stop control.
+// The skipped executables are executed, and the unexecuted blocks are loaded from storage and
+// enqueued again. The loader returns parents before their children, and blocks which are already
+// enqueued or executed are ignored by onProcessableBlock.
+func (e *Core) processSkippedBlocks(ctx context.Context) error {
+	e.skippedLock.Lock()
+	executables := make([]*entity.ExecutableBlock, 0, len(e.skippedExecutables))
+	for _, executable := range e.skippedExecutables {
+		executables = append(executables, executable)
+	}
+	e.skippedExecutables = make(map[flow.Identifier]*entity.ExecutableBlock)
+	e.skippedLock.Unlock()
+
+	sort.Slice(executables, func(i, j int) bool {
+		return executables[i].Block.Header.Height < executables[j].Block.Header.Height
+	})
+
+	unexecuted, err := e.loader.LoadUnexecuted(ctx)
+	if err != nil {
+		return fmt.Errorf("could not load unexecuted blocks: %w", err)
+	}
+
+	e.log.Info().
+		Int("unexecuted_blocks", len(unexecuted)).
+		Int("skipped_executables", len(executables)).
+		Msg("processing blocks skipped while execution was stopped")
+
+	e.executeConcurrently(executables)
+
+	for _, blockID := range unexecuted {
+		header, err := e.headers.ByBlockID(blockID)
+		if err != nil {
+			return fmt.Errorf("failed to get header of unexecuted block %v: %w", blockID, err)
+		}
+
+		err = e.onProcessableBlock(blockID, header.Height)
+		if err != nil {
+			return fmt.Errorf("failed to process skipped block %v (height: %v): %w", blockID, header.Height, err)
+		}
+	}
+
+	return nil
+}
+
 func (e *Core) onProcessableBlock(blockID flow.Identifier, height uint64) error {
-	// skip if stopControl tells to skip
+	// skip if stopControl tells to skip. the block is loaded again once execution is resumed
 	if !e.stopControl.ShouldExecuteBlock(blockID, height) {
 		return nil
 	}
@@ -459,6 +535,9 @@ func (e *Core) executeConcurrently(executables []*entity.ExecutableBlock) {
 
 func (e *Core) execute(ctx context.Context, executable *entity.ExecutableBlock) error {
 	if !e.stopControl.ShouldExecuteBlock(executable.Block.Header.ID(), executable.Block.Header.Height) {
+		e.skippedLock.Lock()
+		e.skippedExecutables[executable.ID()] = executable
+		e.skippedLock.Unlock()
 		return nil
 	}
 
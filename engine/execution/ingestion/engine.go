@@ -96,6 +96,9 @@ func New(
 		loader:              loader,
 	}
 
+	// blocks skipped while execution was stopped are executed once it is resumed
+	stopControl.AddResumeConsumer(eng.onExecutionResumed)
+
 	return &eng, nil
 }
 
@@ -367,6 +370,19 @@ func (e *Engine) executeBlock(
 
 	// don't execute the block if the stop control says no
 	if !e.stopControl.ShouldExecuteBlock(executableBlock.Block.Header.ID(), executableBlock.Block.Header.Height) {
+		// the block stays in the execution queue, so that it is executed if execution is resumed
+		err := e.mempool.Run(func(
+			_ *stdmap.BlockByCollectionBackdata,
+			_ *stdmap.QueuesBackdata,
+		) error {
+			executableBlock.Executing = false
+			return nil
+		})
+		if err != nil {
+			e.log.Err(err).
+				Hex("block_id", logging.Entity(executableBlock)).
+				Msg("could not mark skipped block as not executing")
+		}
 		return
 	}
 
@@ -580,6 +596,31 @@ func (e *Engine) onBlockExecuted(
 	return nil
 }
 
+// onExecutionResumed is called when execution is resumed after being stopped.
+// It loads the blocks which were not processed while execution was stopped, and
+// executes the blocks at the head of the execution queues which were skipped.
+func (e *Engine) onExecutionResumed() {
+	e.unit.Launch(func() {
+		err := e.reloadUnexecutedBlocks()
+		if err != nil {
+			e.log.Fatal().Err(err).Msg("failed to load all unexecuted blocks after resuming execution")
+		}
+
+		err = e.mempool.Run(func(
+			_ *stdmap.BlockByCollectionBackdata,
+			executionQueues *stdmap.QueuesBackdata,
+		) error {
+			for _, queue := range executionQueues.All() {
+				e.executeBlockIfComplete(queue.Head.Item.(*entity.ExecutableBlock))
+			}
+			return nil
+		})
+		if err != nil {
+			e.log.Fatal().Err(err).Msg("failed to execute blocks after resuming execution")
+		}
+	})
+}
+
 // executeBlockIfComplete checks whether the block is ready to be executed.
 // if yes, execute the block
 // return a bool indicates whether the block was completed
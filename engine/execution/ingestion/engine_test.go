@@ -104,6 +104,8 @@ func runWithEngine(t *testing.T, f func(testingContext)) {
 		&flow.Header{Height: 1},
 		false,
 		false,
+		nil,
+		nil,
 	)
 
 	uploadMgr := uploader.NewManager(trace.NewNoopTracer())
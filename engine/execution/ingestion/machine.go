@@ -9,6 +9,7 @@ import (
 	"github.com/onflow/flow-go/engine/common/requester"
 	"github.com/onflow/flow-go/engine/execution"
 	"github.com/onflow/flow-go/engine/execution/computation"
+	"github.com/onflow/flow-go/engine/execution/ingestion/loader"
 	"github.com/onflow/flow-go/engine/execution/ingestion/stop"
 	"github.com/onflow/flow-go/engine/execution/ingestion/uploader"
 	"github.com/onflow/flow-go/engine/execution/provider"
@@ -79,11 +80,13 @@ func NewMachine(
 		throttle,
 		execState,
 		stopControl,
+		headers,
 		blocks,
 		collections,
 		e,
 		collectionFetcher,
 		e,
+		loader.NewUnfinalizedLoader(logger, state, headers, execState),
 	)
 
 	if err != nil {
@@ -45,6 +45,14 @@ const (
 //  4. stop is already set by the version beacon and is set by the version beacon.
 //     This means version boundaries were edited. The resulting stop
 //     height is the new one.
+//
+// Additionally, a stop can be scheduled before the first block reaching a time, view or
+// epoch (see ScheduledStop). Once a finalized block satisfies the scheduled stop, it is
+// converted to a stop at the height of that block, which is handled like a manual stop.
+//
+// If the stop does not crash the node, execution is paused and can be resumed with Resume.
+// The pending stops and the paused state are persisted, so they survive a restart.
+// A crashing stop only logs the reached stop before crashing, no diagnostic dumps are written.
 type StopControl struct {
 	unit                    *engine.Unit
 	maxGracefulStopDuration time.Duration
@@ -60,11 +68,26 @@ type StopControl struct {
 	headers        StopControlHeaders
 	exeState       state.ReadOnlyExecutionState
 	versionBeacons storage.VersionBeacons
+	protocolState  protocol.State
+	// executionStops persists the stop state, nil if the stop state is not persisted.
+	executionStops storage.ExecutionStops
 
 	// stopped is true if node should no longer be executing blocks.
 	stopped bool
+	// paused is true if execution stopped at a stop that did not crash the node.
+	paused bool
 	// stopBoundary is when the node should stop.
 	stopBoundary stopBoundary
+	// scheduledStop is the stop before the first block satisfying its conditions,
+	// it is converted to a stopBoundary once a finalized block satisfies it.
+	scheduledStop ScheduledStop
+	// scheduledStopImmutable is true once the scheduled stop started affecting execution,
+	// see ShouldExecuteBlock.
+	scheduledStopImmutable bool
+	// lastStop describes the last reached stop.
+	lastStop StopInfo
+	// resumeConsumers are notified when execution is resumed.
+	resumeConsumers []func()
 	// nodeVersion could be nil right now. See NewStopControl.
 	nodeVersion *semver.Version
 	// last seen version beacon, used to detect version beacon changes
@@ -97,6 +120,7 @@ type stopBoundarySource string
 const (
 	stopBoundarySourceManual        stopBoundarySource = "manual"
 	stopBoundarySourceVersionBeacon stopBoundarySource = "versionBeacon"
+	stopBoundarySourceScheduled     stopBoundarySource = "scheduled"
 )
 
 type stopBoundary struct {
@@ -145,6 +169,61 @@ func (s stopBoundary) String() string {
 	return sb.String()
 }
 
+// ScheduledStop stops execution before the first block satisfying any of its conditions.
+// Zero values are unset. The block timestamp is used for the time condition, so all
+// execution nodes stop at the same block.
+type ScheduledStop struct {
+	// BeforeTime stops before the first block with a timestamp at or after it.
+	BeforeTime time.Time
+	// BeforeView stops before the first block with a view at or above it.
+	BeforeView uint64
+	// BeforeEpoch stops before the first block of the epoch with this or a higher counter.
+	BeforeEpoch uint64
+
+	// if the node should crash or just pause after reaching the stop
+	ShouldCrash bool
+}
+
+func (s ScheduledStop) Set() bool {
+	return !s.BeforeTime.IsZero() || s.BeforeView != 0 || s.BeforeEpoch != 0
+}
+
+// String returns string in the format "crash@[time=2024-01-01T00:00:00Z,view=1000]"
+func (s ScheduledStop) String() string {
+	if !s.Set() {
+		return "none"
+	}
+
+	conditions := make([]string, 0, 3)
+	if !s.BeforeTime.IsZero() {
+		conditions = append(conditions, "time="+s.BeforeTime.UTC().Format(time.RFC3339))
+	}
+	if s.BeforeView != 0 {
+		conditions = append(conditions, fmt.Sprintf("view=%d", s.BeforeView))
+	}
+	if s.BeforeEpoch != 0 {
+		conditions = append(conditions, fmt.Sprintf("epoch=%d", s.BeforeEpoch))
+	}
+
+	action := "stop"
+	if s.ShouldCrash {
+		action = "crash"
+	}
+
+	return fmt.Sprintf("%s@[%s]", action, strings.Join(conditions, ","))
+}
+
+// StopInfo describes a reached stop.
+type StopInfo struct {
+	// Reason is the stop that was reached, empty if no stop was reached.
+	Reason string
+	// AfterHeight and AfterBlockID identify the last block executed before the stop.
+	AfterHeight  uint64
+	AfterBlockID flow.Identifier
+	// At is the time the stop was reached.
+	At time.Time
+}
+
 // StopControlHeaders is an interface for fetching headers
 // Its jut a small subset of storage.Headers for comments see storage.Headers
 type StopControlHeaders interface {
@@ -156,6 +235,9 @@ type StopControlHeaders interface {
 // We currently have no strong guarantee that the node version is a valid semver.
 // See build.SemverV2 for more details. That is why nil is a valid input for node version
 // without a node version, the stop control can still be used for manual stopping.
+//
+// executionStops can be nil, in which case the stop state is not persisted. Otherwise,
+// the persisted stop state is restored, and a node paused before the restart stays paused.
 func NewStopControl(
 	unit *engine.Unit,
 	maxGracefulStopDuration time.Duration,
@@ -167,6 +249,8 @@ func NewStopControl(
 	latestFinalizedBlock *flow.Header,
 	withStoppedExecution bool,
 	crashOnVersionBoundaryReached bool,
+	protocolState protocol.State,
+	executionStops storage.ExecutionStops,
 ) *StopControl {
 	// We should not miss block finalized events, and we should be able to handle them
 	// faster than they are produced anyway.
@@ -185,6 +269,8 @@ func NewStopControl(
 		headers:                       headers,
 		nodeVersion:                   nodeVersion,
 		versionBeacons:                versionBeacons,
+		protocolState:                 protocolState,
+		executionStops:                executionStops,
 		stopped:                       withStoppedExecution,
 		crashOnVersionBoundaryReached: crashOnVersionBoundaryReached,
 		// the default is to never stop
@@ -203,6 +289,13 @@ func NewStopControl(
 			Logger()
 	}
 
+	if sc.executionStops != nil {
+		err := sc.restoreStop()
+		if err != nil {
+			log.Fatal().Err(err).Msg("failed to restore stop control state")
+		}
+	}
+
 	log.Info().Msgf("Created")
 
 	cm := component.NewComponentManagerBuilder()
@@ -263,6 +356,111 @@ func (s *StopControl) checkInitialVersionBeacon(
 
 }
 
+// restoreStop restores the persisted stop state.
+// No errors are expected during normal operation.
+func (s *StopControl) restoreStop() error {
+	stop, err := s.executionStops.Retrieve()
+	if errors.Is(err, storage.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to retrieve stop state: %w", err)
+	}
+
+	if stop.StopBeforeHeight != NoStopHeight {
+		s.stopBoundary = stopBoundary{
+			StopParameters: StopParameters{
+				StopBeforeHeight: stop.StopBeforeHeight,
+				ShouldCrash:      stop.ShouldCrash,
+			},
+			source: stopBoundarySourceManual,
+		}
+	}
+
+	s.scheduledStop = ScheduledStop{
+		BeforeTime:  stop.ScheduledBeforeTime,
+		BeforeView:  stop.ScheduledBeforeView,
+		BeforeEpoch: stop.ScheduledBeforeEpoch,
+		ShouldCrash: stop.ScheduledShouldCrash,
+	}
+
+	s.lastStop = StopInfo{
+		Reason:       stop.Reason,
+		AfterHeight:  stop.StoppedAfterHeight,
+		AfterBlockID: stop.StoppedAfterBlockID,
+		At:           stop.StoppedAt,
+	}
+
+	if stop.Paused {
+		s.stopped = true
+		s.paused = true
+	}
+
+	s.log.Info().
+		Stringer("stop", s.stopBoundary).
+		Stringer("scheduled_stop", s.scheduledStop).
+		Bool("paused", stop.Paused).
+		Str("last_stop_reason", stop.Reason).
+		Msg("Restored stop control state")
+
+	return nil
+}
+
+// persistStop persists the stop state. Stops set by version beacons are not
+// persisted, as they are derived from the version beacons again on restart.
+// No errors are expected during normal operation.
+//
+// Caller must acquire the lock.
+func (s *StopControl) persistStop() error {
+	if s.executionStops == nil {
+		return nil
+	}
+
+	stop := &storage.ExecutionStop{
+		StopBeforeHeight:     NoStopHeight,
+		ScheduledBeforeTime:  s.scheduledStop.BeforeTime,
+		ScheduledBeforeView:  s.scheduledStop.BeforeView,
+		ScheduledBeforeEpoch: s.scheduledStop.BeforeEpoch,
+		ScheduledShouldCrash: s.scheduledStop.ShouldCrash,
+		Paused:               s.paused,
+		Reason:               s.lastStop.Reason,
+		StoppedAfterHeight:   s.lastStop.AfterHeight,
+		StoppedAfterBlockID:  s.lastStop.AfterBlockID,
+		StoppedAt:            s.lastStop.At,
+	}
+
+	// a crashing stop is not persisted once reached, otherwise the node
+	// would crash again right after restarting
+	reachedCrash := s.stopped && s.stopBoundary.ShouldCrash
+	if s.stopBoundary.Set() &&
+		s.stopBoundary.source != stopBoundarySourceVersionBeacon &&
+		!reachedCrash {
+		stop.StopBeforeHeight = s.stopBoundary.StopBeforeHeight
+		stop.ShouldCrash = s.stopBoundary.ShouldCrash
+	}
+
+	err := s.executionStops.Store(stop)
+	if err != nil {
+		return fmt.Errorf("failed to store stop state: %w", err)
+	}
+	return nil
+}
+
+// persistStopOrLog persists the stop state, logging the error if it fails.
+// A failure to persist does not affect the current stop, which is why it is
+// not treated as irrecoverable.
+//
+// Caller must acquire the lock.
+func (s *StopControl) persistStopOrLog() {
+	err := s.persistStop()
+	if err != nil {
+		s.log.Err(err).
+			Stringer("stop", s.stopBoundary).
+			Stringer("scheduled_stop", s.scheduledStop).
+			Msg("Failed to persist stop control state")
+	}
+}
+
 // IsExecutionStopped returns true is block execution has been stopped
 func (s *StopControl) IsExecutionStopped() bool {
 	s.RLock()
@@ -271,7 +469,17 @@ func (s *StopControl) IsExecutionStopped() bool {
 	return s.stopped
 }
 
+// GetLastStop returns the last reached stop. Its Reason is empty if no stop was reached.
+func (s *StopControl) GetLastStop() StopInfo {
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.lastStop
+}
+
 // SetStopParameters sets new stop parameters manually.
+// The new stop parameters are only applied if they are persisted, otherwise the
+// previous stop parameters are kept and the error is returned.
 //
 // Expected error returns during normal operations:
 //   - ErrCannotChangeStop: this indicates that new stop parameters cannot be set.
@@ -287,7 +495,23 @@ func (s *StopControl) SetStopParameters(
 		source:         stopBoundarySourceManual,
 	}
 
-	return s.setStopParameters(boundary)
+	previous := s.stopBoundary
+	err := s.setStopParameters(boundary)
+	if err != nil {
+		return err
+	}
+
+	err = s.persistStop()
+	if err != nil {
+		s.log.Err(err).
+			Stringer("stop", boundary).
+			Stringer("restored_stop", previous).
+			Msg("Failed to persist new stop, restoring previous stop")
+		s.stopBoundary = previous
+		return err
+	}
+
+	return nil
 }
 
 // setStopParameters sets new stop parameters.
@@ -381,6 +605,116 @@ func (s *StopControl) validateStopChange(
 		"new stop height is later than the current one")
 }
 
+// SetScheduledStop sets the stop before the first block satisfying the given conditions,
+// replacing the previously scheduled stop. An unset ScheduledStop removes the scheduled stop.
+// The scheduled stop is only applied if it is persisted, otherwise the previously scheduled
+// stop is kept and the error is returned.
+//
+// Expected error returns during normal operations:
+//   - ErrCannotChangeStop: this indicates that the scheduled stop cannot be set, because
+//     the node is already stopped or stopping commenced.
+func (s *StopControl) SetScheduledStop(stop ScheduledStop) error {
+	s.Lock()
+	defer s.Unlock()
+
+	log := s.log.With().
+		Stringer("old_scheduled_stop", s.scheduledStop).
+		Stringer("new_scheduled_stop", stop).
+		Logger()
+
+	var err error
+	switch {
+	case s.stopped:
+		err = fmt.Errorf("cannot update scheduled stop, already stopped: %w", ErrCannotChangeStop)
+	case s.scheduledStopImmutable:
+		err = fmt.Errorf("cannot update scheduled stop, stopping commenced for %s: %w",
+			s.scheduledStop, ErrCannotChangeStop)
+	case s.stopBoundary.immutable:
+		err = fmt.Errorf("cannot update scheduled stop, stopping commenced for %s: %w",
+			s.stopBoundary, ErrCannotChangeStop)
+	}
+	if err != nil {
+		log.Info().Err(err).Msg("cannot set scheduled stop")
+		return err
+	}
+
+	previous := s.scheduledStop
+	s.scheduledStop = stop
+
+	err = s.persistStop()
+	if err != nil {
+		log.Err(err).Msg("Failed to persist scheduled stop, restoring previous scheduled stop")
+		s.scheduledStop = previous
+		return err
+	}
+
+	log.Info().Msg("new scheduled stop set")
+	return nil
+}
+
+// GetScheduledStop returns the pending scheduled stop, which is unset if no stop is scheduled.
+func (s *StopControl) GetScheduledStop() ScheduledStop {
+	s.RLock()
+	defer s.RUnlock()
+
+	return s.scheduledStop
+}
+
+var ErrCannotResume = errors.New("cannot resume execution")
+
+// Resume resumes execution stopped by a stop that did not crash the node, or started
+// with stopped execution. Pending stops are removed, as they could immediately stop
+// execution again. The resume consumers are notified after execution is resumed.
+//
+// Expected error returns during normal operations:
+//   - ErrCannotResume: execution is not stopped.
+func (s *StopControl) Resume() error {
+	s.Lock()
+
+	if !s.stopped {
+		s.Unlock()
+		return fmt.Errorf("execution is not stopped: %w", ErrCannotResume)
+	}
+
+	s.log.Info().
+		Stringer("stop", s.stopBoundary).
+		Stringer("scheduled_stop", s.scheduledStop).
+		Str("last_stop_reason", s.lastStop.Reason).
+		Msg("Resuming execution")
+
+	s.stopped = false
+	s.paused = false
+	s.stopBoundary = stopBoundary{
+		StopParameters: StopParameters{
+			StopBeforeHeight: NoStopHeight,
+		},
+	}
+	s.scheduledStop = ScheduledStop{}
+	s.scheduledStopImmutable = false
+
+	err := s.persistStop()
+	consumers := s.resumeConsumers
+	s.Unlock()
+
+	for _, consumer := range consumers {
+		consumer()
+	}
+
+	if err != nil {
+		return fmt.Errorf("execution resumed, but the stop state was not persisted: %w", err)
+	}
+	return nil
+}
+
+// AddResumeConsumer adds a function which is called after execution is resumed.
+// It is used by the ingestion engines to execute the blocks skipped while stopped.
+func (s *StopControl) AddResumeConsumer(consumer func()) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.resumeConsumers = append(s.resumeConsumers, consumer)
+}
+
 // GetStopParameters returns the upcoming stop parameters or nil if no stop is set.
 func (s *StopControl) GetStopParameters() StopParameters {
 	s.RLock()
@@ -391,7 +725,7 @@ func (s *StopControl) GetStopParameters() StopParameters {
 
 // ShouldExecuteBlock should be called when new block can be executed.
 // The block should not be executed if its height is above or equal to
-// s.stopBoundary.StopBeforeHeight.
+// s.stopBoundary.StopBeforeHeight, or if it satisfies the scheduled stop.
 //
 // It returns a boolean indicating if the block should be executed.
 func (s *StopControl) ShouldExecuteBlock(blockID flow.Identifier, height uint64) bool {
@@ -405,22 +739,81 @@ func (s *StopControl) ShouldExecuteBlock(blockID flow.Identifier, height uint64)
 
 	// Skips blocks at or above requested stopHeight
 	// doing so means we have started the stopping process
-	if height < s.stopBoundary.StopBeforeHeight {
+	if height >= s.stopBoundary.StopBeforeHeight {
+		s.log.Info().
+			Msgf("Skipping execution of %s at height %d"+
+				" because stop has been requested %s",
+				blockID,
+				height,
+				s.stopBoundary)
+
+		// stopBoundary is now immutable, because it started affecting execution
+		s.stopBoundary.immutable = true
+		return false
+	}
+
+	if !s.scheduledStop.Set() {
+		return true
+	}
+
+	reached, err := s.scheduledStopReached(blockID)
+	if err != nil {
+		// the block is skipped, as executing it could pass the scheduled stop
+		s.log.Err(err).
+			Stringer("block_id", blockID).
+			Uint64("height", height).
+			Stringer("scheduled_stop", s.scheduledStop).
+			Msg("Failed to check scheduled stop, skipping execution")
+		return false
+	}
+	if !reached {
 		return true
 	}
 
 	s.log.Info().
 		Msgf("Skipping execution of %s at height %d"+
-			" because stop has been requested %s",
+			" because stop has been scheduled %s",
 			blockID,
 			height,
-			s.stopBoundary)
+			s.scheduledStop)
 
-	// stopBoundary is now immutable, because it started affecting execution
-	s.stopBoundary.immutable = true
+	// scheduledStop is now immutable, because it started affecting execution
+	s.scheduledStopImmutable = true
 	return false
 }
 
+// scheduledStopReached returns true if the given block satisfies the scheduled stop.
+// No errors are expected during normal operation.
+//
+// Caller must acquire the lock.
+func (s *StopControl) scheduledStopReached(blockID flow.Identifier) (bool, error) {
+	snapshot := s.protocolState.AtBlockID(blockID)
+	header, err := snapshot.Head()
+	if err != nil {
+		return false, fmt.Errorf("failed to get header: %w", err)
+	}
+
+	if s.scheduledStop.BeforeView != 0 && header.View >= s.scheduledStop.BeforeView {
+		return true, nil
+	}
+
+	if !s.scheduledStop.BeforeTime.IsZero() && !header.Timestamp.Before(s.scheduledStop.BeforeTime) {
+		return true, nil
+	}
+
+	if s.scheduledStop.BeforeEpoch != 0 {
+		counter, err := snapshot.Epochs().Current().Counter()
+		if err != nil {
+			return false, fmt.Errorf("failed to get epoch counter: %w", err)
+		}
+		if counter >= s.scheduledStop.BeforeEpoch {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 // blockFinalized is called when a block is marked as finalized
 //
 // Once finalization reached stopHeight we can be sure no other fork will be valid at
@@ -457,6 +850,7 @@ func (s *StopControl) blockFinalized(
 	}
 
 	s.processNewVersionBeacons(ctx, h.Height)
+	s.processScheduledStop(ctx, h)
 
 	// we are not at the stop yet, nothing to do
 	if h.Height < s.stopBoundary.StopBeforeHeight {
@@ -550,6 +944,15 @@ func (s *StopControl) stopExecution() {
 		Logger()
 
 	s.stopped = true
+	s.paused = !s.stopBoundary.ShouldCrash
+	s.lastStop = StopInfo{
+		Reason:       s.stopBoundary.String(),
+		AfterHeight:  s.stopBoundary.StopBeforeHeight - 1,
+		AfterBlockID: s.stopBoundary.stopAfterExecuting,
+		At:           time.Now(),
+	}
+	s.persistStopOrLog()
+
 	log.Warn().Msg("Stopping as finalization reached requested stop")
 
 	if s.stopBoundary.ShouldCrash {
@@ -569,6 +972,63 @@ func (s *StopControl) stopExecution() {
 	}
 }
 
+// processScheduledStop converts the scheduled stop to a stop at the height of the
+// given finalized block, if the block satisfies the scheduled stop.
+// The blocks are finalized in order, so the block is the first finalized block
+// satisfying the scheduled stop.
+//
+// Caller must acquire the lock.
+func (s *StopControl) processScheduledStop(
+	ctx irrecoverable.SignalerContext,
+	h *flow.Header,
+) {
+	if !s.scheduledStop.Set() {
+		return
+	}
+
+	reached, err := s.scheduledStopReached(h.ID())
+	if err != nil {
+		s.log.Err(err).
+			Stringer("block_id", h.ID()).
+			Stringer("scheduled_stop", s.scheduledStop).
+			Msg("Failed to check scheduled stop for stop control")
+
+		ctx.Throw(fmt.Errorf("failed to check scheduled stop for stop control: %w", err))
+		return
+	}
+	if !reached {
+		return
+	}
+
+	scheduledStop := s.scheduledStop
+	lg := s.log.With().
+		Stringer("scheduled_stop", scheduledStop).
+		Uint64("stop_height", h.Height).
+		Logger()
+
+	// the scheduled stop is consumed, even if the stop boundary cannot be changed,
+	// because then the node is already stopping at an earlier height
+	s.scheduledStop = ScheduledStop{}
+	s.scheduledStopImmutable = false
+
+	err = s.setStopParameters(stopBoundary{
+		StopParameters: StopParameters{
+			StopBeforeHeight: h.Height,
+			ShouldCrash:      scheduledStop.ShouldCrash,
+		},
+		source: stopBoundarySourceScheduled,
+	})
+	if err != nil {
+		lg.Info().
+			Err(err).
+			Msg("Cannot change stop boundary when reaching scheduled stop")
+	} else {
+		lg.Info().Msg("Scheduled stop reached by finalization")
+	}
+
+	s.persistStopOrLog()
+}
+
 // processNewVersionBeacons processes version beacons and updates the stop control stop
 // height if needed.
 //
@@ -663,7 +1123,11 @@ func (s *StopControl) processNewVersionBeacons(
 			Uint64("stop_height", stopHeight).
 			Err(err).
 			Msg("Cannot change stop boundary when detecting new version beacon")
+		return
 	}
+
+	// the version beacon might have replaced a persisted manual stop
+	s.persistStopOrLog()
 }
 
 // getVersionBeaconStopHeight returns the stop height that should be set
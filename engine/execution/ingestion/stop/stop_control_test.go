@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/coreos/go-semver/semver"
+	"github.com/dgraph-io/badger/v2"
 	testifyMock "github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
@@ -14,6 +15,9 @@ import (
 	"github.com/onflow/flow-go/engine/execution/state/mock"
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module/irrecoverable"
+	protocolMock "github.com/onflow/flow-go/state/protocol/mock"
+	"github.com/onflow/flow-go/storage"
+	badgerStorage "github.com/onflow/flow-go/storage/badger"
 	storageMock "github.com/onflow/flow-go/storage/mock"
 	"github.com/onflow/flow-go/utils/unittest"
 )
@@ -34,6 +38,8 @@ func TestCannotSetNewValuesAfterStoppingCommenced(t *testing.T) {
 			&flow.Header{Height: 1},
 			false,
 			false,
+			nil,
+			nil,
 		)
 
 		require.False(t, sc.GetStopParameters().Set())
@@ -80,6 +86,8 @@ func TestCannotSetNewValuesAfterStoppingCommenced(t *testing.T) {
 			&flow.Header{Height: 1},
 			false,
 			false,
+			nil,
+			nil,
 		)
 
 		require.False(t, sc.GetStopParameters().Set())
@@ -136,6 +144,8 @@ func TestExecutionFallingBehind(t *testing.T) {
 		&flow.Header{Height: 1},
 		false,
 		false,
+		nil,
+		nil,
 	)
 
 	// set stop at 22, so 21 is the last height which should be processed
@@ -198,6 +208,8 @@ func TestAddStopForPastBlocks(t *testing.T) {
 		&flow.Header{Height: 1},
 		false,
 		false,
+		nil,
+		nil,
 	)
 
 	// finalize blocks first
@@ -254,6 +266,8 @@ func TestAddStopForPastBlocksExecutionFallingBehind(t *testing.T) {
 		&flow.Header{Height: 1},
 		false,
 		false,
+		nil,
+		nil,
 	)
 
 	execState.On("IsBlockExecuted", headerD.Height-1, headerD.ParentID).Return(false, nil)
@@ -307,6 +321,8 @@ func TestStopControlWithVersionControl(t *testing.T) {
 			&flow.Header{Height: 1},
 			false,
 			false,
+			nil,
+			nil,
 		)
 
 		// setting this means all finalized blocks are considered already executed
@@ -409,6 +425,8 @@ func TestStopControlWithVersionControl(t *testing.T) {
 			&flow.Header{Height: 1},
 			false,
 			false,
+			nil,
+			nil,
 		)
 
 		versionBeacons.
@@ -487,6 +505,8 @@ func TestStopControlWithVersionControl(t *testing.T) {
 			&flow.Header{Height: 1},
 			false,
 			false,
+			nil,
+			nil,
 		)
 
 		versionBeacons.
@@ -565,6 +585,8 @@ func TestStopControlWithVersionControl(t *testing.T) {
 			&flow.Header{Height: 1},
 			false,
 			false,
+			nil,
+			nil,
 		)
 
 		vbStop := StopParameters{
@@ -619,6 +641,8 @@ func TestStartingStopped(t *testing.T) {
 		&flow.Header{Height: 1},
 		true,
 		false,
+		nil,
+		nil,
 	)
 	require.True(t, sc.IsExecutionStopped())
 }
@@ -640,6 +664,8 @@ func TestStoppedStateRejectsAllBlocksAndChanged(t *testing.T) {
 		&flow.Header{Height: 1},
 		true,
 		false,
+		nil,
+		nil,
 	)
 	require.True(t, sc.IsExecutionStopped())
 
@@ -670,6 +696,8 @@ func Test_StopControlWorkers(t *testing.T) {
 			&flow.Header{Height: 1},
 			true,
 			false,
+			nil,
+			nil,
 		)
 
 		ctx, cancel := context.WithCancel(context.Background())
@@ -697,6 +725,8 @@ func Test_StopControlWorkers(t *testing.T) {
 			&flow.Header{Height: 1},
 			false,
 			false,
+			nil,
+			nil,
 		)
 
 		ctx, cancel := context.WithCancel(context.Background())
@@ -756,6 +786,8 @@ func Test_StopControlWorkers(t *testing.T) {
 			headerB,
 			false,
 			false,
+			nil,
+			nil,
 		)
 
 		ctx, cancel := context.WithCancel(context.Background())
@@ -826,6 +858,8 @@ func Test_StopControlWorkers(t *testing.T) {
 			headerB,
 			false,
 			false,
+			nil,
+			nil,
 		)
 
 		ctx, cancel := context.WithCancel(context.Background())
@@ -873,3 +907,299 @@ func TestPatchedVersion(t *testing.T) {
 	require.True(t, semver.New("0.31.20+without-netgo-without-adx").Equal(*semver.New("0.31.20")))
 	require.True(t, semver.New("0.31.20+arm").Equal(*semver.New("0.31.20")))
 }
+
+// mockBlockState mocks the protocol state snapshots of the given blocks,
+// all blocks are in the epoch with the given counter.
+func mockBlockState(t *testing.T, epochCounter uint64, headers ...*flow.Header) *protocolMock.State {
+	state := protocolMock.NewState(t)
+	for _, header := range headers {
+		epoch := protocolMock.NewEpoch(t)
+		epoch.On("Counter").Return(epochCounter, nil).Maybe()
+		epochs := protocolMock.NewEpochQuery(t)
+		epochs.On("Current").Return(epoch).Maybe()
+
+		snapshot := protocolMock.NewSnapshot(t)
+		snapshot.On("Head").Return(header, nil).Maybe()
+		snapshot.On("Epochs").Return(epochs).Maybe()
+		state.On("AtBlockID", header.ID()).Return(snapshot).Maybe()
+	}
+	return state
+}
+
+func TestScheduledStop(t *testing.T) {
+
+	t.Run("stops before first block reaching view", func(t *testing.T) {
+		execState := mock.NewExecutionState(t)
+
+		headerA := unittest.BlockHeaderFixture(unittest.WithHeaderHeight(20))
+		headerA.View = 90
+		headerB := unittest.BlockHeaderWithParentFixture(headerA)
+		headerB.View = 100
+
+		sc := NewStopControl(
+			engine.NewUnit(),
+			time.Second,
+			unittest.Logger(),
+			execState,
+			nil,
+			nil,
+			nil,
+			&flow.Header{Height: 1},
+			false,
+			false,
+			mockBlockState(t, 1, headerA, headerB),
+			nil,
+		)
+
+		scheduledStop := ScheduledStop{BeforeView: 100}
+		require.NoError(t, sc.SetScheduledStop(scheduledStop))
+		require.Equal(t, scheduledStop, sc.GetScheduledStop())
+
+		require.True(t, sc.ShouldExecuteBlock(headerA.ID(), headerA.Height))
+		require.False(t, sc.ShouldExecuteBlock(headerB.ID(), headerB.Height))
+
+		// cannot change the scheduled stop after stopping has started
+		err := sc.SetScheduledStop(ScheduledStop{BeforeView: 200})
+		require.ErrorIs(t, err, ErrCannotChangeStop)
+
+		execState.On("IsBlockExecuted", headerA.Height, headerA.ID()).Return(true, nil)
+
+		sc.BlockFinalizedForTesting(headerA)
+		require.False(t, sc.IsExecutionStopped())
+
+		// the finalized block reaching the view converts the scheduled stop to a stop at its height
+		sc.BlockFinalizedForTesting(headerB)
+		require.True(t, sc.IsExecutionStopped())
+		require.False(t, sc.GetScheduledStop().Set())
+		require.Equal(t, StopParameters{StopBeforeHeight: headerB.Height}, sc.GetStopParameters())
+
+		lastStop := sc.GetLastStop()
+		require.Contains(t, lastStop.Reason, string(stopBoundarySourceScheduled))
+		require.Equal(t, headerA.Height, lastStop.AfterHeight)
+		require.Equal(t, headerA.ID(), lastStop.AfterBlockID)
+	})
+
+	t.Run("stops before first block reaching time or epoch", func(t *testing.T) {
+		execState := mock.NewExecutionState(t)
+
+		headerA := unittest.BlockHeaderFixture(unittest.WithHeaderHeight(20))
+		headerB := unittest.BlockHeaderWithParentFixture(headerA)
+
+		scheduledStops := []ScheduledStop{
+			{BeforeTime: headerB.Timestamp},
+			{BeforeEpoch: 5},
+		}
+		for _, scheduledStop := range scheduledStops {
+			sc := NewStopControl(
+				engine.NewUnit(),
+				time.Second,
+				unittest.Logger(),
+				execState,
+				nil,
+				nil,
+				nil,
+				&flow.Header{Height: 1},
+				false,
+				false,
+				mockBlockState(t, 5, headerB),
+				nil,
+			)
+
+			require.NoError(t, sc.SetScheduledStop(scheduledStop))
+			require.False(t, sc.ShouldExecuteBlock(headerB.ID(), headerB.Height))
+		}
+	})
+
+	t.Run("does not move earlier stop", func(t *testing.T) {
+		execState := mock.NewExecutionState(t)
+
+		headerA := unittest.BlockHeaderFixture(unittest.WithHeaderHeight(20))
+		headerA.View = 100
+
+		sc := NewStopControl(
+			engine.NewUnit(),
+			time.Second,
+			unittest.Logger(),
+			execState,
+			nil,
+			nil,
+			nil,
+			&flow.Header{Height: 1},
+			false,
+			false,
+			mockBlockState(t, 1, headerA),
+			nil,
+		)
+
+		stop := StopParameters{StopBeforeHeight: headerA.Height}
+		require.NoError(t, sc.SetStopParameters(stop))
+		require.NoError(t, sc.SetScheduledStop(ScheduledStop{BeforeView: 100}))
+
+		execState.On("IsBlockExecuted", headerA.Height-1, headerA.ParentID).Return(false, nil)
+
+		// the scheduled stop is dropped, the node is already stopping at the same height
+		sc.BlockFinalizedForTesting(headerA)
+		require.False(t, sc.GetScheduledStop().Set())
+		require.Equal(t, stop, sc.GetStopParameters())
+	})
+}
+
+func TestResume(t *testing.T) {
+
+	t.Run("resumes after stop", func(t *testing.T) {
+		execState := mock.NewExecutionState(t)
+		execState.On("IsBlockExecuted", testifyMock.Anything, testifyMock.Anything).Return(true, nil)
+
+		sc := NewStopControl(
+			engine.NewUnit(),
+			time.Second,
+			unittest.Logger(),
+			execState,
+			nil,
+			nil,
+			nil,
+			&flow.Header{Height: 1},
+			false,
+			false,
+			nil,
+			nil,
+		)
+
+		resumed := 0
+		sc.AddResumeConsumer(func() {
+			resumed++
+		})
+
+		err := sc.Resume()
+		require.ErrorIs(t, err, ErrCannotResume)
+		require.Equal(t, 0, resumed)
+
+		require.NoError(t, sc.SetStopParameters(StopParameters{StopBeforeHeight: 21}))
+
+		header := unittest.BlockHeaderFixture(unittest.WithHeaderHeight(21))
+		sc.BlockFinalizedForTesting(header)
+		require.True(t, sc.IsExecutionStopped())
+		require.False(t, sc.ShouldExecuteBlock(header.ID(), header.Height))
+
+		require.NoError(t, sc.Resume())
+		require.Equal(t, 1, resumed)
+		require.False(t, sc.IsExecutionStopped())
+		require.False(t, sc.GetStopParameters().Set())
+		require.True(t, sc.ShouldExecuteBlock(header.ID(), header.Height))
+
+		// the reached stop is still reported
+		require.Equal(t, uint64(20), sc.GetLastStop().AfterHeight)
+	})
+
+	t.Run("resumes when starting stopped", func(t *testing.T) {
+		sc := NewStopControl(
+			engine.NewUnit(),
+			time.Second,
+			unittest.Logger(),
+			nil,
+			nil,
+			nil,
+			nil,
+			&flow.Header{Height: 1},
+			true,
+			false,
+			nil,
+			nil,
+		)
+
+		require.NoError(t, sc.Resume())
+		require.False(t, sc.IsExecutionStopped())
+	})
+}
+
+func TestStopStateIsPersisted(t *testing.T) {
+	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+		executionStops := badgerStorage.NewExecutionStops(db)
+
+		execState := mock.NewExecutionState(t)
+		execState.On("IsBlockExecuted", testifyMock.Anything, testifyMock.Anything).Return(true, nil)
+
+		newStopControl := func() *StopControl {
+			return NewStopControl(
+				engine.NewUnit(),
+				time.Second,
+				unittest.Logger(),
+				execState,
+				nil,
+				nil,
+				nil,
+				&flow.Header{Height: 1},
+				false,
+				false,
+				nil,
+				executionStops,
+			)
+		}
+
+		sc := newStopControl()
+		stop := StopParameters{StopBeforeHeight: 21}
+		require.NoError(t, sc.SetStopParameters(stop))
+		scheduledStop := ScheduledStop{
+			BeforeTime:  time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+			BeforeEpoch: 12,
+			ShouldCrash: true,
+		}
+		require.NoError(t, sc.SetScheduledStop(scheduledStop))
+
+		// pending stops are restored
+		sc = newStopControl()
+		require.False(t, sc.IsExecutionStopped())
+		require.Equal(t, stop, sc.GetStopParameters())
+		require.Equal(t, scheduledStop.BeforeTime.Unix(), sc.GetScheduledStop().BeforeTime.Unix())
+		require.Equal(t, scheduledStop.BeforeEpoch, sc.GetScheduledStop().BeforeEpoch)
+		require.True(t, sc.GetScheduledStop().ShouldCrash)
+
+		require.NoError(t, sc.SetScheduledStop(ScheduledStop{}))
+		header := unittest.BlockHeaderFixture(unittest.WithHeaderHeight(21))
+		sc.BlockFinalizedForTesting(header)
+		require.True(t, sc.IsExecutionStopped())
+
+		// the node stays paused after restart
+		sc = newStopControl()
+		require.True(t, sc.IsExecutionStopped())
+		require.Equal(t, uint64(20), sc.GetLastStop().AfterHeight)
+		require.Equal(t, header.ParentID, sc.GetLastStop().AfterBlockID)
+
+		require.NoError(t, sc.Resume())
+
+		// the node stays resumed after restart
+		sc = newStopControl()
+		require.False(t, sc.IsExecutionStopped())
+		require.False(t, sc.GetStopParameters().Set())
+		require.False(t, sc.GetScheduledStop().Set())
+	})
+}
+
+func TestStopIsNotSetIfNotPersisted(t *testing.T) {
+	executionStops := storageMock.NewExecutionStops(t)
+	executionStops.On("Retrieve").Return(nil, storage.ErrNotFound)
+	executionStops.On("Store", testifyMock.Anything).Return(fmt.Errorf("storage failure"))
+
+	sc := NewStopControl(
+		engine.NewUnit(),
+		time.Second,
+		unittest.Logger(),
+		nil,
+		nil,
+		nil,
+		nil,
+		&flow.Header{Height: 1},
+		false,
+		false,
+		nil,
+		executionStops,
+	)
+
+	err := sc.SetStopParameters(StopParameters{StopBeforeHeight: 21})
+	require.Error(t, err)
+	require.False(t, sc.GetStopParameters().Set())
+
+	err = sc.SetScheduledStop(ScheduledStop{BeforeEpoch: 12})
+	require.Error(t, err)
+	require.False(t, sc.GetScheduledStop().Set())
+}
@@ -0,0 +1,60 @@
+package uploader
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+)
+
+var _ Reader = (*FileReader)(nil)
+
+// FileReader reads the block data written to a directory by a FileUploader.
+type FileReader struct {
+	dir string
+}
+
+func NewFileReader(dir string) *FileReader {
+	return &FileReader{
+		dir: dir,
+	}
+}
+
+func (f *FileReader) BlockIDs(_ context.Context) ([]flow.Identifier, error) {
+	entries, err := os.ReadDir(f.dir)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read block data directory: %w", err)
+	}
+
+	blockIDs := make([]flow.Identifier, 0, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		blockID, ok := blockIDFromObjectName(entry.Name())
+		if !ok {
+			continue
+		}
+		blockIDs = append(blockIDs, blockID)
+	}
+
+	return blockIDs, nil
+}
+
+func (f *FileReader) Read(_ context.Context, blockID flow.Identifier) (*BlockData, error) {
+	file, err := os.Open(path.Join(f.dir, BlockDataObjectName(blockID)))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("block %v is not in the archive: %w", blockID, storage.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot open block data file: %w", err)
+	}
+	defer file.Close()
+
+	return ReadBlockDataFrom(bufio.NewReader(file))
+}
@@ -0,0 +1,66 @@
+package uploader
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+
+	"github.com/fxamacker/cbor/v2"
+
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// Reader reads the block data archived by an Uploader.
+type Reader interface {
+	// BlockIDs returns the IDs of all blocks in the archive, in no particular order.
+	// The archive can contain blocks of abandoned forks.
+	// No errors are expected during normal operation.
+	BlockIDs(ctx context.Context) ([]flow.Identifier, error)
+
+	// Read returns the archived block data of the given block.
+	// Expected errors during normal operation:
+	//   - storage.ErrNotFound if the block is not in the archive
+	Read(ctx context.Context, blockID flow.Identifier) (*BlockData, error)
+}
+
+// BlockDataObjectName returns the name under which the block data of the given block is archived.
+func BlockDataObjectName(blockID flow.Identifier) string {
+	return fmt.Sprintf("%s.cbor", blockID.String())
+}
+
+// blockIDFromObjectName returns the ID of the block archived under the given name,
+// or false if the name is not the name of archived block data.
+func blockIDFromObjectName(name string) (flow.Identifier, bool) {
+	hex, ok := strings.CutSuffix(name, ".cbor")
+	if !ok {
+		return flow.ZeroID, false
+	}
+
+	blockID, err := flow.HexStringToIdentifier(hex)
+	if err != nil {
+		return flow.ZeroID, false
+	}
+	return blockID, true
+}
+
+// ReadBlockDataFrom decodes block data written by WriteComputationResultsTo.
+func ReadBlockDataFrom(reader io.Reader) (*BlockData, error) {
+	// block data of large blocks exceeds the default limits
+	mode, err := cbor.DecOptions{
+		MaxArrayElements: math.MaxInt32,
+		MaxMapPairs:      math.MaxInt32,
+	}.DecMode()
+	if err != nil {
+		return nil, fmt.Errorf("cannot create cbor decoding mode: %w", err)
+	}
+
+	var blockData BlockData
+	err = mode.NewDecoder(reader).Decode(&blockData)
+	if err != nil {
+		return nil, fmt.Errorf("cannot decode block data: %w", err)
+	}
+
+	return &blockData, nil
+}
@@ -0,0 +1,131 @@
+package uploader
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func Test_FileReader(t *testing.T) {
+	unittest.RunWithTempDir(t, func(dir string) {
+		cr, _ := generateComputationResult(t)
+		blockID := cr.ExecutableBlock.ID()
+
+		require.NoError(t, NewFileUploader(dir).Upload(cr))
+		// other files are ignored
+		require.NoError(t, os.WriteFile(path.Join(dir, "notes.txt"), []byte("notes"), 0644))
+
+		reader := NewFileReader(dir)
+
+		blockIDs, err := reader.BlockIDs(context.Background())
+		require.NoError(t, err)
+		require.Equal(t, []flow.Identifier{blockID}, blockIDs)
+
+		blockData, err := reader.Read(context.Background(), blockID)
+		require.NoError(t, err)
+		requireBlockDataEqual(t, ComputationResultToBlockData(cr), blockData)
+
+		_, err = reader.Read(context.Background(), unittest.IdentifierFixture())
+		require.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func Test_S3Reader(t *testing.T) {
+	client := &fakeS3{
+		objects:  make(map[string][]byte),
+		pageSize: 2,
+	}
+
+	var blockIDs []flow.Identifier
+	var results []*BlockData
+	for i := 0; i < 5; i++ {
+		cr, _ := generateComputationResult(t)
+		buf := &bytes.Buffer{}
+		require.NoError(t, WriteComputationResultsTo(cr, buf))
+
+		client.objects[BlockDataObjectName(cr.ExecutableBlock.ID())] = buf.Bytes()
+		blockIDs = append(blockIDs, cr.ExecutableBlock.ID())
+		results = append(results, ComputationResultToBlockData(cr))
+	}
+
+	reader := NewS3Reader(client, "bucket")
+
+	listed, err := reader.BlockIDs(context.Background())
+	require.NoError(t, err)
+	require.ElementsMatch(t, blockIDs, listed)
+
+	for i, blockID := range blockIDs {
+		blockData, err := reader.Read(context.Background(), blockID)
+		require.NoError(t, err)
+		requireBlockDataEqual(t, results[i], blockData)
+	}
+
+	_, err = reader.Read(context.Background(), unittest.IdentifierFixture())
+	require.ErrorIs(t, err, storage.ErrNotFound)
+}
+
+func requireBlockDataEqual(t *testing.T, expected *BlockData, actual *BlockData) {
+	require.Equal(t, expected.Block.ID(), actual.Block.ID())
+	require.Equal(t, expected.Collections, actual.Collections)
+	require.Equal(t, expected.TxResults, actual.TxResults)
+	require.Equal(t, expected.Events, actual.Events)
+	require.Equal(t, expected.TrieUpdates, actual.TrieUpdates)
+	require.Equal(t, expected.FinalStateCommitment, actual.FinalStateCommitment)
+}
+
+// fakeS3 is an in-memory S3API, listing objects in pages of pageSize.
+type fakeS3 struct {
+	objects  map[string][]byte
+	pageSize int
+}
+
+var _ S3API = (*fakeS3)(nil)
+
+func (f *fakeS3) ListObjectsV2(
+	_ context.Context,
+	params *s3.ListObjectsV2Input,
+	_ ...func(*s3.Options),
+) (*s3.ListObjectsV2Output, error) {
+	keys := make([]string, 0, len(f.objects))
+	for key := range f.objects {
+		if params.ContinuationToken == nil || key > *params.ContinuationToken {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	output := &s3.ListObjectsV2Output{}
+	if len(keys) > f.pageSize {
+		keys = keys[:f.pageSize]
+		output.IsTruncated = true
+		output.NextContinuationToken = &keys[len(keys)-1]
+	}
+	for i := range keys {
+		output.Contents = append(output.Contents, types.Object{Key: &keys[i]})
+	}
+	return output, nil
+}
+
+func (f *fakeS3) GetObject(
+	_ context.Context,
+	params *s3.GetObjectInput,
+	_ ...func(*s3.Options),
+) (*s3.GetObjectOutput, error) {
+	object, ok := f.objects[*params.Key]
+	if !ok {
+		return nil, &types.NoSuchKey{}
+	}
+	return &s3.GetObjectOutput{Body: io.NopCloser(bytes.NewReader(object))}, nil
+}
@@ -0,0 +1,153 @@
+package uploader
+
+import (
+	"fmt"
+
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/common/convert"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/trie"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+)
+
+// ValidateBlockData checks that the block data is the archived data of the given block,
+// that its collections and transaction results match the block, and that its final state
+// commitment matches the stored state commitment of the block.
+// It returns an error describing the first inconsistency found.
+func ValidateBlockData(blockID flow.Identifier, blockData *BlockData, commits storage.Commits) error {
+	if blockData.Block == nil {
+		return fmt.Errorf("block data has no block")
+	}
+	if blockData.Block.ID() != blockID {
+		return fmt.Errorf("block data is for block %v", blockData.Block.ID())
+	}
+
+	guarantees := blockData.Block.Payload.Guarantees
+	if len(blockData.Collections) != len(guarantees) {
+		return fmt.Errorf("block has %d collections, but block data has %d",
+			len(guarantees), len(blockData.Collections))
+	}
+
+	var txIDs []flow.Identifier
+	for i, collection := range blockData.Collections {
+		if collection == nil {
+			return fmt.Errorf("collection %d is missing", i)
+		}
+		light := collection.Collection().Light()
+		if light.ID() != guarantees[i].CollectionID {
+			return fmt.Errorf("collection %d is %v, but block has %v",
+				i, light.ID(), guarantees[i].CollectionID)
+		}
+		txIDs = append(txIDs, light.Transactions...)
+	}
+
+	// the results of the system transaction follow the results of the collection transactions
+	if len(blockData.TxResults) < len(txIDs) {
+		return fmt.Errorf("block has %d transactions, but block data has %d transaction results",
+			len(txIDs), len(blockData.TxResults))
+	}
+	for i, txID := range txIDs {
+		if blockData.TxResults[i].TransactionID != txID {
+			return fmt.Errorf("transaction result %d is for %v, but transaction is %v",
+				i, blockData.TxResults[i].TransactionID, txID)
+		}
+	}
+
+	// one chunk per collection and the system chunk
+	if len(blockData.TrieUpdates) != len(guarantees)+1 {
+		return fmt.Errorf("block has %d chunks, but block data has %d trie updates",
+			len(guarantees)+1, len(blockData.TrieUpdates))
+	}
+
+	if first := blockData.TrieUpdates[0]; first != nil {
+		startState, err := commits.ByBlockID(blockData.Block.Header.ParentID)
+		if err != nil {
+			return fmt.Errorf("cannot get state commitment of parent block: %w", err)
+		}
+		if flow.StateCommitment(first.RootHash) != startState {
+			return fmt.Errorf("first trie update starts at %v, but parent block state commitment is %v",
+				first.RootHash, startState)
+		}
+	}
+
+	endState, err := commits.ByBlockID(blockID)
+	if err != nil {
+		return fmt.Errorf("cannot get state commitment of block: %w", err)
+	}
+	if blockData.FinalStateCommitment != endState {
+		return fmt.Errorf("final state commitment is %v, but stored state commitment is %v",
+			blockData.FinalStateCommitment, endState)
+	}
+
+	return nil
+}
+
+// ApplyTrieUpdates applies the trie updates of the block data to the trie at the start
+// state of the block, and returns the trie at the end state of the block.
+// An error is returned if a trie update does not start at the state the previous one
+// ended at, or if the resulting state does not match the final state commitment.
+func ApplyTrieUpdates(start *trie.MTrie, blockData *BlockData) (*trie.MTrie, error) {
+	current := start
+	for i, update := range blockData.TrieUpdates {
+		if update == nil {
+			continue
+		}
+
+		if update.RootHash != current.RootHash() {
+			return nil, fmt.Errorf("trie update %d starts at %v, but trie is at %v",
+				i, update.RootHash, current.RootHash())
+		}
+
+		if len(update.Paths) == 0 {
+			continue
+		}
+
+		payloads := make([]ledger.Payload, len(update.Payloads))
+		for j, payload := range update.Payloads {
+			payloads[j] = *payload
+		}
+
+		var err error
+		current, _, err = trie.NewTrieWithUpdatedRegisters(current, update.Paths, payloads, true)
+		if err != nil {
+			return nil, fmt.Errorf("cannot apply trie update %d: %w", i, err)
+		}
+	}
+
+	if flow.StateCommitment(current.RootHash()) != blockData.FinalStateCommitment {
+		return nil, fmt.Errorf("trie updates end at %v, but final state commitment is %v",
+			current.RootHash(), blockData.FinalStateCommitment)
+	}
+
+	return current, nil
+}
+
+// RegisterEntries returns the registers updated by the block, with their values at the
+// end of the block.
+func RegisterEntries(blockData *BlockData) (flow.RegisterEntries, error) {
+	var entries flow.RegisterEntries
+	indices := make(map[flow.RegisterID]int)
+
+	for _, update := range blockData.TrieUpdates {
+		if update == nil {
+			continue
+		}
+
+		for _, payload := range update.Payloads {
+			id, value, err := convert.PayloadToRegister(payload)
+			if err != nil {
+				return nil, fmt.Errorf("cannot convert payload to register: %w", err)
+			}
+
+			// later chunks overwrite the updates of earlier chunks
+			if i, ok := indices[id]; ok {
+				entries[i].Value = value
+				continue
+			}
+			indices[id] = len(entries)
+			entries = append(entries, flow.RegisterEntry{Key: id, Value: value})
+		}
+	}
+
+	return entries, nil
+}
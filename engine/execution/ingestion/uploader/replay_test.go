@@ -0,0 +1,168 @@
+package uploader
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/common/convert"
+	"github.com/onflow/flow-go/ledger/common/pathfinder"
+	"github.com/onflow/flow-go/ledger/complete"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/trie"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/mempool/entity"
+	storagemock "github.com/onflow/flow-go/storage/mock"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestValidateBlockData(t *testing.T) {
+	blockData, start := blockDataFixture(t)
+	blockID := blockData.Block.ID()
+
+	newCommits := func() *storagemock.Commits {
+		commits := storagemock.NewCommits(t)
+		commits.On("ByBlockID", blockData.Block.Header.ParentID).Return(flow.StateCommitment(start.RootHash()), nil).Maybe()
+		commits.On("ByBlockID", blockID).Return(blockData.FinalStateCommitment, nil).Maybe()
+		return commits
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		require.NoError(t, ValidateBlockData(blockID, blockData, newCommits()))
+	})
+
+	t.Run("different block", func(t *testing.T) {
+		err := ValidateBlockData(unittest.IdentifierFixture(), blockData, newCommits())
+		require.ErrorContains(t, err, "block data is for block")
+	})
+
+	t.Run("missing transaction result", func(t *testing.T) {
+		invalid := *blockData
+		invalid.TxResults = blockData.TxResults[:1]
+		require.Error(t, ValidateBlockData(blockID, &invalid, newCommits()))
+	})
+
+	t.Run("different final state", func(t *testing.T) {
+		commits := storagemock.NewCommits(t)
+		commits.On("ByBlockID", blockData.Block.Header.ParentID).Return(flow.StateCommitment(start.RootHash()), nil)
+		commits.On("ByBlockID", blockID).Return(unittest.StateCommitmentFixture(), nil)
+
+		err := ValidateBlockData(blockID, blockData, commits)
+		require.ErrorContains(t, err, "final state commitment")
+	})
+}
+
+func TestApplyTrieUpdates(t *testing.T) {
+	blockData, start := blockDataFixture(t)
+
+	t.Run("applies updates", func(t *testing.T) {
+		end, err := ApplyTrieUpdates(start, blockData)
+		require.NoError(t, err)
+		require.Equal(t, blockData.FinalStateCommitment, flow.StateCommitment(end.RootHash()))
+
+		for _, entry := range registerEntriesFixture() {
+			path, err := pathfinder.KeyToPath(convert.RegisterIDToLedgerKey(entry.Key), complete.DefaultPathFinderVersion)
+			require.NoError(t, err)
+			require.Equal(t, ledger.Value(entry.Value), end.ReadSinglePayload(path).Value())
+		}
+	})
+
+	t.Run("different start state", func(t *testing.T) {
+		_, err := ApplyTrieUpdates(trie.NewEmptyMTrie(), blockData)
+		require.ErrorContains(t, err, "trie update 0 starts at")
+	})
+}
+
+func TestRegisterEntries(t *testing.T) {
+	blockData, _ := blockDataFixture(t)
+
+	entries, err := RegisterEntries(blockData)
+	require.NoError(t, err)
+	require.ElementsMatch(t, registerEntriesFixture(), entries)
+}
+
+var fixtureOwner = flow.HexToAddress("0x01")
+
+// registerEntriesFixture returns the registers updated by the block of blockDataFixture,
+// with their values at the end of the block.
+func registerEntriesFixture() flow.RegisterEntries {
+	return flow.RegisterEntries{
+		{Key: flow.NewRegisterID(fixtureOwner, "a"), Value: []byte{3}},
+		{Key: flow.NewRegisterID(fixtureOwner, "b"), Value: []byte{2}},
+		{Key: flow.NewRegisterID(fixtureOwner, "c"), Value: []byte{4}},
+	}
+}
+
+// blockDataFixture returns consistent block data of a block with two collections,
+// and the trie at the start state of the block.
+func blockDataFixture(t *testing.T) (*BlockData, *trie.MTrie) {
+	collection1 := unittest.CollectionFixture(2)
+	collection2 := unittest.CollectionFixture(1)
+	guarantee1 := collection1.Guarantee()
+	guarantee2 := collection2.Guarantee()
+
+	block := unittest.BlockWithGuaranteesFixture([]*flow.CollectionGuarantee{&guarantee1, &guarantee2})
+
+	collections := []*entity.CompleteCollection{
+		{Guarantee: &guarantee1, Transactions: collection1.Transactions},
+		{Guarantee: &guarantee2, Transactions: collection2.Transactions},
+	}
+
+	var txResults []*flow.TransactionResult
+	for _, collection := range collections {
+		for _, tx := range collection.Transactions {
+			txResults = append(txResults, &flow.TransactionResult{TransactionID: tx.ID()})
+		}
+	}
+	// system transaction
+	txResults = append(txResults, &flow.TransactionResult{TransactionID: unittest.IdentifierFixture()})
+
+	chunkUpdates := []map[string][]byte{
+		{"a": {1}, "b": {2}},
+		{},
+		{"a": {3}, "c": {4}},
+	}
+
+	start, _, err := trie.NewTrieWithUpdatedRegisters(
+		trie.NewEmptyMTrie(),
+		[]ledger.Path{{0x01}},
+		[]ledger.Payload{*ledger.NewPayload(ledger.NewKey(nil), []byte{1})},
+		true,
+	)
+	require.NoError(t, err)
+
+	current := start
+	trieUpdates := make([]*ledger.TrieUpdate, 0, len(chunkUpdates))
+	for _, registers := range chunkUpdates {
+		keys := make([]ledger.Key, 0, len(registers))
+		values := make([]ledger.Value, 0, len(registers))
+		for key, value := range registers {
+			keys = append(keys, convert.RegisterIDToLedgerKey(flow.NewRegisterID(fixtureOwner, key)))
+			values = append(values, value)
+		}
+
+		update, err := ledger.NewUpdate(ledger.State(current.RootHash()), keys, values)
+		require.NoError(t, err)
+		trieUpdate, err := pathfinder.UpdateToTrieUpdate(update, complete.DefaultPathFinderVersion)
+		require.NoError(t, err)
+		trieUpdates = append(trieUpdates, trieUpdate)
+
+		if len(trieUpdate.Paths) == 0 {
+			continue
+		}
+		payloads := make([]ledger.Payload, len(trieUpdate.Payloads))
+		for i, payload := range trieUpdate.Payloads {
+			payloads[i] = *payload
+		}
+		current, _, err = trie.NewTrieWithUpdatedRegisters(current, trieUpdate.Paths, payloads, true)
+		require.NoError(t, err)
+	}
+
+	return &BlockData{
+		Block:                block,
+		Collections:          collections,
+		TxResults:            txResults,
+		TrieUpdates:          trieUpdates,
+		FinalStateCommitment: flow.StateCommitment(current.RootHash()),
+	}, start
+}
@@ -0,0 +1,80 @@
+package uploader
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+)
+
+var _ Reader = (*S3Reader)(nil)
+
+// S3API is the subset of the S3 client used by the S3Reader.
+type S3API interface {
+	s3.ListObjectsV2APIClient
+	GetObject(ctx context.Context, params *s3.GetObjectInput, optFns ...func(*s3.Options)) (*s3.GetObjectOutput, error)
+}
+
+// S3Reader reads the block data uploaded by a S3Uploader, from S3 or any S3-compatible storage.
+type S3Reader struct {
+	client S3API
+	bucket string
+}
+
+// NewS3Reader returns a new S3 reader instance.
+func NewS3Reader(client S3API, bucket string) *S3Reader {
+	return &S3Reader{
+		client: client,
+		bucket: bucket,
+	}
+}
+
+func (r *S3Reader) BlockIDs(ctx context.Context) ([]flow.Identifier, error) {
+	var blockIDs []flow.Identifier
+
+	paginator := s3.NewListObjectsV2Paginator(r.client, &s3.ListObjectsV2Input{
+		Bucket: &r.bucket,
+	})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("cannot list objects of bucket %s: %w", r.bucket, err)
+		}
+
+		for _, object := range page.Contents {
+			if object.Key == nil {
+				continue
+			}
+			blockID, ok := blockIDFromObjectName(*object.Key)
+			if !ok {
+				continue
+			}
+			blockIDs = append(blockIDs, blockID)
+		}
+	}
+
+	return blockIDs, nil
+}
+
+func (r *S3Reader) Read(ctx context.Context, blockID flow.Identifier) (*BlockData, error) {
+	key := BlockDataObjectName(blockID)
+	output, err := r.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: &r.bucket,
+		Key:    &key,
+	})
+	var noSuchKey *types.NoSuchKey
+	if errors.As(err, &noSuchKey) {
+		return nil, fmt.Errorf("block %v is not in the archive: %w", blockID, storage.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot get object %s: %w", key, err)
+	}
+	defer output.Body.Close()
+
+	return ReadBlockDataFrom(output.Body)
+}
@@ -48,6 +48,48 @@ func (_m *ScriptExecutor) ExecuteScriptAtBlockID(ctx context.Context, script []b
 	return r0, r1, r2
 }
 
+// ExecuteScriptWithTransactionsAtBlockID provides a mock function with given fields: ctx, script, arguments, transactions, blockID
+func (_m *ScriptExecutor) ExecuteScriptWithTransactionsAtBlockID(ctx context.Context, script []byte, arguments [][]byte, transactions []*flow.TransactionBody, blockID flow.Identifier) ([]byte, []flow.SpeculativeTransactionResult, uint64, error) {
+	ret := _m.Called(ctx, script, arguments, transactions, blockID)
+
+	var r0 []byte
+	var r1 []flow.SpeculativeTransactionResult
+	var r2 uint64
+	var r3 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, [][]byte, []*flow.TransactionBody, flow.Identifier) ([]byte, []flow.SpeculativeTransactionResult, uint64, error)); ok {
+		return rf(ctx, script, arguments, transactions, blockID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, [][]byte, []*flow.TransactionBody, flow.Identifier) []byte); ok {
+		r0 = rf(ctx, script, arguments, transactions, blockID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, [][]byte, []*flow.TransactionBody, flow.Identifier) []flow.SpeculativeTransactionResult); ok {
+		r1 = rf(ctx, script, arguments, transactions, blockID)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]flow.SpeculativeTransactionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, []byte, [][]byte, []*flow.TransactionBody, flow.Identifier) uint64); ok {
+		r2 = rf(ctx, script, arguments, transactions, blockID)
+	} else {
+		r2 = ret.Get(2).(uint64)
+	}
+
+	if rf, ok := ret.Get(3).(func(context.Context, []byte, [][]byte, []*flow.TransactionBody, flow.Identifier) error); ok {
+		r3 = rf(ctx, script, arguments, transactions, blockID)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
 // GetAccount provides a mock function with given fields: ctx, address, blockID
 func (_m *ScriptExecutor) GetAccount(ctx context.Context, address flow.Address, blockID flow.Identifier) (*flow.Account, error) {
 	ret := _m.Called(ctx, address, blockID)
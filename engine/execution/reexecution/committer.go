@@ -0,0 +1,104 @@
+package reexecution
+
+import (
+	"fmt"
+
+	"github.com/onflow/flow-go/engine/execution"
+	"github.com/onflow/flow-go/engine/execution/computation/computer"
+	execState "github.com/onflow/flow-go/engine/execution/state"
+	"github.com/onflow/flow-go/fvm/storage/snapshot"
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/common/convert"
+	"github.com/onflow/flow-go/ledger/common/pathfinder"
+	"github.com/onflow/flow-go/ledger/complete"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/trie"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// TrieSource provides the tries of the execution state.
+type TrieSource interface {
+	// FindTrieByStateCommit returns the trie with the given state commitment.
+	// An error is returned if the trie is not available.
+	FindTrieByStateCommit(commitment flow.StateCommitment) (*trie.MTrie, error)
+}
+
+// trieViewCommitter commits the collections of a re-executed block to a detached trie, starting from the
+// trie at the parent commit. Unlike the ledger view committer, it never writes to the ledger or its WAL,
+// so blocks can be re-executed on a live execution node. No proofs are generated.
+type trieViewCommitter struct {
+	trie *trie.MTrie
+}
+
+var _ computer.ViewCommitter = (*trieViewCommitter)(nil)
+
+func newTrieViewCommitter(parent *trie.MTrie) *trieViewCommitter {
+	return &trieViewCommitter{
+		trie: parent,
+	}
+}
+
+func (c *trieViewCommitter) CommitView(
+	executionSnapshot *snapshot.ExecutionSnapshot,
+	baseStorageSnapshot execution.ExtendableStorageSnapshot,
+) (
+	flow.StateCommitment,
+	[]byte,
+	*ledger.TrieUpdate,
+	execution.ExtendableStorageSnapshot,
+	error,
+) {
+	baseCommit := baseStorageSnapshot.Commitment()
+	if flow.StateCommitment(c.trie.RootHash()) != baseCommit {
+		return flow.DummyStateCommitment, nil, nil, nil, fmt.Errorf(
+			"base commit %v does not match committed trie %v", baseCommit, c.trie.RootHash())
+	}
+
+	keys, values := execState.RegisterEntriesToKeysValues(executionSnapshot.UpdatedRegisters())
+
+	paths, err := pathfinder.KeysToPaths(keys, complete.DefaultPathFinderVersion)
+	if err != nil {
+		return flow.DummyStateCommitment, nil, nil, nil, fmt.Errorf("cannot compute register paths: %w", err)
+	}
+
+	payloads := make([]ledger.Payload, len(keys))
+	for i := range keys {
+		payloads[i] = *ledger.NewPayload(keys[i], values[i])
+	}
+
+	trieUpdate := &ledger.TrieUpdate{
+		RootHash: ledger.RootHash(baseCommit),
+		Paths:    paths,
+		Payloads: make([]*ledger.Payload, len(payloads)),
+	}
+	for i := range payloads {
+		trieUpdate.Payloads[i] = &payloads[i]
+	}
+
+	if len(paths) > 0 {
+		c.trie, _, err = trie.NewTrieWithUpdatedRegisters(c.trie, paths, payloads, true)
+		if err != nil {
+			return flow.DummyStateCommitment, nil, nil, nil, fmt.Errorf("cannot update trie: %w", err)
+		}
+	}
+
+	newCommit := flow.StateCommitment(c.trie.RootHash())
+	newStorageSnapshot := baseStorageSnapshot.Extend(newCommit, executionSnapshot.UpdatedRegisterSet())
+
+	return newCommit, []byte{}, trieUpdate, newStorageSnapshot, nil
+}
+
+// Trie returns the trie with all committed collections applied.
+func (c *trieViewCommitter) Trie() *trie.MTrie {
+	return c.trie
+}
+
+// NewTrieStorageSnapshot returns a storage snapshot reading the registers of the given trie.
+func NewTrieStorageSnapshot(t *trie.MTrie) snapshot.StorageSnapshot {
+	return snapshot.NewReadFuncStorageSnapshot(func(id flow.RegisterID) (flow.RegisterValue, error) {
+		path, err := pathfinder.KeyToPath(convert.RegisterIDToLedgerKey(id), complete.DefaultPathFinderVersion)
+		if err != nil {
+			return nil, fmt.Errorf("cannot compute path of register %v: %w", id, err)
+		}
+		return t.ReadSinglePayload(path).Value(), nil
+	})
+}
@@ -0,0 +1,45 @@
+package reexecution
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/engine/execution/storehouse"
+	"github.com/onflow/flow-go/fvm/storage/snapshot"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/trie"
+	"github.com/onflow/flow-go/model/flow"
+)
+
+func TestTrieViewCommitter(t *testing.T) {
+	parent := trie.NewEmptyMTrie()
+	parentCommit := flow.StateCommitment(parent.RootHash())
+	committer := newTrieViewCommitter(parent)
+
+	registerID := flow.NewRegisterID(flow.HexToAddress("01"), "key")
+	executionSnapshot := &snapshot.ExecutionSnapshot{
+		WriteSet: map[flow.RegisterID]flow.RegisterValue{
+			registerID: []byte("value"),
+		},
+	}
+
+	base := storehouse.NewExecutingBlockSnapshot(snapshot.MapStorageSnapshot{}, parentCommit)
+	newCommit, proof, trieUpdate, newSnapshot, err := committer.CommitView(executionSnapshot, base)
+	require.NoError(t, err)
+	require.Empty(t, proof)
+	require.NotEqual(t, parentCommit, newCommit)
+	require.Equal(t, newCommit, newSnapshot.Commitment())
+	require.Len(t, trieUpdate.Paths, 1)
+
+	// the parent trie is not modified
+	require.Equal(t, parentCommit, flow.StateCommitment(parent.RootHash()))
+	require.Equal(t, newCommit, flow.StateCommitment(committer.Trie().RootHash()))
+
+	value, err := NewTrieStorageSnapshot(committer.Trie()).Get(registerID)
+	require.NoError(t, err)
+	require.Equal(t, flow.RegisterValue("value"), value)
+
+	// committing against a different base commit fails
+	_, _, _, _, err = committer.CommitView(executionSnapshot, base)
+	require.Error(t, err)
+}
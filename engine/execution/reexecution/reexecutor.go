@@ -0,0 +1,374 @@
+package reexecution
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/ipfs/go-cid"
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/engine/execution/computation"
+	"github.com/onflow/flow-go/engine/execution/computation/committer"
+	"github.com/onflow/flow-go/engine/execution/computation/computer"
+	"github.com/onflow/flow-go/fvm"
+	"github.com/onflow/flow-go/fvm/storage/derived"
+	"github.com/onflow/flow-go/fvm/storage/snapshot"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/trie"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module"
+	"github.com/onflow/flow-go/module/executiondatasync/execution_data"
+	"github.com/onflow/flow-go/module/executiondatasync/provider"
+	"github.com/onflow/flow-go/module/mempool/entity"
+	"github.com/onflow/flow-go/module/metrics"
+	"github.com/onflow/flow-go/module/trace"
+	"github.com/onflow/flow-go/state/protocol"
+	"github.com/onflow/flow-go/storage"
+)
+
+// StorageSnapshotProvider provides the execution state at the end of an executed block.
+type StorageSnapshotProvider interface {
+	// NewStorageSnapshot creates a new read-only view of the state at the end of the given block.
+	NewStorageSnapshot(commit flow.StateCommitment, blockID flow.Identifier, height uint64) snapshot.StorageSnapshot
+}
+
+// Storages are the storages the re-executed blocks and their expected results are read from.
+type Storages struct {
+	Headers            storage.Headers
+	Blocks             storage.Blocks
+	Collections        storage.Collections
+	Commits            storage.Commits
+	Results            storage.ExecutionResults
+	Seals              storage.Seals
+	Events             storage.Events
+	ServiceEvents      storage.ServiceEvents
+	TransactionResults storage.TransactionResults
+}
+
+// Reexecutor re-executes executed blocks against the execution state at their parent commit,
+// and compares the results with the stored own results, the sealed results and the stored execution data.
+//
+// Re-execution never modifies the execution state: if the parent trie is available from the TrieSource,
+// a re-executed block reads the parent trie and its collections are committed to a detached copy of it.
+// Otherwise the block reads the state from the StorageSnapshotProvider, the end states are not computed,
+// and only the execution results are compared.
+type Reexecutor struct {
+	log           zerolog.Logger
+	vm            fvm.VM
+	vmCtx         fvm.Context
+	state         protocol.State
+	signer        module.Local
+	storages      Storages
+	snapshots     StorageSnapshotProvider            // optional
+	tries         TrieSource                         // optional
+	executionData execution_data.ExecutionDataGetter // optional
+	idProvider    *executionDataIDProvider
+
+	// mu serializes re-executions, and guards lastTrie
+	mu sync.Mutex
+	// lastTrie is the trie at the end of the last re-executed block of a range, which is used as the
+	// parent trie of the next block if it is not available from the TrieSource.
+	lastTrie *trie.MTrie
+}
+
+// NewReexecutor creates a new Reexecutor.
+// snapshots, tries and executionData are optional, but at least one of snapshots and tries must be given:
+// without tries end states are not computed, without execution data register updates are not compared.
+func NewReexecutor(
+	log zerolog.Logger,
+	vm fvm.VM,
+	vmCtx fvm.Context,
+	state protocol.State,
+	signer module.Local,
+	storages Storages,
+	snapshots StorageSnapshotProvider,
+	tries TrieSource,
+	executionData execution_data.ExecutionDataGetter,
+) *Reexecutor {
+	chainID := vmCtx.Chain.ChainID()
+	vmCtx = fvm.NewContextFromParent(vmCtx, computation.DefaultFVMOptions(chainID, false, false)...)
+
+	return &Reexecutor{
+		log:           log.With().Str("component", "reexecutor").Logger(),
+		vm:            vm,
+		vmCtx:         vmCtx,
+		state:         state,
+		signer:        signer,
+		storages:      storages,
+		snapshots:     snapshots,
+		tries:         tries,
+		executionData: executionData,
+		idProvider: &executionDataIDProvider{
+			cids: provider.NewExecutionDataCIDProvider(execution_data.DefaultSerializer),
+		},
+	}
+}
+
+// ReexecuteRange re-executes the finalized blocks in the given height range (inclusive) in order,
+// and returns a report for each block.
+// No errors are expected during normal operation, an error is returned if a block or its expected
+// results can't be read, or if re-execution fails.
+func (r *Reexecutor) ReexecuteRange(ctx context.Context, from uint64, to uint64) ([]*BlockReport, error) {
+	if from > to {
+		return nil, fmt.Errorf("invalid height range: from %d is above to %d", from, to)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	// the last trie is only kept while re-executing a range, so it doesn't hold memory afterwards
+	defer func() {
+		r.lastTrie = nil
+	}()
+
+	reports := make([]*BlockReport, 0, to-from+1)
+	for height := from; height <= to; height++ {
+		blockID, err := r.storages.Headers.BlockIDByHeight(height)
+		if err != nil {
+			return nil, fmt.Errorf("could not get finalized block at height %d: %w", height, err)
+		}
+
+		report, err := r.reexecuteBlock(ctx, blockID)
+		if err != nil {
+			return nil, fmt.Errorf("could not re-execute block %v at height %d: %w", blockID, height, err)
+		}
+
+		reports = append(reports, report)
+	}
+
+	return reports, nil
+}
+
+// ReexecuteBlock re-executes the given block against the execution state at its parent commit,
+// and returns a report of the divergences from the expected results.
+// No errors are expected during normal operation, an error is returned if the block or its expected
+// results can't be read, or if re-execution fails.
+func (r *Reexecutor) ReexecuteBlock(ctx context.Context, blockID flow.Identifier) (*BlockReport, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	defer func() {
+		r.lastTrie = nil
+	}()
+
+	return r.reexecuteBlock(ctx, blockID)
+}
+
+func (r *Reexecutor) reexecuteBlock(ctx context.Context, blockID flow.Identifier) (*BlockReport, error) {
+	block, err := r.storages.Blocks.ByID(blockID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get block: %w", err)
+	}
+
+	parent, err := r.storages.Headers.ByBlockID(block.Header.ParentID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get parent block: %w", err)
+	}
+
+	parentCommit, err := r.storages.Commits.ByBlockID(parent.ID())
+	if err != nil {
+		return nil, fmt.Errorf("could not get state commitment of parent block: %w", err)
+	}
+
+	parentResult, err := r.storages.Results.ByBlockID(parent.ID())
+	if err != nil {
+		return nil, fmt.Errorf("could not get execution result of parent block: %w", err)
+	}
+
+	collections := make(map[flow.Identifier]*entity.CompleteCollection, len(block.Payload.Guarantees))
+	for _, guarantee := range block.Payload.Guarantees {
+		collection, err := r.storages.Collections.ByID(guarantee.CollectionID)
+		if err != nil {
+			return nil, fmt.Errorf("could not get collection %v: %w", guarantee.CollectionID, err)
+		}
+		collections[guarantee.CollectionID] = &entity.CompleteCollection{
+			Guarantee:    guarantee,
+			Transactions: collection.Transactions,
+		}
+	}
+
+	executableBlock := &entity.ExecutableBlock{
+		Block:               block,
+		CompleteCollections: collections,
+		StartState:          &parentCommit,
+	}
+
+	// the block is executed against the parent trie if it is available, otherwise against the
+	// storage snapshot at the parent block, without computing the end states.
+	var viewCommitter computer.ViewCommitter
+	var storageSnapshot snapshot.StorageSnapshot
+	trieCommitter, err := r.trieCommitter(parentCommit)
+	if err == nil {
+		viewCommitter = trieCommitter
+		storageSnapshot = NewTrieStorageSnapshot(trieCommitter.Trie())
+	} else {
+		if r.snapshots == nil {
+			return nil, fmt.Errorf("parent trie is not available: %w", err)
+		}
+		r.log.Warn().Err(err).
+			Hex("block_id", blockID[:]).
+			Msg("parent trie is not available, end states of re-executed block are not computed")
+		viewCommitter = committer.NewNoopViewCommitter()
+		storageSnapshot = r.snapshots.NewStorageSnapshot(parentCommit, parent.ID(), parent.Height)
+	}
+
+	blockComputer, err := computer.NewBlockComputer(
+		r.vm,
+		r.vmCtx,
+		metrics.NewNoopCollector(),
+		trace.NewNoopTracer(),
+		r.log,
+		viewCommitter,
+		r.signer,
+		r.idProvider,
+		nil,
+		r.state,
+		1,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not create block computer: %w", err)
+	}
+
+	r.log.Info().
+		Hex("block_id", blockID[:]).
+		Uint64("height", block.Header.Height).
+		Msg("re-executing block")
+
+	result, err := blockComputer.ExecuteBlock(
+		ctx,
+		parentResult.ID(),
+		executableBlock,
+		storageSnapshot,
+		derived.NewEmptyDerivedBlockData(0),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not execute block: %w", err)
+	}
+
+	if trieCommitter != nil {
+		r.lastTrie = trieCommitter.Trie()
+	}
+
+	expected, err := r.expectedResults(ctx, block)
+	if err != nil {
+		return nil, err
+	}
+
+	return newBlockReport(block, result, expected, trieCommitter != nil), nil
+}
+
+// trieCommitter returns a committer starting at the trie with the given commitment.
+func (r *Reexecutor) trieCommitter(commit flow.StateCommitment) (*trieViewCommitter, error) {
+	if r.lastTrie != nil && flow.StateCommitment(r.lastTrie.RootHash()) == commit {
+		return newTrieViewCommitter(r.lastTrie), nil
+	}
+
+	if r.tries == nil {
+		return nil, fmt.Errorf("no trie source")
+	}
+
+	t, err := r.tries.FindTrieByStateCommit(commit)
+	if err != nil {
+		return nil, fmt.Errorf("could not find trie for commit %v: %w", commit, err)
+	}
+
+	return newTrieViewCommitter(t), nil
+}
+
+// expectedResults reads the stored own results and the sealed results of the given block.
+func (r *Reexecutor) expectedResults(ctx context.Context, block *flow.Block) (*expectedResults, error) {
+	blockID := block.ID()
+
+	commit, err := r.storages.Commits.ByBlockID(blockID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get state commitment: %w", err)
+	}
+
+	result, err := r.storages.Results.ByBlockID(blockID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get execution result: %w", err)
+	}
+
+	txResults, err := r.storages.TransactionResults.ByBlockID(blockID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get transaction results: %w", err)
+	}
+
+	events, err := r.storages.Events.ByBlockID(blockID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get events: %w", err)
+	}
+
+	serviceEvents, err := r.storages.ServiceEvents.ByBlockID(blockID)
+	if err != nil {
+		return nil, fmt.Errorf("could not get service events: %w", err)
+	}
+
+	expected := &expectedResults{
+		commit:             commit,
+		result:             result,
+		transactionResults: txResults,
+		events:             events,
+		serviceEvents:      serviceEvents,
+	}
+
+	seal, err := r.storages.Seals.FinalizedSealForBlock(blockID)
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return nil, fmt.Errorf("could not get seal: %w", err)
+	}
+	if err == nil {
+		sealedResult, err := r.storages.Results.ByID(seal.ResultID)
+		if err != nil {
+			return nil, fmt.Errorf("could not get sealed execution result: %w", err)
+		}
+		expected.seal = seal
+		expected.sealedResult = sealedResult
+	}
+
+	if r.executionData != nil {
+		executionData, err := r.executionData.Get(ctx, result.ExecutionDataID)
+		if err != nil {
+			r.log.Warn().Err(err).
+				Hex("block_id", blockID[:]).
+				Msg("could not get stored execution data, register updates are not compared")
+		} else {
+			expected.executionData = executionData
+		}
+	}
+
+	return expected, nil
+}
+
+// executionDataIDProvider computes the ID of the execution data of a re-executed block without storing it.
+type executionDataIDProvider struct {
+	cids *provider.ExecutionDataCIDProvider
+}
+
+var _ provider.Provider = (*executionDataIDProvider)(nil)
+
+func (p *executionDataIDProvider) Provide(
+	_ context.Context,
+	_ uint64,
+	executionData *execution_data.BlockExecutionData,
+) (flow.Identifier, *flow.BlockExecutionDataRoot, error) {
+	chunkDataIDs := make([]cid.Cid, len(executionData.ChunkExecutionDatas))
+	for i, chunkExecutionData := range executionData.ChunkExecutionDatas {
+		cedID, err := p.cids.CalculateChunkExecutionDataID(*chunkExecutionData)
+		if err != nil {
+			return flow.ZeroID, nil, fmt.Errorf("failed to compute chunk execution data ID at index %d: %w", i, err)
+		}
+		chunkDataIDs[i] = cedID
+	}
+
+	edRoot := &flow.BlockExecutionDataRoot{
+		BlockID:               executionData.BlockID,
+		ChunkExecutionDataIDs: chunkDataIDs,
+	}
+	rootID, err := p.cids.CalculateExecutionDataRootID(*edRoot)
+	if err != nil {
+		return flow.ZeroID, nil, fmt.Errorf("failed to compute execution data root ID: %w", err)
+	}
+
+	return rootID, edRoot, nil
+}
@@ -0,0 +1,453 @@
+package reexecution
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/onflow/flow-go/engine/execution"
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/common/convert"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/executiondatasync/execution_data"
+)
+
+// Sources the re-executed results are compared with.
+const (
+	SourceStored        = "stored"
+	SourceSealed        = "sealed"
+	SourceExecutionData = "execution_data"
+)
+
+// Divergence is a difference between a re-executed value and the expected value from the given source.
+type Divergence struct {
+	Source   string `json:"source"`
+	Field    string `json:"field"`
+	Expected string `json:"expected"`
+	Actual   string `json:"actual"`
+}
+
+func (d Divergence) String() string {
+	return fmt.Sprintf("%s %s: expected %s, actual %s", d.Source, d.Field, d.Expected, d.Actual)
+}
+
+// TransactionReport reports the first divergence of a re-executed transaction.
+type TransactionReport struct {
+	Index         int             `json:"index"`
+	TransactionID flow.Identifier `json:"transaction_id"`
+	ChunkIndex    int             `json:"chunk_index"`
+	Divergence    Divergence      `json:"divergence"`
+}
+
+// ChunkReport reports the divergences of a re-executed chunk.
+type ChunkReport struct {
+	Index       int          `json:"index"`
+	Divergences []Divergence `json:"divergences"`
+}
+
+// BlockReport reports the divergences of a re-executed block from its expected results.
+// Only diverging chunks and transactions are reported.
+type BlockReport struct {
+	BlockID flow.Identifier `json:"block_id"`
+	Height  uint64          `json:"height"`
+	// EndStateComputed is false if the parent trie was not available, in which case
+	// state commitments, result IDs and execution data IDs are not compared.
+	EndStateComputed bool                 `json:"end_state_computed"`
+	EndState         flow.StateCommitment `json:"end_state"`
+	Divergences      []Divergence         `json:"divergences,omitempty"`
+	Chunks           []ChunkReport        `json:"chunks,omitempty"`
+	Transactions     []TransactionReport  `json:"transactions,omitempty"`
+}
+
+// Diverged returns true if any divergence was found.
+func (r *BlockReport) Diverged() bool {
+	return len(r.Divergences) > 0 || len(r.Chunks) > 0 || len(r.Transactions) > 0
+}
+
+// expectedResults are the results a re-executed block is compared with.
+type expectedResults struct {
+	commit             flow.StateCommitment
+	result             *flow.ExecutionResult
+	transactionResults []flow.TransactionResult
+	events             []flow.Event
+	serviceEvents      []flow.Event
+
+	// seal and sealedResult are nil if the block is not sealed
+	seal         *flow.Seal
+	sealedResult *flow.ExecutionResult
+
+	// executionData is nil if the execution data is not available
+	executionData *execution_data.BlockExecutionData
+}
+
+func newBlockReport(
+	block *flow.Block,
+	result *execution.ComputationResult,
+	expected *expectedResults,
+	endStateComputed bool,
+) *BlockReport {
+	computed := result.ExecutionReceipt.ExecutionResult
+
+	report := &BlockReport{
+		BlockID:          block.ID(),
+		Height:           block.Header.Height,
+		EndStateComputed: endStateComputed,
+	}
+
+	if endStateComputed {
+		endState, err := computed.FinalStateCommitment()
+		if err == nil {
+			report.EndState = endState
+		}
+
+		report.Divergences = appendDivergence(report.Divergences,
+			SourceStored, "end state", expected.commit, report.EndState)
+		report.Divergences = appendDivergence(report.Divergences,
+			SourceStored, "result ID", expected.result.ID(), computed.ID())
+		report.Divergences = appendDivergence(report.Divergences,
+			SourceStored, "execution data ID", expected.result.ExecutionDataID, computed.ExecutionDataID)
+		if expected.seal != nil {
+			report.Divergences = appendDivergence(report.Divergences,
+				SourceSealed, "end state", expected.seal.FinalState, report.EndState)
+			report.Divergences = appendDivergence(report.Divergences,
+				SourceSealed, "result ID", expected.seal.ResultID, computed.ID())
+		}
+	}
+
+	report.Divergences = append(report.Divergences,
+		diffEvents(SourceStored, "service event", result.AllServiceEvents(), expected.serviceEvents)...)
+	if expected.sealedResult != nil {
+		equal, err := result.AllConvertedServiceEvents().EqualTo(expected.sealedResult.ServiceEvents)
+		if err != nil || !equal {
+			report.Divergences = append(report.Divergences, Divergence{
+				Source:   SourceSealed,
+				Field:    "service events",
+				Expected: fmt.Sprint(expected.sealedResult.ServiceEvents),
+				Actual:   fmt.Sprint(computed.ServiceEvents),
+			})
+		}
+	}
+
+	report.Chunks = diffChunks(result, computed.Chunks, expected, endStateComputed, &report.Divergences)
+	report.Transactions = diffTransactions(result, expected)
+
+	return report
+}
+
+// diffChunks compares the computed chunks with the chunks of the stored and sealed results, and the
+// register updates of each collection with the trie updates of the stored execution data.
+// Chunk count mismatches are added to blockDivergences.
+func diffChunks(
+	result *execution.ComputationResult,
+	chunks flow.ChunkList,
+	expected *expectedResults,
+	endStateComputed bool,
+	blockDivergences *[]Divergence,
+) []ChunkReport {
+	*blockDivergences = appendDivergence(*blockDivergences,
+		SourceStored, "chunk count", len(expected.result.Chunks), len(chunks))
+	if expected.sealedResult != nil {
+		*blockDivergences = appendDivergence(*blockDivergences,
+			SourceSealed, "chunk count", len(expected.sealedResult.Chunks), len(chunks))
+	}
+	if expected.executionData != nil {
+		*blockDivergences = appendDivergence(*blockDivergences,
+			SourceExecutionData, "chunk count", len(expected.executionData.ChunkExecutionDatas), len(chunks))
+	}
+
+	var reports []ChunkReport
+	for i, chunk := range chunks {
+		var divergences []Divergence
+
+		divergences = append(divergences,
+			diffChunk(SourceStored, chunkAt(expected.result, i), chunk, endStateComputed)...)
+		if expected.sealedResult != nil {
+			divergences = append(divergences,
+				diffChunk(SourceSealed, chunkAt(expected.sealedResult, i), chunk, endStateComputed)...)
+		}
+
+		if expected.executionData != nil && i < len(expected.executionData.ChunkExecutionDatas) {
+			divergence, ok := diffRegisterUpdates(
+				expected.executionData.ChunkExecutionDatas[i].TrieUpdate,
+				result.CollectionExecutionResultAt(i).ExecutionSnapshot().UpdatedRegisters(),
+			)
+			if ok {
+				divergences = append(divergences, divergence)
+			}
+		}
+
+		if len(divergences) > 0 {
+			reports = append(reports, ChunkReport{
+				Index:       i,
+				Divergences: divergences,
+			})
+		}
+	}
+
+	return reports
+}
+
+func chunkAt(result *flow.ExecutionResult, index int) *flow.Chunk {
+	if index >= len(result.Chunks) {
+		return nil
+	}
+	return result.Chunks[index]
+}
+
+// diffChunk compares a computed chunk with the expected chunk from the given source.
+// State commitments are only compared if end states were computed.
+func diffChunk(source string, expected *flow.Chunk, actual *flow.Chunk, endStateComputed bool) []Divergence {
+	if expected == nil {
+		return []Divergence{{
+			Source:   source,
+			Field:    "chunk",
+			Expected: "missing",
+			Actual:   actual.ID().String(),
+		}}
+	}
+
+	var divergences []Divergence
+	divergences = appendDivergence(divergences,
+		source, "event collection", expected.EventCollection, actual.EventCollection)
+	divergences = appendDivergence(divergences,
+		source, "number of transactions", expected.NumberOfTransactions, actual.NumberOfTransactions)
+	divergences = appendDivergence(divergences,
+		source, "total computation used", expected.TotalComputationUsed, actual.TotalComputationUsed)
+	if endStateComputed {
+		divergences = appendDivergence(divergences,
+			source, "start state", expected.StartState, actual.StartState)
+		divergences = appendDivergence(divergences,
+			source, "end state", expected.EndState, actual.EndState)
+	}
+	return divergences
+}
+
+// diffRegisterUpdates compares the registers updated by a computed collection with the
+// trie update of the stored execution data, and returns the first diverging register.
+func diffRegisterUpdates(expected *ledger.TrieUpdate, actual flow.RegisterEntries) (Divergence, bool) {
+	var expectedEntries flow.RegisterEntries
+	if expected != nil {
+		expectedEntries = make(flow.RegisterEntries, 0, len(expected.Payloads))
+		for _, payload := range expected.Payloads {
+			key, err := payload.Key()
+			if err != nil {
+				return Divergence{
+					Source:   SourceExecutionData,
+					Field:    "register update",
+					Expected: fmt.Sprintf("invalid payload key: %v", err),
+					Actual:   "",
+				}, true
+			}
+			id, err := convert.LedgerKeyToRegisterID(key)
+			if err != nil {
+				return Divergence{
+					Source:   SourceExecutionData,
+					Field:    "register update",
+					Expected: fmt.Sprintf("invalid register key: %v", err),
+					Actual:   "",
+				}, true
+			}
+			expectedEntries = append(expectedEntries, flow.RegisterEntry{
+				Key:   id,
+				Value: payload.Value(),
+			})
+		}
+	}
+
+	sortRegisterEntries(expectedEntries)
+	actual = append(flow.RegisterEntries(nil), actual...)
+	sortRegisterEntries(actual)
+
+	// both lists are sorted, so they are walked in lockstep to find the first register
+	// that is only updated by one of them, or updated to different values.
+	i, j := 0, 0
+	for i < len(expectedEntries) || j < len(actual) {
+		switch {
+		case j >= len(actual) || (i < len(expectedEntries) && registerIDLess(expectedEntries[i].Key, actual[j].Key)):
+			return Divergence{
+				Source:   SourceExecutionData,
+				Field:    "register update",
+				Expected: formatRegisterEntry(expectedEntries[i]),
+				Actual:   "missing",
+			}, true
+		case i >= len(expectedEntries) || registerIDLess(actual[j].Key, expectedEntries[i].Key):
+			return Divergence{
+				Source:   SourceExecutionData,
+				Field:    "register update",
+				Expected: "missing",
+				Actual:   formatRegisterEntry(actual[j]),
+			}, true
+		case !bytes.Equal(expectedEntries[i].Value, actual[j].Value):
+			return Divergence{
+				Source:   SourceExecutionData,
+				Field:    "register update",
+				Expected: formatRegisterEntry(expectedEntries[i]),
+				Actual:   formatRegisterEntry(actual[j]),
+			}, true
+		}
+		i++
+		j++
+	}
+
+	return Divergence{}, false
+}
+
+func sortRegisterEntries(entries flow.RegisterEntries) {
+	sort.Slice(entries, func(i, j int) bool {
+		return registerIDLess(entries[i].Key, entries[j].Key)
+	})
+}
+
+func registerIDLess(a flow.RegisterID, b flow.RegisterID) bool {
+	if a.Owner != b.Owner {
+		return a.Owner < b.Owner
+	}
+	return a.Key < b.Key
+}
+
+func formatRegisterEntry(entry flow.RegisterEntry) string {
+	return fmt.Sprintf("%s=%s", entry.Key, hex.EncodeToString(entry.Value))
+}
+
+// diffTransactions compares the computed transaction results and events with the stored ones,
+// and returns a report of the first divergence of each diverging transaction.
+func diffTransactions(result *execution.ComputationResult, expected *expectedResults) []TransactionReport {
+	actualResults := result.AllTransactionResults()
+
+	chunkIndices := make([]int, 0, len(actualResults))
+	for i := 0; i < result.BlockExecutionResult.Size(); i++ {
+		for range result.CollectionExecutionResultAt(i).TransactionResults() {
+			chunkIndices = append(chunkIndices, i)
+		}
+	}
+
+	actualEvents := groupEventsByTransaction(result.AllEvents())
+	expectedEvents := groupEventsByTransaction(expected.events)
+
+	var reports []TransactionReport
+	for i := 0; i < len(actualResults) || i < len(expected.transactionResults); i++ {
+		var divergences []Divergence
+		var txID flow.Identifier
+		chunkIndex := -1
+
+		switch {
+		case i >= len(actualResults):
+			txID = expected.transactionResults[i].TransactionID
+			divergences = append(divergences, Divergence{
+				Source:   SourceStored,
+				Field:    "transaction",
+				Expected: txID.String(),
+				Actual:   "missing",
+			})
+		case i >= len(expected.transactionResults):
+			txID = actualResults[i].TransactionID
+			chunkIndex = chunkIndices[i]
+			divergences = append(divergences, Divergence{
+				Source:   SourceStored,
+				Field:    "transaction",
+				Expected: "missing",
+				Actual:   txID.String(),
+			})
+		default:
+			txID = actualResults[i].TransactionID
+			chunkIndex = chunkIndices[i]
+			divergences = diffTransactionResult(expected.transactionResults[i], actualResults[i])
+			divergences = append(divergences,
+				diffEvents(SourceStored, "event", actualEvents[uint32(i)], expectedEvents[uint32(i)])...)
+		}
+
+		if len(divergences) > 0 {
+			reports = append(reports, TransactionReport{
+				Index:         i,
+				TransactionID: txID,
+				ChunkIndex:    chunkIndex,
+				Divergence:    divergences[0],
+			})
+		}
+	}
+
+	return reports
+}
+
+func diffTransactionResult(expected flow.TransactionResult, actual flow.TransactionResult) []Divergence {
+	var divergences []Divergence
+	divergences = appendDivergence(divergences,
+		SourceStored, "transaction ID", expected.TransactionID, actual.TransactionID)
+	divergences = appendDivergence(divergences,
+		SourceStored, "error message", expected.ErrorMessage, actual.ErrorMessage)
+	divergences = appendDivergence(divergences,
+		SourceStored, "computation used", expected.ComputationUsed, actual.ComputationUsed)
+	return divergences
+}
+
+func groupEventsByTransaction(events []flow.Event) map[uint32][]flow.Event {
+	grouped := make(map[uint32][]flow.Event)
+	for _, event := range events {
+		grouped[event.TransactionIndex] = append(grouped[event.TransactionIndex], event)
+	}
+	return grouped
+}
+
+// diffEvents compares the computed events with the expected events in order,
+// and returns the first divergence.
+func diffEvents(source string, field string, actual []flow.Event, expected []flow.Event) []Divergence {
+	for i := 0; i < len(actual) || i < len(expected); i++ {
+		switch {
+		case i >= len(actual):
+			return []Divergence{{
+				Source:   source,
+				Field:    fmt.Sprintf("%s %d", field, i),
+				Expected: formatEvent(expected[i]),
+				Actual:   "missing",
+			}}
+		case i >= len(expected):
+			return []Divergence{{
+				Source:   source,
+				Field:    fmt.Sprintf("%s %d", field, i),
+				Expected: "missing",
+				Actual:   formatEvent(actual[i]),
+			}}
+		case !eventsEqual(expected[i], actual[i]):
+			return []Divergence{{
+				Source:   source,
+				Field:    fmt.Sprintf("%s %d", field, i),
+				Expected: formatEvent(expected[i]),
+				Actual:   formatEvent(actual[i]),
+			}}
+		}
+	}
+	return nil
+}
+
+func eventsEqual(a flow.Event, b flow.Event) bool {
+	return a.Type == b.Type &&
+		a.TransactionID == b.TransactionID &&
+		a.TransactionIndex == b.TransactionIndex &&
+		a.EventIndex == b.EventIndex &&
+		bytes.Equal(a.Payload, b.Payload)
+}
+
+func formatEvent(event flow.Event) string {
+	return fmt.Sprintf("%s (tx %v, index %d): %s",
+		event.Type, event.TransactionID, event.EventIndex, hex.EncodeToString(event.Payload))
+}
+
+func appendDivergence[T comparable](divergences []Divergence, source string, field string, expected T, actual T) []Divergence {
+	if expected == actual {
+		return divergences
+	}
+	return append(divergences, Divergence{
+		Source:   source,
+		Field:    field,
+		Expected: formatValue(expected),
+		Actual:   formatValue(actual),
+	})
+}
+
+func formatValue(value any) string {
+	if commit, ok := value.(flow.StateCommitment); ok {
+		return hex.EncodeToString(commit[:])
+	}
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,103 @@
+package reexecution
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/common/convert"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestDiffEvents(t *testing.T) {
+	txID := unittest.IdentifierFixture()
+	events := []flow.Event{
+		unittest.EventFixture(flow.EventAccountCreated, 0, 0, txID, 0),
+		unittest.EventFixture(flow.EventAccountCreated, 0, 1, txID, 0),
+	}
+
+	t.Run("equal", func(t *testing.T) {
+		require.Empty(t, diffEvents(SourceStored, "event", events, events))
+	})
+
+	t.Run("different payload", func(t *testing.T) {
+		actual := []flow.Event{events[0], events[1]}
+		actual[1].Payload = []byte{1}
+
+		divergences := diffEvents(SourceStored, "event", actual, events)
+		require.Len(t, divergences, 1)
+		require.Equal(t, "event 1", divergences[0].Field)
+	})
+
+	t.Run("missing event", func(t *testing.T) {
+		divergences := diffEvents(SourceStored, "event", events[:1], events)
+		require.Len(t, divergences, 1)
+		require.Equal(t, "missing", divergences[0].Actual)
+	})
+}
+
+func TestDiffRegisterUpdates(t *testing.T) {
+	owner := flow.HexToAddress("01")
+	entries := flow.RegisterEntries{
+		{Key: flow.NewRegisterID(owner, "b"), Value: []byte{2}},
+		{Key: flow.NewRegisterID(owner, "a"), Value: []byte{1}},
+	}
+
+	trieUpdate := &ledger.TrieUpdate{}
+	for _, entry := range entries {
+		trieUpdate.Payloads = append(trieUpdate.Payloads,
+			ledger.NewPayload(convert.RegisterIDToLedgerKey(entry.Key), entry.Value))
+	}
+
+	t.Run("equal in any order", func(t *testing.T) {
+		_, diverged := diffRegisterUpdates(trieUpdate, flow.RegisterEntries{entries[1], entries[0]})
+		require.False(t, diverged)
+	})
+
+	t.Run("different value", func(t *testing.T) {
+		actual := flow.RegisterEntries{entries[0], {Key: entries[1].Key, Value: []byte{3}}}
+
+		divergence, diverged := diffRegisterUpdates(trieUpdate, actual)
+		require.True(t, diverged)
+		require.Equal(t, SourceExecutionData, divergence.Source)
+		require.Equal(t, formatRegisterEntry(entries[1]), divergence.Expected)
+	})
+
+	t.Run("missing update", func(t *testing.T) {
+		divergence, diverged := diffRegisterUpdates(trieUpdate, entries[:1])
+		require.True(t, diverged)
+		require.Equal(t, "missing", divergence.Actual)
+	})
+}
+
+func TestDiffChunk(t *testing.T) {
+	expected := unittest.ChunkFixture(unittest.IdentifierFixture(), 0)
+
+	actual := *expected
+	actual.EndState = unittest.StateCommitmentFixture()
+	require.Empty(t, diffChunk(SourceStored, expected, &actual, false))
+
+	divergences := diffChunk(SourceStored, expected, &actual, true)
+	require.Len(t, divergences, 1)
+	require.Equal(t, "end state", divergences[0].Field)
+
+	actual.TotalComputationUsed++
+	divergences = diffChunk(SourceSealed, expected, &actual, false)
+	require.Len(t, divergences, 1)
+	require.Equal(t, SourceSealed, divergences[0].Source)
+	require.Equal(t, "total computation used", divergences[0].Field)
+}
+
+func TestDiffTransactionResult(t *testing.T) {
+	expected := unittest.TransactionResultsFixture(1)[0]
+	require.Empty(t, diffTransactionResult(expected, expected))
+
+	actual := expected
+	actual.ErrorMessage = "failed"
+	actual.ComputationUsed++
+	divergences := diffTransactionResult(expected, actual)
+	require.Len(t, divergences, 2)
+	require.Equal(t, "error message", divergences[0].Field)
+}
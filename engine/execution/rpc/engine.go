@@ -26,6 +26,9 @@ import (
 	"github.com/onflow/flow-go/engine/common/rpc"
 	"github.com/onflow/flow-go/engine/common/rpc/convert"
 	exeEng "github.com/onflow/flow-go/engine/execution"
+	"github.com/onflow/flow-go/engine/execution/rpc/profiles"
+	"github.com/onflow/flow-go/engine/execution/rpc/registers"
+	"github.com/onflow/flow-go/engine/execution/rpc/scripts"
 	"github.com/onflow/flow-go/engine/execution/state"
 	fvmerrors "github.com/onflow/flow-go/fvm/errors"
 	"github.com/onflow/flow-go/model/flow"
@@ -44,11 +47,14 @@ type Config struct {
 
 // Engine implements a gRPC server with a simplified version of the Observation API.
 type Engine struct {
-	unit    *engine.Unit
-	log     zerolog.Logger
-	handler *handler     // the gRPC service implementation
-	server  *grpc.Server // the gRPC server
-	config  Config
+	unit             *engine.Unit
+	log              zerolog.Logger
+	handler          *handler                    // the gRPC service implementation
+	registersHandler *registersHandler           // the gRPC registers service implementation
+	profilesHandler  *transactionProfilesHandler // the gRPC transaction profiles service implementation
+	scriptsHandler   *scriptsHandler             // the gRPC scripts service implementation
+	server           *grpc.Server                // the gRPC server
+	config           Config
 }
 
 // New returns a new RPC engine.
@@ -61,9 +67,12 @@ func New(
 	events storage.Events,
 	exeResults storage.ExecutionResults,
 	txResults storage.TransactionResults,
+	txProfiles storage.TransactionProfiles,
 	commits storage.Commits,
 	chainID flow.ChainID,
 	signerIndicesDecoder hotstuff.BlockSignerDecoder,
+	registerStore exeEng.RegisterStore, // nil if the storehouse is disabled
+	ownerRegisters OwnerRegisterIndex, // nil if the storehouse is disabled
 	apiRatelimits map[string]int, // the api rate limit (max calls per second) for each of the gRPC API e.g. Ping->100, ExecuteScriptAtBlockID->300
 	apiBurstLimits map[string]int, // the api burst limit (max calls at the same time) for each of the gRPC API e.g. Ping->50, ExecuteScriptAtBlockID->10
 ) *Engine {
@@ -73,23 +82,30 @@ func New(
 		grpc.MaxSendMsgSize(int(config.MaxMsgSize)),
 	}
 
-	var interceptors []grpc.UnaryServerInterceptor // ordered list of interceptors
+	var interceptors []grpc.UnaryServerInterceptor        // ordered list of interceptors
+	var streamInterceptors []grpc.StreamServerInterceptor // ordered list of stream interceptors
 	// if rpc metrics is enabled, add the grpc metrics interceptor as a server option
 	if config.RpcMetricsEnabled {
 		interceptors = append(interceptors, grpc_prometheus.UnaryServerInterceptor)
+		streamInterceptors = append(streamInterceptors, grpc_prometheus.StreamServerInterceptor)
 	}
 
 	if len(apiRatelimits) > 0 {
 		// create a rate limit interceptor
-		rateLimitInterceptor := rpc.NewRateLimiterInterceptor(log, apiRatelimits, apiBurstLimits).UnaryServerInterceptor
+		rateLimiter := rpc.NewRateLimiterInterceptor(log, apiRatelimits, apiBurstLimits)
 		// append the rate limit interceptor to the list of interceptors
-		interceptors = append(interceptors, rateLimitInterceptor)
+		interceptors = append(interceptors, rateLimiter.UnaryServerInterceptor)
+		streamInterceptors = append(streamInterceptors, rateLimiter.StreamServerInterceptor)
 	}
 
 	// create a chained unary interceptor
 	chainedInterceptors := grpc.ChainUnaryInterceptor(interceptors...)
 	serverOptions = append(serverOptions, chainedInterceptors)
 
+	// create a chained stream interceptor
+	chainedStreamInterceptors := grpc.ChainStreamInterceptor(streamInterceptors...)
+	serverOptions = append(serverOptions, chainedStreamInterceptors)
+
 	server := grpc.NewServer(serverOptions...)
 
 	eng := &Engine{
@@ -108,6 +124,25 @@ func New(
 			log:                  log,
 			maxBlockRange:        DefaultMaxBlockRange,
 		},
+		registersHandler: &registersHandler{
+			engine:                 scriptsExecutor,
+			headers:                headers,
+			commits:                commits,
+			registerStore:          registerStore,
+			ownerRegisters:         ownerRegisters,
+			maxRegistersPerRequest: DefaultMaxRegistersPerRequest,
+			maxResponseSize:        DefaultMaxRegistersResponseSize,
+			streamChunkSize:        DefaultRegistersStreamChunkSize,
+		},
+		profilesHandler: &transactionProfilesHandler{
+			transactionProfiles: txProfiles,
+		},
+		scriptsHandler: &scriptsHandler{
+			engine:          scriptsExecutor,
+			commits:         commits,
+			chain:           chainID,
+			maxTransactions: DefaultMaxScriptTransactions,
+		},
 		server: server,
 		config: config,
 	}
@@ -118,6 +153,9 @@ func New(
 	}
 
 	execution.RegisterExecutionAPIServer(eng.server, eng.handler)
+	registers.RegisterRegistersAPIServer(eng.server, eng.registersHandler)
+	profiles.RegisterTransactionProfilesAPIServer(eng.server, eng.profilesHandler)
+	scripts.RegisterScriptsAPIServer(eng.server, eng.scriptsHandler)
 
 	return eng
 }
@@ -0,0 +1,81 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/engine/common/rpc/convert"
+	"github.com/onflow/flow-go/engine/execution/rpc/profiles"
+	"github.com/onflow/flow-go/storage"
+)
+
+// transactionProfilesHandler implements the TransactionProfilesAPI.
+type transactionProfilesHandler struct {
+	profiles.UnimplementedTransactionProfilesAPIServer
+
+	transactionProfiles storage.TransactionProfiles
+}
+
+var _ profiles.TransactionProfilesAPIServer = (*transactionProfilesHandler)(nil)
+
+// GetTransactionProfile returns the resource profile of a transaction executed in the given block.
+func (h *transactionProfilesHandler) GetTransactionProfile(
+	_ context.Context,
+	req *profiles.GetTransactionProfileRequest,
+) (*profiles.GetTransactionProfileResponse, error) {
+
+	blockID, err := convert.BlockID(req.GetBlockId())
+	if err != nil {
+		return nil, err
+	}
+
+	txID, err := convert.TransactionID(req.GetTransactionId())
+	if err != nil {
+		return nil, err
+	}
+
+	profile, err := h.transactionProfiles.ByBlockIDTransactionID(blockID, txID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound,
+				"profile of transaction %s in block %s not found, the block has not been executed by node or was pruned", txID, blockID)
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get profile of transaction %s in block %s: %v", txID, blockID, err)
+	}
+
+	return &profiles.GetTransactionProfileResponse{
+		Profile: convert.TransactionProfileToMessage(*profile),
+	}, nil
+}
+
+// GetTransactionProfilesByBlockID returns the resource profiles of all transactions executed in the given block,
+// ordered by transaction index.
+func (h *transactionProfilesHandler) GetTransactionProfilesByBlockID(
+	_ context.Context,
+	req *profiles.GetTransactionProfilesByBlockIDRequest,
+) (*profiles.GetTransactionProfilesByBlockIDResponse, error) {
+
+	blockID, err := convert.BlockID(req.GetBlockId())
+	if err != nil {
+		return nil, err
+	}
+
+	txProfiles, err := h.transactionProfiles.ByBlockID(blockID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get transaction profiles of block %s: %v", blockID, err)
+	}
+
+	// profiles are stored together with the execution results, so there are no profiles
+	// if the block has not been executed
+	if len(txProfiles) == 0 {
+		return nil, status.Errorf(codes.NotFound,
+			"transaction profiles of block %s not found, the block has not been executed by node or was pruned", blockID)
+	}
+
+	return &profiles.GetTransactionProfilesByBlockIDResponse{
+		Profiles: convert.TransactionProfilesToMessages(txProfiles),
+	}, nil
+}
@@ -0,0 +1,148 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: profiles/profiles.proto
+
+package profiles
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// TransactionProfilesAPIClient is the client API for TransactionProfilesAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TransactionProfilesAPIClient interface {
+	// GetTransactionProfile returns the resource profile of a transaction executed in the given block.
+	GetTransactionProfile(ctx context.Context, in *GetTransactionProfileRequest, opts ...grpc.CallOption) (*GetTransactionProfileResponse, error)
+	// GetTransactionProfilesByBlockID returns the resource profiles of all transactions executed in the given block,
+	// ordered by transaction index.
+	GetTransactionProfilesByBlockID(ctx context.Context, in *GetTransactionProfilesByBlockIDRequest, opts ...grpc.CallOption) (*GetTransactionProfilesByBlockIDResponse, error)
+}
+
+type transactionProfilesAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTransactionProfilesAPIClient(cc grpc.ClientConnInterface) TransactionProfilesAPIClient {
+	return &transactionProfilesAPIClient{cc}
+}
+
+func (c *transactionProfilesAPIClient) GetTransactionProfile(ctx context.Context, in *GetTransactionProfileRequest, opts ...grpc.CallOption) (*GetTransactionProfileResponse, error) {
+	out := new(GetTransactionProfileResponse)
+	err := c.cc.Invoke(ctx, "/flow.execution.profiles.TransactionProfilesAPI/GetTransactionProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transactionProfilesAPIClient) GetTransactionProfilesByBlockID(ctx context.Context, in *GetTransactionProfilesByBlockIDRequest, opts ...grpc.CallOption) (*GetTransactionProfilesByBlockIDResponse, error) {
+	out := new(GetTransactionProfilesByBlockIDResponse)
+	err := c.cc.Invoke(ctx, "/flow.execution.profiles.TransactionProfilesAPI/GetTransactionProfilesByBlockID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TransactionProfilesAPIServer is the server API for TransactionProfilesAPI service.
+// All implementations must embed UnimplementedTransactionProfilesAPIServer
+// for forward compatibility
+type TransactionProfilesAPIServer interface {
+	// GetTransactionProfile returns the resource profile of a transaction executed in the given block.
+	GetTransactionProfile(context.Context, *GetTransactionProfileRequest) (*GetTransactionProfileResponse, error)
+	// GetTransactionProfilesByBlockID returns the resource profiles of all transactions executed in the given block,
+	// ordered by transaction index.
+	GetTransactionProfilesByBlockID(context.Context, *GetTransactionProfilesByBlockIDRequest) (*GetTransactionProfilesByBlockIDResponse, error)
+	mustEmbedUnimplementedTransactionProfilesAPIServer()
+}
+
+// UnimplementedTransactionProfilesAPIServer must be embedded to have forward compatible implementations.
+type UnimplementedTransactionProfilesAPIServer struct {
+}
+
+func (UnimplementedTransactionProfilesAPIServer) GetTransactionProfile(context.Context, *GetTransactionProfileRequest) (*GetTransactionProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionProfile not implemented")
+}
+func (UnimplementedTransactionProfilesAPIServer) GetTransactionProfilesByBlockID(context.Context, *GetTransactionProfilesByBlockIDRequest) (*GetTransactionProfilesByBlockIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransactionProfilesByBlockID not implemented")
+}
+func (UnimplementedTransactionProfilesAPIServer) mustEmbedUnimplementedTransactionProfilesAPIServer() {
+}
+
+// UnsafeTransactionProfilesAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TransactionProfilesAPIServer will
+// result in compilation errors.
+type UnsafeTransactionProfilesAPIServer interface {
+	mustEmbedUnimplementedTransactionProfilesAPIServer()
+}
+
+func RegisterTransactionProfilesAPIServer(s grpc.ServiceRegistrar, srv TransactionProfilesAPIServer) {
+	s.RegisterService(&TransactionProfilesAPI_ServiceDesc, srv)
+}
+
+func _TransactionProfilesAPI_GetTransactionProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionProfilesAPIServer).GetTransactionProfile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flow.execution.profiles.TransactionProfilesAPI/GetTransactionProfile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionProfilesAPIServer).GetTransactionProfile(ctx, req.(*GetTransactionProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransactionProfilesAPI_GetTransactionProfilesByBlockID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransactionProfilesByBlockIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransactionProfilesAPIServer).GetTransactionProfilesByBlockID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flow.execution.profiles.TransactionProfilesAPI/GetTransactionProfilesByBlockID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransactionProfilesAPIServer).GetTransactionProfilesByBlockID(ctx, req.(*GetTransactionProfilesByBlockIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TransactionProfilesAPI_ServiceDesc is the grpc.ServiceDesc for TransactionProfilesAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TransactionProfilesAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "flow.execution.profiles.TransactionProfilesAPI",
+	HandlerType: (*TransactionProfilesAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTransactionProfile",
+			Handler:    _TransactionProfilesAPI_GetTransactionProfile_Handler,
+		},
+		{
+			MethodName: "GetTransactionProfilesByBlockID",
+			Handler:    _TransactionProfilesAPI_GetTransactionProfilesByBlockID_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "profiles/profiles.proto",
+}
@@ -0,0 +1,92 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/engine/common/rpc/convert"
+	"github.com/onflow/flow-go/engine/execution/rpc/profiles"
+	"github.com/onflow/flow-go/model/flow"
+	realstorage "github.com/onflow/flow-go/storage"
+	storage "github.com/onflow/flow-go/storage/mock"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestGetTransactionProfile tests getting the profile of a single transaction.
+func TestGetTransactionProfile(t *testing.T) {
+	blockID := unittest.IdentifierFixture()
+	profile := unittest.TransactionProfilesFixture(1)[0]
+
+	req := &profiles.GetTransactionProfileRequest{
+		BlockId:       blockID[:],
+		TransactionId: profile.TransactionID[:],
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		txProfiles := storage.NewTransactionProfiles(t)
+		h := &transactionProfilesHandler{transactionProfiles: txProfiles}
+
+		txProfiles.On("ByBlockIDTransactionID", blockID, profile.TransactionID).Return(&profile, nil)
+
+		resp, err := h.GetTransactionProfile(context.Background(), req)
+		require.NoError(t, err)
+
+		actual, err := convert.MessageToTransactionProfile(resp.GetProfile())
+		require.NoError(t, err)
+		require.Equal(t, profile, *actual)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		txProfiles := storage.NewTransactionProfiles(t)
+		h := &transactionProfilesHandler{transactionProfiles: txProfiles}
+
+		txProfiles.On("ByBlockIDTransactionID", blockID, profile.TransactionID).Return(nil, realstorage.ErrNotFound)
+
+		_, err := h.GetTransactionProfile(context.Background(), req)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("missing transaction ID", func(t *testing.T) {
+		h := &transactionProfilesHandler{transactionProfiles: storage.NewTransactionProfiles(t)}
+
+		_, err := h.GetTransactionProfile(context.Background(), &profiles.GetTransactionProfileRequest{
+			BlockId: blockID[:],
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+// TestGetTransactionProfilesByBlockID tests getting the profiles of all transactions of a block.
+func TestGetTransactionProfilesByBlockID(t *testing.T) {
+	blockID := unittest.IdentifierFixture()
+	req := &profiles.GetTransactionProfilesByBlockIDRequest{BlockId: blockID[:]}
+
+	t.Run("happy path", func(t *testing.T) {
+		expected := unittest.TransactionProfilesFixture(3)
+		txProfiles := storage.NewTransactionProfiles(t)
+		h := &transactionProfilesHandler{transactionProfiles: txProfiles}
+
+		txProfiles.On("ByBlockID", blockID).Return(expected, nil)
+
+		resp, err := h.GetTransactionProfilesByBlockID(context.Background(), req)
+		require.NoError(t, err)
+
+		actual, err := convert.MessagesToTransactionProfiles(resp.GetProfiles())
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+	})
+
+	t.Run("block not executed", func(t *testing.T) {
+		txProfiles := storage.NewTransactionProfiles(t)
+		h := &transactionProfilesHandler{transactionProfiles: txProfiles}
+
+		txProfiles.On("ByBlockID", blockID).Return([]flow.TransactionProfile{}, nil)
+
+		_, err := h.GetTransactionProfilesByBlockID(context.Background(), req)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
@@ -0,0 +1,236 @@
+package rpc
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+
+	"github.com/onflow/flow/protobuf/go/flow/entities"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/engine/common/rpc/convert"
+	exeEng "github.com/onflow/flow-go/engine/execution"
+	"github.com/onflow/flow-go/engine/execution/rpc/registers"
+	"github.com/onflow/flow-go/engine/execution/storehouse"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+)
+
+const (
+	// DefaultMaxRegistersPerRequest is the maximum number of registers which can be requested
+	// in a single GetRegistersAtBlockID call.
+	DefaultMaxRegistersPerRequest = 1000
+
+	// DefaultMaxRegistersResponseSize is the maximum total size in bytes of the register values
+	// returned by a single GetRegistersAtBlockID call.
+	DefaultMaxRegistersResponseSize = 16 * 1024 * 1024 // 16 MiB
+
+	// DefaultRegistersStreamChunkSize is the size in bytes above which the registers streamed by
+	// GetOwnerRegistersAtBlockID are split into a new message.
+	DefaultRegistersStreamChunkSize = 1024 * 1024 // 1 MiB
+)
+
+// OwnerRegisterIndex provides the registers of an owner from the register database of the storehouse.
+type OwnerRegisterIndex interface {
+	// IterateOwnerRegisters calls fn for the value of every register of the given owner at the given height.
+	// Expected errors during normal operation:
+	//   - storage.ErrHeightNotIndexed if the height is out of the range of stored heights
+	IterateOwnerRegisters(owner string, height uint64, fn func(entry flow.RegisterEntry) error) error
+}
+
+// registersHandler implements the batched register reads of the RegistersAPI.
+type registersHandler struct {
+	registers.UnimplementedRegistersAPIServer
+
+	engine  exeEng.ScriptExecutor
+	headers storage.Headers
+	commits storage.Commits
+
+	// registerStore and ownerRegisters are nil if the storehouse is disabled
+	registerStore  exeEng.RegisterStore
+	ownerRegisters OwnerRegisterIndex
+
+	maxRegistersPerRequest int
+	maxResponseSize        int
+	streamChunkSize        int
+}
+
+var _ registers.RegistersAPIServer = (*registersHandler)(nil)
+
+// GetRegistersAtBlockID returns the values of the given registers at the end of the given block.
+// Registers are read from the storehouse if it is enabled, otherwise from the execution state.
+func (h *registersHandler) GetRegistersAtBlockID(
+	ctx context.Context,
+	req *registers.GetRegistersAtBlockIDRequest,
+) (*registers.GetRegistersAtBlockIDResponse, error) {
+
+	blockID, err := convert.BlockID(req.GetBlockId())
+	if err != nil {
+		return nil, err
+	}
+
+	ids := req.GetRegisterIds()
+	if len(ids) > h.maxRegistersPerRequest {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"too many registers requested: %d, at most %d registers can be requested at once", len(ids), h.maxRegistersPerRequest)
+	}
+
+	var read func(id *entities.RegisterID) ([]byte, error)
+	if h.registerStore != nil {
+		header, err := h.headers.ByBlockID(blockID)
+		if err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				return nil, status.Errorf(codes.NotFound, "block %s not found", blockID)
+			}
+			return nil, status.Errorf(codes.Internal, "failed to get header for block %s: %v", blockID, err)
+		}
+
+		read = func(id *entities.RegisterID) ([]byte, error) {
+			return h.registerStore.GetRegister(header.Height, blockID, registerIDFromMessage(id))
+		}
+	} else {
+		// return a more user friendly error if block has not been executed
+		if _, err = h.commits.ByBlockID(blockID); err != nil {
+			if errors.Is(err, storage.ErrNotFound) {
+				return nil, status.Errorf(codes.NotFound, "block %s has not been executed by node or was pruned", blockID)
+			}
+			return nil, status.Errorf(codes.Internal, "state commitment for block ID %s could not be retrieved", blockID)
+		}
+
+		read = func(id *entities.RegisterID) ([]byte, error) {
+			return h.engine.GetRegisterAtBlockID(ctx, id.GetOwner(), id.GetKey(), blockID)
+		}
+	}
+
+	values := make([][]byte, len(ids))
+	size := 0
+	for i, id := range ids {
+		if err := ctx.Err(); err != nil {
+			return nil, status.FromContextError(err).Err()
+		}
+
+		value, err := read(id)
+		if err != nil {
+			return nil, registerReadError(blockID, id, err)
+		}
+
+		size += len(value)
+		if size > h.maxResponseSize {
+			return nil, status.Errorf(codes.ResourceExhausted,
+				"register values exceed the maximum response size of %d bytes", h.maxResponseSize)
+		}
+
+		values[i] = value
+	}
+
+	return &registers.GetRegistersAtBlockIDResponse{
+		Values: values,
+	}, nil
+}
+
+// GetOwnerRegistersAtBlockID streams all registers of the given owner at the end of the given block.
+// The registers are read from the register database of the storehouse, so the block must be finalized,
+// and executed and indexed by the storehouse.
+func (h *registersHandler) GetOwnerRegistersAtBlockID(
+	req *registers.GetOwnerRegistersAtBlockIDRequest,
+	stream registers.RegistersAPI_GetOwnerRegistersAtBlockIDServer,
+) error {
+	if h.registerStore == nil || h.ownerRegisters == nil {
+		return status.Errorf(codes.Unimplemented, "owner register scans are only available if the storehouse is enabled")
+	}
+
+	blockID, err := convert.BlockID(req.GetBlockId())
+	if err != nil {
+		return err
+	}
+
+	header, err := h.headers.ByBlockID(blockID)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return status.Errorf(codes.NotFound, "block %s not found", blockID)
+		}
+		return status.Errorf(codes.Internal, "failed to get header for block %s: %v", blockID, err)
+	}
+
+	lastHeight := h.registerStore.LastFinalizedAndExecutedHeight()
+	if header.Height > lastHeight {
+		return status.Errorf(codes.FailedPrecondition,
+			"block %s at height %d is not finalized and executed yet, last finalized and executed height is %d",
+			blockID, header.Height, lastHeight)
+	}
+
+	finalizedID, err := h.headers.BlockIDByHeight(header.Height)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get finalized block at height %d: %v", header.Height, err)
+	}
+	if finalizedID != blockID {
+		return status.Errorf(codes.FailedPrecondition, "block %s is not finalized", blockID)
+	}
+
+	ctx := stream.Context()
+	owner := string(req.GetOwner())
+
+	var chunk []*registers.Register
+	chunkSize := 0
+
+	err = h.ownerRegisters.IterateOwnerRegisters(owner, header.Height, func(entry flow.RegisterEntry) error {
+		if err := ctx.Err(); err != nil {
+			return status.FromContextError(err).Err()
+		}
+
+		size := len(entry.Key.Owner) + len(entry.Key.Key) + len(entry.Value)
+		if len(chunk) > 0 && chunkSize+size > h.streamChunkSize {
+			err := stream.Send(&registers.GetOwnerRegistersAtBlockIDResponse{Registers: chunk})
+			if err != nil {
+				return err
+			}
+			chunk = nil
+			chunkSize = 0
+		}
+
+		chunk = append(chunk, &registers.Register{
+			Id:    convert.RegisterIDToMessage(entry.Key),
+			Value: entry.Value,
+		})
+		chunkSize += size
+
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, storage.ErrHeightNotIndexed) {
+			return status.Errorf(codes.NotFound, "registers at block %s have been pruned", blockID)
+		}
+		if _, ok := status.FromError(err); ok {
+			return err
+		}
+		return status.Errorf(codes.Internal, "failed to read registers of owner %s: %v", hex.EncodeToString(req.GetOwner()), err)
+	}
+
+	if len(chunk) > 0 {
+		return stream.Send(&registers.GetOwnerRegistersAtBlockIDResponse{Registers: chunk})
+	}
+
+	return nil
+}
+
+// registerIDFromMessage converts a register ID message to a register ID, without validating the owner.
+func registerIDFromMessage(id *entities.RegisterID) flow.RegisterID {
+	return flow.RegisterID{
+		Owner: string(id.GetOwner()),
+		Key:   string(id.GetKey()),
+	}
+}
+
+// registerReadError converts an error reading the given register into a gRPC status error.
+func registerReadError(blockID flow.Identifier, id *entities.RegisterID, err error) error {
+	switch {
+	case errors.Is(err, storehouse.ErrNotExecuted):
+		return status.Errorf(codes.NotFound, "block %s has not been executed by node", blockID)
+	case errors.Is(err, storage.ErrHeightNotIndexed):
+		return status.Errorf(codes.NotFound, "registers at block %s have been pruned", blockID)
+	default:
+		return status.Errorf(codes.Internal, "failed to collect register (owner : %s, key: %s): %v",
+			hex.EncodeToString(id.GetOwner()), string(id.GetKey()), err)
+	}
+}
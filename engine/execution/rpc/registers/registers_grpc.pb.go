@@ -0,0 +1,175 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: registers/registers.proto
+
+package registers
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// RegistersAPIClient is the client API for RegistersAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type RegistersAPIClient interface {
+	// GetRegistersAtBlockID returns the values of the given registers at the end of the given block.
+	GetRegistersAtBlockID(ctx context.Context, in *GetRegistersAtBlockIDRequest, opts ...grpc.CallOption) (*GetRegistersAtBlockIDResponse, error)
+	// GetOwnerRegistersAtBlockID streams all registers of the given owner at the end of the given block,
+	// in order of their key. It is only available on nodes with the storehouse enabled.
+	GetOwnerRegistersAtBlockID(ctx context.Context, in *GetOwnerRegistersAtBlockIDRequest, opts ...grpc.CallOption) (RegistersAPI_GetOwnerRegistersAtBlockIDClient, error)
+}
+
+type registersAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRegistersAPIClient(cc grpc.ClientConnInterface) RegistersAPIClient {
+	return &registersAPIClient{cc}
+}
+
+func (c *registersAPIClient) GetRegistersAtBlockID(ctx context.Context, in *GetRegistersAtBlockIDRequest, opts ...grpc.CallOption) (*GetRegistersAtBlockIDResponse, error) {
+	out := new(GetRegistersAtBlockIDResponse)
+	err := c.cc.Invoke(ctx, "/flow.execution.registers.RegistersAPI/GetRegistersAtBlockID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *registersAPIClient) GetOwnerRegistersAtBlockID(ctx context.Context, in *GetOwnerRegistersAtBlockIDRequest, opts ...grpc.CallOption) (RegistersAPI_GetOwnerRegistersAtBlockIDClient, error) {
+	stream, err := c.cc.NewStream(ctx, &RegistersAPI_ServiceDesc.Streams[0], "/flow.execution.registers.RegistersAPI/GetOwnerRegistersAtBlockID", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &registersAPIGetOwnerRegistersAtBlockIDClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type RegistersAPI_GetOwnerRegistersAtBlockIDClient interface {
+	Recv() (*GetOwnerRegistersAtBlockIDResponse, error)
+	grpc.ClientStream
+}
+
+type registersAPIGetOwnerRegistersAtBlockIDClient struct {
+	grpc.ClientStream
+}
+
+func (x *registersAPIGetOwnerRegistersAtBlockIDClient) Recv() (*GetOwnerRegistersAtBlockIDResponse, error) {
+	m := new(GetOwnerRegistersAtBlockIDResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// RegistersAPIServer is the server API for RegistersAPI service.
+// All implementations must embed UnimplementedRegistersAPIServer
+// for forward compatibility
+type RegistersAPIServer interface {
+	// GetRegistersAtBlockID returns the values of the given registers at the end of the given block.
+	GetRegistersAtBlockID(context.Context, *GetRegistersAtBlockIDRequest) (*GetRegistersAtBlockIDResponse, error)
+	// GetOwnerRegistersAtBlockID streams all registers of the given owner at the end of the given block,
+	// in order of their key. It is only available on nodes with the storehouse enabled.
+	GetOwnerRegistersAtBlockID(*GetOwnerRegistersAtBlockIDRequest, RegistersAPI_GetOwnerRegistersAtBlockIDServer) error
+	mustEmbedUnimplementedRegistersAPIServer()
+}
+
+// UnimplementedRegistersAPIServer must be embedded to have forward compatible implementations.
+type UnimplementedRegistersAPIServer struct {
+}
+
+func (UnimplementedRegistersAPIServer) GetRegistersAtBlockID(context.Context, *GetRegistersAtBlockIDRequest) (*GetRegistersAtBlockIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRegistersAtBlockID not implemented")
+}
+func (UnimplementedRegistersAPIServer) GetOwnerRegistersAtBlockID(*GetOwnerRegistersAtBlockIDRequest, RegistersAPI_GetOwnerRegistersAtBlockIDServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetOwnerRegistersAtBlockID not implemented")
+}
+func (UnimplementedRegistersAPIServer) mustEmbedUnimplementedRegistersAPIServer() {}
+
+// UnsafeRegistersAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RegistersAPIServer will
+// result in compilation errors.
+type UnsafeRegistersAPIServer interface {
+	mustEmbedUnimplementedRegistersAPIServer()
+}
+
+func RegisterRegistersAPIServer(s grpc.ServiceRegistrar, srv RegistersAPIServer) {
+	s.RegisterService(&RegistersAPI_ServiceDesc, srv)
+}
+
+func _RegistersAPI_GetRegistersAtBlockID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRegistersAtBlockIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RegistersAPIServer).GetRegistersAtBlockID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flow.execution.registers.RegistersAPI/GetRegistersAtBlockID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RegistersAPIServer).GetRegistersAtBlockID(ctx, req.(*GetRegistersAtBlockIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RegistersAPI_GetOwnerRegistersAtBlockID_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetOwnerRegistersAtBlockIDRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(RegistersAPIServer).GetOwnerRegistersAtBlockID(m, &registersAPIGetOwnerRegistersAtBlockIDServer{stream})
+}
+
+type RegistersAPI_GetOwnerRegistersAtBlockIDServer interface {
+	Send(*GetOwnerRegistersAtBlockIDResponse) error
+	grpc.ServerStream
+}
+
+type registersAPIGetOwnerRegistersAtBlockIDServer struct {
+	grpc.ServerStream
+}
+
+func (x *registersAPIGetOwnerRegistersAtBlockIDServer) Send(m *GetOwnerRegistersAtBlockIDResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// RegistersAPI_ServiceDesc is the grpc.ServiceDesc for RegistersAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RegistersAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "flow.execution.registers.RegistersAPI",
+	HandlerType: (*RegistersAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetRegistersAtBlockID",
+			Handler:    _RegistersAPI_GetRegistersAtBlockID_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetOwnerRegistersAtBlockID",
+			Handler:       _RegistersAPI_GetOwnerRegistersAtBlockID_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "registers/registers.proto",
+}
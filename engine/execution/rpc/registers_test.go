@@ -0,0 +1,242 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/onflow/flow/protobuf/go/flow/entities"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/engine/common/rpc/convert"
+	mockEng "github.com/onflow/flow-go/engine/execution/mock"
+	"github.com/onflow/flow-go/engine/execution/rpc/registers"
+	"github.com/onflow/flow-go/engine/execution/storehouse"
+	"github.com/onflow/flow-go/model/flow"
+	realstorage "github.com/onflow/flow-go/storage"
+	storage "github.com/onflow/flow-go/storage/mock"
+	"github.com/onflow/flow-go/storage/pebble"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestGetRegistersAtBlockID tests the batched register reads, with and without the storehouse.
+func TestGetRegistersAtBlockID(t *testing.T) {
+	header := unittest.BlockHeaderFixture()
+	blockID := header.ID()
+	id1 := flow.RegisterID{Owner: string(unittest.RandomAddressFixture().Bytes()), Key: "key1"}
+	id2 := flow.RegisterID{Owner: string(unittest.RandomAddressFixture().Bytes()), Key: "key2"}
+
+	req := &registers.GetRegistersAtBlockIDRequest{
+		BlockId:     blockID[:],
+		RegisterIds: []*entities.RegisterID{convert.RegisterIDToMessage(id1), convert.RegisterIDToMessage(id2)},
+	}
+
+	t.Run("storehouse disabled", func(t *testing.T) {
+		commits := storage.NewCommits(t)
+		engine := mockEng.NewScriptExecutor(t)
+		h := newRegistersHandler(engine, nil, commits, nil, nil)
+
+		commits.On("ByBlockID", blockID).Return(unittest.StateCommitmentFixture(), nil)
+		engine.On("GetRegisterAtBlockID", context.Background(), []byte(id1.Owner), []byte(id1.Key), blockID).
+			Return([]byte("value1"), nil)
+		engine.On("GetRegisterAtBlockID", context.Background(), []byte(id2.Owner), []byte(id2.Key), blockID).
+			Return(nil, nil)
+
+		resp, err := h.GetRegistersAtBlockID(context.Background(), req)
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{[]byte("value1"), nil}, resp.GetValues())
+	})
+
+	t.Run("storehouse disabled, block not executed", func(t *testing.T) {
+		commits := storage.NewCommits(t)
+		h := newRegistersHandler(mockEng.NewScriptExecutor(t), nil, commits, nil, nil)
+
+		commits.On("ByBlockID", blockID).Return(nil, realstorage.ErrNotFound)
+
+		_, err := h.GetRegistersAtBlockID(context.Background(), req)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("storehouse enabled", func(t *testing.T) {
+		headers := storage.NewHeaders(t)
+		registerStore := mockEng.NewRegisterStore(t)
+		h := newRegistersHandler(mockEng.NewScriptExecutor(t), headers, nil, registerStore, nil)
+
+		headers.On("ByBlockID", blockID).Return(header, nil)
+		registerStore.On("GetRegister", header.Height, blockID, id1).Return([]byte("value1"), nil)
+		registerStore.On("GetRegister", header.Height, blockID, id2).Return([]byte("value2"), nil)
+
+		resp, err := h.GetRegistersAtBlockID(context.Background(), req)
+		require.NoError(t, err)
+		require.Equal(t, [][]byte{[]byte("value1"), []byte("value2")}, resp.GetValues())
+	})
+
+	t.Run("storehouse enabled, block not executed", func(t *testing.T) {
+		headers := storage.NewHeaders(t)
+		registerStore := mockEng.NewRegisterStore(t)
+		h := newRegistersHandler(mockEng.NewScriptExecutor(t), headers, nil, registerStore, nil)
+
+		headers.On("ByBlockID", blockID).Return(header, nil)
+		registerStore.On("GetRegister", header.Height, blockID, id1).Return(nil, storehouse.ErrNotExecuted)
+
+		_, err := h.GetRegistersAtBlockID(context.Background(), req)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("too many registers", func(t *testing.T) {
+		h := newRegistersHandler(mockEng.NewScriptExecutor(t), nil, nil, nil, nil)
+		h.maxRegistersPerRequest = 1
+
+		_, err := h.GetRegistersAtBlockID(context.Background(), req)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("response too large", func(t *testing.T) {
+		headers := storage.NewHeaders(t)
+		registerStore := mockEng.NewRegisterStore(t)
+		h := newRegistersHandler(mockEng.NewScriptExecutor(t), headers, nil, registerStore, nil)
+		h.maxResponseSize = 10
+
+		headers.On("ByBlockID", blockID).Return(header, nil)
+		registerStore.On("GetRegister", header.Height, blockID, id1).Return([]byte("value1"), nil)
+		registerStore.On("GetRegister", header.Height, blockID, id2).Return([]byte("value2"), nil)
+
+		_, err := h.GetRegistersAtBlockID(context.Background(), req)
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+}
+
+// TestGetOwnerRegistersAtBlockID tests streaming the registers of an owner from the register database.
+func TestGetOwnerRegistersAtBlockID(t *testing.T) {
+	owner := string(unittest.RandomAddressFixture().Bytes())
+	otherOwner := string(unittest.RandomAddressFixture().Bytes())
+
+	header := unittest.BlockHeaderFixture(unittest.WithHeaderHeight(2))
+	blockID := header.ID()
+
+	req := &registers.GetOwnerRegistersAtBlockIDRequest{
+		BlockId: blockID[:],
+		Owner:   []byte(owner),
+	}
+
+	expected := make([]*registers.Register, 0, 10)
+	entries := make(flow.RegisterEntries, 0, 11)
+	for i := 0; i < 10; i++ {
+		id := flow.RegisterID{Owner: owner, Key: fmt.Sprintf("key%d", i)}
+		value := []byte(fmt.Sprintf("value%d", i))
+		entries = append(entries, flow.RegisterEntry{Key: id, Value: value})
+		expected = append(expected, &registers.Register{Id: convert.RegisterIDToMessage(id), Value: value})
+	}
+	entries = append(entries, flow.RegisterEntry{Key: flow.RegisterID{Owner: otherOwner, Key: "key"}, Value: []byte("value")})
+
+	pebble.RunWithRegistersStorageAtInitialHeights(t, 1, 1, func(r *pebble.Registers) {
+		require.NoError(t, r.Store(entries, 2))
+
+		t.Run("streams registers in chunks", func(t *testing.T) {
+			headers := storage.NewHeaders(t)
+			registerStore := mockEng.NewRegisterStore(t)
+			h := newRegistersHandler(mockEng.NewScriptExecutor(t), headers, nil, registerStore, r)
+			h.streamChunkSize = 64
+
+			headers.On("ByBlockID", blockID).Return(header, nil)
+			headers.On("BlockIDByHeight", header.Height).Return(blockID, nil)
+			registerStore.On("LastFinalizedAndExecutedHeight").Return(uint64(2))
+
+			stream := &ownerRegistersStream{ctx: context.Background()}
+			err := h.GetOwnerRegistersAtBlockID(req, stream)
+			require.NoError(t, err)
+
+			require.Greater(t, len(stream.responses), 1)
+			var received []*registers.Register
+			for _, resp := range stream.responses {
+				received = append(received, resp.GetRegisters()...)
+			}
+			require.Equal(t, len(expected), len(received))
+			for i := range expected {
+				require.Equal(t, expected[i].GetId().GetOwner(), received[i].GetId().GetOwner())
+				require.Equal(t, expected[i].GetId().GetKey(), received[i].GetId().GetKey())
+				require.Equal(t, expected[i].GetValue(), received[i].GetValue())
+			}
+		})
+
+		t.Run("block not finalized and executed", func(t *testing.T) {
+			headers := storage.NewHeaders(t)
+			registerStore := mockEng.NewRegisterStore(t)
+			h := newRegistersHandler(mockEng.NewScriptExecutor(t), headers, nil, registerStore, r)
+
+			headers.On("ByBlockID", blockID).Return(header, nil)
+			registerStore.On("LastFinalizedAndExecutedHeight").Return(uint64(1))
+
+			err := h.GetOwnerRegistersAtBlockID(req, &ownerRegistersStream{ctx: context.Background()})
+			require.Equal(t, codes.FailedPrecondition, status.Code(err))
+		})
+
+		t.Run("block not finalized", func(t *testing.T) {
+			headers := storage.NewHeaders(t)
+			registerStore := mockEng.NewRegisterStore(t)
+			h := newRegistersHandler(mockEng.NewScriptExecutor(t), headers, nil, registerStore, r)
+
+			headers.On("ByBlockID", blockID).Return(header, nil)
+			headers.On("BlockIDByHeight", header.Height).Return(unittest.IdentifierFixture(), nil)
+			registerStore.On("LastFinalizedAndExecutedHeight").Return(uint64(2))
+
+			err := h.GetOwnerRegistersAtBlockID(req, &ownerRegistersStream{ctx: context.Background()})
+			require.Equal(t, codes.FailedPrecondition, status.Code(err))
+		})
+	})
+
+	t.Run("storehouse disabled", func(t *testing.T) {
+		h := newRegistersHandler(mockEng.NewScriptExecutor(t), nil, nil, nil, nil)
+
+		err := h.GetOwnerRegistersAtBlockID(req, &ownerRegistersStream{ctx: context.Background()})
+		require.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+}
+
+func newRegistersHandler(
+	engine *mockEng.ScriptExecutor,
+	headers *storage.Headers,
+	commits *storage.Commits,
+	registerStore *mockEng.RegisterStore,
+	ownerRegisters *pebble.Registers,
+) *registersHandler {
+	h := &registersHandler{
+		engine:                 engine,
+		maxRegistersPerRequest: DefaultMaxRegistersPerRequest,
+		maxResponseSize:        DefaultMaxRegistersResponseSize,
+		streamChunkSize:        DefaultRegistersStreamChunkSize,
+	}
+	// avoid storing typed nil pointers in the interface fields
+	if headers != nil {
+		h.headers = headers
+	}
+	if commits != nil {
+		h.commits = commits
+	}
+	if registerStore != nil {
+		h.registerStore = registerStore
+	}
+	if ownerRegisters != nil {
+		h.ownerRegisters = ownerRegisters
+	}
+	return h
+}
+
+// ownerRegistersStream collects the responses sent on a GetOwnerRegistersAtBlockID stream.
+type ownerRegistersStream struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses []*registers.GetOwnerRegistersAtBlockIDResponse
+}
+
+func (s *ownerRegistersStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *ownerRegistersStream) Send(resp *registers.GetOwnerRegistersAtBlockIDResponse) error {
+	s.responses = append(s.responses, resp)
+	return nil
+}
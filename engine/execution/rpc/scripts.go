@@ -0,0 +1,96 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/engine/common/rpc/convert"
+	exeEng "github.com/onflow/flow-go/engine/execution"
+	"github.com/onflow/flow-go/engine/execution/rpc/scripts"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+)
+
+// DefaultMaxScriptTransactions is the maximum number of transactions which can be applied before
+// executing a script in a single ExecuteScriptWithTransactionsAtBlockID call.
+const DefaultMaxScriptTransactions = 100
+
+// scriptsHandler implements the ScriptsAPI.
+type scriptsHandler struct {
+	scripts.UnimplementedScriptsAPIServer
+
+	engine  exeEng.ScriptExecutor
+	commits storage.Commits
+	chain   flow.ChainID
+
+	maxTransactions int
+}
+
+var _ scripts.ScriptsAPIServer = (*scriptsHandler)(nil)
+
+// ExecuteScriptWithTransactionsAtBlockID executes the script on top of the state at the end of the given
+// block, after applying the given transactions in order without committing them.
+func (h *scriptsHandler) ExecuteScriptWithTransactionsAtBlockID(
+	ctx context.Context,
+	req *scripts.ExecuteScriptWithTransactionsAtBlockIDRequest,
+) (*scripts.ExecuteScriptWithTransactionsAtBlockIDResponse, error) {
+
+	blockID, err := convert.BlockID(req.GetBlockId())
+	if err != nil {
+		return nil, err
+	}
+
+	txMessages := req.GetTransactions()
+	if len(txMessages) > h.maxTransactions {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"too many transactions: %d, at most %d transactions can be applied", len(txMessages), h.maxTransactions)
+	}
+
+	transactions := make([]*flow.TransactionBody, len(txMessages))
+	for i, m := range txMessages {
+		tx, err := convert.MessageToTransaction(m, h.chain.Chain())
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid transaction %d: %v", i, err)
+		}
+		transactions[i] = &tx
+	}
+
+	// return a more user friendly error if block has not been executed
+	if _, err = h.commits.ByBlockID(blockID); err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "block %s has not been executed by node or was pruned", blockID)
+		}
+		return nil, status.Errorf(codes.Internal, "state commitment for block ID %s could not be retrieved", blockID)
+	}
+
+	value, results, compUsage, err := h.engine.ExecuteScriptWithTransactionsAtBlockID(
+		ctx,
+		req.GetScript(),
+		req.GetArguments(),
+		transactions,
+		blockID,
+	)
+	if err != nil {
+		// same as ExecuteScriptAtBlockID, script failures are reported as invalid arguments
+		return nil, status.Errorf(codes.InvalidArgument, "failed to execute script: %v", err)
+	}
+
+	txResults := make([]*scripts.SpeculativeTransactionResult, len(results))
+	for i, result := range results {
+		txResults[i] = &scripts.SpeculativeTransactionResult{
+			TransactionId:   convert.IdentifierToMessage(result.TransactionID),
+			Events:          convert.EventsToMessages(result.Events),
+			ErrorMessage:    result.ErrorMessage,
+			ComputationUsed: result.ComputationUsed,
+		}
+	}
+
+	return &scripts.ExecuteScriptWithTransactionsAtBlockIDResponse{
+		Value:              value,
+		TransactionResults: txResults,
+		ComputationUsage:   compUsage,
+	}, nil
+}
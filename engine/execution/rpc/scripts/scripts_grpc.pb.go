@@ -0,0 +1,111 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.21.12
+// source: scripts/scripts.proto
+
+package scripts
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ScriptsAPIClient is the client API for ScriptsAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ScriptsAPIClient interface {
+	// ExecuteScriptWithTransactionsAtBlockID executes the script on top of the state at the end of the given
+	// block, after applying the given transactions in order. The transactions are never committed, they are
+	// applied without authorization and sequence number checks, so they do not need to be signed.
+	ExecuteScriptWithTransactionsAtBlockID(ctx context.Context, in *ExecuteScriptWithTransactionsAtBlockIDRequest, opts ...grpc.CallOption) (*ExecuteScriptWithTransactionsAtBlockIDResponse, error)
+}
+
+type scriptsAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewScriptsAPIClient(cc grpc.ClientConnInterface) ScriptsAPIClient {
+	return &scriptsAPIClient{cc}
+}
+
+func (c *scriptsAPIClient) ExecuteScriptWithTransactionsAtBlockID(ctx context.Context, in *ExecuteScriptWithTransactionsAtBlockIDRequest, opts ...grpc.CallOption) (*ExecuteScriptWithTransactionsAtBlockIDResponse, error) {
+	out := new(ExecuteScriptWithTransactionsAtBlockIDResponse)
+	err := c.cc.Invoke(ctx, "/flow.execution.scripts.ScriptsAPI/ExecuteScriptWithTransactionsAtBlockID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ScriptsAPIServer is the server API for ScriptsAPI service.
+// All implementations must embed UnimplementedScriptsAPIServer
+// for forward compatibility
+type ScriptsAPIServer interface {
+	// ExecuteScriptWithTransactionsAtBlockID executes the script on top of the state at the end of the given
+	// block, after applying the given transactions in order. The transactions are never committed, they are
+	// applied without authorization and sequence number checks, so they do not need to be signed.
+	ExecuteScriptWithTransactionsAtBlockID(context.Context, *ExecuteScriptWithTransactionsAtBlockIDRequest) (*ExecuteScriptWithTransactionsAtBlockIDResponse, error)
+	mustEmbedUnimplementedScriptsAPIServer()
+}
+
+// UnimplementedScriptsAPIServer must be embedded to have forward compatible implementations.
+type UnimplementedScriptsAPIServer struct {
+}
+
+func (UnimplementedScriptsAPIServer) ExecuteScriptWithTransactionsAtBlockID(context.Context, *ExecuteScriptWithTransactionsAtBlockIDRequest) (*ExecuteScriptWithTransactionsAtBlockIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteScriptWithTransactionsAtBlockID not implemented")
+}
+func (UnimplementedScriptsAPIServer) mustEmbedUnimplementedScriptsAPIServer() {}
+
+// UnsafeScriptsAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ScriptsAPIServer will
+// result in compilation errors.
+type UnsafeScriptsAPIServer interface {
+	mustEmbedUnimplementedScriptsAPIServer()
+}
+
+func RegisterScriptsAPIServer(s grpc.ServiceRegistrar, srv ScriptsAPIServer) {
+	s.RegisterService(&ScriptsAPI_ServiceDesc, srv)
+}
+
+func _ScriptsAPI_ExecuteScriptWithTransactionsAtBlockID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExecuteScriptWithTransactionsAtBlockIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ScriptsAPIServer).ExecuteScriptWithTransactionsAtBlockID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/flow.execution.scripts.ScriptsAPI/ExecuteScriptWithTransactionsAtBlockID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ScriptsAPIServer).ExecuteScriptWithTransactionsAtBlockID(ctx, req.(*ExecuteScriptWithTransactionsAtBlockIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ScriptsAPI_ServiceDesc is the grpc.ServiceDesc for ScriptsAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ScriptsAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "flow.execution.scripts.ScriptsAPI",
+	HandlerType: (*ScriptsAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ExecuteScriptWithTransactionsAtBlockID",
+			Handler:    _ScriptsAPI_ExecuteScriptWithTransactionsAtBlockID_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "scripts/scripts.proto",
+}
@@ -0,0 +1,106 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onflow/flow/protobuf/go/flow/entities"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/onflow/flow-go/engine/common/rpc/convert"
+	mockEng "github.com/onflow/flow-go/engine/execution/mock"
+	"github.com/onflow/flow-go/engine/execution/rpc/scripts"
+	"github.com/onflow/flow-go/model/flow"
+	realstorage "github.com/onflow/flow-go/storage"
+	storage "github.com/onflow/flow-go/storage/mock"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestExecuteScriptWithTransactionsAtBlockID tests executing a script after applying transactions.
+func TestExecuteScriptWithTransactionsAtBlockID(t *testing.T) {
+	blockID := unittest.IdentifierFixture()
+	script := []byte("access(all) fun main(): Int { return 1 }")
+	arguments := [][]byte{[]byte(`{"type":"Int","value":"1"}`)}
+
+	tx := unittest.TransactionBodyFixture()
+	req := &scripts.ExecuteScriptWithTransactionsAtBlockIDRequest{
+		BlockId:      blockID[:],
+		Script:       script,
+		Arguments:    arguments,
+		Transactions: []*entities.Transaction{convert.TransactionToMessage(tx)},
+	}
+
+	newHandler := func(engine *mockEng.ScriptExecutor, commits *storage.Commits) *scriptsHandler {
+		return &scriptsHandler{
+			engine:          engine,
+			commits:         commits,
+			chain:           flow.Testnet,
+			maxTransactions: DefaultMaxScriptTransactions,
+		}
+	}
+
+	t.Run("happy path", func(t *testing.T) {
+		engine := mockEng.NewScriptExecutor(t)
+		commits := storage.NewCommits(t)
+		h := newHandler(engine, commits)
+
+		events := unittest.EventsFixture(2)
+		results := []flow.SpeculativeTransactionResult{{
+			TransactionID:   tx.ID(),
+			Events:          events,
+			ErrorMessage:    "failed",
+			ComputationUsed: 20,
+		}}
+
+		commits.On("ByBlockID", blockID).Return(unittest.StateCommitmentFixture(), nil)
+		engine.On("ExecuteScriptWithTransactionsAtBlockID", mock.Anything, script, arguments,
+			mock.MatchedBy(func(actual []*flow.TransactionBody) bool {
+				return len(actual) == 1 && actual[0].ID() == tx.ID()
+			}), blockID).
+			Return([]byte("value"), results, uint64(30), nil)
+
+		resp, err := h.ExecuteScriptWithTransactionsAtBlockID(context.Background(), req)
+		require.NoError(t, err)
+		require.Equal(t, []byte("value"), resp.GetValue())
+		require.Equal(t, uint64(30), resp.GetComputationUsage())
+
+		require.Len(t, resp.GetTransactionResults(), 1)
+		txResult := resp.GetTransactionResults()[0]
+		require.Equal(t, tx.ID(), convert.MessageToIdentifier(txResult.GetTransactionId()))
+		require.Equal(t, convert.EventsToMessages(events), txResult.GetEvents())
+		require.Equal(t, "failed", txResult.GetErrorMessage())
+		require.Equal(t, uint64(20), txResult.GetComputationUsed())
+	})
+
+	t.Run("block not executed", func(t *testing.T) {
+		commits := storage.NewCommits(t)
+		h := newHandler(mockEng.NewScriptExecutor(t), commits)
+
+		commits.On("ByBlockID", blockID).Return(nil, realstorage.ErrNotFound)
+
+		_, err := h.ExecuteScriptWithTransactionsAtBlockID(context.Background(), req)
+		require.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("too many transactions", func(t *testing.T) {
+		h := newHandler(mockEng.NewScriptExecutor(t), storage.NewCommits(t))
+		h.maxTransactions = 0
+
+		_, err := h.ExecuteScriptWithTransactionsAtBlockID(context.Background(), req)
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("invalid transaction", func(t *testing.T) {
+		h := newHandler(mockEng.NewScriptExecutor(t), storage.NewCommits(t))
+
+		_, err := h.ExecuteScriptWithTransactionsAtBlockID(context.Background(), &scripts.ExecuteScriptWithTransactionsAtBlockIDRequest{
+			BlockId:      blockID[:],
+			Script:       script,
+			Transactions: []*entities.Transaction{nil},
+		})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
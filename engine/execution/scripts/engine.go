@@ -63,6 +63,28 @@ func (e *Engine) ExecuteScriptAtBlockID(
 		blockSnapshot)
 }
 
+func (e *Engine) ExecuteScriptWithTransactionsAtBlockID(
+	ctx context.Context,
+	script []byte,
+	arguments [][]byte,
+	transactions []*flow.TransactionBody,
+	blockID flow.Identifier,
+) ([]byte, []flow.SpeculativeTransactionResult, uint64, error) {
+
+	blockSnapshot, header, err := e.execState.CreateStorageSnapshot(blockID)
+	if err != nil {
+		return nil, nil, 0, fmt.Errorf("failed to create storage snapshot: %w", err)
+	}
+
+	return e.queryExecutor.ExecuteScriptWithTransactions(
+		ctx,
+		script,
+		arguments,
+		transactions,
+		header,
+		blockSnapshot)
+}
+
 func (e *Engine) GetRegisterAtBlockID(
 	ctx context.Context,
 	owner, key []byte,
@@ -103,7 +103,9 @@ type state struct {
 	events             storage.Events
 	serviceEvents      storage.ServiceEvents
 	transactionResults storage.TransactionResults
-	db                 *badger.DB
+	// transactionProfiles stores the resource profiles of executed transactions
+	transactionProfiles storage.TransactionProfiles
+	db                  *badger.DB
 
 	registerStore execution.RegisterStore
 	// when it is true, registers are stored in both register store and ledger
@@ -124,6 +126,7 @@ func NewExecutionState(
 	events storage.Events,
 	serviceEvents storage.ServiceEvents,
 	transactionResults storage.TransactionResults,
+	transactionProfiles storage.TransactionProfiles,
 	db *badger.DB,
 	tracer module.Tracer,
 	registerStore execution.RegisterStore,
@@ -142,6 +145,7 @@ func NewExecutionState(
 		events:              events,
 		serviceEvents:       serviceEvents,
 		transactionResults:  transactionResults,
+		transactionProfiles: transactionProfiles,
 		db:                  db,
 		registerStore:       registerStore,
 		enableRegisterStore: enableRegisterStore,
@@ -440,6 +444,14 @@ func (s *state) saveExecutionResults(
 		return fmt.Errorf("cannot store transaction result: %w", err)
 	}
 
+	err = s.transactionProfiles.BatchStore(
+		blockID,
+		result.AllTransactionProfiles(),
+		batch)
+	if err != nil {
+		return fmt.Errorf("cannot store transaction profiles: %w", err)
+	}
+
 	executionResult := &result.ExecutionReceipt.ExecutionResult
 	err = s.results.BatchStore(executionResult, batch)
 	if err != nil {
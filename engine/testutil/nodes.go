@@ -534,6 +534,7 @@ func ExecutionNode(t *testing.T, hub *stub.Hub, identity bootstrap.NodeInfo, ide
 	eventsStorage := storage.NewEvents(node.Metrics, node.PublicDB)
 	serviceEventsStorage := storage.NewServiceEvents(node.Metrics, node.PublicDB)
 	txResultStorage := storage.NewTransactionResults(node.Metrics, node.PublicDB, storage.DefaultCacheSize)
+	txProfileStorage := storage.NewTransactionProfiles(node.Metrics, node.PublicDB, storage.DefaultCacheSize)
 	commitsStorage := storage.NewCommits(node.Metrics, node.PublicDB)
 	chunkDataPackStorage := storage.NewChunkDataPacks(node.Metrics, node.PublicDB, collectionsStorage, 100)
 	results := storage.NewExecutionResults(node.Metrics, node.PublicDB)
@@ -631,7 +632,7 @@ func ExecutionNode(t *testing.T, hub *stub.Hub, identity bootstrap.NodeInfo, ide
 
 	storehouseEnabled := true
 	execState := executionState.NewExecutionState(
-		ls, commitsStorage, node.Blocks, node.Headers, collectionsStorage, chunkDataPackStorage, results, myReceipts, eventsStorage, serviceEventsStorage, txResultStorage, node.PublicDB, node.Tracer,
+		ls, commitsStorage, node.Blocks, node.Headers, collectionsStorage, chunkDataPackStorage, results, myReceipts, eventsStorage, serviceEventsStorage, txResultStorage, txProfileStorage, node.PublicDB, node.Tracer,
 		// TODO: test with register store
 		registerStore,
 		storehouseEnabled,
@@ -725,6 +726,8 @@ func ExecutionNode(t *testing.T, hub *stub.Hub, identity bootstrap.NodeInfo, ide
 		latestFinalizedBlock,
 		false,
 		true,
+		node.State,
+		nil,
 	)
 
 	fetcher := exeFetcher.NewCollectionFetcher(node.Log, requestEngine, node.State, false)
@@ -563,8 +563,14 @@ func (builder *FollowerServiceBuilder) validateParams() error {
 func (builder *FollowerServiceBuilder) initPublicLibp2pNode(networkKey crypto.PrivateKey) (p2p.LibP2PNode, error) {
 	var pis []peer.AddrInfo
 
+	// the bootstrap identities only carry their tcp addresses, their quic addresses are derived from them if quic is enabled.
+	peerAddressInfo := utils.PeerAddressInfo
+	if builder.FlowConfig.NetworkConfig.QUICTransportEnabled {
+		peerAddressInfo = utils.PeerAddressInfoWithQUIC
+	}
+
 	for _, b := range builder.bootstrapIdentities {
-		pi, err := utils.PeerAddressInfo(*b)
+		pi, err := peerAddressInfo(*b)
 		if err != nil {
 			return nil, fmt.Errorf("could not extract peer address info from bootstrap identity %v: %w", b, err)
 		}
@@ -572,7 +578,7 @@ func (builder *FollowerServiceBuilder) initPublicLibp2pNode(networkKey crypto.Pr
 		pis = append(pis, pi)
 	}
 
-	node, err := p2pbuilder.NewNodeBuilder(
+	nodeBuilder := p2pbuilder.NewNodeBuilder(
 		builder.Logger,
 		&builder.FlowConfig.NetworkConfig.GossipSub,
 		&p2pbuilderconfig.MetricsConfig{
@@ -605,7 +611,12 @@ func (builder *FollowerServiceBuilder) initPublicLibp2pNode(networkKey crypto.Pr
 				p2pdht.AsClient(),
 				dht.BootstrapPeers(pis...),
 			)
-		}).Build()
+		})
+	if builder.FlowConfig.NetworkConfig.QUICTransportEnabled {
+		nodeBuilder.EnableQUICTransport()
+	}
+
+	node, err := nodeBuilder.Build()
 	if err != nil {
 		return nil, fmt.Errorf("could not build public libp2p node: %w", err)
 	}
@@ -688,6 +699,9 @@ func (builder *FollowerServiceBuilder) enqueuePublicNetworkInit() {
 					SpamReportQueueSize:     builder.FlowConfig.NetworkConfig.AlspConfig.SpamReportQueueSize,
 					DisablePenalty:          builder.FlowConfig.NetworkConfig.AlspConfig.DisablePenalty,
 					HeartBeatInterval:       builder.FlowConfig.NetworkConfig.AlspConfig.HearBeatInterval,
+					SnapshotDir:             builder.FlowConfig.NetworkConfig.AlspConfig.SpamRecordSnapshotDir,
+					SnapshotInterval:        builder.FlowConfig.NetworkConfig.AlspConfig.SpamRecordSnapshotInterval,
+					IdentityProvider:        builder.IdentityProvider,
 					AlspMetrics:             builder.Metrics.Network,
 					HeroCacheMetricsFactory: builder.HeroCacheMetricsFactory(),
 					NetworkType:             network.PublicNetwork,
@@ -10,6 +10,7 @@ import (
 	"github.com/onflow/cadence/runtime/common"
 	"github.com/onflow/flow-core-contracts/lib/go/templates"
 
+	"github.com/onflow/flow-go/fvm/systemcontracts"
 	"github.com/onflow/flow-go/model/flow"
 )
 
@@ -44,6 +45,9 @@ var setupFeesTransactionTemplate string
 //go:embed scripts/setExecutionMemoryLimit.cdc
 var setExecutionMemoryLimit string
 
+//go:embed scripts/verifyPayerBalanceForTxExecution.cdc
+var verifyPayerBalanceForTxExecution string
+
 func DeployTxFeesContractTransaction(flowFees, service flow.Address, contract []byte) *flow.TransactionBody {
 
 	return flow.NewTransactionBody().
@@ -243,3 +247,13 @@ func SetExecutionMemoryLimitTransaction(
 
 	return tx, nil
 }
+
+// VerifyPayerBalanceForTxExecutionScript returns a script that checks whether a payer has
+// enough balance to pay for the inclusion and maximum execution fees of a transaction.
+func VerifyPayerBalanceForTxExecutionScript(chainID flow.ChainID) []byte {
+	sc := systemcontracts.SystemContractsForChain(chainID)
+	return []byte(templates.ReplaceAddresses(
+		verifyPayerBalanceForTxExecution,
+		sc.AsTemplateEnv(),
+	))
+}
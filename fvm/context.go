@@ -7,6 +7,7 @@ import (
 	otelTrace "go.opentelemetry.io/otel/trace"
 
 	"github.com/onflow/flow-go/fvm/environment"
+	"github.com/onflow/flow-go/fvm/evm/debug"
 	reusableRuntime "github.com/onflow/flow-go/fvm/runtime"
 	"github.com/onflow/flow-go/fvm/storage/derived"
 	"github.com/onflow/flow-go/fvm/storage/state"
@@ -46,6 +47,10 @@ type Context struct {
 	// AllowProgramCacheWritesInScripts determines if the program cache can be written to in scripts
 	// By default, the program cache is only updated by transactions.
 	AllowProgramCacheWritesInScripts bool
+
+	// EVMTracer is used to trace the EVM transactions executed by Flow transactions.
+	// By default, EVM transactions are not traced.
+	EVMTracer debug.EVMTracer
 }
 
 // NewContext initializes a new execution context with the provided options.
@@ -76,6 +81,7 @@ func defaultContext() Context {
 		MaxStateInteractionSize:           DefaultMaxInteractionSize,
 		TransactionExecutorParams:         DefaultTransactionExecutorParams(),
 		EnvironmentParams:                 environment.DefaultEnvironmentParams(),
+		EVMTracer:                         debug.NopTracer,
 	}
 	return ctx
 }
@@ -373,6 +379,14 @@ func WithEVMEnabled(enabled bool) Option {
 	}
 }
 
+// WithEVMTracer sets the tracer used to trace EVM transactions
+func WithEVMTracer(tracer debug.EVMTracer) Option {
+	return func(ctx Context) Context {
+		ctx.EVMTracer = tracer
+		return ctx
+	}
+}
+
 // WithAllowProgramCacheWritesInScriptsEnabled enables caching of programs accessed by scripts
 func WithAllowProgramCacheWritesInScriptsEnabled(enabled bool) Option {
 	return func(ctx Context) Context {
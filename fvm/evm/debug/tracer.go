@@ -0,0 +1,224 @@
+package debug
+
+import (
+	"encoding/json"
+	"fmt"
+
+	gethCommon "github.com/onflow/go-ethereum/common"
+	"github.com/onflow/go-ethereum/eth/tracers"
+	"github.com/onflow/go-ethereum/eth/tracers/logger"
+	"github.com/rs/zerolog"
+
+	// registers the native tracers (e.g. callTracer) in the default tracer directory
+	_ "github.com/onflow/go-ethereum/eth/tracers/native"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/component"
+	"github.com/onflow/flow-go/module/irrecoverable"
+)
+
+const (
+	// CallTracerName is the name of the geth native call tracer, which produces
+	// the nested call frames of a transaction (`callTracer` JSON format).
+	CallTracerName = "callTracer"
+
+	// StructLoggerName is the name of the geth opcode-level struct logger.
+	StructLoggerName = "structLogger"
+
+	// uploadQueueSize is the number of trace results waiting to be uploaded. Once the
+	// queue is full, the results of newly traced transactions are dropped.
+	uploadQueueSize = 1000
+
+	// uploadWorkers is the number of workers uploading the trace results concurrently.
+	uploadWorkers = 4
+)
+
+// EVMTracer creates tracers for EVM transactions and collects their results.
+//
+// Implementations must be safe for concurrent use, since the same EVMTracer is
+// shared by all transactions of a block.
+type EVMTracer interface {
+	// WithBlockID returns an EVMTracer collecting traces of transactions executed
+	// as part of the Flow block with the given ID.
+	WithBlockID(blockID flow.Identifier) EVMTracer
+
+	// TxTracer returns a new tracer used to trace a single EVM transaction.
+	// Returns nil if tracing is disabled.
+	TxTracer() tracers.Tracer
+
+	// Collect collects the result of the given tracer, which was used to trace
+	// the EVM transaction with the given hash.
+	Collect(txHash gethCommon.Hash, tracer tracers.Tracer)
+}
+
+var _ EVMTracer = (*Tracer)(nil)
+var _ component.Component = (*Tracer)(nil)
+
+// Tracer is an EVMTracer that traces EVM transactions using a named geth tracer,
+// and uploads the JSON result of every trace using the provided Uploader.
+//
+// The uploads are done by a fixed number of workers consuming a bounded queue; the workers
+// only run while the Tracer component is started, and the traces collected once the queue is
+// full are dropped.
+type Tracer struct {
+	component.Component
+	log      zerolog.Logger
+	name     string
+	config   json.RawMessage
+	uploader Uploader
+	uploads  chan traceUpload
+	blockID  flow.Identifier
+}
+
+// traceUpload is the result of a trace waiting to be uploaded.
+type traceUpload struct {
+	log     zerolog.Logger
+	traceID string
+	result  json.RawMessage
+}
+
+// NewEVMTracer creates a new Tracer using the geth tracer with the given name and
+// JSON config, e.g. CallTracerName or StructLoggerName.
+// Returns an error if no tracer can be created with the given name and config.
+func NewEVMTracer(
+	log zerolog.Logger,
+	name string,
+	config json.RawMessage,
+	uploader Uploader,
+) (*Tracer, error) {
+	t := &Tracer{
+		log:      log.With().Str("component", "evm-tracer").Str("tracer", name).Logger(),
+		name:     name,
+		config:   config,
+		uploader: uploader,
+		uploads:  make(chan traceUpload, uploadQueueSize),
+	}
+
+	// make sure the tracer can be created, so that misconfiguration is reported on startup
+	if _, err := t.newTxTracer(); err != nil {
+		return nil, err
+	}
+
+	cm := component.NewComponentManagerBuilder()
+	for i := 0; i < uploadWorkers; i++ {
+		cm.AddWorker(t.uploadWorker)
+	}
+	t.Component = cm.Build()
+
+	return t, nil
+}
+
+// NewEVMCallTracer creates a new Tracer using the geth native call tracer.
+func NewEVMCallTracer(log zerolog.Logger, uploader Uploader) (*Tracer, error) {
+	return NewEVMTracer(log, CallTracerName, nil, uploader)
+}
+
+// WithBlockID returns a copy of the tracer collecting traces of transactions
+// executed as part of the Flow block with the given ID.
+func (t *Tracer) WithBlockID(blockID flow.Identifier) EVMTracer {
+	tracer := *t
+	tracer.blockID = blockID
+	tracer.log = t.log.With().Hex("block_id", blockID[:]).Logger()
+	return &tracer
+}
+
+// TxTracer returns a new tracer used to trace a single EVM transaction.
+// Returns nil if the tracer cannot be created, in which case the transaction is not traced.
+func (t *Tracer) TxTracer() tracers.Tracer {
+	tracer, err := t.newTxTracer()
+	if err != nil {
+		// tracing must never impact execution, so the transaction is executed without tracer
+		t.log.Error().Err(err).Msg("failed to create evm transaction tracer")
+		return nil
+	}
+	return tracer
+}
+
+func (t *Tracer) newTxTracer() (tracers.Tracer, error) {
+	if t.name == StructLoggerName {
+		cfg := &logger.Config{}
+		if len(t.config) > 0 {
+			if err := json.Unmarshal(t.config, cfg); err != nil {
+				return nil, fmt.Errorf("invalid struct logger config: %w", err)
+			}
+		}
+		return logger.NewStructLogger(cfg), nil
+	}
+
+	// only the native tracers are supported, JS tracers are too expensive to run during execution
+	if tracers.DefaultDirectory.IsJS(t.name) {
+		return nil, fmt.Errorf("unsupported tracer %s", t.name)
+	}
+
+	tracer, err := tracers.DefaultDirectory.New(t.name, &tracers.Context{}, t.config)
+	if err != nil {
+		return nil, fmt.Errorf("could not create tracer %s: %w", t.name, err)
+	}
+	return tracer, nil
+}
+
+// Collect retrieves the result of the given tracer and queues it for upload.
+//
+// The upload is done asynchronously, and errors are only logged, since tracing
+// must never impact execution. For the same reason, Collect never blocks: the result
+// is dropped if the upload queue is full.
+func (t *Tracer) Collect(txHash gethCommon.Hash, tracer tracers.Tracer) {
+	if tracer == nil {
+		return
+	}
+
+	lg := t.log.With().Str("tx_hash", txHash.Hex()).Logger()
+
+	result, err := tracer.GetResult()
+	if err != nil {
+		lg.Error().Err(err).Msg("failed to get evm transaction trace result")
+		return
+	}
+
+	upload := traceUpload{
+		log:     lg,
+		traceID: TraceID(txHash, t.blockID),
+		result:  result,
+	}
+	select {
+	case t.uploads <- upload:
+	default:
+		lg.Warn().Str("trace_id", upload.traceID).Msg("evm transaction trace upload queue is full, dropping trace")
+	}
+}
+
+// uploadWorker uploads the queued trace results until the component is stopped.
+// The results remaining in the queue on shutdown are dropped.
+func (t *Tracer) uploadWorker(ctx irrecoverable.SignalerContext, ready component.ReadyFunc) {
+	ready()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case upload := <-t.uploads:
+			err := t.uploader.Upload(upload.traceID, upload.result)
+			if err != nil {
+				upload.log.Error().Err(err).Msg("failed to upload evm transaction trace")
+				continue
+			}
+			upload.log.Debug().Str("trace_id", upload.traceID).Msg("evm transaction trace uploaded")
+		}
+	}
+}
+
+// TraceID returns the identifier of the trace of the EVM transaction with the given hash,
+// executed as part of the Flow block with the given ID.
+func TraceID(txHash gethCommon.Hash, blockID flow.Identifier) string {
+	return fmt.Sprintf("%s-%s", blockID.String(), txHash.Hex())
+}
+
+var NopTracer EVMTracer = nopTracer{}
+
+// nopTracer is an EVMTracer which does not trace any transaction.
+type nopTracer struct{}
+
+func (nopTracer) WithBlockID(flow.Identifier) EVMTracer { return NopTracer }
+
+func (nopTracer) TxTracer() tracers.Tracer { return nil }
+
+func (nopTracer) Collect(gethCommon.Hash, tracers.Tracer) {}
@@ -0,0 +1,114 @@
+package debug_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	gethCommon "github.com/onflow/go-ethereum/common"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/fvm/evm/debug"
+	"github.com/onflow/flow-go/module/irrecoverable"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestNewEVMTracer(t *testing.T) {
+	uploader, err := debug.NewFileUploader(t.TempDir())
+	require.NoError(t, err)
+
+	t.Run("call tracer", func(t *testing.T) {
+		tracer, err := debug.NewEVMCallTracer(zerolog.Nop(), uploader)
+		require.NoError(t, err)
+		assert.NotNil(t, tracer.TxTracer())
+	})
+
+	t.Run("struct logger", func(t *testing.T) {
+		tracer, err := debug.NewEVMTracer(zerolog.Nop(), debug.StructLoggerName, json.RawMessage(`{"disableStack":true}`), uploader)
+		require.NoError(t, err)
+		assert.NotNil(t, tracer.TxTracer())
+	})
+
+	t.Run("unknown tracer", func(t *testing.T) {
+		_, err := debug.NewEVMTracer(zerolog.Nop(), "unknownTracer", nil, uploader)
+		require.Error(t, err)
+	})
+
+	t.Run("nop tracer", func(t *testing.T) {
+		assert.Nil(t, debug.NopTracer.TxTracer())
+		assert.Nil(t, debug.NopTracer.WithBlockID(unittest.IdentifierFixture()).TxTracer())
+	})
+}
+
+func TestFileUploader(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "traces")
+	uploader, err := debug.NewFileUploader(dir)
+	require.NoError(t, err)
+
+	id := debug.TraceID(gethCommon.HexToHash("0x01"), unittest.IdentifierFixture())
+	data := json.RawMessage(`{"type":"CALL"}`)
+	require.NoError(t, uploader.Upload(id, data))
+
+	stored, err := os.ReadFile(filepath.Join(dir, id+".json"))
+	require.NoError(t, err)
+	assert.JSONEq(t, string(data), string(stored))
+}
+
+// blockingUploader is an Uploader which blocks every upload until it is released.
+type blockingUploader struct {
+	release  chan struct{}
+	uploaded chan string
+}
+
+func (u *blockingUploader) Upload(id string, _ json.RawMessage) error {
+	<-u.release
+	u.uploaded <- id
+	return nil
+}
+
+// TestTracer_Collect evaluates that the traces are uploaded by the workers of the tracer while it is running,
+// that Collect does not block when the upload queue is full, and that the workers stop with the tracer.
+func TestTracer_Collect(t *testing.T) {
+	uploader := &blockingUploader{
+		release:  make(chan struct{}),
+		uploaded: make(chan string, 10_000),
+	}
+	// the struct logger produces a result even for a tracer that did not trace any transaction.
+	tracer, err := debug.NewEVMTracer(zerolog.Nop(), debug.StructLoggerName, nil, uploader)
+	require.NoError(t, err)
+
+	ctx, cancel := irrecoverable.NewMockSignalerContextWithCancel(t, context.Background())
+	tracer.Start(ctx)
+	unittest.RequireComponentsReadyBefore(t, time.Second, tracer)
+
+	blockTracer := tracer.WithBlockID(unittest.IdentifierFixture())
+	collect := func(count int) {
+		for i := 0; i < count; i++ {
+			txTracer := blockTracer.TxTracer()
+			require.NotNil(t, txTracer)
+			blockTracer.Collect(gethCommon.BigToHash(big.NewInt(int64(i))), txTracer)
+		}
+	}
+
+	// all the uploads are blocked, so Collect must drop the traces beyond the queue capacity instead of blocking.
+	unittest.RequireReturnsBefore(t, func() {
+		collect(5_000)
+	}, 10*time.Second, "collect blocked on a full upload queue")
+
+	// releasing the uploads uploads the queued traces, and not the dropped ones.
+	close(uploader.release)
+	require.Eventually(t, func() bool {
+		return len(uploader.uploaded) > 0
+	}, 5*time.Second, 10*time.Millisecond)
+	time.Sleep(100 * time.Millisecond)
+	require.Less(t, len(uploader.uploaded), 5_000)
+
+	cancel()
+	unittest.RequireComponentsDoneBefore(t, time.Second, tracer)
+}
@@ -0,0 +1,93 @@
+package debug
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/sethvargo/go-retry"
+)
+
+const uploadTimeout = 10 * time.Second
+
+// Uploader stores the results of EVM transaction traces.
+type Uploader interface {
+	// Upload stores the given trace under the given trace ID.
+	// All errors returned from this function can be considered benign.
+	Upload(id string, data json.RawMessage) error
+}
+
+var _ Uploader = (*GCPUploader)(nil)
+
+// GCPUploader uploads traces as objects into a GCP bucket.
+type GCPUploader struct {
+	bucket *storage.BucketHandle
+}
+
+// NewGCPUploader creates a new uploader for the GCP bucket with the given name.
+func NewGCPUploader(bucketName string) (*GCPUploader, error) {
+	// no need to close the client according to documentation
+	// https://pkg.go.dev/cloud.google.com/go/storage#Client.Close
+	client, err := storage.NewClient(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("cannot create GCP Bucket client: %w", err)
+	}
+
+	return &GCPUploader{
+		bucket: client.Bucket(bucketName),
+	}, nil
+}
+
+// Upload uploads the trace into an object named after the trace ID.
+// Uploads are retried a few times, since GCP occasionally fails with HTTP 5xx errors.
+func (g *GCPUploader) Upload(id string, data json.RawMessage) error {
+	backoff := retry.WithMaxRetries(3, retry.NewExponential(time.Second))
+
+	return retry.Do(context.Background(), backoff, func(ctx context.Context) error {
+		ctx, cancel := context.WithTimeout(ctx, uploadTimeout)
+		defer cancel()
+
+		writer := g.bucket.Object(id).NewWriter(ctx)
+		if _, err := writer.Write(data); err != nil {
+			_ = writer.Close()
+			return retry.RetryableError(fmt.Errorf("could not write trace %s: %w", id, err))
+		}
+		if err := writer.Close(); err != nil {
+			return retry.RetryableError(fmt.Errorf("could not close trace %s: %w", id, err))
+		}
+		return nil
+	})
+}
+
+var _ Uploader = (*FileUploader)(nil)
+
+// FileUploader writes traces as JSON files into a local directory.
+type FileUploader struct {
+	dir string
+}
+
+// NewFileUploader creates a new uploader writing traces into the given directory.
+// The directory is created if it does not exist.
+func NewFileUploader(dir string) (*FileUploader, error) {
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return nil, fmt.Errorf("could not create traces directory %s: %w", dir, err)
+	}
+
+	return &FileUploader{
+		dir: dir,
+	}, nil
+}
+
+// Upload writes the trace into a file named after the trace ID.
+func (f *FileUploader) Upload(id string, data json.RawMessage) error {
+	err := os.WriteFile(filepath.Join(f.dir, fmt.Sprintf("%s.json", id)), data, 0644)
+	if err != nil {
+		return fmt.Errorf("could not write trace %s: %w", id, err)
+	}
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	gethVM "github.com/onflow/go-ethereum/core/vm"
 	gethParams "github.com/onflow/go-ethereum/params"
 
+	"github.com/onflow/flow-go/fvm/evm/debug"
 	"github.com/onflow/flow-go/fvm/evm/types"
 )
 
@@ -31,6 +32,8 @@ type Config struct {
 	TxContext *gethVM.TxContext
 	// base unit of gas for direct calls
 	DirectCallBaseGasUsage uint64
+	// tracer used to trace each executed transaction
+	EVMTracer debug.EVMTracer
 }
 
 func (c *Config) ChainRules() gethParams.Rules {
@@ -96,6 +99,7 @@ func defaultConfig() *Config {
 			},
 			GetPrecompile: gethCore.GetPrecompile,
 		},
+		EVMTracer: debug.NopTracer,
 	}
 }
 
@@ -200,6 +204,18 @@ func WithExtraPrecompiles(precompiles []types.Precompile) Option {
 	}
 }
 
+// WithTransactionTracer sets the tracer used to trace each executed transaction,
+// a nil tracer disables tracing
+func WithTransactionTracer(tracer debug.EVMTracer) Option {
+	return func(c *Config) *Config {
+		if tracer == nil {
+			tracer = debug.NopTracer
+		}
+		c.EVMTracer = tracer
+		return c
+	}
+}
+
 // WithRandom sets the block context random field
 func WithRandom(rand *gethCommon.Hash) Option {
 	return func(c *Config) *Config {
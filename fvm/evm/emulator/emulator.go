@@ -10,8 +10,10 @@ import (
 	gethTypes "github.com/onflow/go-ethereum/core/types"
 	gethVM "github.com/onflow/go-ethereum/core/vm"
 	gethCrypto "github.com/onflow/go-ethereum/crypto"
+	"github.com/onflow/go-ethereum/eth/tracers"
 	gethParams "github.com/onflow/go-ethereum/params"
 
+	"github.com/onflow/flow-go/fvm/evm/debug"
 	"github.com/onflow/flow-go/fvm/evm/emulator/state"
 	"github.com/onflow/flow-go/fvm/evm/types"
 	"github.com/onflow/flow-go/model/flow"
@@ -46,6 +48,7 @@ func newConfig(ctx types.BlockContext) *Config {
 		WithExtraPrecompiles(ctx.ExtraPrecompiles),
 		WithGetBlockHashFunction(ctx.GetHashFunc),
 		WithRandom(&ctx.Random),
+		WithTransactionTracer(ctx.Tracer),
 	)
 }
 
@@ -113,21 +116,32 @@ func (bl *BlockView) DirectCall(call *types.DirectCall) (*types.Result, error) {
 	if err != nil {
 		return nil, err
 	}
+
+	// deployments at a target address bypass the state transition
+	// and run the interpreter directly, hence they are not traced
+	if call.SubType == types.DeployCallSubType && !call.EmptyToField() {
+		return proc.deployAt(call.From, call.To, call.Data, call.GasLimit, call.Value, txHash)
+	}
+
+	txTracer := proc.startTracing(bl.config.EVMTracer)
+
+	var res *types.Result
 	switch call.SubType {
 	case types.DepositCallSubType:
-		return proc.mintTo(call, txHash)
+		res, err = proc.mintTo(call, txHash)
 	case types.WithdrawCallSubType:
-		return proc.withdrawFrom(call, txHash)
-	case types.DeployCallSubType:
-		if !call.EmptyToField() {
-			return proc.deployAt(call.From, call.To, call.Data, call.GasLimit, call.Value, txHash)
-		}
-		fallthrough
+		res, err = proc.withdrawFrom(call, txHash)
 	default:
 		// TODO: when we support mutiple calls per block, we need
 		// to update the value zero here for tx index
-		return proc.runDirect(call.Message(), txHash, 0)
+		res, err = proc.runDirect(call.Message(), txHash, 0)
+	}
+	if err != nil {
+		return res, err
 	}
+
+	bl.collectTrace(res, txTracer)
+	return res, nil
 }
 
 // RunTransaction runs an evm transaction
@@ -151,6 +165,7 @@ func (bl *BlockView) RunTransaction(
 
 	// update tx context origin
 	proc.evm.TxContext.Origin = msg.From
+	txTracer := proc.startTracing(bl.config.EVMTracer)
 	res, err := proc.run(msg, tx.Hash(), 0, tx.Type())
 	if err != nil {
 		return nil, err
@@ -160,6 +175,7 @@ func (bl *BlockView) RunTransaction(
 		return nil, err
 	}
 
+	bl.collectTrace(res, txTracer)
 	return res, nil
 }
 
@@ -183,6 +199,8 @@ func (bl *BlockView) BatchRunTransactions(txs []*gethTypes.Transaction) ([]*type
 
 		// update tx context origin
 		proc.evm.TxContext.Origin = msg.From
+		// each transaction of the batch is traced with its own tracer
+		txTracer := proc.startTracing(bl.config.EVMTracer)
 		res, err := proc.run(msg, tx.Hash(), uint(i), tx.Type())
 		if err != nil {
 			return nil, err
@@ -195,6 +213,7 @@ func (bl *BlockView) BatchRunTransactions(txs []*gethTypes.Transaction) ([]*type
 		// this clears state for any subsequent transaction runs
 		proc.state.Reset()
 
+		bl.collectTrace(res, txTracer)
 		batchResults[i] = res
 	}
 
@@ -236,6 +255,15 @@ func (bl *BlockView) DryRunTransaction(
 	return proc.run(msg, tx.Hash(), 0, tx.Type())
 }
 
+// collectTrace collects the trace of a transaction, unless the transaction is invalid
+// (invalid transactions are not executed, hence there is nothing to collect).
+func (bl *BlockView) collectTrace(res *types.Result, txTracer tracers.Tracer) {
+	if txTracer == nil || res == nil || res.Invalid() {
+		return
+	}
+	bl.config.EVMTracer.Collect(res.TxHash, txTracer)
+}
+
 func (bl *BlockView) newProcedure() (*procedure, error) {
 	execState, err := state.NewStateDB(bl.ledger, bl.rootAddr)
 	if err != nil {
@@ -261,6 +289,18 @@ type procedure struct {
 	state  types.StateDB
 }
 
+// startTracing sets up a new tracer from the given EVM tracer for the next
+// run of the procedure, and returns it. Returns nil if tracing is disabled.
+func (proc *procedure) startTracing(evmTracer debug.EVMTracer) tracers.Tracer {
+	txTracer := evmTracer.TxTracer()
+	if txTracer == nil {
+		proc.evm.Config.Tracer = nil
+		return nil
+	}
+	proc.evm.Config.Tracer = txTracer
+	return txTracer
+}
+
 // commit commits the changes to the state (with optional finalization)
 func (proc *procedure) commit(finalize bool) error {
 	err := proc.state.Commit(finalize)
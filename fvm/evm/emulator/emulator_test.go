@@ -1,21 +1,29 @@
 package emulator_test
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"math"
 	"math/big"
+	"strings"
 	"testing"
+	"time"
 
 	gethCommon "github.com/onflow/go-ethereum/common"
 	gethTypes "github.com/onflow/go-ethereum/core/types"
 	gethVM "github.com/onflow/go-ethereum/core/vm"
 	gethParams "github.com/onflow/go-ethereum/params"
+	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 
+	"github.com/onflow/flow-go/fvm/evm/debug"
 	"github.com/onflow/flow-go/fvm/evm/emulator"
 	"github.com/onflow/flow-go/fvm/evm/testutils"
 	"github.com/onflow/flow-go/fvm/evm/types"
 	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/irrecoverable"
+	"github.com/onflow/flow-go/utils/unittest"
 )
 
 var blockNumber = big.NewInt(10)
@@ -778,3 +786,138 @@ func (mp *MockedPrecompile) Run(input []byte) ([]byte, error) {
 	}
 	return mp.RunFunc(input)
 }
+
+// testTraceUploader collects the uploaded traces
+type testTraceUploader struct {
+	traces chan json.RawMessage
+}
+
+func (u *testTraceUploader) Upload(_ string, data json.RawMessage) error {
+	u.traces <- data
+	return nil
+}
+
+func TestTransactionTracing(t *testing.T) {
+	testutils.RunWithTestBackend(t, func(backend *testutils.TestBackend) {
+		testutils.RunWithTestFlowEVMRootAddress(t, backend, func(rootAddr flow.Address) {
+			testContract := testutils.GetStorageTestContract(t)
+			testutils.RunWithDeployedContract(t, testContract, backend, rootAddr, func(testContract *testutils.TestContract) {
+				testutils.RunWithEOATestAccount(t, backend, rootAddr, func(testAccount *testutils.EOATestAccount) {
+
+					uploader := &testTraceUploader{traces: make(chan json.RawMessage, 10)}
+					tracer, err := debug.NewEVMCallTracer(zerolog.Nop(), uploader)
+					require.NoError(t, err)
+					signalerCtx, cancel := irrecoverable.NewMockSignalerContextWithCancel(t, context.Background())
+					tracer.Start(signalerCtx)
+					unittest.RequireComponentsReadyBefore(t, time.Second, tracer)
+					defer func() {
+						cancel()
+						unittest.RequireComponentsDoneBefore(t, time.Second, tracer)
+					}()
+
+					ctx := types.NewDefaultBlockContext(blockNumber.Uint64())
+					ctx.Tracer = tracer.WithBlockID(unittest.IdentifierFixture())
+
+					type callFrame struct {
+						Type  string `json:"type"`
+						From  string `json:"from"`
+						To    string `json:"to"`
+						Error string `json:"error"`
+					}
+
+					receiveTrace := func() callFrame {
+						var data json.RawMessage
+						select {
+						case data = <-uploader.traces:
+						case <-time.After(5 * time.Second):
+							require.FailNow(t, "trace was not uploaded")
+						}
+						var frame callFrame
+						require.NoError(t, json.Unmarshal(data, &frame))
+						return frame
+					}
+
+					t.Run("trace reverted transaction", func(t *testing.T) {
+						RunWithNewEmulator(t, backend, rootAddr, func(env *emulator.Emulator) {
+							blk, err := env.NewBlockView(ctx)
+							require.NoError(t, err)
+
+							tx := testAccount.PrepareAndSignTx(
+								t,
+								testContract.DeployedAt.ToCommon(),
+								testContract.MakeCallData(t, "storeButRevert", big.NewInt(1)),
+								big.NewInt(0),
+								1_000_000,
+								big.NewInt(0),
+							)
+							res, err := blk.RunTransaction(tx)
+							require.NoError(t, err)
+							require.Error(t, res.VMError)
+
+							frame := receiveTrace()
+							require.Equal(t, "CALL", frame.Type)
+							require.Equal(t, strings.ToLower(testAccount.Address().ToCommon().Hex()), frame.From)
+							require.Equal(t, strings.ToLower(testContract.DeployedAt.ToCommon().Hex()), frame.To)
+							require.Equal(t, "execution reverted", frame.Error)
+						})
+					})
+
+					t.Run("trace each transaction of a batch", func(t *testing.T) {
+						RunWithNewEmulator(t, backend, rootAddr, func(env *emulator.Emulator) {
+							blk, err := env.NewBlockView(ctx)
+							require.NoError(t, err)
+
+							txs := make([]*gethTypes.Transaction, 2)
+							for i := range txs {
+								txs[i] = testAccount.PrepareAndSignTx(
+									t,
+									testContract.DeployedAt.ToCommon(),
+									testContract.MakeCallData(t, "store", big.NewInt(int64(i))),
+									big.NewInt(0),
+									1_000_000,
+									big.NewInt(0),
+								)
+							}
+							results, err := blk.BatchRunTransactions(txs)
+							require.NoError(t, err)
+							require.Len(t, results, len(txs))
+
+							for range txs {
+								frame := receiveTrace()
+								require.Equal(t, "CALL", frame.Type)
+								require.Empty(t, frame.Error)
+							}
+						})
+					})
+
+					t.Run("invalid transactions are not traced", func(t *testing.T) {
+						RunWithNewEmulator(t, backend, rootAddr, func(env *emulator.Emulator) {
+							blk, err := env.NewBlockView(ctx)
+							require.NoError(t, err)
+
+							tx := testAccount.SignTx(t,
+								gethTypes.NewTransaction(
+									100, // invalid nonce
+									testContract.DeployedAt.ToCommon(),
+									big.NewInt(0),
+									1_000_000,
+									big.NewInt(0),
+									nil,
+								),
+							)
+							res, err := blk.RunTransaction(tx)
+							require.NoError(t, err)
+							require.Error(t, res.ValidationError)
+
+							select {
+							case <-uploader.traces:
+								require.FailNow(t, "invalid transaction should not be traced")
+							case <-time.After(100 * time.Millisecond):
+							}
+						})
+					})
+				})
+			})
+		})
+	})
+}
@@ -7,6 +7,8 @@ import (
 	gethTypes "github.com/onflow/go-ethereum/core/types"
 	gethVM "github.com/onflow/go-ethereum/core/vm"
 	gethCrypto "github.com/onflow/go-ethereum/crypto"
+
+	"github.com/onflow/flow-go/fvm/evm/debug"
 )
 
 var (
@@ -35,6 +37,10 @@ type BlockContext struct {
 
 	// a set of extra precompiles to be injected
 	ExtraPrecompiles []Precompile
+
+	// Tracer is used to trace the transactions executed in the block,
+	// if not set no tracing is done.
+	Tracer debug.EVMTracer
 }
 
 // NewDefaultBlockContext returns a new default block context
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/runtime/common"
 
 	"github.com/onflow/flow-go/fvm/environment"
 	"github.com/onflow/flow-go/fvm/errors"
@@ -120,6 +121,8 @@ type VM interface {
 	)
 
 	GetAccount(Context, flow.Address, snapshot.StorageSnapshot) (*flow.Account, error)
+	GetAccountBalance(Context, flow.Address, snapshot.StorageSnapshot) (uint64, error)
+	GetAccountAvailableBalance(Context, flow.Address, snapshot.StorageSnapshot) (uint64, error)
 }
 
 var _ VM = (*VirtualMachine)(nil)
@@ -211,6 +214,56 @@ func (vm *VirtualMachine) GetAccount(
 	*flow.Account,
 	error,
 ) {
+	env := newSnapshotReadScriptEnv(ctx, storageSnapshot)
+	account, err := env.GetAccount(address)
+	if err != nil {
+		return nil, wrapAccountInfoError("cannot get account", err)
+	}
+	return account, nil
+}
+
+// GetAccountBalance returns the FLOW balance of an account, or an error if
+// the balance could not be read.
+func (vm *VirtualMachine) GetAccountBalance(
+	ctx Context,
+	address flow.Address,
+	storageSnapshot snapshot.StorageSnapshot,
+) (
+	uint64,
+	error,
+) {
+	env := newSnapshotReadScriptEnv(ctx, storageSnapshot)
+	balance, err := env.GetAccountBalance(common.MustBytesToAddress(address.Bytes()))
+	if err != nil {
+		return 0, wrapAccountInfoError("cannot get account balance", err)
+	}
+	return balance, nil
+}
+
+// GetAccountAvailableBalance returns the FLOW balance of an account which is
+// not reserved for storage, or an error if the balance could not be read.
+func (vm *VirtualMachine) GetAccountAvailableBalance(
+	ctx Context,
+	address flow.Address,
+	storageSnapshot snapshot.StorageSnapshot,
+) (
+	uint64,
+	error,
+) {
+	env := newSnapshotReadScriptEnv(ctx, storageSnapshot)
+	balance, err := env.GetAccountAvailableBalance(common.MustBytesToAddress(address.Bytes()))
+	if err != nil {
+		return 0, wrapAccountInfoError("cannot get account available balance", err)
+	}
+	return balance, nil
+}
+
+// newSnapshotReadScriptEnv creates a script environment which reads directly
+// from the given storage snapshot.
+func newSnapshotReadScriptEnv(
+	ctx Context,
+	storageSnapshot snapshot.StorageSnapshot,
+) environment.Environment {
 	blockDatabase := storage.NewBlockDatabase(
 		storageSnapshot,
 		0,
@@ -224,21 +277,21 @@ func (vm *VirtualMachine) GetAccount(
 				meter.DefaultParameters().
 					WithStorageInteractionLimit(ctx.MaxStateInteractionSize)))
 
-	env := environment.NewScriptEnv(
+	return environment.NewScriptEnv(
 		context.Background(),
 		ctx.TracerSpan,
 		ctx.EnvironmentParams,
 		storageTxn)
-	account, err := env.GetAccount(address)
-	if err != nil {
-		if errors.IsLedgerFailure(err) {
-			return nil, fmt.Errorf(
-				"cannot get account, this error usually happens if the "+
-					"reference block for this query is not set to a recent "+
-					"block: %w",
-				err)
-		}
-		return nil, fmt.Errorf("cannot get account: %w", err)
+}
+
+func wrapAccountInfoError(msg string, err error) error {
+	if errors.IsLedgerFailure(err) {
+		return fmt.Errorf(
+			"%s, this error usually happens if the "+
+				"reference block for this query is not set to a recent "+
+				"block: %w",
+			msg,
+			err)
 	}
-	return account, nil
+	return fmt.Errorf("%s: %w", msg, err)
 }
@@ -27,6 +27,7 @@ import (
 	"github.com/onflow/flow-go/engine/execution/testutil"
 	exeUtils "github.com/onflow/flow-go/engine/execution/utils"
 	"github.com/onflow/flow-go/fvm"
+	"github.com/onflow/flow-go/fvm/blueprints"
 	fvmCrypto "github.com/onflow/flow-go/fvm/crypto"
 	"github.com/onflow/flow-go/fvm/environment"
 	"github.com/onflow/flow-go/fvm/errors"
@@ -3079,3 +3080,98 @@ func TestEVM(t *testing.T) {
 		}),
 	)
 }
+
+func TestVerifyPayerBalanceForTxExecutionScript(t *testing.T) {
+	t.Run("service account can pay for transaction",
+		newVMTest().withBootstrapProcedureOptions(
+			fvm.WithTransactionFee(fvm.DefaultTransactionFees),
+		).run(
+			func(t *testing.T, vm fvm.VM, chain flow.Chain, ctx fvm.Context, snapshotTree snapshot.SnapshotTree) {
+				script := fvm.Script(blueprints.VerifyPayerBalanceForTxExecutionScript(chain.ChainID())).
+					WithArguments(
+						jsoncdc.MustEncode(cadence.NewAddress(chain.ServiceAddress())),
+						jsoncdc.MustEncode(cadence.UFix64(100_000_000)),
+						jsoncdc.MustEncode(cadence.UFix64(9999)),
+					)
+
+				_, output, err := vm.Run(ctx, script, snapshotTree)
+				require.NoError(t, err)
+				require.NoError(t, output.Err)
+
+				canExecuteTransaction, _, maxFees, err := fvm.DecodeVerifyPayerBalanceResult(output.Value)
+				require.NoError(t, err)
+				require.True(t, bool(canExecuteTransaction))
+				require.Greater(t, uint64(maxFees), uint64(0))
+			},
+		),
+	)
+}
+
+func TestAccountBalances(t *testing.T) {
+	t.Parallel()
+
+	t.Run("balances match the account fields",
+		newVMTest().withContextOptions(
+			fvm.WithAuthorizationChecksEnabled(false),
+			fvm.WithSequenceNumberCheckAndIncrementEnabled(false),
+		).withBootstrapProcedureOptions(
+			fvm.WithStorageMBPerFLOW(1_000_000_000),
+		).
+			run(func(t *testing.T, vm fvm.VM, chain flow.Chain, ctx fvm.Context, snapshotTree snapshot.SnapshotTree) {
+				snapshotTree, account := createAccount(
+					t,
+					vm,
+					chain,
+					ctx,
+					snapshotTree)
+
+				txBody := transferTokensTx(chain).
+					AddArgument(jsoncdc.MustEncode(cadence.UFix64(100_000_000))).
+					AddArgument(jsoncdc.MustEncode(cadence.Address(account))).
+					AddAuthorizer(chain.ServiceAddress())
+
+				executionSnapshot, output, err := vm.Run(
+					ctx,
+					fvm.Transaction(txBody, 0),
+					snapshotTree)
+				require.NoError(t, err)
+				require.NoError(t, output.Err)
+
+				snapshotTree = snapshotTree.Append(executionSnapshot)
+
+				field := func(name string) uint64 {
+					script := fvm.Script([]byte(fmt.Sprintf(`
+						access(all) fun main(): UFix64 {
+							return getAccount(0x%s).%s
+						}
+					`, account.Hex(), name)))
+
+					_, output, err := vm.Run(ctx, script, snapshotTree)
+					require.NoError(t, err)
+					require.NoError(t, output.Err)
+					return uint64(output.Value.(cadence.UFix64))
+				}
+
+				balance, err := vm.GetAccountBalance(ctx, account, snapshotTree)
+				require.NoError(t, err)
+				require.Equal(t, field("balance"), balance)
+				require.GreaterOrEqual(t, balance, uint64(100_000_000))
+
+				availableBalance, err := vm.GetAccountAvailableBalance(ctx, account, snapshotTree)
+				require.NoError(t, err)
+				require.Equal(t, field("availableBalance"), availableBalance)
+				require.LessOrEqual(t, availableBalance, balance)
+			}),
+	)
+
+	t.Run("available balance fails for accounts that don't exist",
+		newVMTest().
+			run(func(t *testing.T, vm fvm.VM, chain flow.Chain, ctx fvm.Context, snapshotTree snapshot.SnapshotTree) {
+				nonExistentAddress, err := chain.AddressAtIndex(100)
+				require.NoError(t, err)
+
+				_, err = vm.GetAccountAvailableBalance(ctx, nonExistentAddress, snapshotTree)
+				require.Error(t, err)
+			}),
+	)
+}
@@ -33,9 +33,9 @@ var VerifyPayerBalanceResultType = &cadence.StructType{
 	},
 }
 
-// decodeVerifyPayerBalanceResult decodes the VerifyPayerBalanceResult struct
+// DecodeVerifyPayerBalanceResult decodes the VerifyPayerBalanceResult struct
 // https://github.com/onflow/flow-core-contracts/blob/7c70c6a1d33c2879b60c78e363fa68fc6fce13b9/contracts/FlowFees.cdc#L75
-func decodeVerifyPayerBalanceResult(resultValue cadence.Value) (
+func DecodeVerifyPayerBalanceResult(resultValue cadence.Value) (
 	canExecuteTransaction cadence.Bool,
 	requiredBalance cadence.UFix64,
 	maximumTransactionFees cadence.UFix64,
@@ -103,7 +103,7 @@ func (_ TransactionPayerBalanceChecker) CheckPayerBalanceAndReturnMaxFees(
 		return 0, errors.NewPayerBalanceCheckFailure(proc.Transaction.Payer, err)
 	}
 
-	payerCanPay, requiredBalance, maxFees, err := decodeVerifyPayerBalanceResult(resultValue)
+	payerCanPay, requiredBalance, maxFees, err := DecodeVerifyPayerBalanceResult(resultValue)
 	if err != nil {
 		return 0, errors.NewPayerBalanceCheckFailure(proc.Transaction.Payer, err)
 	}
@@ -104,6 +104,70 @@ func (limiter TransactionStorageLimiter) getStorageCheckAddresses(
 	return addresses
 }
 
+// StorageUsedChanges returns the storage used before and after the transaction of the accounts
+// checked by the storage limiter, i.e. the accounts of the registers updated by the transaction.
+// The storage used before the transaction is read from the given storage snapshot, the transaction
+// must have been executed on top of it.
+func (limiter TransactionStorageLimiter) StorageUsedChanges(
+	ctx Context,
+	storageSnapshot snapshot.StorageSnapshot,
+	executionSnapshot *snapshot.ExecutionSnapshot,
+	payer flow.Address,
+) (
+	[]flow.StorageUsedChange,
+	error,
+) {
+	addresses := limiter.getStorageCheckAddresses(ctx, executionSnapshot, payer, 0)
+
+	changes := make([]flow.StorageUsedChange, 0, len(addresses))
+	for _, address := range addresses {
+		id := flow.AccountStatusRegisterID(address)
+
+		before, err := storageSnapshot.Get(id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read account status of %s: %w", address, err)
+		}
+
+		after, ok := executionSnapshot.WriteSet[id]
+		if !ok {
+			after = before
+		}
+
+		usedBefore, err := storageUsedFromAccountStatus(before)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode account status of %s: %w", address, err)
+		}
+
+		usedAfter, err := storageUsedFromAccountStatus(after)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode account status of %s: %w", address, err)
+		}
+
+		changes = append(changes, flow.StorageUsedChange{
+			Address:           address,
+			StorageUsedBefore: usedBefore,
+			StorageUsedAfter:  usedAfter,
+		})
+	}
+
+	return changes, nil
+}
+
+// storageUsedFromAccountStatus returns the storage used stored in the account status register value.
+// The storage used of an account without status (i.e. which does not exist) is zero.
+func storageUsedFromAccountStatus(value flow.RegisterValue) (uint64, error) {
+	if len(value) == 0 {
+		return 0, nil
+	}
+
+	status, err := environment.AccountStatusFromBytes(value)
+	if err != nil {
+		return 0, err
+	}
+
+	return status.StorageUsed(), nil
+}
+
 // checkStorageLimits checks if the transaction changed the storage of any
 // address and exceeded the storage limit.
 func (limiter TransactionStorageLimiter) checkStorageLimits(
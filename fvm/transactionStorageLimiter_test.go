@@ -220,6 +220,47 @@ func TestTransactionStorageLimiter(t *testing.T) {
 	})
 }
 
+func TestTransactionStorageLimiter_StorageUsedChanges(t *testing.T) {
+	existing := flow.HexToAddress("1")
+	created := flow.HexToAddress("2")
+	untouched := flow.HexToAddress("3")
+
+	accountStatus := func(used uint64) flow.RegisterValue {
+		status := environment.NewAccountStatus()
+		status.SetStorageUsed(used)
+		return status.ToBytes()
+	}
+
+	storageSnapshot := snapshot.MapStorageSnapshot{
+		flow.AccountStatusRegisterID(existing):  accountStatus(100),
+		flow.AccountStatusRegisterID(untouched): accountStatus(50),
+	}
+	executionSnapshot := &snapshot.ExecutionSnapshot{
+		WriteSet: map[flow.RegisterID]flow.RegisterValue{
+			flow.AccountStatusRegisterID(existing): accountStatus(120),
+			flow.NewRegisterID(existing, "a"):      flow.RegisterValue("foo"),
+			flow.AccountStatusRegisterID(created):  accountStatus(10),
+			flow.NewRegisterID(untouched, ""):      nil,
+		},
+	}
+
+	ctx := fvm.Context{
+		EnvironmentParams: environment.EnvironmentParams{
+			Chain: flow.Emulator.Chain(),
+		},
+	}
+
+	d := &fvm.TransactionStorageLimiter{}
+	changes, err := d.StorageUsedChanges(ctx, storageSnapshot, executionSnapshot, existing)
+	require.NoError(t, err)
+
+	require.ElementsMatch(t, []flow.StorageUsedChange{
+		{Address: existing, StorageUsedBefore: 100, StorageUsedAfter: 120},
+		{Address: created, StorageUsedBefore: 0, StorageUsedAfter: 10},
+		{Address: untouched, StorageUsedBefore: 50, StorageUsedAfter: 50},
+	}, changes)
+}
+
 func bytesToUFix64(b uint64) cadence.Value {
 	return cadence.UFix64(b * 100)
 }
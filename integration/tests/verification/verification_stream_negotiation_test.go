@@ -1,6 +1,7 @@
 package verification
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -18,13 +19,25 @@ func TestVerificationStreamNegotiation(t *testing.T) {
 	suite.Run(t, s)
 }
 
+// TestVerificationStreamNegotiationZstd enables both gzip and zstd stream compression between execution and verification nodes, with
+// zstd being the most preferred one. It evaluates that the nodes negotiate the best common compressor while the rest of network
+// runs on plain libp2p streams.
+func TestVerificationStreamNegotiationZstd(t *testing.T) {
+	s := new(VerificationStreamNegotiationSuite)
+	s.PreferredUnicasts = strings.Join([]string{
+		string(protocols.GzipCompressionUnicast),
+		string(protocols.ZstdCompressionUnicast),
+	}, ",") // preferred unicasts are listed in ascending order of preference
+	suite.Run(t, s)
+}
+
 type VerificationStreamNegotiationSuite struct {
 	Suite
 }
 
 // TestVerificationNodeHappyPath verifies the integration of verification and execution nodes over the
 // happy path of successfully issuing a result approval for the first chunk of the first block of the testnet.
-// Note that gzip stream compression is enabled between verification and execution nodes.
+// Note that stream compression is enabled between verification and execution nodes.
 func (suite *VerificationStreamNegotiationSuite) TestVerificationNodeHappyPath() {
 	testVerificationNodeHappyPath(suite.T(), suite.exe1ID, suite.verID, suite.BlockState, suite.ReceiptState, suite.ApprovalState)
 }
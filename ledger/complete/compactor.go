@@ -59,6 +59,35 @@ type Compactor struct {
 	trieUpdateCh                         <-chan *WALTrieUpdate
 	triggerCheckpointOnNextSegmentFinish *atomic.Bool // to trigger checkpoint manually
 	metrics                              module.WALMetrics
+
+	// deltaCheckpoints is the number of delta checkpoints created between full checkpoints,
+	// delta checkpoints are disabled if 0.
+	deltaCheckpoints uint
+	// the fields below are only accessed while checkpointing, which is limited to one at a time.
+	// baseTries is only retained until the next checkpoint, and only if the next checkpoint is a delta checkpoint.
+	baseTries         []*trie.MTrie // tries of the last created checkpoint, nil if the next checkpoint is a full checkpoint
+	baseCheckpointNum int           // number of the last created checkpoint
+	deltasSinceFull   uint          // number of delta checkpoints created since the last full checkpoint
+}
+
+// CompactorOption is an option for the Compactor.
+type CompactorOption func(*Compactor)
+
+// WithDeltaCheckpoints enables delta checkpoints. After each full checkpoint, the next n checkpoints
+// are created as delta checkpoints, which only store the trie nodes changed since the previous checkpoint.
+// The first checkpoint created by the Compactor is always a full checkpoint.
+// Delta checkpoints are smaller and faster to create, but loading a delta checkpoint requires loading
+// all the checkpoints it is based on, back to the last full checkpoint.
+//
+// Creating a delta checkpoint requires the tries of the previous checkpoint, so the Compactor retains them
+// between two checkpoints, unless the next checkpoint is a full checkpoint. The retained tries share all
+// unchanged nodes with the tries of the ledger, so the additional memory is the nodes replaced by the trie
+// updates between the two checkpoints, i.e. roughly the nodes created by the updates of checkpointDistance
+// WAL segments. At most one set of base tries is retained at a time.
+func WithDeltaCheckpoints(n uint) CompactorOption {
+	return func(c *Compactor) {
+		c.deltaCheckpoints = n
+	}
 }
 
 // NewCompactor creates new Compactor which writes WAL record and triggers
@@ -79,6 +108,7 @@ func NewCompactor(
 	checkpointsToKeep uint,
 	triggerCheckpointOnNextSegmentFinish *atomic.Bool,
 	metrics module.WALMetrics,
+	opts ...CompactorOption,
 ) (*Compactor, error) {
 	if checkpointDistance < 1 {
 		checkpointDistance = 1
@@ -105,7 +135,7 @@ func NewCompactor(
 	// Create trieQueue with initial values from ledger state.
 	trieQueue := realWAL.NewTrieQueueWithValues(checkpointCapacity, tries)
 
-	return &Compactor{
+	c := &Compactor{
 		checkpointer:                         checkpointer,
 		wal:                                  w,
 		trieQueue:                            trieQueue,
@@ -118,7 +148,13 @@ func NewCompactor(
 		checkpointsToKeep:                    checkpointsToKeep,
 		triggerCheckpointOnNextSegmentFinish: triggerCheckpointOnNextSegmentFinish,
 		metrics:                              metrics,
-	}, nil
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c, nil
 }
 
 // Subscribe subscribes observer to Compactor.
@@ -292,7 +328,7 @@ Loop:
 // Since this function is only for checkpointing, Compactor isn't affected by returned error.
 func (c *Compactor) checkpoint(ctx context.Context, tries []*trie.MTrie, checkpointNum int) error {
 
-	err := createCheckpoint(c.checkpointer, c.logger, tries, checkpointNum, c.metrics)
+	err := c.createCheckpoint(tries, checkpointNum)
 	if err != nil {
 		return &createCheckpointError{num: checkpointNum, err: err}
 	}
@@ -326,6 +362,41 @@ func (c *Compactor) checkpoint(ctx context.Context, tries []*trie.MTrie, checkpo
 	return nil
 }
 
+// createCheckpoint creates a delta checkpoint against the last created checkpoint if delta checkpoints
+// are enabled and the number of delta checkpoints since the last full checkpoint is below the configured
+// number, otherwise it creates a full checkpoint.
+// Errors indicate that checkpoint file can't be created.
+// Caller should handle returned errors by retrying checkpointing when appropriate.
+func (c *Compactor) createCheckpoint(tries []*trie.MTrie, checkpointNum int) error {
+	if c.deltaCheckpoints > 0 && c.baseTries != nil && c.deltasSinceFull < c.deltaCheckpoints {
+		err := createDeltaCheckpoint(c.checkpointer, c.logger, tries, c.baseTries, c.baseCheckpointNum, checkpointNum, c.metrics)
+		if err != nil {
+			// create a full checkpoint when checkpointing is retried,
+			// in case the base checkpoint can't be used anymore.
+			c.baseTries = nil
+			return err
+		}
+		c.deltasSinceFull++
+	} else {
+		err := createCheckpoint(c.checkpointer, c.logger, tries, checkpointNum, c.metrics)
+		if err != nil {
+			return err
+		}
+		c.deltasSinceFull = 0
+	}
+
+	// only retain the tries if the next checkpoint is a delta checkpoint based on this checkpoint,
+	// otherwise release them so that the nodes they don't share with the ledger can be garbage collected.
+	if c.deltasSinceFull < c.deltaCheckpoints {
+		c.baseTries = tries
+		c.baseCheckpointNum = checkpointNum
+	} else {
+		c.baseTries = nil
+	}
+
+	return nil
+}
+
 // createCheckpoint creates checkpoint with given checkpointNum and tries.
 // Errors indicate that checkpoint file can't be created.
 // Caller should handle returned errors by retrying checkpointing when appropriate.
@@ -354,7 +425,46 @@ func createCheckpoint(checkpointer *realWAL.Checkpointer, logger zerolog.Logger,
 	return nil
 }
 
+// createDeltaCheckpoint creates delta checkpoint with given checkpointNum and tries,
+// against the base checkpoint with given baseCheckpointNum and baseTries.
+// Errors indicate that checkpoint file can't be created.
+// Caller should handle returned errors by retrying checkpointing when appropriate.
+func createDeltaCheckpoint(
+	checkpointer *realWAL.Checkpointer,
+	logger zerolog.Logger,
+	tries []*trie.MTrie,
+	baseTries []*trie.MTrie,
+	baseCheckpointNum int,
+	checkpointNum int,
+	metrics module.WALMetrics,
+) error {
+
+	logger.Info().Msgf("serializing delta checkpoint %d with %v tries, base checkpoint %d", checkpointNum, len(tries), baseCheckpointNum)
+
+	startTime := time.Now()
+
+	fileName := realWAL.NumberToFilename(checkpointNum)
+	baseFileName := realWAL.NumberToFilename(baseCheckpointNum)
+	err := realWAL.StoreDeltaCheckpoint(tries, baseTries, baseFileName, checkpointer.Dir(), fileName, logger)
+	if err != nil {
+		return fmt.Errorf("error serializing delta checkpoint (%d): %w", checkpointNum, err)
+	}
+
+	size, err := realWAL.ReadCheckpointFileSize(checkpointer.Dir(), fileName)
+	if err != nil {
+		return fmt.Errorf("error reading checkpoint file size (%d): %w", checkpointNum, err)
+	}
+
+	metrics.ExecutionCheckpointSize(size)
+
+	duration := time.Since(startTime)
+	logger.Info().Float64("total_time_s", duration.Seconds()).Msgf("created delta checkpoint %d", checkpointNum)
+
+	return nil
+}
+
 // cleanupCheckpoints deletes prior checkpoint files if needed.
+// Checkpoints which the kept delta checkpoints are based on are not deleted.
 // Since the function is side-effect free, all failures are simply a no-op.
 func cleanupCheckpoints(checkpointer *realWAL.Checkpointer, checkpointsToKeep int) error {
 	// Don't list checkpoints if we keep them all
@@ -369,7 +479,15 @@ func cleanupCheckpoints(checkpointer *realWAL.Checkpointer, checkpointsToKeep in
 		// if condition guarantees this never fails
 		checkpointsToRemove := checkpoints[:len(checkpoints)-int(checkpointsToKeep)]
 
+		requiredBases, err := checkpointBases(checkpointer, checkpoints[len(checkpoints)-int(checkpointsToKeep):])
+		if err != nil {
+			return err
+		}
+
 		for _, checkpoint := range checkpointsToRemove {
+			if _, ok := requiredBases[checkpoint]; ok {
+				continue
+			}
 			err := checkpointer.RemoveCheckpoint(checkpoint)
 			if err != nil {
 				return fmt.Errorf("cannot remove checkpoint %d: %w", checkpoint, err)
@@ -379,6 +497,29 @@ func cleanupCheckpoints(checkpointer *realWAL.Checkpointer, checkpointsToKeep in
 	return nil
 }
 
+// checkpointBases returns the numbers of all checkpoints that the given checkpoints are
+// directly or transitively based on.
+func checkpointBases(checkpointer *realWAL.Checkpointer, checkpoints []int) (map[int]struct{}, error) {
+	bases := make(map[int]struct{})
+	for _, checkpoint := range checkpoints {
+		for {
+			base, isDelta, err := checkpointer.CheckpointBase(checkpoint)
+			if err != nil {
+				return nil, fmt.Errorf("cannot read base of checkpoint %d: %w", checkpoint, err)
+			}
+			if !isDelta || base < 0 {
+				break
+			}
+			if _, ok := bases[base]; ok {
+				break
+			}
+			bases[base] = struct{}{}
+			checkpoint = base
+		}
+	}
+	return bases, nil
+}
+
 // processTrieUpdate writes trie update to WAL, updates activeSegmentNum,
 // and returns tries for checkpointing if needed.
 // It sends WAL update result, receives updated trie, and pushes updated trie to trieQueue.
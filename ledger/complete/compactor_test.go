@@ -733,6 +733,103 @@ func TestCompactorConcurrency(t *testing.T) {
 	})
 }
 
+// TestCompactorDeltaCheckpoints tests that the compactor creates delta checkpoints between full checkpoints,
+// and keeps the checkpoints that the kept delta checkpoints are based on.
+func TestCompactorDeltaCheckpoints(t *testing.T) {
+	const (
+		numInsPerStep      = 2
+		pathByteSize       = 32
+		minPayloadByteSize = 2 << 15 // 64  KB
+		maxPayloadByteSize = 2 << 16 // 128 KB
+		size               = 10
+		checkpointDistance = 3
+		checkpointsToKeep  = 1
+		deltaCheckpoints   = 2
+		forestCapacity     = size * 10
+		segmentSize        = 32 * 1024 // 32 KB
+	)
+
+	metricsCollector := &metrics.NoopCollector{}
+
+	unittest.RunWithTempDir(t, func(dir string) {
+		wal, err := realWAL.NewDiskWAL(unittest.Logger(), nil, metrics.NewNoopCollector(), dir, forestCapacity, pathByteSize, segmentSize)
+		require.NoError(t, err)
+
+		l, err := NewLedger(wal, size*10, metricsCollector, unittest.Logger(), DefaultPathFinderVersion)
+		require.NoError(t, err)
+
+		compactor, err := NewCompactor(l, wal, unittest.Logger(), forestCapacity, checkpointDistance, checkpointsToKeep, atomic.NewBool(false), metrics.NewNoopCollector(),
+			WithDeltaCheckpoints(deltaCheckpoints))
+		require.NoError(t, err)
+
+		// checkpoints are created at segments 2, 5 and 8
+		co := CompactorObserver{fromBound: 8, done: make(chan struct{})}
+		compactor.Subscribe(&co)
+
+		<-compactor.Ready()
+
+		rootState := l.InitialState()
+		for i := 0; i < size; i++ {
+			time.Sleep(LedgerUpdateDelay)
+
+			payloads := testutils.RandomPayloads(numInsPerStep, minPayloadByteSize, maxPayloadByteSize)
+
+			keys := make([]ledger.Key, len(payloads))
+			values := make([]ledger.Value, len(payloads))
+			for i, p := range payloads {
+				k, err := p.Key()
+				require.NoError(t, err)
+				keys[i] = k
+				values[i] = p.Value()
+			}
+
+			update, err := ledger.NewUpdate(rootState, keys, values)
+			require.NoError(t, err)
+
+			rootState, _, err = l.Set(update)
+			require.NoError(t, err)
+		}
+
+		select {
+		case <-co.done:
+		case <-time.After(60 * time.Second):
+			assert.FailNow(t, "timed out")
+		}
+
+		checkpointer, err := wal.NewCheckpointer()
+		require.NoError(t, err)
+
+		// checkpoint 2 is a full checkpoint, 5 and 8 are delta checkpoints.
+		// 2 and 5 are kept, because checkpoint 8 is based on them.
+		_, isDelta, err := checkpointer.CheckpointBase(2)
+		require.NoError(t, err)
+		require.False(t, isDelta)
+
+		base, isDelta, err := checkpointer.CheckpointBase(5)
+		require.NoError(t, err)
+		require.True(t, isDelta)
+		require.Equal(t, 2, base)
+
+		base, isDelta, err = checkpointer.CheckpointBase(8)
+		require.NoError(t, err)
+		require.True(t, isDelta)
+		require.Equal(t, 5, base)
+
+		testCheckpointedTriesMatchReplayedTriesFromSegments(t, checkpointer, 8, dir, true)
+
+		// the trie root hashes are read from the delta checkpoint
+		roots, err := realWAL.ReadTriesRootHash(unittest.Logger(), dir, realWAL.NumberToFilename(8))
+		require.NoError(t, err)
+		require.NotEmpty(t, roots)
+
+		<-l.Done()
+		<-compactor.Done()
+
+		// the next checkpoint is a full checkpoint, so the tries of checkpoint 8 are released
+		require.Nil(t, compactor.baseTries)
+	})
+}
+
 func testCheckpointedTriesMatchReplayedTriesFromSegments(
 	t *testing.T,
 	checkpointer *realWAL.Checkpointer,
@@ -0,0 +1,342 @@
+package wal
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/common/bitutils"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/flattener"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/node"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/trie"
+)
+
+// readCheckpointV7 reads a delta checkpoint file. The base checkpoint is loaded from the
+// same directory first, which recursively loads its own base if it is a delta checkpoint as well.
+// See StoreDeltaCheckpoint for the file format.
+func readCheckpointV7(f *os.File, logger zerolog.Logger) ([]*trie.MTrie, error) {
+	dir, fileName := filepath.Split(f.Name())
+
+	lg := logger.With().Str("checkpoint_file", f.Name()).Logger()
+	lg.Info().Msgf("reading v7 delta checkpoint file")
+
+	nodeCount, trieCount, expectedSum, err := readTopTriesFooter(f)
+	if err != nil {
+		return nil, fmt.Errorf("could not read delta checkpoint footer: %w", err)
+	}
+
+	// restart from the beginning of the file, make sure CRC32Reader has seen all the bytes
+	// in order to compute the correct checksum
+	_, err = f.Seek(0, io.SeekStart)
+	if err != nil {
+		return nil, fmt.Errorf("could not seek to 0: %w", err)
+	}
+
+	reader := NewCRC32Reader(bufio.NewReaderSize(f, defaultBufioReadSize))
+
+	err = validateFileHeader(MagicBytesCheckpointHeader, VersionV7, reader)
+	if err != nil {
+		return nil, err
+	}
+
+	baseFileName, baseTrieCount, err := readBaseCheckpoint(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	if baseFileName == fileName {
+		return nil, fmt.Errorf("delta checkpoint %v refers to itself as base checkpoint", fileName)
+	}
+
+	lg.Info().Msgf("loading base checkpoint %v", baseFileName)
+
+	baseTries, err := LoadCheckpoint(filepath.Join(dir, baseFileName), logger)
+	if err != nil {
+		return nil, fmt.Errorf("could not load base checkpoint %v: %w", baseFileName, err)
+	}
+
+	if len(baseTries) != int(baseTrieCount) {
+		return nil, fmt.Errorf("mismatch base trie count, delta checkpoint expects %v, base checkpoint %v has %v",
+			baseTrieCount, baseFileName, len(baseTries))
+	}
+
+	buf := make([]byte, encBaseNodeRefCountSize)
+	_, err = io.ReadFull(reader, buf)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read base node reference count: %w", err)
+	}
+	refCount := binary.BigEndian.Uint64(buf)
+
+	// index 0 is nil, the referenced base nodes take the indices 1 to refCount,
+	// and the changed nodes follow.
+	nodes := make([]*node.Node, 1, 1+refCount+nodeCount)
+
+	buf = make([]byte, encBaseNodeRefSize)
+	for i := uint64(0); i < refCount; i++ {
+		_, err = io.ReadFull(reader, buf)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read base node reference %d: %w", i, err)
+		}
+		ref, err := decodeBaseNodeRef(buf)
+		if err != nil {
+			return nil, err
+		}
+		n, err := resolveBaseNodeRef(baseTries, ref)
+		if err != nil {
+			return nil, fmt.Errorf("could not resolve base node reference %d: %w", i, err)
+		}
+		nodes = append(nodes, n)
+	}
+
+	getNode := func(nodeIndex uint64) (*node.Node, error) {
+		if nodeIndex >= uint64(len(nodes)) {
+			return nil, fmt.Errorf("sequence of serialized nodes does not satisfy Descendents-First-Relationship")
+		}
+		return nodes[nodeIndex], nil
+	}
+
+	// Scratch buffer is used as temporary buffer that reader can read into.
+	// Raw data in scratch buffer should be copied or converted into desired
+	// objects before next Read operation.
+	scratch := make([]byte, 1024*4) // must not be less than 1024
+
+	for i := uint64(0); i < nodeCount; i++ {
+		n, err := flattener.ReadNode(reader, scratch, getNode)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read node at index %d: %w", i, err)
+		}
+		nodes = append(nodes, n)
+	}
+
+	tries := make([]*trie.MTrie, trieCount)
+	for i := uint16(0); i < trieCount; i++ {
+		t, err := flattener.ReadTrie(reader, scratch, getNode)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read trie at index %d: %w", i, err)
+		}
+		tries[i] = t
+	}
+
+	// read footer and discard, since we only care about checksum
+	_, err = io.ReadFull(reader, scratch[:encNodeCountSize+encTrieCountSize])
+	if err != nil {
+		return nil, fmt.Errorf("cannot read footer: %w", err)
+	}
+
+	actualSum := reader.Crc32()
+	if actualSum != expectedSum {
+		return nil, fmt.Errorf("invalid checksum in delta checkpoint, expected %v, actual %v",
+			expectedSum, actualSum)
+	}
+
+	// read the checksum and discard, since we only care about whether ensureReachedEOF
+	_, err = io.ReadFull(reader, scratch[:crc32SumSize])
+	if err != nil {
+		return nil, fmt.Errorf("could not read checksum from delta checkpoint file: %w", err)
+	}
+
+	err = ensureReachedEOF(reader)
+	if err != nil {
+		return nil, fmt.Errorf("fail to read delta checkpoint file: %w", err)
+	}
+
+	lg.Info().
+		Uint64("base_node_reference_count", refCount).
+		Uint64("changed_node_count", nodeCount).
+		Msgf("finish reading delta checkpoint, trie root count: %v", len(tries))
+
+	return tries, nil
+}
+
+// resolveBaseNodeRef returns the node of the base tries located by the given reference.
+func resolveBaseNodeRef(baseTries []*trie.MTrie, ref baseNodeRef) (*node.Node, error) {
+	if int(ref.trieIndex) >= len(baseTries) {
+		return nil, fmt.Errorf("base trie index %d out of range, base trie count: %d", ref.trieIndex, len(baseTries))
+	}
+
+	n := baseTries[ref.trieIndex].RootNode()
+	for depth := 0; n != nil && n.Height() > int(ref.height); depth++ {
+		if n.IsLeaf() {
+			return nil, fmt.Errorf("found leaf at height %d above the referenced height %d", n.Height(), ref.height)
+		}
+		if bitutils.ReadBit(ref.path[:], depth) == 0 {
+			n = n.LeftChild()
+		} else {
+			n = n.RightChild()
+		}
+	}
+
+	if n == nil || n.Height() != int(ref.height) {
+		return nil, fmt.Errorf("no node at height %d in base trie %d", ref.height, ref.trieIndex)
+	}
+
+	return n, nil
+}
+
+// readDeltaTriesRootHash returns the root hashes of the tries stored in a delta checkpoint file.
+// The encoded tries are stored right before the footer, after the base node references and the
+// changed nodes, so only the end of the file is read.
+func readDeltaTriesRootHash(logger zerolog.Logger, dir string, fileName string) (
+	trieRootsToReturn []ledger.RootHash,
+	errToReturn error,
+) {
+	errToReturn = withFile(logger, filePathCheckpointHeader(dir, fileName), func(file *os.File) error {
+		err := validateFileHeader(MagicBytesCheckpointHeader, VersionV7, file)
+		if err != nil {
+			return err
+		}
+
+		_, triesCount, _, err := readTopTriesFooter(file)
+		if err != nil {
+			return fmt.Errorf("could not read delta checkpoint footer: %w", err)
+		}
+
+		footerOffset := encNodeCountSize + encTrieCountSize + crc32SumSize
+		trieRootOffset := footerOffset + flattener.EncodedTrieSize*int(triesCount)
+
+		_, err = file.Seek(int64(-trieRootOffset), io.SeekEnd)
+		if err != nil {
+			return fmt.Errorf("could not seek to trie roots: %w", err)
+		}
+
+		reader := bufio.NewReaderSize(file, defaultBufioReadSize)
+		trieRoots := make([]ledger.RootHash, 0, triesCount)
+		scratch := make([]byte, 1024*4) // must not be less than 1024
+		for i := 0; i < int(triesCount); i++ {
+			trieRootNode, err := flattener.ReadEncodedTrie(reader, scratch)
+			if err != nil {
+				return fmt.Errorf("could not read trie root node: %w", err)
+			}
+
+			trieRoots = append(trieRoots, ledger.RootHash(trieRootNode.RootHash))
+		}
+
+		trieRootsToReturn = trieRoots
+		return nil
+	})
+
+	return trieRootsToReturn, errToReturn
+}
+
+// validateDeltaCheckpointFile validates the checksum of a delta checkpoint file, and then validates
+// its base checkpoint, which recursively validates the base of the base if it is a delta checkpoint as well.
+func validateDeltaCheckpointFile(logger zerolog.Logger, dir string, fileName string, baseFileName string) error {
+	if baseFileName == fileName {
+		return fmt.Errorf("delta checkpoint %v refers to itself as base checkpoint", fileName)
+	}
+
+	err := withFile(logger, filePathCheckpointHeader(dir, fileName), func(file *os.File) error {
+		_, _, expectedSum, err := readTopTriesFooter(file)
+		if err != nil {
+			return fmt.Errorf("could not read delta checkpoint footer: %w", err)
+		}
+
+		size, err := file.Seek(-crc32SumSize, io.SeekEnd)
+		if err != nil {
+			return fmt.Errorf("could not seek to checksum: %w", err)
+		}
+
+		_, err = file.Seek(0, io.SeekStart)
+		if err != nil {
+			return fmt.Errorf("could not seek to 0: %w", err)
+		}
+
+		// the checksum covers all the bytes of the file before the checksum itself
+		reader := NewCRC32Reader(bufio.NewReaderSize(file, defaultBufioReadSize))
+		_, err = io.CopyN(io.Discard, reader, size)
+		if err != nil {
+			return fmt.Errorf("could not read delta checkpoint file: %w", err)
+		}
+
+		actualSum := reader.Crc32()
+		if actualSum != expectedSum {
+			return fmt.Errorf("invalid checksum in delta checkpoint, expected %v, actual %v",
+				expectedSum, actualSum)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = validateCheckpointFile(logger, dir, baseFileName)
+	if err != nil {
+		return fmt.Errorf("could not validate base checkpoint %v: %w", baseFileName, err)
+	}
+
+	return nil
+}
+
+// ReadCheckpointBase returns the file name of the base checkpoint of the checkpoint with the given
+// file name, and true if the checkpoint is a delta checkpoint.
+// It returns ("", false, nil) if the checkpoint is a full checkpoint.
+func ReadCheckpointBase(dir string, fileName string) (string, bool, error) {
+	var baseFileName string
+	var isDelta bool
+
+	err := withFile(zerolog.Nop(), filePathCheckpointHeader(dir, fileName), func(file *os.File) error {
+		reader := bufio.NewReader(file)
+		magic, version, err := readFileHeader(reader)
+		if err != nil {
+			return err
+		}
+
+		if magic != MagicBytesCheckpointHeader {
+			return fmt.Errorf("wrong magic bytes, expect %#x, but got: %#x", MagicBytesCheckpointHeader, magic)
+		}
+
+		if version != VersionV7 {
+			return nil
+		}
+
+		baseFileName, _, err = readBaseCheckpoint(reader)
+		if err != nil {
+			return err
+		}
+		isDelta = true
+		return nil
+	})
+	if err != nil {
+		return "", false, fmt.Errorf("could not read checkpoint header of %v: %w", fileName, err)
+	}
+
+	return baseFileName, isDelta, nil
+}
+
+func decodeBaseNodeRef(encoded []byte) (baseNodeRef, error) {
+	if len(encoded) != encBaseNodeRefSize {
+		return baseNodeRef{}, fmt.Errorf("wrong base node reference size, expect %v, got %v", encBaseNodeRefSize, len(encoded))
+	}
+	var ref baseNodeRef
+	ref.trieIndex = binary.BigEndian.Uint16(encoded)
+	ref.height = binary.BigEndian.Uint16(encoded[encBaseTrieIndexSize:])
+	copy(ref.path[:], encoded[encBaseTrieIndexSize+encBaseNodeHeightSize:])
+	return ref, nil
+}
+
+// readBaseCheckpoint reads the base checkpoint file name and base trie count of a delta checkpoint,
+// the reader must be positioned right after the file header.
+func readBaseCheckpoint(reader io.Reader) (string, uint16, error) {
+	buf := make([]byte, encBaseFileNameLengthSize)
+	_, err := io.ReadFull(reader, buf)
+	if err != nil {
+		return "", 0, fmt.Errorf("cannot read base checkpoint file name length: %w", err)
+	}
+
+	buf = make([]byte, int(binary.BigEndian.Uint16(buf))+encTrieCountSize)
+	_, err = io.ReadFull(reader, buf)
+	if err != nil {
+		return "", 0, fmt.Errorf("cannot read base checkpoint: %w", err)
+	}
+
+	baseFileName := string(buf[:len(buf)-encTrieCountSize])
+	baseTrieCount := binary.BigEndian.Uint16(buf[len(buf)-encTrieCountSize:])
+	return baseFileName, baseTrieCount, nil
+}
@@ -0,0 +1,159 @@
+package wal
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/ledger/complete/mtrie/trie"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestBaseNodeRefEncoding(t *testing.T) {
+	ref := baseNodeRef{trieIndex: 3, height: 250}
+	ref.path[0] = 0xa8
+
+	decoded, err := decodeBaseNodeRef(encodeBaseNodeRef(ref))
+	require.NoError(t, err)
+	require.Equal(t, ref, decoded)
+}
+
+func TestWriteAndReadDeltaCheckpoint(t *testing.T) {
+	unittest.RunWithTempDir(t, func(dir string) {
+		tries := createMultipleRandomTries(t)
+		baseTries := tries[:60]
+		deltaTries := append([]*trie.MTrie{trie.NewEmptyMTrie()}, tries[40:]...)
+		logger := unittest.Logger()
+
+		baseFileName := NumberToFilename(1)
+		require.NoError(t, StoreCheckpointV6Concurrently(baseTries, dir, baseFileName, logger))
+
+		fileName := NumberToFilename(2)
+		require.NoError(t, StoreDeltaCheckpoint(deltaTries, baseTries, baseFileName, dir, fileName, logger))
+
+		decoded, err := LoadCheckpoint(path.Join(dir, fileName), logger)
+		require.NoError(t, err)
+		requireTriesEqual(t, deltaTries, decoded)
+
+		// the delta checkpoint only stores the changed nodes
+		fullFileName := "checkpoint-full"
+		require.NoError(t, StoreCheckpointV6Concurrently(deltaTries, dir, fullFileName, logger))
+		deltaSize, err := ReadCheckpointFileSize(dir, fileName)
+		require.NoError(t, err)
+		fullSize, err := ReadCheckpointFileSize(dir, fullFileName)
+		require.NoError(t, err)
+		require.Less(t, deltaSize, fullSize)
+	})
+}
+
+func TestWriteAndReadDeltaCheckpointChain(t *testing.T) {
+	unittest.RunWithTempDir(t, func(dir string) {
+		tries := createMultipleRandomTries(t)
+		logger := unittest.Logger()
+
+		baseTries := tries[:40]
+		require.NoError(t, StoreCheckpointV6Concurrently(baseTries, dir, NumberToFilename(1), logger))
+
+		// each delta checkpoint is based on the previous checkpoint
+		for i, window := range [][]*trie.MTrie{tries[20:60], tries[40:80], tries[60:]} {
+			num := i + 2
+			require.NoError(t, StoreDeltaCheckpoint(window, baseTries, NumberToFilename(num-1), dir, NumberToFilename(num), logger))
+			baseTries = window
+		}
+
+		decoded, err := LoadCheckpoint(path.Join(dir, NumberToFilename(4)), logger)
+		require.NoError(t, err)
+		requireTriesEqual(t, tries[60:], decoded)
+
+		base, isDelta, err := ReadCheckpointBase(dir, NumberToFilename(4))
+		require.NoError(t, err)
+		require.True(t, isDelta)
+		require.Equal(t, NumberToFilename(3), base)
+
+		_, isDelta, err = ReadCheckpointBase(dir, NumberToFilename(1))
+		require.NoError(t, err)
+		require.False(t, isDelta)
+
+		// merging the chain into a full checkpoint results in the same tries
+		mergedFileName := "checkpoint-merged"
+		require.NoError(t, StoreCheckpointV6Concurrently(decoded, dir, mergedFileName, logger))
+		merged, err := LoadCheckpoint(path.Join(dir, mergedFileName), logger)
+		require.NoError(t, err)
+		requireTriesEqual(t, tries[60:], merged)
+	})
+}
+
+func TestReadDeltaCheckpointMissingBase(t *testing.T) {
+	unittest.RunWithTempDir(t, func(dir string) {
+		tries := createMultipleRandomTries(t)
+		logger := unittest.Logger()
+
+		baseFileName := NumberToFilename(1)
+		require.NoError(t, StoreCheckpointV6Concurrently(tries[:50], dir, baseFileName, logger))
+
+		fileName := NumberToFilename(2)
+		require.NoError(t, StoreDeltaCheckpoint(tries[50:], tries[:50], baseFileName, dir, fileName, logger))
+
+		require.NoError(t, deleteCheckpointFiles(dir, baseFileName))
+
+		_, err := LoadCheckpoint(path.Join(dir, fileName), logger)
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+func TestStoreDeltaCheckpointRequiresBase(t *testing.T) {
+	unittest.RunWithTempDir(t, func(dir string) {
+		tries := createSimpleTrie(t)
+		logger := unittest.Logger()
+
+		fileName := NumberToFilename(2)
+		require.Error(t, StoreDeltaCheckpoint(tries, tries, NumberToFilename(1), dir, fileName, logger))
+
+		_, err := os.Stat(path.Join(dir, fileName))
+		require.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
+
+func TestReadDeltaCheckpointTriesRootHash(t *testing.T) {
+	unittest.RunWithTempDir(t, func(dir string) {
+		tries := createMultipleRandomTries(t)
+		logger := unittest.Logger()
+
+		require.NoError(t, StoreCheckpointV6Concurrently(tries[:40], dir, NumberToFilename(1), logger))
+		require.NoError(t, StoreDeltaCheckpoint(tries[20:60], tries[:40], NumberToFilename(1), dir, NumberToFilename(2), logger))
+		require.NoError(t, StoreDeltaCheckpoint(tries[40:], tries[20:60], NumberToFilename(2), dir, NumberToFilename(3), logger))
+
+		roots, err := ReadTriesRootHash(logger, dir, NumberToFilename(3))
+		require.NoError(t, err)
+		require.Len(t, roots, len(tries[40:]))
+		for i, root := range roots {
+			require.Equal(t, tries[40+i].RootHash(), root)
+		}
+
+		require.NoError(t, CheckpointHasRootHash(logger, dir, NumberToFilename(3), tries[len(tries)-1].RootHash()))
+
+		t.Run("corrupted delta checkpoint", func(t *testing.T) {
+			filePath := path.Join(dir, NumberToFilename(2))
+			data, err := os.ReadFile(filePath)
+			require.NoError(t, err)
+			corrupted := append([]byte{}, data...)
+			corrupted[len(corrupted)/2] ^= 0xff
+			require.NoError(t, os.WriteFile(filePath, corrupted, 0644))
+			defer func() {
+				require.NoError(t, os.WriteFile(filePath, data, 0644))
+			}()
+
+			_, err = ReadTriesRootHash(logger, dir, NumberToFilename(3))
+			require.ErrorContains(t, err, "invalid checksum")
+		})
+
+		t.Run("missing base checkpoint", func(t *testing.T) {
+			require.NoError(t, deleteCheckpointFiles(dir, NumberToFilename(1)))
+
+			_, err := ReadTriesRootHash(logger, dir, NumberToFilename(3))
+			require.ErrorIs(t, err, os.ErrNotExist)
+		})
+	})
+}
@@ -0,0 +1,274 @@
+package wal
+
+import (
+	"encoding/binary"
+	"fmt"
+	"path"
+
+	"github.com/rs/zerolog"
+
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/common/bitutils"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/node"
+	"github.com/onflow/flow-go/ledger/complete/mtrie/trie"
+	utilsio "github.com/onflow/flow-go/utils/io"
+)
+
+const (
+	encBaseFileNameLengthSize = 2
+	encBaseTrieIndexSize      = 2
+	encBaseNodeHeightSize     = 2
+	encBaseNodeRefCountSize   = 8
+	encBaseNodeRefSize        = encBaseTrieIndexSize + encBaseNodeHeightSize + ledger.PathLen
+)
+
+// baseNodeRef locates a node of a trie stored in the base checkpoint of a delta checkpoint.
+// The node is found by descending from the root of the base trie at trieIndex, following
+// the first (ledger.NodeMaxHeight - height) bits of path.
+type baseNodeRef struct {
+	trieIndex uint16
+	height    uint16
+	path      ledger.Path
+}
+
+// StoreDeltaCheckpoint stores the given tries as a delta checkpoint (version 7) against the base checkpoint
+// baseFileName in outputDir, whose tries are given as baseTries.
+// The delta checkpoint is a single file, which only contains the trie nodes that are not found at the
+// same position in the tries of the base checkpoint. Unchanged subtries are stored as references into the
+// base tries, so the base checkpoint must be kept as long as the delta checkpoint is in use.
+//
+// the file stores:
+//  1. version
+//  2. base checkpoint file name
+//  3. base trie count
+//  4. base node references
+//  5. changed nodes
+//  6. tries
+//  7. node count and trie count
+//  8. checksum
+func StoreDeltaCheckpoint(
+	tries []*trie.MTrie,
+	baseTries []*trie.MTrie,
+	baseFileName string,
+	outputDir string,
+	outputFile string,
+	logger zerolog.Logger,
+) error {
+	err := storeDeltaCheckpoint(tries, baseTries, baseFileName, outputDir, outputFile, logger)
+	if err != nil {
+		cleanupErr := deleteCheckpointFiles(outputDir, outputFile)
+		if cleanupErr != nil {
+			return fmt.Errorf("fail to cleanup temp file %s, after running into error: %w", cleanupErr, err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func storeDeltaCheckpoint(
+	tries []*trie.MTrie,
+	baseTries []*trie.MTrie,
+	baseFileName string,
+	outputDir string,
+	outputFile string,
+	logger zerolog.Logger,
+) (
+	errToReturn error,
+) {
+	if len(tries) == 0 {
+		logger.Info().Msg("no tries to be checkpointed")
+		return nil
+	}
+
+	if len(baseTries) == 0 {
+		return fmt.Errorf("base checkpoint %v has no tries", baseFileName)
+	}
+
+	if len(baseTries) > int(^uint16(0)) || len(tries) > int(^uint16(0)) {
+		return fmt.Errorf("too many tries to be checkpointed: %v base tries, %v tries", len(baseTries), len(tries))
+	}
+
+	if len(baseFileName) > int(^uint16(0)) {
+		return fmt.Errorf("base checkpoint file name is too long: %v", len(baseFileName))
+	}
+
+	if !utilsio.FileExists(path.Join(outputDir, baseFileName)) {
+		return fmt.Errorf("base checkpoint file %v does not exist in %v", baseFileName, outputDir)
+	}
+
+	lg := logger.With().
+		Int("version", int(VersionV7)).
+		Int("trie_count", len(tries)).
+		Str("base_checkpoint_file", baseFileName).
+		Str("checkpoint_file", path.Join(outputDir, outputFile)).
+		Logger()
+
+	refs, refIndices := findBaseNodes(tries, baseTries)
+
+	lg.Info().Msgf("storing delta checkpoint, base node reference count: %v", len(refs))
+
+	closable, err := createClosableWriter(outputDir, outputFile, lg)
+	if err != nil {
+		return fmt.Errorf("could not create writer for delta checkpoint: %w", err)
+	}
+
+	defer func() {
+		errToReturn = closeAndMergeError(closable, errToReturn)
+	}()
+
+	writer := NewCRC32Writer(closable)
+
+	_, err = writer.Write(encodeVersion(MagicBytesCheckpointHeader, VersionV7))
+	if err != nil {
+		return fmt.Errorf("cannot write version into delta checkpoint file: %w", err)
+	}
+
+	_, err = writer.Write(encodeBaseCheckpoint(baseFileName, uint16(len(baseTries))))
+	if err != nil {
+		return fmt.Errorf("cannot write base checkpoint into delta checkpoint file: %w", err)
+	}
+
+	_, err = writer.Write(encodeBaseNodeRefCount(uint64(len(refs))))
+	if err != nil {
+		return fmt.Errorf("cannot write base node reference count: %w", err)
+	}
+
+	for _, ref := range refs {
+		_, err = writer.Write(encodeBaseNodeRef(ref))
+		if err != nil {
+			return fmt.Errorf("cannot write base node reference: %w", err)
+		}
+	}
+
+	// referenced base nodes take the indices 1 to len(refs), so the iterator of
+	// storeUniqueNodes skips them and their subtries, and only changed nodes are stored.
+	nodeCounter := uint64(len(refs)) + 1
+	scratch := make([]byte, 1024*4)
+	for _, t := range tries {
+		root := t.RootNode()
+		if root == nil {
+			continue
+		}
+		nodeCounter, err = storeUniqueNodes(root, refIndices, nodeCounter, scratch, writer, func(uint64) {})
+		if err != nil {
+			return fmt.Errorf("fail to store nodes for root trie %v: %w", root.Hash(), err)
+		}
+	}
+
+	changedNodeCount := nodeCounter - uint64(len(refs)) - 1
+
+	err = storeTries(scratch, tries, refIndices, writer)
+	if err != nil {
+		return fmt.Errorf("could not store trie root nodes: %w", err)
+	}
+
+	checksum, err := storeTopLevelTrieFooter(changedNodeCount, uint16(len(tries)), writer)
+	if err != nil {
+		return fmt.Errorf("could not store footer: %w", err)
+	}
+
+	lg.Info().
+		Uint64("changed_node_count", changedNodeCount).
+		Uint32("checksum", checksum).
+		Msg("delta checkpoint file has been successfully stored")
+
+	return nil
+}
+
+// findBaseNodes finds the topmost nodes of the given tries that are also found at the same position
+// in a base trie, by comparing each trie with the last base trie, or with a base trie with the same root.
+// It returns the references to the found nodes, and a map of visited nodes which contains the nil node
+// at index 0 and the found nodes at the indices 1 to len(refs), in the order of refs.
+func findBaseNodes(tries []*trie.MTrie, baseTries []*trie.MTrie) ([]baseNodeRef, map[*node.Node]uint64) {
+	baseTrieIndices := make(map[ledger.RootHash]int, len(baseTries))
+	for i, t := range baseTries {
+		baseTrieIndices[t.RootHash()] = i
+	}
+
+	f := &baseNodeFinder{
+		indices: map[*node.Node]uint64{nil: 0},
+		changed: make(map[*node.Node]struct{}),
+	}
+
+	for _, t := range tries {
+		i, ok := baseTrieIndices[t.RootHash()]
+		if !ok {
+			i = len(baseTries) - 1
+		}
+		f.trieIndex = uint16(i)
+		f.find(t.RootNode(), baseTries[i].RootNode(), ledger.Path{}, 0)
+	}
+
+	return f.refs, f.indices
+}
+
+type baseNodeFinder struct {
+	trieIndex uint16
+	refs      []baseNodeRef
+	indices   map[*node.Node]uint64
+	changed   map[*node.Node]struct{}
+}
+
+// find compares the node n at the given position with the base node at the same position,
+// and records n as a reference if they are the same, otherwise it descends into the children of n.
+// depth is the number of bits of path that locate n.
+func (f *baseNodeFinder) find(n *node.Node, base *node.Node, path ledger.Path, depth int) {
+	if n == nil {
+		return
+	}
+	if _, ok := f.indices[n]; ok {
+		return
+	}
+	if _, ok := f.changed[n]; ok {
+		return
+	}
+
+	if base != nil && base.Height() == n.Height() && base.Hash() == n.Hash() {
+		f.refs = append(f.refs, baseNodeRef{
+			trieIndex: f.trieIndex,
+			height:    uint16(n.Height()),
+			path:      path,
+		})
+		f.indices[n] = uint64(len(f.refs))
+		return
+	}
+
+	f.changed[n] = struct{}{}
+	if n.IsLeaf() {
+		return
+	}
+
+	var baseLeft, baseRight *node.Node
+	if base != nil && !base.IsLeaf() && base.Height() == n.Height() {
+		baseLeft, baseRight = base.LeftChild(), base.RightChild()
+	}
+
+	rightPath := path
+	bitutils.SetBit(rightPath[:], depth)
+
+	f.find(n.LeftChild(), baseLeft, path, depth+1)
+	f.find(n.RightChild(), baseRight, rightPath, depth+1)
+}
+
+func encodeBaseCheckpoint(baseFileName string, baseTrieCount uint16) []byte {
+	buf := make([]byte, encBaseFileNameLengthSize+len(baseFileName)+encTrieCountSize)
+	binary.BigEndian.PutUint16(buf, uint16(len(baseFileName)))
+	copy(buf[encBaseFileNameLengthSize:], baseFileName)
+	binary.BigEndian.PutUint16(buf[encBaseFileNameLengthSize+len(baseFileName):], baseTrieCount)
+	return buf
+}
+
+func encodeBaseNodeRefCount(count uint64) []byte {
+	buf := make([]byte, encBaseNodeRefCountSize)
+	binary.BigEndian.PutUint64(buf, count)
+	return buf
+}
+
+func encodeBaseNodeRef(ref baseNodeRef) []byte {
+	buf := make([]byte, encBaseNodeRefSize)
+	binary.BigEndian.PutUint16(buf, ref.trieIndex)
+	binary.BigEndian.PutUint16(buf[encBaseTrieIndexSize:], ref.height)
+	copy(buf[encBaseTrieIndexSize+encBaseNodeHeightSize:], ref.path[:])
+	return buf
+}
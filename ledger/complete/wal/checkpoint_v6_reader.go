@@ -20,6 +20,9 @@ import (
 // ErrEOFNotReached for indicating end of file not reached error
 var ErrEOFNotReached = errors.New("expect to reach EOF, but actually didn't")
 
+// ReadTriesRootHash returns the root hashes of the tries stored in the given v6 or v7 (delta) checkpoint,
+// after validating the checkpoint files. The root hashes of a delta checkpoint are read from the delta
+// checkpoint file itself, its base checkpoint is only validated.
 func ReadTriesRootHash(logger zerolog.Logger, dir string, fileName string) (
 	[]ledger.RootHash,
 	error,
@@ -28,6 +31,15 @@ func ReadTriesRootHash(logger zerolog.Logger, dir string, fileName string) (
 	if err != nil {
 		return nil, err
 	}
+
+	_, isDelta, err := ReadCheckpointBase(dir, fileName)
+	if err != nil {
+		return nil, err
+	}
+	if isDelta {
+		return readDeltaTriesRootHash(logger, dir, fileName)
+	}
+
 	return readTriesRootHash(logger, dir, fileName)
 }
 
@@ -117,7 +129,16 @@ func OpenAndReadCheckpointV6(dir string, fileName string, logger zerolog.Logger)
 
 // ReadCheckpointFileSize returns the total size of the checkpoint file
 func ReadCheckpointFileSize(dir string, fileName string) (uint64, error) {
+	_, isDelta, err := ReadCheckpointBase(dir, fileName)
+	if err != nil {
+		return 0, err
+	}
+
 	paths := allFilePaths(dir, fileName)
+	if isDelta {
+		// delta checkpoint is stored in a single file
+		paths = []string{filePathCheckpointHeader(dir, fileName)}
+	}
 	totalSize := uint64(0)
 	for _, path := range paths {
 		fileInfo, err := os.Stat(path)
@@ -860,6 +881,14 @@ func ensureReachedEOF(reader io.Reader) error {
 }
 
 func validateCheckpointFile(logger zerolog.Logger, dir, fileName string) error {
+	baseFileName, isDelta, err := ReadCheckpointBase(dir, fileName)
+	if err != nil {
+		return err
+	}
+	if isDelta {
+		return validateDeltaCheckpointFile(logger, dir, fileName, baseFileName)
+	}
+
 	headerPath := filePathCheckpointHeader(dir, fileName)
 	// validate header file
 	subtrieChecksums, topTrieChecksum, err := readCheckpointHeader(headerPath, logger)
@@ -60,9 +60,17 @@ const VersionV5 uint16 = 0x05
 //     file name extension
 const VersionV6 uint16 = 0x06
 
+// Version 7 is a delta checkpoint:
+//   - it is stored in a single file, which refers to a base checkpoint in the same directory
+//   - only trie nodes not found in the tries of the base checkpoint are stored, the unchanged
+//     subtries are stored as references to the nodes of the base tries
+//
+// See StoreDeltaCheckpoint for more details.
+const VersionV7 uint16 = 0x07
+
 // MaxVersion is the latest checkpoint version we support.
 // Need to update MaxVersion when creating a newer version.
-const MaxVersion = VersionV6
+const MaxVersion = VersionV7
 
 const (
 	encMagicSize        = 2
@@ -640,6 +648,23 @@ func HasRootCheckpoint(dir string) (bool, error) {
 	}
 }
 
+// CheckpointBase returns the number of the base checkpoint of the given checkpoint, and true
+// if the checkpoint is a delta checkpoint. The returned number is -1 if the base checkpoint
+// is not a numbered checkpoint, such as the root checkpoint.
+func (c *Checkpointer) CheckpointBase(checkpoint int) (int, bool, error) {
+	baseFileName, isDelta, err := ReadCheckpointBase(c.dir, NumberToFilename(checkpoint))
+	if err != nil || !isDelta {
+		return -1, false, err
+	}
+
+	base, err := strconv.Atoi(strings.TrimPrefix(baseFileName, checkpointFilenamePrefix))
+	if err != nil || NumberToFilename(base) != baseFileName {
+		return -1, true, nil
+	}
+
+	return base, true, nil
+}
+
 func (c *Checkpointer) RemoveCheckpoint(checkpoint int) error {
 	name := NumberToFilename(checkpoint)
 	return deleteCheckpointFiles(c.dir, name)
@@ -697,6 +722,8 @@ func readCheckpoint(f *os.File, logger zerolog.Logger) ([]*trie.MTrie, error) {
 		return readCheckpointV5(f, logger)
 	case VersionV6:
 		return readCheckpointV6(f, logger)
+	case VersionV7:
+		return readCheckpointV7(f, logger)
 	default:
 		return nil, fmt.Errorf("unsupported file version %x", version)
 	}
@@ -0,0 +1,11 @@
+package flow
+
+// SpeculativeTransactionResult is the outcome of a transaction applied, but not committed,
+// on top of the state of a block before executing a script.
+type SpeculativeTransactionResult struct {
+	TransactionID Identifier
+	Events        EventsList
+	// ErrorMessage is empty if the transaction succeeded.
+	ErrorMessage    string
+	ComputationUsed uint64
+}
@@ -0,0 +1,45 @@
+package flow
+
+import (
+	"time"
+)
+
+// TransactionProfile contains the resources used by an execution node to execute a transaction.
+// Unlike the transaction result, it is not deterministic (e.g. the execution time), and is
+// only kept by the execution node which executed the transaction.
+type TransactionProfile struct {
+	// TransactionID is the ID of the profiled transaction.
+	TransactionID Identifier
+	// ComputationUsed is the total computation used by the transaction.
+	ComputationUsed uint64
+	// ComputationIntensities is the intensity of each kind of computation used by the transaction,
+	// keyed by the Cadence computation kind.
+	ComputationIntensities map[uint]uint
+	// MemoryEstimate is the estimated total memory used by the transaction.
+	MemoryEstimate uint64
+	// MemoryIntensities is the intensity of each kind of memory used by the transaction,
+	// keyed by the Cadence memory kind.
+	MemoryIntensities map[uint]uint
+	// RegistersRead is the number of registers read from the execution state.
+	RegistersRead uint64
+	// RegistersWritten is the number of registers written to the execution state.
+	RegistersWritten uint64
+	// BytesRead is the total size of the registers read from the execution state.
+	BytesRead uint64
+	// BytesWritten is the total size of the registers written to the execution state.
+	BytesWritten uint64
+	// EventsSize is the total size of the events emitted by the transaction.
+	EventsSize uint64
+	// ExecutionTime is the time spent executing the transaction.
+	ExecutionTime time.Duration
+}
+
+// ID returns the ID of the profiled transaction.
+func (p TransactionProfile) ID() Identifier {
+	return p.TransactionID
+}
+
+// Checksum returns the ID of the profiled transaction.
+func (p TransactionProfile) Checksum() Identifier {
+	return p.ID()
+}
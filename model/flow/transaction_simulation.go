@@ -0,0 +1,43 @@
+package flow
+
+// TransactionSimulationResult is the result of executing a transaction on top of the state
+// of a block, without committing it.
+type TransactionSimulationResult struct {
+	// TransactionID is the ID of the simulated transaction.
+	TransactionID Identifier
+	// ComputationUsed is the computation used by the transaction.
+	ComputationUsed uint64
+	// Fees is the fee estimate for the transaction.
+	Fees TransactionFeeEstimate
+	// Events are the events emitted by the transaction.
+	Events []Event
+	// ErrorMessage is the error message of the transaction, empty if it succeeded.
+	ErrorMessage string
+	// StorageChanges are the changes of the storage used by the accounts updated by the transaction.
+	StorageChanges []StorageUsedChange
+}
+
+// TransactionFeeEstimate is the breakdown of the fees of a transaction, computed from the fee
+// parameters of the FlowFees contract. All values are UFix64 values, i.e. in units of 10^-8.
+type TransactionFeeEstimate struct {
+	SurgeFactor         uint64
+	InclusionEffortCost uint64
+	ExecutionEffortCost uint64
+	InclusionEffort     uint64
+	ExecutionEffort     uint64
+	// InclusionFee is the part of the fee caused by the inclusion effort.
+	InclusionFee uint64
+	// ExecutionFee is the part of the fee caused by the execution effort.
+	ExecutionFee uint64
+	// TotalFee is the fee deducted from the payer.
+	TotalFee uint64
+}
+
+// StorageUsedChange is the change of the storage used by an account during a transaction.
+type StorageUsedChange struct {
+	Address Address
+	// StorageUsedBefore is the storage used by the account before the transaction, in bytes.
+	StorageUsedBefore uint64
+	// StorageUsedAfter is the storage used by the account after the transaction, in bytes.
+	StorageUsedAfter uint64
+}
@@ -41,6 +41,65 @@ func (_m *ScriptExecutor) ExecuteAtBlockHeight(ctx context.Context, script []byt
 	return r0, r1
 }
 
+// ExecuteWithTransactionsAtBlockHeight provides a mock function with given fields: ctx, script, arguments, transactions, height
+func (_m *ScriptExecutor) ExecuteWithTransactionsAtBlockHeight(ctx context.Context, script []byte, arguments [][]byte, transactions []*flow.TransactionBody, height uint64) ([]byte, []flow.SpeculativeTransactionResult, error) {
+	ret := _m.Called(ctx, script, arguments, transactions, height)
+
+	var r0 []byte
+	var r1 []flow.SpeculativeTransactionResult
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, [][]byte, []*flow.TransactionBody, uint64) ([]byte, []flow.SpeculativeTransactionResult, error)); ok {
+		return rf(ctx, script, arguments, transactions, height)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []byte, [][]byte, []*flow.TransactionBody, uint64) []byte); ok {
+		r0 = rf(ctx, script, arguments, transactions, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []byte, [][]byte, []*flow.TransactionBody, uint64) []flow.SpeculativeTransactionResult); ok {
+		r1 = rf(ctx, script, arguments, transactions, height)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]flow.SpeculativeTransactionResult)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, []byte, [][]byte, []*flow.TransactionBody, uint64) error); ok {
+		r2 = rf(ctx, script, arguments, transactions, height)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetAccountAvailableBalance provides a mock function with given fields: ctx, address, height
+func (_m *ScriptExecutor) GetAccountAvailableBalance(ctx context.Context, address flow.Address, height uint64) (uint64, error) {
+	ret := _m.Called(ctx, address, height)
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Address, uint64) (uint64, error)); ok {
+		return rf(ctx, address, height)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Address, uint64) uint64); ok {
+		r0 = rf(ctx, address, height)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, flow.Address, uint64) error); ok {
+		r1 = rf(ctx, address, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAccountAtBlockHeight provides a mock function with given fields: ctx, address, height
 func (_m *ScriptExecutor) GetAccountAtBlockHeight(ctx context.Context, address flow.Address, height uint64) (*flow.Account, error) {
 	ret := _m.Called(ctx, address, height)
@@ -67,6 +126,56 @@ func (_m *ScriptExecutor) GetAccountAtBlockHeight(ctx context.Context, address f
 	return r0, r1
 }
 
+// GetAccountBalance provides a mock function with given fields: ctx, address, height
+func (_m *ScriptExecutor) GetAccountBalance(ctx context.Context, address flow.Address, height uint64) (uint64, error) {
+	ret := _m.Called(ctx, address, height)
+
+	var r0 uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Address, uint64) (uint64, error)); ok {
+		return rf(ctx, address, height)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, flow.Address, uint64) uint64); ok {
+		r0 = rf(ctx, address, height)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, flow.Address, uint64) error); ok {
+		r1 = rf(ctx, address, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimulateTransactionAtBlockHeight provides a mock function with given fields: ctx, tx, skipSignatureChecks, height
+func (_m *ScriptExecutor) SimulateTransactionAtBlockHeight(ctx context.Context, tx *flow.TransactionBody, skipSignatureChecks bool, height uint64) (*flow.TransactionSimulationResult, error) {
+	ret := _m.Called(ctx, tx, skipSignatureChecks, height)
+
+	var r0 *flow.TransactionSimulationResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, *flow.TransactionBody, bool, uint64) (*flow.TransactionSimulationResult, error)); ok {
+		return rf(ctx, tx, skipSignatureChecks, height)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, *flow.TransactionBody, bool, uint64) *flow.TransactionSimulationResult); ok {
+		r0 = rf(ctx, tx, skipSignatureChecks, height)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*flow.TransactionSimulationResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, *flow.TransactionBody, bool, uint64) error); ok {
+		r1 = rf(ctx, tx, skipSignatureChecks, height)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewScriptExecutor interface {
 	mock.TestingT
 	Cleanup(func())
@@ -38,10 +38,48 @@ type ScriptExecutor interface {
 		height uint64,
 	) ([]byte, error)
 
+	// ExecuteWithTransactionsAtBlockHeight executes provided script against the block height, after
+	// applying the provided transactions in order. The transactions are never committed, and do not
+	// need to be signed. The result value is returned encoded as byte array, together with the result
+	// of each transaction. An error will be returned if script doesn't successfully execute.
+	// Expected errors:
+	// - storage.ErrNotFound if block or register value at height was not found.
+	// - storage.ErrHeightNotIndexed if the data for the block height is not available
+	ExecuteWithTransactionsAtBlockHeight(
+		ctx context.Context,
+		script []byte,
+		arguments [][]byte,
+		transactions []*flow.TransactionBody,
+		height uint64,
+	) ([]byte, []flow.SpeculativeTransactionResult, error)
+
+	// SimulateTransactionAtBlockHeight executes provided transaction against the block height without
+	// committing it, optionally skipping signature checks. The returned result contains the computation
+	// used, fee estimate, events, error and storage changes of the transaction.
+	// Expected errors:
+	// - storage.ErrNotFound if block or register value at height was not found.
+	// - storage.ErrHeightNotIndexed if the data for the block height is not available
+	SimulateTransactionAtBlockHeight(
+		ctx context.Context,
+		tx *flow.TransactionBody,
+		skipSignatureChecks bool,
+		height uint64,
+	) (*flow.TransactionSimulationResult, error)
+
 	// GetAccountAtBlockHeight returns a Flow account by the provided address and block height.
 	// Expected errors:
 	// - storage.ErrHeightNotIndexed if the data for the block height is not available
 	GetAccountAtBlockHeight(ctx context.Context, address flow.Address, height uint64) (*flow.Account, error)
+
+	// GetAccountBalance returns a Flow account balance by the provided address and block height.
+	// Expected errors:
+	// - storage.ErrHeightNotIndexed if the data for the block height is not available
+	GetAccountBalance(ctx context.Context, address flow.Address, height uint64) (uint64, error)
+
+	// GetAccountAvailableBalance returns a Flow account available balance by the provided address and block height.
+	// Expected errors:
+	// - storage.ErrHeightNotIndexed if the data for the block height is not available
+	GetAccountAvailableBalance(ctx context.Context, address flow.Address, height uint64) (uint64, error)
 }
 
 var _ ScriptExecutor = (*Scripts)(nil)
@@ -62,10 +100,14 @@ func NewScripts(
 	queryConf query.QueryConfig,
 	derivedChainData *derived.DerivedChainData,
 	enableProgramCacheWrites bool,
+	fvmOptions []fvm.Option,
 ) *Scripts {
 	vm := fvm.NewVirtualMachine()
 
 	options := computation.DefaultFVMOptions(chainID, false, false)
+	// the FVM options of the node, e.g. storage limits and transaction fees, so that simulated
+	// transactions are subject to the same limits and fees as on the network
+	options = append(options, fvmOptions...)
 	blocks := environment.NewBlockFinder(header)
 	options = append(options, fvm.WithBlocks(blocks)) // add blocks for getBlocks calls in scripts
 	options = append(options, fvm.WithMetricsReporter(metrics))
@@ -113,6 +155,50 @@ func (s *Scripts) ExecuteAtBlockHeight(
 	return value, err
 }
 
+// ExecuteWithTransactionsAtBlockHeight executes provided script against the block height, after
+// applying the provided transactions in order on top of the block's state. The transactions are
+// never committed. A result value is returned encoded as byte array, together with the result of
+// each transaction. An error will be returned if script doesn't successfully execute.
+// Expected errors:
+// - Script execution related errors
+// - storage.ErrHeightNotIndexed if the data for the block height is not available
+func (s *Scripts) ExecuteWithTransactionsAtBlockHeight(
+	ctx context.Context,
+	script []byte,
+	arguments [][]byte,
+	transactions []*flow.TransactionBody,
+	height uint64,
+) ([]byte, []flow.SpeculativeTransactionResult, error) {
+
+	snap, header, err := s.snapshotWithBlock(height)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	value, results, compUsage, err := s.executor.ExecuteScriptWithTransactions(ctx, script, arguments, transactions, header, snap)
+	// TODO: return compUsage when upstream can handle it
+	_ = compUsage
+	return value, results, err
+}
+
+// SimulateTransactionAtBlockHeight executes provided transaction against the block height without
+// committing it, optionally skipping signature checks.
+// Expected errors:
+// - storage.ErrHeightNotIndexed if the data for the block height is not available
+func (s *Scripts) SimulateTransactionAtBlockHeight(
+	ctx context.Context,
+	tx *flow.TransactionBody,
+	skipSignatureChecks bool,
+	height uint64,
+) (*flow.TransactionSimulationResult, error) {
+	snap, header, err := s.snapshotWithBlock(height)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.executor.SimulateTransaction(ctx, tx, skipSignatureChecks, header, snap)
+}
+
 // GetAccountAtBlockHeight returns a Flow account by the provided address and block height.
 // Expected errors:
 // - Script execution related errors
@@ -126,6 +212,32 @@ func (s *Scripts) GetAccountAtBlockHeight(ctx context.Context, address flow.Addr
 	return s.executor.GetAccount(ctx, address, header, snap)
 }
 
+// GetAccountBalance returns a Flow account balance by the provided address and block height.
+// Expected errors:
+// - Script execution related errors
+// - storage.ErrHeightNotIndexed if the data for the block height is not available
+func (s *Scripts) GetAccountBalance(ctx context.Context, address flow.Address, height uint64) (uint64, error) {
+	snap, header, err := s.snapshotWithBlock(height)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.executor.GetAccountBalance(ctx, address, header, snap)
+}
+
+// GetAccountAvailableBalance returns a Flow account available balance by the provided address and block height.
+// Expected errors:
+// - Script execution related errors
+// - storage.ErrHeightNotIndexed if the data for the block height is not available
+func (s *Scripts) GetAccountAvailableBalance(ctx context.Context, address flow.Address, height uint64) (uint64, error) {
+	snap, header, err := s.snapshotWithBlock(height)
+	if err != nil {
+		return 0, err
+	}
+
+	return s.executor.GetAccountAvailableBalance(ctx, address, header, snap)
+}
+
 // snapshotWithBlock is a common function for executing scripts and get account functionality.
 // It creates a storage snapshot that is needed by the FVM to execute scripts.
 func (s *Scripts) snapshotWithBlock(height uint64) (snapshot.StorageSnapshot, *flow.Header, error) {
@@ -112,6 +112,157 @@ func (s *scriptTestSuite) TestScriptExecution() {
 	})
 }
 
+func (s *scriptTestSuite) TestScriptExecutionWithTransactions() {
+	address := s.chain.ServiceAddress()
+	code := []byte(`
+		access(all) fun main(address: Address): Int {
+			return getAuthAccount<auth(Storage) &Account>(address).storage.copy<Int>(from: /storage/speculative) ?? 0
+		}`)
+	arg, err := jsoncdc.Encode(cadence.NewAddress(address))
+	s.Require().NoError(err)
+
+	saveTx := flow.NewTransactionBody().
+		SetScript([]byte(`
+			transaction {
+				prepare(signer: auth(Storage) &Account) {
+					signer.storage.save(42, to: /storage/speculative)
+				}
+			}`)).
+		AddAuthorizer(address)
+
+	failingTx := flow.NewTransactionBody().
+		SetScript([]byte(`transaction { execute { panic("failed") } }`))
+
+	s.Run("Script sees the applied transactions", func() {
+		result, txResults, err := s.scripts.ExecuteWithTransactionsAtBlockHeight(
+			context.Background(),
+			code,
+			[][]byte{arg},
+			[]*flow.TransactionBody{failingTx, saveTx},
+			s.height,
+		)
+		s.Require().NoError(err)
+
+		val, err := jsoncdc.Decode(nil, result)
+		s.Require().NoError(err)
+		s.Assert().Equal(int64(42), val.(cadence.Int).Value.Int64())
+
+		s.Require().Len(txResults, 2)
+		s.Assert().Equal(failingTx.ID(), txResults[0].TransactionID)
+		s.Assert().Contains(txResults[0].ErrorMessage, "failed")
+		s.Assert().Equal(saveTx.ID(), txResults[1].TransactionID)
+		s.Assert().Empty(txResults[1].ErrorMessage)
+		s.Assert().NotZero(txResults[1].ComputationUsed)
+	})
+
+	s.Run("Transactions are not committed", func() {
+		result, err := s.scripts.ExecuteAtBlockHeight(context.Background(), code, [][]byte{arg}, s.height)
+		s.Require().NoError(err)
+
+		val, err := jsoncdc.Decode(nil, result)
+		s.Require().NoError(err)
+		s.Assert().Equal(int64(0), val.(cadence.Int).Value.Int64())
+	})
+
+	s.Run("Without transactions", func() {
+		result, txResults, err := s.scripts.ExecuteWithTransactionsAtBlockHeight(
+			context.Background(),
+			code,
+			[][]byte{arg},
+			nil,
+			s.height,
+		)
+		s.Require().NoError(err)
+		s.Assert().Empty(txResults)
+
+		val, err := jsoncdc.Decode(nil, result)
+		s.Require().NoError(err)
+		s.Assert().Equal(int64(0), val.(cadence.Int).Value.Int64())
+	})
+}
+
+func (s *scriptTestSuite) TestSimulateTransaction() {
+	address := s.chain.ServiceAddress()
+
+	s.Run("Successful transaction", func() {
+		tx := flow.NewTransactionBody().
+			SetScript([]byte(`
+				transaction {
+					prepare(signer: auth(Storage) &Account) {
+						signer.storage.save("simulated", to: /storage/simulated)
+					}
+					execute {
+						log("simulated")
+					}
+				}`)).
+			SetProposalKey(address, 0, 0).
+			SetPayer(address).
+			AddAuthorizer(address)
+
+		result, err := s.scripts.SimulateTransactionAtBlockHeight(context.Background(), tx, true, s.height)
+		s.Require().NoError(err)
+
+		s.Assert().Equal(tx.ID(), result.TransactionID)
+		s.Assert().Empty(result.ErrorMessage)
+		s.Assert().NotZero(result.ComputationUsed)
+
+		s.Assert().Equal(tx.InclusionEffort(), result.Fees.InclusionEffort)
+		s.Assert().Equal(result.ComputationUsed, result.Fees.ExecutionEffort)
+		s.Assert().NotZero(result.Fees.SurgeFactor)
+		s.Assert().Equal(result.Fees.InclusionFee+result.Fees.ExecutionFee, result.Fees.TotalFee)
+
+		s.Require().Len(result.StorageChanges, 1)
+		s.Assert().Equal(address, result.StorageChanges[0].Address)
+		s.Assert().Greater(result.StorageChanges[0].StorageUsedAfter, result.StorageChanges[0].StorageUsedBefore)
+	})
+
+	s.Run("Failed transaction", func() {
+		tx := flow.NewTransactionBody().
+			SetScript([]byte(`transaction { execute { panic("simulated failure") } }`)).
+			SetProposalKey(address, 0, 0).
+			SetPayer(address)
+
+		result, err := s.scripts.SimulateTransactionAtBlockHeight(context.Background(), tx, true, s.height)
+		s.Require().NoError(err)
+		s.Assert().Contains(result.ErrorMessage, "simulated failure")
+	})
+
+	s.Run("Computation limit exceeded", func() {
+		const computationLimit = 10
+		tx := flow.NewTransactionBody().
+			SetScript([]byte(`
+				transaction {
+					execute {
+						var i = 0
+						while i < 1000 {
+							i = i + 1
+						}
+					}
+				}`)).
+			SetComputeLimit(computationLimit).
+			SetProposalKey(address, 0, 0).
+			SetPayer(address)
+
+		result, err := s.scripts.SimulateTransactionAtBlockHeight(context.Background(), tx, true, s.height)
+		s.Require().NoError(err)
+		s.Assert().NotEmpty(result.ErrorMessage)
+
+		// fees are estimated for the computation limit, as when they are deducted
+		s.Assert().Equal(uint64(computationLimit), result.Fees.ExecutionEffort)
+	})
+
+	s.Run("Signature checks", func() {
+		tx := flow.NewTransactionBody().
+			SetScript([]byte(`transaction {}`)).
+			SetProposalKey(address, 0, 0).
+			SetPayer(address)
+
+		result, err := s.scripts.SimulateTransactionAtBlockHeight(context.Background(), tx, false, s.height)
+		s.Require().NoError(err)
+		s.Assert().NotEmpty(result.ErrorMessage)
+	})
+}
+
 func (s *scriptTestSuite) TestGetAccount() {
 	s.Run("Get Service Account", func() {
 		address := s.chain.ServiceAddress()
@@ -131,6 +282,34 @@ func (s *scriptTestSuite) TestGetAccount() {
 	})
 }
 
+func (s *scriptTestSuite) TestGetAccountBalance() {
+	s.Run("Get Service Account Balance", func() {
+		address := s.chain.ServiceAddress()
+		account, err := s.scripts.GetAccountAtBlockHeight(context.Background(), address, s.height)
+		s.Require().NoError(err)
+
+		balance, err := s.scripts.GetAccountBalance(context.Background(), address, s.height)
+		s.Require().NoError(err)
+		s.Assert().Equal(account.Balance, balance)
+
+		availableBalance, err := s.scripts.GetAccountAvailableBalance(context.Background(), address, s.height)
+		s.Require().NoError(err)
+		s.Assert().NotZero(availableBalance)
+		s.Assert().LessOrEqual(availableBalance, balance)
+	})
+
+	s.Run("Get New Account Balance", func() {
+		address := s.createAccount()
+		balance, err := s.scripts.GetAccountBalance(context.Background(), address, s.height)
+		s.Require().NoError(err)
+		s.Assert().Zero(balance)
+
+		availableBalance, err := s.scripts.GetAccountAvailableBalance(context.Background(), address, s.height)
+		s.Require().NoError(err)
+		s.Assert().Zero(availableBalance)
+	})
+}
+
 func (s *scriptTestSuite) SetupTest() {
 	logger := unittest.LoggerForTest(s.Suite.T(), zerolog.InfoLevel)
 	entropyProvider := testutil.EntropyProviderFixture(nil)
@@ -172,6 +351,8 @@ func (s *scriptTestSuite) SetupTest() {
 		nil,
 		nil,
 		nil,
+		nil,
+		nil,
 		flow.Testnet.Chain(),
 		derivedChainData,
 		nil,
@@ -188,6 +369,7 @@ func (s *scriptTestSuite) SetupTest() {
 		query.NewDefaultConfig(),
 		derivedChainData,
 		true,
+		[]fvm.Option{fvm.WithAccountStorageLimit(true)},
 	)
 
 	s.bootstrap()
@@ -200,6 +382,8 @@ func (s *scriptTestSuite) TearDownTest() {
 func (s *scriptTestSuite) bootstrap() {
 	bootstrapOpts := []fvm.BootstrapProcedureOption{
 		fvm.WithInitialTokenSupply(unittest.GenesisTokenSupply),
+		fvm.WithTransactionFee(fvm.DefaultTransactionFees),
+		fvm.WithStorageMBPerFLOW(fvm.DefaultStorageMBPerFLOW),
 	}
 
 	executionSnapshot, out, err := s.vm.Run(
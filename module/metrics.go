@@ -414,6 +414,9 @@ type NetworkCoreMetrics interface {
 	UnicastMessageSendingStarted(topic string)
 	// UnicastMessageSendingCompleted decrements the metric tracking the number of unicast messages sent by the node.
 	UnicastMessageSendingCompleted(topic string)
+	// UnicastMessageCompressed tracks the size of a unicast message sent by the node before and after compression,
+	// from which the compression ratio per channel and compressor is derived.
+	UnicastMessageCompressed(topic string, compressor string, uncompressedBytes uint64, compressedBytes uint64)
 	// MessageProcessingStarted increments the metric tracking the number of messages being processed by the node.
 	MessageProcessingStarted(topic string)
 	// MessageProcessingFinished tracks the time spent by the node to process a message and decrements the metric tracking
@@ -841,6 +844,14 @@ type ExecutionDataPrunerMetrics interface {
 	Pruned(height uint64, duration time.Duration)
 }
 
+type RegisterDBPrunerMetrics interface {
+	// Pruned records the new first height of the register db and the duration of the pruning run.
+	Pruned(height uint64, duration time.Duration)
+
+	// RegistersPruned records the number of register versions removed by a pruning run.
+	RegistersPruned(count uint64)
+}
+
 type RestMetrics interface {
 	// Example recorder taken from:
 	// https://github.com/slok/go-http-metrics/blob/master/metrics/prometheus/prometheus.go
@@ -875,6 +886,7 @@ type AccessMetrics interface {
 	RestMetrics
 	GRPCConnectionPoolMetrics
 	TransactionMetrics
+	TransactionValidationMetrics
 	BackendScriptsMetrics
 
 	// UpdateExecutionReceiptMaxHeight is called whenever we store an execution receipt from a block from a newer height
@@ -1028,6 +1040,18 @@ type TransactionMetrics interface {
 	TransactionSubmissionFailed()
 }
 
+type TransactionValidationMetrics interface {
+	// TransactionValidated tracks number of successfully validated transactions
+	TransactionValidated()
+
+	// TransactionValidationFailed tracks number of transactions that failed validation, by reason
+	TransactionValidationFailed(reason string)
+
+	// TransactionValidationSkipped tracks number of transactions for which an optional validation
+	// (e.g. the payer balance check) was skipped due to an internal error
+	TransactionValidationSkipped()
+}
+
 type PingMetrics interface {
 	// NodeReachable tracks the round trip time in milliseconds taken to ping a node
 	// The nodeInfo provides additional information about the node such as the name of the node operator
@@ -16,6 +16,12 @@ func WithTransactionMetrics(m module.TransactionMetrics) AccessCollectorOpts {
 	}
 }
 
+func WithTransactionValidationMetrics(m module.TransactionValidationMetrics) AccessCollectorOpts {
+	return func(ac *AccessCollector) {
+		ac.TransactionValidationMetrics = m
+	}
+}
+
 func WithBackendScriptsMetrics(m module.BackendScriptsMetrics) AccessCollectorOpts {
 	return func(ac *AccessCollector) {
 		ac.BackendScriptsMetrics = m
@@ -31,6 +37,7 @@ func WithRestMetrics(m module.RestMetrics) AccessCollectorOpts {
 type AccessCollector struct {
 	module.RestMetrics
 	module.TransactionMetrics
+	module.TransactionValidationMetrics
 	module.BackendScriptsMetrics
 
 	connectionReused      prometheus.Counter
@@ -25,6 +25,7 @@ const (
 	LabelService             = "service"
 	LabelRejectionReason     = "rejection_reason"
 	LabelAccountAddress      = "acct_address" // Account address for a machine account
+	LabelCompressor          = "compressor"
 )
 
 const (
@@ -130,6 +131,8 @@ const (
 	ResourceTransactionResults                 = "transaction_results"                    // execution node
 	ResourceTransactionResultIndices           = "transaction_result_indices"             // execution node
 	ResourceTransactionResultByBlock           = "transaction_result_by_block"            // execution node
+	ResourceTransactionProfiles                = "transaction_profiles"                   // execution node
+	ResourceTransactionProfileByBlock          = "transaction_profile_by_block"           // execution node
 	ResourceExecutionDataCache                 = "execution_data_cache"                   // access node
 )
 
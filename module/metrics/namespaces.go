@@ -44,6 +44,7 @@ const (
 const (
 	subsystemTransactionTiming     = "transaction_timing"
 	subsystemTransactionSubmission = "transaction_submission"
+	subsystemTransactionValidation = "transaction_validation"
 	subsystemConnectionPool        = "connection_pool"
 	subsystemHTTP                  = "http"
 )
@@ -93,6 +94,7 @@ const (
 	subsystemExeDataPruner          = "pruner"
 	subsystemExecutionDataRequester = "execution_data_requester"
 	subsystemExecutionStateIndexer  = "execution_state_indexer"
+	subsystemRegisterDBPruner       = "register_db_pruner"
 	subsystemExeDataBlobstore       = "blobstore"
 )
 
@@ -36,6 +36,9 @@ type NetworkCollector struct {
 	queueDuration                *prometheus.HistogramVec
 	numMessagesProcessing        *prometheus.GaugeVec
 	numDirectMessagesSending     *prometheus.GaugeVec
+	unicastUncompressedBytes     *prometheus.CounterVec
+	unicastCompressedBytes       *prometheus.CounterVec
+	unicastCompressionRatio      *prometheus.HistogramVec
 	inboundProcessTime           *prometheus.CounterVec
 	outboundConnectionCount      prometheus.Gauge
 	inboundConnectionCount       prometheus.Gauge
@@ -193,6 +196,34 @@ func NewNetworkCollector(logger zerolog.Logger, opts ...NetworkCollectorOpt) *Ne
 		}, []string{LabelChannel},
 	)
 
+	nc.unicastUncompressedBytes = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespaceNetwork,
+			Subsystem: subsystemGossip,
+			Name:      nc.prefix + "unicast_uncompressed_bytes_total",
+			Help:      "the total size of the unicast messages sent before compression",
+		}, []string{LabelChannel, LabelCompressor},
+	)
+
+	nc.unicastCompressedBytes = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: namespaceNetwork,
+			Subsystem: subsystemGossip,
+			Name:      nc.prefix + "unicast_compressed_bytes_total",
+			Help:      "the total size of the unicast messages sent after compression",
+		}, []string{LabelChannel, LabelCompressor},
+	)
+
+	nc.unicastCompressionRatio = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: namespaceNetwork,
+			Subsystem: subsystemGossip,
+			Name:      nc.prefix + "unicast_compression_ratio",
+			Help:      "the compression ratio (uncompressed size / compressed size) of the unicast messages sent",
+			Buckets:   []float64{0.5, 1, 1.5, 2, 3, 5, 10, 20},
+		}, []string{LabelChannel, LabelCompressor},
+	)
+
 	nc.inboundProcessTime = promauto.NewCounterVec(
 		prometheus.CounterOpts{
 			Namespace: namespaceNetwork,
@@ -301,6 +332,16 @@ func (nc *NetworkCollector) UnicastMessageSendingCompleted(topic string) {
 	nc.numDirectMessagesSending.WithLabelValues(topic).Dec()
 }
 
+// UnicastMessageCompressed tracks the size of a unicast message sent by the node before and after compression,
+// from which the compression ratio per channel and compressor is derived.
+func (nc *NetworkCollector) UnicastMessageCompressed(topic string, compressor string, uncompressedBytes uint64, compressedBytes uint64) {
+	nc.unicastUncompressedBytes.WithLabelValues(topic, compressor).Add(float64(uncompressedBytes))
+	nc.unicastCompressedBytes.WithLabelValues(topic, compressor).Add(float64(compressedBytes))
+	if compressedBytes > 0 {
+		nc.unicastCompressionRatio.WithLabelValues(topic, compressor).Observe(float64(uncompressedBytes) / float64(compressedBytes))
+	}
+}
+
 func (nc *NetworkCollector) RoutingTablePeerAdded() {
 	nc.routingTableSize.Inc()
 }
@@ -26,13 +26,14 @@ func NewNoopCollector() *NoopCollector {
 	return nc
 }
 
-func (nc *NoopCollector) OutboundMessageSent(int, string, string, string)        {}
-func (nc *NoopCollector) InboundMessageReceived(int, string, string, string)     {}
-func (nc *NoopCollector) DuplicateInboundMessagesDropped(string, string, string) {}
-func (nc *NoopCollector) UnicastMessageSendingStarted(topic string)              {}
-func (nc *NoopCollector) UnicastMessageSendingCompleted(topic string)            {}
-func (nc *NoopCollector) BlockProposed(*flow.Block)                              {}
-func (nc *NoopCollector) BlockProposalDuration(duration time.Duration)           {}
+func (nc *NoopCollector) OutboundMessageSent(int, string, string, string)         {}
+func (nc *NoopCollector) InboundMessageReceived(int, string, string, string)      {}
+func (nc *NoopCollector) DuplicateInboundMessagesDropped(string, string, string)  {}
+func (nc *NoopCollector) UnicastMessageSendingStarted(topic string)               {}
+func (nc *NoopCollector) UnicastMessageSendingCompleted(topic string)             {}
+func (nc *NoopCollector) UnicastMessageCompressed(string, string, uint64, uint64) {}
+func (nc *NoopCollector) BlockProposed(*flow.Block)                               {}
+func (nc *NoopCollector) BlockProposalDuration(duration time.Duration)            {}
 
 // interface check
 var _ module.BackendScriptsMetrics = (*NoopCollector)(nil)
@@ -212,6 +213,9 @@ func (nc *NoopCollector) TransactionFinalized(txID flow.Identifier, when time.Ti
 func (nc *NoopCollector) TransactionExecuted(txID flow.Identifier, when time.Time)              {}
 func (nc *NoopCollector) TransactionExpired(txID flow.Identifier)                               {}
 func (nc *NoopCollector) TransactionSubmissionFailed()                                          {}
+func (nc *NoopCollector) TransactionValidated()                                                 {}
+func (nc *NoopCollector) TransactionValidationFailed(reason string)                             {}
+func (nc *NoopCollector) TransactionValidationSkipped()                                         {}
 func (nc *NoopCollector) UpdateExecutionReceiptMaxHeight(height uint64)                         {}
 func (nc *NoopCollector) UpdateLastFullBlockHeight(height uint64)                               {}
 func (nc *NoopCollector) ChunkDataPackRequestProcessed()                                        {}
@@ -230,6 +234,7 @@ func (nc *NoopCollector) RequestFailed(duration time.Duration, retryable bool)
 func (nc *NoopCollector) RequestCanceled()                                                      {}
 func (nc *NoopCollector) ResponseDropped()                                                      {}
 func (nc *NoopCollector) Pruned(height uint64, duration time.Duration)                          {}
+func (nc *NoopCollector) RegistersPruned(count uint64)                                          {}
 func (nc *NoopCollector) UpdateCollectionMaxHeight(height uint64)                               {}
 func (nc *NoopCollector) BucketAvailableSlots(uint64, uint64)                                   {}
 func (nc *NoopCollector) OnKeyPutSuccess(uint32)                                                {}
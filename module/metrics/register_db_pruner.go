@@ -0,0 +1,53 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/onflow/flow-go/module"
+)
+
+var _ module.RegisterDBPrunerMetrics = (*RegisterDBPrunerCollector)(nil)
+
+type RegisterDBPrunerCollector struct {
+	pruneDurations     prometheus.Histogram
+	latestHeightPruned prometheus.Gauge
+	registersPruned    prometheus.Counter
+}
+
+func NewRegisterDBPrunerCollector() *RegisterDBPrunerCollector {
+	return &RegisterDBPrunerCollector{
+		pruneDurations: promauto.NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespaceAccess,
+			Subsystem: subsystemRegisterDBPruner,
+			Name:      "prune_duration_ms",
+			Help:      "the duration of pruning the register db in milliseconds",
+			Buckets:   []float64{1_000, 10_000, 60_000, 300_000, 1_800_000},
+		}),
+		latestHeightPruned: promauto.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespaceAccess,
+			Subsystem: subsystemRegisterDBPruner,
+			Name:      "latest_height_pruned",
+			Help:      "the first height available in the register db after the latest pruning",
+		}),
+		registersPruned: promauto.NewCounter(prometheus.CounterOpts{
+			Namespace: namespaceAccess,
+			Subsystem: subsystemRegisterDBPruner,
+			Name:      "registers_pruned",
+			Help:      "the number of register versions removed from the register db",
+		}),
+	}
+}
+
+// Pruned records the new first height of the register db and the duration of the pruning run.
+func (c *RegisterDBPrunerCollector) Pruned(height uint64, duration time.Duration) {
+	c.pruneDurations.Observe(float64(duration.Milliseconds()))
+	c.latestHeightPruned.Set(float64(height))
+}
+
+// RegistersPruned records the number of register versions removed by a pruning run.
+func (c *RegisterDBPrunerCollector) RegistersPruned(count uint64) {
+	c.registersPruned.Add(float64(count))
+}
@@ -0,0 +1,65 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/onflow/flow-go/module"
+)
+
+// Transaction validation failure reasons
+const (
+	InvalidTransactionRateLimited = "payer_exceeded_rate_limit"
+	InvalidTransactionByteSize    = "transaction_exceeded_size_limit"
+	IncompleteTransaction         = "missing_fields"
+	InvalidGasLimit               = "invalid_gas_limit"
+	ExpiredTransaction            = "transaction_expired"
+	InvalidScript                 = "invalid_script"
+	InvalidAddresses              = "invalid_address"
+	InvalidSignature              = "invalid_signature"
+	DuplicatedSignature           = "duplicate_signature"
+	InsufficientBalance           = "payer_insufficient_balance"
+)
+
+type TransactionValidationCollector struct {
+	transactionValidated         prometheus.Counter
+	transactionValidationFailed  *prometheus.CounterVec
+	transactionValidationSkipped prometheus.Counter
+}
+
+var _ module.TransactionValidationMetrics = (*TransactionValidationCollector)(nil)
+
+func NewTransactionValidationCollector() *TransactionValidationCollector {
+	return &TransactionValidationCollector{
+		transactionValidated: promauto.NewCounter(prometheus.CounterOpts{
+			Name:      "transaction_validation_successes_total",
+			Namespace: namespaceAccess,
+			Subsystem: subsystemTransactionValidation,
+			Help:      "counter for the validated transactions",
+		}),
+		transactionValidationFailed: promauto.NewCounterVec(prometheus.CounterOpts{
+			Name:      "transaction_validation_failed_total",
+			Namespace: namespaceAccess,
+			Subsystem: subsystemTransactionValidation,
+			Help:      "counter for the transactions that failed validation, by reason",
+		}, []string{LabelRejectionReason}),
+		transactionValidationSkipped: promauto.NewCounter(prometheus.CounterOpts{
+			Name:      "transaction_validation_skipped_total",
+			Namespace: namespaceAccess,
+			Subsystem: subsystemTransactionValidation,
+			Help:      "counter for the transactions for which an optional validation was skipped due to an internal error",
+		}),
+	}
+}
+
+func (tc *TransactionValidationCollector) TransactionValidated() {
+	tc.transactionValidated.Inc()
+}
+
+func (tc *TransactionValidationCollector) TransactionValidationFailed(reason string) {
+	tc.transactionValidationFailed.WithLabelValues(reason).Inc()
+}
+
+func (tc *TransactionValidationCollector) TransactionValidationSkipped() {
+	tc.transactionValidationSkipped.Inc()
+}
@@ -75,6 +75,11 @@ func (_m *NetworkCoreMetrics) QueueDuration(duration time.Duration, priority int
 	_m.Called(duration, priority)
 }
 
+// UnicastMessageCompressed provides a mock function with given fields: topic, compressor, uncompressedBytes, compressedBytes
+func (_m *NetworkCoreMetrics) UnicastMessageCompressed(topic string, compressor string, uncompressedBytes uint64, compressedBytes uint64) {
+	_m.Called(topic, compressor, uncompressedBytes, compressedBytes)
+}
+
 // UnicastMessageSendingCompleted provides a mock function with given fields: topic
 func (_m *NetworkCoreMetrics) UnicastMessageSendingCompleted(topic string) {
 	_m.Called(topic)
@@ -522,6 +522,11 @@ func (_m *NetworkMetrics) SetWarningStateCount(_a0 uint) {
 	_m.Called(_a0)
 }
 
+// UnicastMessageCompressed provides a mock function with given fields: topic, compressor, uncompressedBytes, compressedBytes
+func (_m *NetworkMetrics) UnicastMessageCompressed(topic string, compressor string, uncompressedBytes uint64, compressedBytes uint64) {
+	_m.Called(topic, compressor, uncompressedBytes, compressedBytes)
+}
+
 // UnicastMessageSendingCompleted provides a mock function with given fields: topic
 func (_m *NetworkMetrics) UnicastMessageSendingCompleted(topic string) {
 	_m.Called(topic)
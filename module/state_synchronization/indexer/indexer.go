@@ -105,8 +105,9 @@ func (i *Indexer) Start(ctx irrecoverable.SignalerContext) {
 // LowestIndexedHeight returns the lowest height indexed by the execution indexer.
 func (i *Indexer) LowestIndexedHeight() (uint64, error) {
 	// TODO: use a separate value to track the lowest indexed height. We're using the registers db's
-	// value here to start because it's convenient. When pruning support is added, this will need to
-	// be updated.
+	// value here to start because it's convenient. The registers db's first height is advanced when
+	// the register db is pruned, so data below it is considered unavailable even if other indexes
+	// still contain it.
 	return i.registers.FirstHeight(), nil
 }
 
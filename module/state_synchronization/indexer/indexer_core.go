@@ -27,6 +27,8 @@ type IndexerCore struct {
 	registers    storage.RegisterIndex
 	headers      storage.Headers
 	events       storage.Events
+	eventLookups storage.EventLookups
+	accountTxs   storage.AccountTransactions
 	collections  storage.Collections
 	transactions storage.Transactions
 	results      storage.LightTransactionResults
@@ -48,6 +50,8 @@ func New(
 	registers storage.RegisterIndex,
 	headers storage.Headers,
 	events storage.Events,
+	eventLookups storage.EventLookups,
+	accountTxs storage.AccountTransactions,
 	collections storage.Collections,
 	transactions storage.Transactions,
 	results storage.LightTransactionResults,
@@ -72,6 +76,8 @@ func New(
 		collections:      collections,
 		transactions:     transactions,
 		events:           events,
+		eventLookups:     eventLookups,
+		accountTxs:       accountTxs,
 		results:          results,
 		serviceAddress:   chain.ServiceAddress(),
 		derivedChainData: derivedChainData,
@@ -152,6 +158,17 @@ func (c *IndexerCore) IndexBlockData(data *execution_data.BlockExecutionDataEnti
 			return fmt.Errorf("could not index events at height %d: %w", header.Height, err)
 		}
 
+		err = c.eventLookups.BatchIndex(data.BlockID, header.Height, events, batch)
+		if err != nil {
+			return fmt.Errorf("could not index event lookups at height %d: %w", header.Height, err)
+		}
+
+		accountTxs := findAccountTransactions(data.BlockID, header.Height, data.ChunkExecutionDatas)
+		err = c.accountTxs.BatchIndex(header.Height, accountTxs, batch)
+		if err != nil {
+			return fmt.Errorf("could not index account transactions at height %d: %w", header.Height, err)
+		}
+
 		err = c.results.BatchStore(data.BlockID, results, batch)
 		if err != nil {
 			return fmt.Errorf("could not index transaction results at height %d: %w", header.Height, err)
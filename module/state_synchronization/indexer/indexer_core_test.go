@@ -35,6 +35,8 @@ type indexCoreTest struct {
 	indexer          *IndexerCore
 	registers        *storagemock.RegisterIndex
 	events           *storagemock.Events
+	eventLookups     *storagemock.EventLookups
+	accountTxs       *storagemock.AccountTransactions
 	collection       *flow.Collection
 	collections      *storagemock.Collections
 	transactions     *storagemock.Transactions
@@ -62,6 +64,8 @@ func newIndexCoreTest(
 		t:            t,
 		registers:    storagemock.NewRegisterIndex(t),
 		events:       storagemock.NewEvents(t),
+		eventLookups: storagemock.NewEventLookups(t),
+		accountTxs:   storagemock.NewAccountTransactions(t),
 		collection:   &collection,
 		results:      storagemock.NewLightTransactionResults(t),
 		collections:  storagemock.NewCollections(t),
@@ -138,6 +142,12 @@ func (i *indexCoreTest) setStoreEvents(f func(*testing.T, flow.Identifier, []flo
 			require.NotNil(i.t, batch)
 			return f(i.t, blockID, events)
 		})
+	i.eventLookups.
+		On("BatchIndex", mock.AnythingOfType("flow.Identifier"), mock.AnythingOfType("uint64"), mock.AnythingOfType("[]flow.Event"), mock.Anything).
+		Return(func(blockID flow.Identifier, height uint64, events []flow.Event, batch storage.BatchStorage) error {
+			require.NotNil(i.t, batch)
+			return f(i.t, blockID, []flow.EventsList{events})
+		})
 	return i
 }
 
@@ -151,6 +161,16 @@ func (i *indexCoreTest) setStoreTransactionResults(f func(*testing.T, flow.Ident
 	return i
 }
 
+func (i *indexCoreTest) setStoreAccountTransactions(f func(*testing.T, []storage.AccountTransaction) error) *indexCoreTest {
+	i.accountTxs.
+		On("BatchIndex", mock.AnythingOfType("uint64"), mock.AnythingOfType("[]storage.AccountTransaction"), mock.Anything).
+		Return(func(height uint64, transactions []storage.AccountTransaction, batch storage.BatchStorage) error {
+			require.NotNil(i.t, batch)
+			return f(i.t, transactions)
+		})
+	return i
+}
+
 func (i *indexCoreTest) setGetRegisters(f func(t *testing.T, ID flow.RegisterID, height uint64) (flow.RegisterValue, error)) *indexCoreTest {
 	i.registers.
 		On("Get", mock.AnythingOfType("flow.RegisterID"), mock.AnythingOfType("uint64")).
@@ -172,6 +192,16 @@ func (i *indexCoreTest) useDefaultEvents() *indexCoreTest {
 	i.events.
 		On("BatchStore", mock.AnythingOfType("flow.Identifier"), mock.AnythingOfType("[]flow.EventsList"), mock.Anything).
 		Return(nil)
+	i.eventLookups.
+		On("BatchIndex", mock.AnythingOfType("flow.Identifier"), mock.AnythingOfType("uint64"), mock.AnythingOfType("[]flow.Event"), mock.Anything).
+		Return(nil)
+	return i
+}
+
+func (i *indexCoreTest) useDefaultAccountTransactions() *indexCoreTest {
+	i.accountTxs.
+		On("BatchIndex", mock.AnythingOfType("uint64"), mock.AnythingOfType("[]storage.AccountTransaction"), mock.Anything).
+		Return(nil)
 	return i
 }
 
@@ -222,6 +252,8 @@ func (i *indexCoreTest) initIndexer() *indexCoreTest {
 		i.registers,
 		i.headers,
 		i.events,
+		i.eventLookups,
+		i.accountTxs,
 		i.collections,
 		i.transactions,
 		i.results,
@@ -266,6 +298,7 @@ func TestExecutionState_IndexBlockData(t *testing.T) {
 
 		err := newIndexCoreTest(t, blocks, execData).
 			initIndexer().
+			useDefaultAccountTransactions().
 			useDefaultEvents().
 			useDefaultTransactionResults().
 			// make sure update registers match in length and are same as block data ledger payloads
@@ -315,6 +348,7 @@ func TestExecutionState_IndexBlockData(t *testing.T) {
 		testRegisterFound := false
 		err = newIndexCoreTest(t, blocks, execData).
 			initIndexer().
+			useDefaultAccountTransactions().
 			useDefaultEvents().
 			useDefaultStorageMocks().
 			useDefaultTransactionResults().
@@ -357,6 +391,7 @@ func TestExecutionState_IndexBlockData(t *testing.T) {
 
 		err := newIndexCoreTest(t, blocks, execData).
 			initIndexer().
+			useDefaultAccountTransactions().
 			useDefaultStorageMocks().
 			// make sure all events are stored at once in order
 			setStoreEvents(func(t *testing.T, actualBlockID flow.Identifier, actualEvents []flow.EventsList) error {
@@ -405,6 +440,7 @@ func TestExecutionState_IndexBlockData(t *testing.T) {
 
 		err := newIndexCoreTest(t, blocks, execData).
 			initIndexer().
+			useDefaultAccountTransactions().
 			useDefaultStorageMocks().
 			// make sure an empty set of events were stored
 			setStoreEvents(func(t *testing.T, actualBlockID flow.Identifier, actualEvents []flow.EventsList) error {
@@ -433,6 +469,44 @@ func TestExecutionState_IndexBlockData(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("Index Account Transactions", func(t *testing.T) {
+		txCollection := unittest.CollectionFixture(2)
+		ed := &execution_data.BlockExecutionData{
+			BlockID: block.ID(),
+			ChunkExecutionDatas: []*execution_data.ChunkExecutionData{
+				{Collection: &txCollection},
+			},
+		}
+		execData := execution_data.NewBlockExecutionDataEntity(block.ID(), ed)
+
+		err := newIndexCoreTest(t, blocks, execData).
+			initIndexer().
+			useDefaultStorageMocks().
+			useDefaultEvents().
+			useDefaultTransactionResults().
+			// make sure all transactions were indexed with the block and transaction position.
+			// transaction fixtures use the same account as payer, proposer and authorizer
+			setStoreAccountTransactions(func(t *testing.T, actual []storage.AccountTransaction) error {
+				for i, tx := range txCollection.Transactions {
+					assert.Contains(t, actual, storage.AccountTransaction{
+						Address:          tx.Payer,
+						BlockID:          block.ID(),
+						BlockHeight:      block.Header.Height,
+						TransactionID:    tx.ID(),
+						TransactionIndex: uint32(i),
+						Roles:            storage.AccountTransactionRolePayer | storage.AccountTransactionRoleProposer | storage.AccountTransactionRoleAuthorizer,
+					})
+				}
+				return nil
+			}).
+			setStoreRegisters(func(t *testing.T, entries flow.RegisterEntries, height uint64) error {
+				return nil
+			}).
+			runIndexBlockData()
+
+		assert.NoError(t, err)
+	})
+
 	t.Run("Index Collections", func(t *testing.T) {
 		expectedCollections := unittest.CollectionListFixture(2)
 		ed := &execution_data.BlockExecutionData{
@@ -445,6 +519,7 @@ func TestExecutionState_IndexBlockData(t *testing.T) {
 		execData := execution_data.NewBlockExecutionDataEntity(block.ID(), ed)
 		err := newIndexCoreTest(t, blocks, execData).
 			initIndexer().
+			useDefaultAccountTransactions().
 			useDefaultStorageMocks().
 			// make sure an empty set of events were stored
 			setStoreEvents(func(t *testing.T, actualBlockID flow.Identifier, actualEvents []flow.EventsList) error {
@@ -500,6 +575,7 @@ func TestExecutionState_IndexBlockData(t *testing.T) {
 		execData := execution_data.NewBlockExecutionDataEntity(block.ID(), ed)
 		err := newIndexCoreTest(t, blocks, execData).
 			initIndexer().
+			useDefaultAccountTransactions().
 			useDefaultStorageMocks().
 			// make sure all events are stored at once in order
 			setStoreEvents(func(t *testing.T, actualBlockID flow.Identifier, actualEvents []flow.EventsList) error {
@@ -703,6 +779,8 @@ func TestIndexerIntegration_StoreAndGet(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 				flow.Testnet.Chain(),
 				derivedChainData,
 				nil,
@@ -737,6 +815,8 @@ func TestIndexerIntegration_StoreAndGet(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 				flow.Testnet.Chain(),
 				derivedChainData,
 				nil,
@@ -764,6 +844,8 @@ func TestIndexerIntegration_StoreAndGet(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 				flow.Testnet.Chain(),
 				derivedChainData,
 				nil,
@@ -808,6 +890,8 @@ func TestIndexerIntegration_StoreAndGet(t *testing.T) {
 				nil,
 				nil,
 				nil,
+				nil,
+				nil,
 				flow.Testnet.Chain(),
 				derivedChainData,
 				nil,
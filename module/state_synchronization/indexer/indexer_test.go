@@ -55,6 +55,7 @@ func newIndexerTest(t *testing.T, availableBlocks int, lastIndexedIndex int) *in
 		}).
 		useDefaultBlockByHeight().
 		useDefaultEvents().
+		useDefaultAccountTransactions().
 		useDefaultTransactionResults().
 		initIndexer()
 
@@ -11,6 +11,8 @@ import (
 	"github.com/onflow/flow-go/fvm/storage/derived"
 	"github.com/onflow/flow-go/fvm/storage/snapshot"
 	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/executiondatasync/execution_data"
+	"github.com/onflow/flow-go/storage"
 )
 
 var (
@@ -50,6 +52,56 @@ func findContractUpdates(events []flow.Event) (map[common.Location]struct{}, err
 	return invalidatedPrograms, nil
 }
 
+// findAccountTransactions returns the account transactions index entries for all transactions within the
+// provided chunks. Transactions are indexed by their payer, proposer and authorizers.
+//
+// Accounts whose registers were updated by a transaction are not indexed: execution data records the
+// register updates of a whole chunk, which can not be attributed to the individual transactions.
+func findAccountTransactions(
+	blockID flow.Identifier,
+	height uint64,
+	chunks []*execution_data.ChunkExecutionData,
+) []storage.AccountTransaction {
+	entries := make([]storage.AccountTransaction, 0)
+
+	txIndex := uint32(0)
+	for _, chunk := range chunks {
+		if chunk.Collection == nil {
+			continue
+		}
+
+		for _, tx := range chunk.Collection.Transactions {
+			roles := make(map[flow.Address]storage.AccountTransactionRoles)
+			roles[tx.Payer] |= storage.AccountTransactionRolePayer
+			roles[tx.ProposalKey.Address] |= storage.AccountTransactionRoleProposer
+			for _, authorizer := range tx.Authorizers {
+				roles[authorizer] |= storage.AccountTransactionRoleAuthorizer
+			}
+
+			txID := tx.ID()
+			for address, accountRoles := range roles {
+				// the system transaction has no payer or proposer
+				if address == flow.EmptyAddress {
+					continue
+				}
+
+				entries = append(entries, storage.AccountTransaction{
+					Address:          address,
+					BlockID:          blockID,
+					BlockHeight:      height,
+					TransactionID:    txID,
+					TransactionIndex: txIndex,
+					Roles:            accountRoles,
+				})
+			}
+
+			txIndex++
+		}
+	}
+
+	return entries
+}
+
 // parseAccountContractUpdated parses an account contract updated event and returns the address location.
 // No errors are expected during normal operation and indicate an invalid protocol event was encountered
 func parseAccountContractUpdated(event *flow.Event) (common.AddressLocation, error) {
@@ -12,7 +12,11 @@ import (
 
 	"github.com/onflow/flow/protobuf/go/flow/entities"
 
+	"github.com/onflow/flow-go/ledger"
+	"github.com/onflow/flow-go/ledger/common/convert"
 	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/executiondatasync/execution_data"
+	"github.com/onflow/flow-go/storage"
 	"github.com/onflow/flow-go/utils/unittest"
 	"github.com/onflow/flow-go/utils/unittest/generator"
 )
@@ -57,6 +61,70 @@ func TestFindContractUpdates(t *testing.T) {
 	assert.Truef(t, ok, "could not find %s", expected2.ID())
 }
 
+// TestFindAccountTransactions tests the findAccountTransactions function returns entries for the payer,
+// proposer and authorizers of all transactions
+func TestFindAccountTransactions(t *testing.T) {
+	t.Parallel()
+
+	blockID := unittest.IdentifierFixture()
+	height := uint64(10)
+
+	// use distinct accounts for the payer, proposer and authorizer of each transaction
+	withRandomAccounts := func(collection *flow.Collection) {
+		for _, tx := range collection.Transactions {
+			tx.Payer = unittest.RandomAddressFixture()
+			tx.ProposalKey.Address = unittest.RandomAddressFixture()
+			tx.Authorizers = []flow.Address{unittest.RandomAddressFixture()}
+		}
+	}
+	collection1 := unittest.CollectionFixture(2, withRandomAccounts)
+	collection2 := unittest.CollectionFixture(1, withRandomAccounts)
+
+	// the payer of the first transaction is also its authorizer
+	tx1 := collection1.Transactions[0]
+	tx1.Authorizers = []flow.Address{tx1.Payer}
+
+	// registers updated by a chunk are not attributed to its transactions
+	touched := unittest.RandomAddressFixture()
+	update := trieUpdateWithPayloadsFixture([]*ledger.Payload{
+		ledger.NewPayload(convert.RegisterIDToLedgerKey(flow.NewRegisterID(touched, "key")), []byte{1}),
+	})
+
+	chunks := []*execution_data.ChunkExecutionData{
+		{Collection: &collection1},
+		{Collection: &collection2, TrieUpdate: update},
+	}
+
+	entries := findAccountTransactions(blockID, height, chunks)
+
+	expected := make([]storage.AccountTransaction, 0)
+	addEntry := func(address flow.Address, tx *flow.TransactionBody, txIndex uint32, roles storage.AccountTransactionRoles) {
+		expected = append(expected, storage.AccountTransaction{
+			Address:          address,
+			BlockID:          blockID,
+			BlockHeight:      height,
+			TransactionID:    tx.ID(),
+			TransactionIndex: txIndex,
+			Roles:            roles,
+		})
+	}
+
+	addEntry(tx1.Payer, tx1, 0, storage.AccountTransactionRolePayer|storage.AccountTransactionRoleAuthorizer)
+	addEntry(tx1.ProposalKey.Address, tx1, 0, storage.AccountTransactionRoleProposer)
+
+	tx2 := collection1.Transactions[1]
+	addEntry(tx2.Payer, tx2, 1, storage.AccountTransactionRolePayer)
+	addEntry(tx2.ProposalKey.Address, tx2, 1, storage.AccountTransactionRoleProposer)
+	addEntry(tx2.Authorizers[0], tx2, 1, storage.AccountTransactionRoleAuthorizer)
+
+	tx3 := collection2.Transactions[0]
+	addEntry(tx3.Payer, tx3, 2, storage.AccountTransactionRolePayer)
+	addEntry(tx3.ProposalKey.Address, tx3, 2, storage.AccountTransactionRoleProposer)
+	addEntry(tx3.Authorizers[0], tx3, 2, storage.AccountTransactionRoleAuthorizer)
+
+	assert.ElementsMatch(t, expected, entries)
+}
+
 func contractUpdatedFixture(t *testing.T, address common.Address, contractName string) flow.Event {
 	contractUpdateEventType := &cadence.EventType{
 		Location:            stdlib.AccountContractAddedEventType.Location,
@@ -95,7 +95,12 @@ func (connector *upstreamConnector) connect(ctx context.Context, bootstrapPeer f
 	default:
 	}
 
-	peerAddrInfo, err := utils.PeerAddressInfo(bootstrapPeer)
+	peerAddressInfo := utils.PeerAddressInfo
+	if utils.ListensOnQUIC(connector.unstakedNode.Host()) {
+		// the bootstrap identities only carry their tcp addresses, their quic addresses are derived from them.
+		peerAddressInfo = utils.PeerAddressInfoWithQUIC
+	}
+	peerAddrInfo, err := peerAddressInfo(bootstrapPeer)
 
 	if err != nil {
 		return err
@@ -3,6 +3,7 @@ package network
 import (
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/module/component"
+	"github.com/onflow/flow-go/network/alsp/model"
 	"github.com/onflow/flow-go/network/channels"
 )
 
@@ -50,4 +51,16 @@ type MisbehaviorReportManager interface {
 	// disallow-listed if the overall penalty of the misbehaving node drops below the disallow-listing threshold.
 	// The implementation of this function should be thread-safe and non-blocking.
 	HandleMisbehaviorReport(channels.Channel, MisbehaviorReport)
+	SpamRecordsExposer
+	// DisallowListNotificationConsumer is notified of all disallow-listing and allow-listing updates distributed to
+	// the network, so that the disallow-list causes other than ALSP, e.g., the admin cause, are persisted along with
+	// the spam records when snapshots are enabled.
+	DisallowListNotificationConsumer
+}
+
+// SpamRecordsExposer exposes the spam records kept by the misbehavior report manager, e.g., for inspection by the admin commands.
+type SpamRecordsExposer interface {
+	// SpamRecords returns a copy of the spam records of all nodes that currently have one.
+	// The implementation of this function should be thread-safe and non-blocking.
+	SpamRecords() []model.ProtocolSpamRecord
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sync"
 	"time"
 
 	"github.com/rs/zerolog"
@@ -39,6 +40,12 @@ var (
 	// ErrHeartBeatIntervalNotSet is returned when the heartbeat interval is not set, it is a fatal irrecoverable error,
 	// and the ALSP module cannot be initialized.
 	ErrHeartBeatIntervalNotSet = errors.New("heartbeat interval is not set")
+	// ErrSnapshotIntervalNotSet is returned when spam record snapshots are enabled but the snapshot interval is not set,
+	// it is a fatal irrecoverable error, and the ALSP module cannot be initialized.
+	ErrSnapshotIntervalNotSet = errors.New("snapshot interval is not set")
+	// ErrSnapshotIdentityProviderNotSet is returned when spam record snapshots are enabled but the identity provider is not set,
+	// it is a fatal irrecoverable error, and the ALSP module cannot be initialized.
+	ErrSnapshotIdentityProviderNotSet = errors.New("identity provider is not set")
 )
 
 type SpamRecordCacheFactory func(zerolog.Logger, uint32, module.HeroCacheMetrics) alsp.SpamRecordCache
@@ -91,6 +98,24 @@ type MisbehaviorReportManager struct {
 
 	// decayFunc is the function that calculates the decay of the spam record.
 	decayFunc SpamRecordDecayFunc
+
+	// heartBeatInterval is the interval between two heartbeats, i.e., two decays of the spam records.
+	heartBeatInterval time.Duration
+
+	// snapshotFile is the file the spam records are snapshotted to, empty if snapshots are disabled.
+	snapshotFile string
+	// snapshotInterval is the interval between two snapshots of the spam records.
+	snapshotInterval time.Duration
+	// idProvider is used to drop the restored spam records of identities that are no longer in the protocol state.
+	// It is only set if snapshots are enabled.
+	idProvider module.IdentityProvider
+
+	// disallowListCauses are the causes other than ALSP for which nodes are disallow-listed at the networking layer,
+	// e.g., by the admin commands. They are recorded from the disallow-listing notifications distributed to the
+	// network, so that they are snapshotted along with the spam records. The ALSP cause is not recorded, as it is
+	// restored from the spam records.
+	disallowListCauses     map[flow.Identifier]map[network.DisallowListedCause]struct{}
+	disallowListCausesLock sync.Mutex
 }
 
 var _ network.MisbehaviorReportManager = (*MisbehaviorReportManager)(nil)
@@ -119,7 +144,15 @@ type MisbehaviorReportManagerConfig struct {
 	// HeartBeatInterval is the interval between the heartbeats. Heartbeat is a recurring event that is used to
 	// apply recurring actions, e.g., decay the penalty of the misbehaving nodes.
 	HeartBeatInterval time.Duration
-	Opts              []MisbehaviorReportManagerOption
+	// SnapshotDir is the directory the spam records are snapshotted to, so that the penalties survive node restarts.
+	// Snapshots are disabled if empty.
+	SnapshotDir string
+	// SnapshotInterval is the interval between two snapshots of the spam records. A snapshot is also taken at shutdown.
+	SnapshotInterval time.Duration
+	// IdentityProvider is used to drop the restored spam records of identities that are no longer in the protocol state.
+	// It is only required if snapshots are enabled.
+	IdentityProvider module.IdentityProvider
+	Opts             []MisbehaviorReportManagerOption
 }
 
 // validate validates the MisbehaviorReportManagerConfig instance. It returns an error if the config is invalid.
@@ -142,6 +175,14 @@ func (c MisbehaviorReportManagerConfig) validate() error {
 	if c.HeartBeatInterval == 0 {
 		return ErrHeartBeatIntervalNotSet
 	}
+	if c.SnapshotDir != "" {
+		if c.SnapshotInterval == 0 {
+			return ErrSnapshotIntervalNotSet
+		}
+		if c.IdentityProvider == nil {
+			return ErrSnapshotIdentityProviderNotSet
+		}
+	}
 	return nil
 }
 
@@ -172,6 +213,14 @@ func NewMisbehaviorReportManager(cfg *MisbehaviorReportManagerConfig, consumer n
 		disallowListingConsumer: consumer,
 		cacheFactory:            defaultSpamRecordCacheFactory(),
 		decayFunc:               defaultSpamRecordDecayFunc(),
+		heartBeatInterval:       cfg.HeartBeatInterval,
+		disallowListCauses:      make(map[flow.Identifier]map[network.DisallowListedCause]struct{}),
+	}
+
+	if cfg.SnapshotDir != "" {
+		m.snapshotFile = snapshotFilePath(cfg.SnapshotDir, cfg.NetworkType)
+		m.snapshotInterval = cfg.SnapshotInterval
+		m.idProvider = cfg.IdentityProvider
 	}
 
 	store := queue.NewHeroStore(
@@ -195,9 +244,20 @@ func NewMisbehaviorReportManager(cfg *MisbehaviorReportManagerConfig, consumer n
 
 	builder := component.NewComponentManagerBuilder()
 	builder.AddWorker(func(ctx irrecoverable.SignalerContext, ready component.ReadyFunc) {
+		if m.snapshotFile != "" {
+			// restores the spam records before the first heartbeat, so that restored records are decayed
+			// and disallow-listed identities are reported to the consumer before the manager is ready.
+			m.restoreSnapshot()
+		}
 		ready()
 		m.heartbeatLoop(ctx, cfg.HeartBeatInterval) // blocking call
 	})
+	if m.snapshotFile != "" {
+		builder.AddWorker(func(ctx irrecoverable.SignalerContext, ready component.ReadyFunc) {
+			ready()
+			m.snapshotLoop(ctx) // blocking call
+		})
+	}
 	for i := 0; i < defaultMisbehaviorReportManagerWorkers; i++ {
 		builder.AddWorker(m.workerPool.WorkerLogic())
 	}
@@ -255,6 +315,109 @@ func (m *MisbehaviorReportManager) HandleMisbehaviorReport(channel channels.Chan
 	lg.Debug().Msg("misbehavior report submitted")
 }
 
+// SpamRecords returns a copy of the spam records of all nodes that currently have one.
+// The implementation of this function is thread-safe and non-blocking.
+// Args:
+//
+//	none.
+//
+// Returns:
+//
+//	[]model.ProtocolSpamRecord: the spam records in the cache.
+func (m *MisbehaviorReportManager) SpamRecords() []model.ProtocolSpamRecord {
+	ids := m.cache.Identities()
+	records := make([]model.ProtocolSpamRecord, 0, len(ids))
+	for _, id := range ids {
+		record, ok := m.cache.Get(id)
+		if !ok {
+			// the record has been removed since listing the identities.
+			continue
+		}
+		records = append(records, *record)
+	}
+	return records
+}
+
+// OnDisallowListNotification records the cause of a disallow-listing notification distributed to the network,
+// so that it is persisted by the spam record snapshots. The ALSP cause is ignored, as it is persisted by the spam records.
+// The implementation of this function is thread-safe and non-blocking.
+// Args:
+//
+//	notification: the disallow-listing notification.
+//
+// Returns:
+//
+//	none.
+func (m *MisbehaviorReportManager) OnDisallowListNotification(notification *network.DisallowListingUpdate) {
+	if notification.Cause == network.DisallowListedCauseAlsp {
+		return
+	}
+
+	m.disallowListCausesLock.Lock()
+	defer m.disallowListCausesLock.Unlock()
+
+	for _, id := range notification.FlowIds {
+		causes, ok := m.disallowListCauses[id]
+		if !ok {
+			causes = make(map[network.DisallowListedCause]struct{})
+			m.disallowListCauses[id] = causes
+		}
+		causes[notification.Cause] = struct{}{}
+	}
+}
+
+// OnAllowListNotification removes the cause of an allow-listing notification distributed to the network from the
+// recorded disallow-list causes. The ALSP cause is ignored, as it is persisted by the spam records.
+// The implementation of this function is thread-safe and non-blocking.
+// Args:
+//
+//	notification: the allow-listing notification.
+//
+// Returns:
+//
+//	none.
+func (m *MisbehaviorReportManager) OnAllowListNotification(notification *network.AllowListingUpdate) {
+	if notification.Cause == network.DisallowListedCauseAlsp {
+		return
+	}
+
+	m.disallowListCausesLock.Lock()
+	defer m.disallowListCausesLock.Unlock()
+
+	for _, id := range notification.FlowIds {
+		causes, ok := m.disallowListCauses[id]
+		if !ok {
+			continue
+		}
+		delete(causes, notification.Cause)
+		if len(causes) == 0 {
+			delete(m.disallowListCauses, id)
+		}
+	}
+}
+
+// recordedDisallowListCauses returns a copy of the recorded causes other than ALSP for which nodes are disallow-listed.
+// The implementation of this function is thread-safe and non-blocking.
+// Args:
+//
+//	none.
+//
+// Returns:
+//
+//	map[flow.Identifier][]network.DisallowListedCause: the disallow-list causes of each disallow-listed node.
+func (m *MisbehaviorReportManager) recordedDisallowListCauses() map[flow.Identifier][]network.DisallowListedCause {
+	m.disallowListCausesLock.Lock()
+	defer m.disallowListCausesLock.Unlock()
+
+	disallowListCauses := make(map[flow.Identifier][]network.DisallowListedCause, len(m.disallowListCauses))
+	for id, causes := range m.disallowListCauses {
+		for cause := range causes {
+			disallowListCauses[id] = append(disallowListCauses[id], cause)
+		}
+	}
+	return disallowListCauses
+}
+
 // heartbeatLoop starts the heartbeat ticks ticker to tick at the given intervals. It is a blocking function, and
 // should be called in a separate goroutine. It returns when the context is canceled. Hearbeats are recurring events that
 // are used to perform periodic tasks.
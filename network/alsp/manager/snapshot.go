@@ -0,0 +1,211 @@
+package alspmgr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/irrecoverable"
+	"github.com/onflow/flow-go/network"
+	"github.com/onflow/flow-go/network/alsp/model"
+	"github.com/onflow/flow-go/utils/io"
+	"github.com/onflow/flow-go/utils/logging"
+)
+
+// spamRecordSnapshot is the content of the spam record snapshot file.
+type spamRecordSnapshot struct {
+	// TakenAt is the time the snapshot was taken, used to decay the restored records for the time the node was down.
+	TakenAt time.Time `json:"taken_at"`
+	// Records are the spam records at the time the snapshot was taken.
+	Records []model.ProtocolSpamRecord `json:"records"`
+	// DisallowListCauses are the causes other than ALSP for which nodes were disallow-listed at the time the snapshot
+	// was taken, e.g., by the admin commands.
+	DisallowListCauses map[flow.Identifier][]network.DisallowListedCause `json:"disallow_list_causes"`
+}
+
+// snapshotFilePath returns the path of the spam record snapshot file in the given directory.
+// The file name includes the networking type, as nodes may run a private and a public network side by side.
+func snapshotFilePath(dir string, networkType network.NetworkingType) string {
+	return filepath.Join(dir, fmt.Sprintf("alsp-spam-records-%s.json", networkType))
+}
+
+// snapshotLoop takes a snapshot of the spam records at the snapshot interval, and a final snapshot when the
+// context is canceled. It is a blocking function, and should be called in a separate goroutine.
+// Failing to take a snapshot is logged but not considered irrecoverable, as the penalties are still enforced in memory.
+// Args:
+//
+//	ctx: the context.
+//
+// Returns:
+//
+//	none.
+func (m *MisbehaviorReportManager) snapshotLoop(ctx irrecoverable.SignalerContext) {
+	ticker := time.NewTicker(m.snapshotInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			if err := m.snapshot(); err != nil {
+				m.logger.Error().Err(err).Str("file", m.snapshotFile).Msg("failed to snapshot spam records at shutdown")
+			}
+			return
+		case <-ticker.C:
+			if err := m.snapshot(); err != nil {
+				m.logger.Error().Err(err).Str("file", m.snapshotFile).Msg("failed to snapshot spam records")
+			}
+		}
+	}
+}
+
+// snapshot writes all spam records in the cache to the snapshot file.
+// Args:
+//
+//	none.
+//
+// Returns:
+//
+//	error: if the snapshot cannot be written. No error is irrecoverable.
+func (m *MisbehaviorReportManager) snapshot() error {
+	snapshot := spamRecordSnapshot{
+		TakenAt:            time.Now(),
+		Records:            m.SpamRecords(),
+		DisallowListCauses: m.recordedDisallowListCauses(),
+	}
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("could not encode spam records: %w", err)
+	}
+
+	err = io.WriteFileAtomically(m.snapshotFile, data)
+	if err != nil {
+		return fmt.Errorf("could not write spam records: %w", err)
+	}
+
+	m.logger.Debug().
+		Int("records", len(snapshot.Records)).
+		Int("disallow_listed", len(snapshot.DisallowListCauses)).
+		Msg("spam records snapshotted")
+	return nil
+}
+
+// restoreSnapshot restores the spam records from the snapshot file into the cache. Records of identities that are
+// no longer in the protocol state or are ejected are dropped, and the remaining records are decayed for the heartbeats
+// missed since the snapshot was taken. Identities which are still disallow-listed by ALSP are reported to the
+// disallow-listing consumer, as are the identities disallow-listed for other causes, e.g., by the admin commands.
+// A missing or corrupted snapshot is logged and the manager starts with empty spam records.
+// Args:
+//
+//	none.
+//
+// Returns:
+//
+//	none.
+func (m *MisbehaviorReportManager) restoreSnapshot() {
+	lg := m.logger.With().Str("file", m.snapshotFile).Logger()
+
+	data, err := os.ReadFile(m.snapshotFile)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			lg.Info().Msg("no spam record snapshot found, starting with empty spam records")
+			return
+		}
+		lg.Error().Err(err).Msg("failed to read spam record snapshot, starting with empty spam records")
+		return
+	}
+
+	var snapshot spamRecordSnapshot
+	err = json.Unmarshal(data, &snapshot)
+	if err != nil {
+		lg.Error().Err(err).Msg("failed to decode spam record snapshot, starting with empty spam records")
+		return
+	}
+
+	elapsed := time.Since(snapshot.TakenAt)
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	missedHeartbeats := float64(elapsed / m.heartBeatInterval)
+
+	restored, dropped := 0, 0
+	for _, restoredRecord := range snapshot.Records {
+		id := restoredRecord.OriginId
+		if !m.isRestorable(id) {
+			dropped++
+			continue
+		}
+
+		// the penalty decays by the decay speed at every heartbeat, and never becomes positive.
+		restoredRecord.Penalty = math.Min(restoredRecord.Penalty+missedHeartbeats*restoredRecord.Decay, 0)
+		if restoredRecord.Penalty == float64(0) {
+			restoredRecord.DisallowListed = false
+		}
+
+		// reports processed since startup may have already created a record, so the restored
+		// penalty is added to the current one instead of replacing it.
+		_, err := m.cache.AdjustWithInit(id, func(record model.ProtocolSpamRecord) (model.ProtocolSpamRecord, error) {
+			record.Penalty += restoredRecord.Penalty
+			record.Decay = restoredRecord.Decay
+			record.CutoffCounter = restoredRecord.CutoffCounter
+			record.DisallowListed = restoredRecord.DisallowListed
+			return record, nil
+		})
+		if err != nil {
+			lg.Error().Err(err).Hex("identifier", logging.ID(id)).Msg("failed to restore spam record")
+			continue
+		}
+		restored++
+
+		if restoredRecord.DisallowListed {
+			m.disallowListingConsumer.OnDisallowListNotification(&network.DisallowListingUpdate{
+				FlowIds: flow.IdentifierList{id},
+				Cause:   network.DisallowListedCauseAlsp,
+			})
+		}
+	}
+
+	disallowListed := 0
+	for id, causes := range snapshot.DisallowListCauses {
+		if !m.isRestorable(id) {
+			dropped++
+			continue
+		}
+		disallowListed++
+
+		for _, cause := range causes {
+			update := &network.DisallowListingUpdate{
+				FlowIds: flow.IdentifierList{id},
+				Cause:   cause,
+			}
+			// the cause is recorded directly, as the consumer is not required to notify the manager back.
+			m.OnDisallowListNotification(update)
+			m.disallowListingConsumer.OnDisallowListNotification(update)
+		}
+	}
+
+	lg.Info().
+		Time("taken_at", snapshot.TakenAt).
+		Int("restored", restored).
+		Int("disallow_listed", disallowListed).
+		Int("dropped", dropped).
+		Msg("spam records restored from snapshot")
+}
+
+// isRestorable returns true if the snapshotted state of the given identity should be restored, i.e., if the
+// identity is still in the protocol state and is not ejected.
+// Args:
+//
+//	id: the identifier of the node.
+//
+// Returns:
+//
+//	bool: true if the state of the identity should be restored.
+func (m *MisbehaviorReportManager) isRestorable(id flow.Identifier) bool {
+	identity, ok := m.idProvider.ByNodeID(id)
+	return ok && !identity.IsEjected()
+}
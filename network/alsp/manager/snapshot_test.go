@@ -0,0 +1,227 @@
+package alspmgr_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module"
+	"github.com/onflow/flow-go/module/id"
+	"github.com/onflow/flow-go/module/irrecoverable"
+	"github.com/onflow/flow-go/network"
+	"github.com/onflow/flow-go/network/alsp"
+	"github.com/onflow/flow-go/network/alsp/internal"
+	alspmgr "github.com/onflow/flow-go/network/alsp/manager"
+	"github.com/onflow/flow-go/network/alsp/model"
+	"github.com/onflow/flow-go/network/mocknetwork"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestSpamRecordSnapshot_RestoredAfterRestart tests that the spam records are snapshotted at shutdown and restored
+// by the next manager using the same snapshot directory. Records of identities that are no longer in the protocol
+// state are dropped, and identities which are still disallow-listed are reported to the consumer on startup.
+func TestSpamRecordSnapshot_RestoredAfterRestart(t *testing.T) {
+	identities := unittest.IdentityListFixture(2)
+	disallowListedId := identities[0].NodeID
+	penalizedId := identities[1].NodeID
+	unknownId := unittest.IdentifierFixture()
+
+	disallowListedRecord := model.ProtocolSpamRecord{
+		OriginId:       disallowListedId,
+		Decay:          1000,
+		CutoffCounter:  1,
+		DisallowListed: true,
+		Penalty:        model.DisallowListingThreshold - 1,
+	}
+	penalizedRecord := model.ProtocolSpamRecord{
+		OriginId: penalizedId,
+		Decay:    model.InitialDecaySpeed,
+		Penalty:  -10,
+	}
+
+	cfg := snapshotManagerCfgFixture(t, id.NewFixedIdentityProvider(identities))
+
+	// the first manager accumulates the spam records, and snapshots them at shutdown
+	cache := startManagerWithSnapshot(t, cfg, mocknetwork.NewDisallowListNotificationConsumer(t), func(_ *alspmgr.MisbehaviorReportManager, cache alsp.SpamRecordCache) {
+		for _, record := range []model.ProtocolSpamRecord{disallowListedRecord, penalizedRecord, {OriginId: unknownId, Decay: 1000, Penalty: -5}} {
+			record := record
+			_, err := cache.AdjustWithInit(record.OriginId, func(model.ProtocolSpamRecord) (model.ProtocolSpamRecord, error) {
+				return record, nil
+			})
+			require.NoError(t, err)
+		}
+	})
+	require.Equal(t, uint(3), cache.Size())
+
+	// the second manager restores the spam records on startup
+	consumer := mocknetwork.NewDisallowListNotificationConsumer(t)
+	consumer.On("OnDisallowListNotification", &network.DisallowListingUpdate{
+		FlowIds: flow.IdentifierList{disallowListedId},
+		Cause:   network.DisallowListedCauseAlsp,
+	}).Return().Once()
+
+	startManagerWithSnapshot(t, cfg, consumer, func(_ *alspmgr.MisbehaviorReportManager, cache alsp.SpamRecordCache) {
+		require.Equal(t, uint(2), cache.Size())
+
+		record, ok := cache.Get(disallowListedId)
+		require.True(t, ok)
+		require.Equal(t, disallowListedRecord, *record)
+
+		record, ok = cache.Get(penalizedId)
+		require.True(t, ok)
+		require.Equal(t, penalizedRecord, *record)
+
+		_, ok = cache.Get(unknownId)
+		require.False(t, ok)
+	})
+}
+
+// TestSpamRecordSnapshot_DisallowListCausesRestored tests that the disallow-list causes other than ALSP, e.g., set by
+// the admin commands, are snapshotted and reported to the consumer on startup, and that the records and causes of
+// ejected identities are dropped.
+func TestSpamRecordSnapshot_DisallowListCausesRestored(t *testing.T) {
+	identities := unittest.IdentityListFixture(2)
+	ejected := unittest.IdentityFixture(unittest.WithParticipationStatus(flow.EpochParticipationStatusEjected))
+	identities = append(identities, ejected)
+	disallowListedId := identities[0].NodeID
+	allowListedId := identities[1].NodeID
+
+	cfg := snapshotManagerCfgFixture(t, id.NewFixedIdentityProvider(identities))
+
+	startManagerWithSnapshot(t, cfg, mocknetwork.NewDisallowListNotificationConsumer(t), func(m *alspmgr.MisbehaviorReportManager, cache alsp.SpamRecordCache) {
+		m.OnDisallowListNotification(&network.DisallowListingUpdate{
+			FlowIds: flow.IdentifierList{disallowListedId, allowListedId, ejected.NodeID},
+			Cause:   network.DisallowListedCauseAdmin,
+		})
+		m.OnAllowListNotification(&network.AllowListingUpdate{
+			FlowIds: flow.IdentifierList{allowListedId},
+			Cause:   network.DisallowListedCauseAdmin,
+		})
+		// the ALSP cause is restored from the spam records instead
+		m.OnDisallowListNotification(&network.DisallowListingUpdate{
+			FlowIds: flow.IdentifierList{allowListedId},
+			Cause:   network.DisallowListedCauseAlsp,
+		})
+
+		_, err := cache.AdjustWithInit(ejected.NodeID, func(record model.ProtocolSpamRecord) (model.ProtocolSpamRecord, error) {
+			record.Penalty = -10
+			return record, nil
+		})
+		require.NoError(t, err)
+	})
+
+	// the restored causes are snapshotted again by the next manager, even if the consumer does not notify the manager back
+	for i := 0; i < 2; i++ {
+		consumer := mocknetwork.NewDisallowListNotificationConsumer(t)
+		consumer.On("OnDisallowListNotification", &network.DisallowListingUpdate{
+			FlowIds: flow.IdentifierList{disallowListedId},
+			Cause:   network.DisallowListedCauseAdmin,
+		}).Return().Once()
+
+		startManagerWithSnapshot(t, cfg, consumer, func(_ *alspmgr.MisbehaviorReportManager, cache alsp.SpamRecordCache) {
+			_, ok := cache.Get(ejected.NodeID)
+			require.False(t, ok)
+		})
+	}
+}
+
+// TestSpamRecordSnapshot_DecayedForElapsedTime tests that the restored spam records are decayed for the heartbeats
+// missed since the snapshot was taken, and that a record decayed to zero is no longer disallow-listed.
+func TestSpamRecordSnapshot_DecayedForElapsedTime(t *testing.T) {
+	identities := unittest.IdentityListFixture(1)
+	originId := identities[0].NodeID
+
+	cfg := snapshotManagerCfgFixture(t, id.NewFixedIdentityProvider(identities))
+
+	startManagerWithSnapshot(t, cfg, mocknetwork.NewDisallowListNotificationConsumer(t), func(_ *alspmgr.MisbehaviorReportManager, cache alsp.SpamRecordCache) {
+		_, err := cache.AdjustWithInit(originId, func(record model.ProtocolSpamRecord) (model.ProtocolSpamRecord, error) {
+			record.Penalty = model.DisallowListingThreshold - 1
+			record.Decay = 1000
+			record.CutoffCounter = 1
+			record.DisallowListed = true
+			return record, nil
+		})
+		require.NoError(t, err)
+	})
+
+	// with a decay speed of 1000 and a heartbeat interval of one millisecond, the penalty fully decays within
+	// the 100ms between the two managers, hence no disallow-list notification is expected.
+	cfg.HeartBeatInterval = time.Millisecond
+	time.Sleep(100 * time.Millisecond)
+
+	startManagerWithSnapshot(t, cfg, mocknetwork.NewDisallowListNotificationConsumer(t), func(_ *alspmgr.MisbehaviorReportManager, cache alsp.SpamRecordCache) {
+		record, ok := cache.Get(originId)
+		require.True(t, ok)
+		require.Equal(t, float64(0), record.Penalty)
+		require.False(t, record.DisallowListed)
+		require.Equal(t, uint64(1), record.CutoffCounter)
+	})
+}
+
+// TestSpamRecordSnapshot_InitializationError tests that enabling snapshots requires a snapshot interval and an identity provider.
+func TestSpamRecordSnapshot_InitializationError(t *testing.T) {
+	consumer := mocknetwork.NewDisallowListNotificationConsumer(t)
+
+	t.Run("missing snapshot interval", func(t *testing.T) {
+		cfg := snapshotManagerCfgFixture(t, id.NewFixedIdentityProvider(nil))
+		cfg.SnapshotInterval = 0
+		m, err := alspmgr.NewMisbehaviorReportManager(cfg, consumer)
+		require.ErrorIs(t, err, alspmgr.ErrSnapshotIntervalNotSet)
+		require.Nil(t, m)
+	})
+
+	t.Run("missing identity provider", func(t *testing.T) {
+		cfg := snapshotManagerCfgFixture(t, nil)
+		m, err := alspmgr.NewMisbehaviorReportManager(cfg, consumer)
+		require.ErrorIs(t, err, alspmgr.ErrSnapshotIdentityProviderNotSet)
+		require.Nil(t, m)
+	})
+}
+
+// snapshotManagerCfgFixture creates a new MisbehaviorReportManagerConfig with snapshots enabled in a temporary directory.
+// The heartbeat and snapshot intervals are long enough to not tick during the test.
+func snapshotManagerCfgFixture(t *testing.T, idProvider module.IdentityProvider) *alspmgr.MisbehaviorReportManagerConfig {
+	cfg := managerCfgFixture(t)
+	cfg.NetworkType = network.PrivateNetwork
+	cfg.HeartBeatInterval = time.Hour
+	cfg.SnapshotDir = t.TempDir()
+	cfg.SnapshotInterval = time.Hour
+	if idProvider != nil {
+		cfg.IdentityProvider = idProvider
+	}
+	return cfg
+}
+
+// startManagerWithSnapshot starts a manager with the given config, calls fn with the manager and its spam record cache
+// once the manager is ready, and stops the manager. Returns the spam record cache of the manager.
+func startManagerWithSnapshot(
+	t *testing.T,
+	cfg *alspmgr.MisbehaviorReportManagerConfig,
+	consumer network.DisallowListNotificationConsumer,
+	fn func(m *alspmgr.MisbehaviorReportManager, cache alsp.SpamRecordCache),
+) alsp.SpamRecordCache {
+	var cache alsp.SpamRecordCache
+	cfg.Opts = []alspmgr.MisbehaviorReportManagerOption{
+		alspmgr.WithSpamRecordsCacheFactory(func(logger zerolog.Logger, size uint32, metrics module.HeroCacheMetrics) alsp.SpamRecordCache {
+			cache = internal.NewSpamRecordCache(size, logger, metrics, model.SpamRecordFactory())
+			return cache
+		}),
+	}
+	m, err := alspmgr.NewMisbehaviorReportManager(cfg, consumer)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	signalerCtx := irrecoverable.NewMockSignalerContext(t, ctx)
+	m.Start(signalerCtx)
+	unittest.RequireCloseBefore(t, m.Ready(), 100*time.Millisecond, "ALSP manager did not start")
+
+	fn(m, cache)
+
+	cancel()
+	unittest.RequireCloseBefore(t, m.Done(), 100*time.Millisecond, "ALSP manager did not stop")
+	return cache
+}
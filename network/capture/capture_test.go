@@ -0,0 +1,123 @@
+package capture_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/atomic"
+
+	"github.com/onflow/flow-go/model/messages"
+	"github.com/onflow/flow-go/network/capture"
+	"github.com/onflow/flow-go/network/channels"
+	"github.com/onflow/flow-go/network/codec"
+	"github.com/onflow/flow-go/network/codec/cbor"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestRecorder_RoundTrip evaluates that the inbound and outbound messages recorded by a recorder are read back from the
+// capture in the order they are recorded and with all their attributes intact.
+func TestRecorder_RoundTrip(t *testing.T) {
+	c := cbor.NewCodec()
+	origin := unittest.IdentifierFixture()
+	targets := unittest.IdentifierListFixture(3)
+
+	request, err := c.Encode(&messages.SyncRequest{Nonce: 1, Height: 10})
+	require.NoError(t, err)
+	response, err := c.Encode(&messages.SyncResponse{Nonce: 1, Height: 20})
+	require.NoError(t, err)
+
+	buf := &bytes.Buffer{}
+	recorder := capture.NewRecorder(unittest.Logger(), buf, capture.DefaultQueueSize)
+	recorder.RecordInbound(channels.SyncCommittee, origin, request)
+	recorder.RecordOutbound(channels.SyncCommittee, unittest.IdentifierFixture(), targets, response)
+	require.NoError(t, recorder.Close())
+
+	// messages recorded after closing the recorder are dropped.
+	recorder.RecordInbound(channels.SyncCommittee, origin, request)
+
+	records, err := capture.ReadAll(buf)
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	require.Equal(t, capture.Inbound, records[0].Direction)
+	require.Equal(t, channels.SyncCommittee, records[0].Channel)
+	require.Equal(t, origin, records[0].OriginID)
+	require.Empty(t, records[0].TargetIDs)
+	require.Equal(t, request, records[0].Payload)
+	code, err := records[0].MessageCode()
+	require.NoError(t, err)
+	require.Equal(t, codec.CodeSyncRequest, code)
+
+	require.Equal(t, capture.Outbound, records[1].Direction)
+	require.Equal(t, targets, records[1].TargetIDs)
+	require.Equal(t, response, records[1].Payload)
+	require.False(t, records[1].Timestamp.Before(records[0].Timestamp))
+	require.Zero(t, recorder.Dropped())
+}
+
+// TestRecorder_FullQueue evaluates that recording a message never blocks on a slow writer: the records that do not fit in
+// the queue of the recorder are dropped and counted, while the queued records are written once the writer catches up.
+func TestRecorder_FullQueue(t *testing.T) {
+	queueSize := 5
+	w := &blockingWriter{unblock: make(chan struct{})}
+	recorder := capture.NewRecorder(unittest.Logger(), w, uint(queueSize))
+
+	// the first record is taken off the queue by the writer, which blocks on writing it.
+	recorder.RecordInbound(channels.SyncCommittee, unittest.IdentifierFixture(), unittest.RandomBytes(10))
+	require.Eventually(t, func() bool {
+		return w.writing.Load()
+	}, time.Second, 10*time.Millisecond)
+
+	// the next records fill up the queue, and the rest are dropped without blocking.
+	unittest.RequireReturnsBefore(t, func() {
+		for i := 0; i < queueSize+3; i++ {
+			recorder.RecordInbound(channels.SyncCommittee, unittest.IdentifierFixture(), unittest.RandomBytes(10))
+		}
+	}, time.Second, "recording blocked on a full queue")
+	require.Equal(t, uint64(3), recorder.Dropped())
+
+	close(w.unblock)
+	require.NoError(t, recorder.Close())
+
+	records, err := capture.ReadAll(&w.buf)
+	require.NoError(t, err)
+	require.Len(t, records, queueSize+1)
+}
+
+// blockingWriter is an io.Writer that blocks on writing until unblock is closed.
+type blockingWriter struct {
+	buf     bytes.Buffer
+	writing atomic.Bool
+	unblock chan struct{}
+}
+
+func (w *blockingWriter) Write(p []byte) (int, error) {
+	w.writing.Store(true)
+	<-w.unblock
+	return w.buf.Write(p)
+}
+
+// TestReader_TruncatedCapture evaluates that reading a capture that ends in the middle of a record, e.g., when the node
+// crashed while recording, returns all the complete records followed by an io.ErrUnexpectedEOF.
+func TestReader_TruncatedCapture(t *testing.T) {
+	buf := &bytes.Buffer{}
+	recorder := capture.NewRecorder(unittest.Logger(), buf, capture.DefaultQueueSize)
+	recorder.RecordInbound(channels.SyncCommittee, unittest.IdentifierFixture(), unittest.RandomBytes(100))
+	recorder.RecordInbound(channels.SyncCommittee, unittest.IdentifierFixture(), unittest.RandomBytes(100))
+	require.NoError(t, recorder.Close())
+
+	data := buf.Bytes()
+	reader := capture.NewReader(bytes.NewReader(data[:len(data)-10]))
+
+	_, err := reader.Next()
+	require.NoError(t, err)
+
+	_, err = reader.Next()
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	_, err = capture.ReadAll(bytes.NewReader(data[:len(data)-10]))
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
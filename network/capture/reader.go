@@ -0,0 +1,82 @@
+package capture
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// Reader reads the records of a capture written by a Recorder, in the order they are recorded.
+type Reader struct {
+	r *bufio.Reader
+}
+
+// NewReader creates a reader of the capture from the given reader.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: bufio.NewReader(r)}
+}
+
+// Next reads the next record of the capture.
+// Expected errors during normal operations:
+//   - io.EOF if there are no more records in the capture.
+//   - io.ErrUnexpectedEOF if the capture ends in the middle of a record, e.g., when the node crashed while recording.
+//
+// Any other error indicates a malformed capture.
+func (r *Reader) Next() (*Record, error) {
+	var header [frameHeaderSize]byte
+	if _, err := io.ReadFull(r.r, header[:]); err != nil {
+		// io.ReadFull returns io.EOF only if no byte of the header is read, i.e., at the end of a capture.
+		return nil, err
+	}
+
+	size := binary.BigEndian.Uint32(header[:])
+	if size > MaxRecordSize {
+		return nil, fmt.Errorf("record size %d exceeds max record size %d", size, MaxRecordSize)
+	}
+
+	data := make([]byte, size)
+	if _, err := io.ReadFull(r.r, data); err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, io.ErrUnexpectedEOF
+		}
+		return nil, err
+	}
+
+	record, err := decodeRecord(data)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode record: %w", err)
+	}
+	return record, nil
+}
+
+// ReadAll reads all the records of the capture from the given reader.
+// No errors are expected during normal operation.
+func ReadAll(r io.Reader) ([]*Record, error) {
+	reader := NewReader(r)
+	var records []*Record
+	for {
+		record, err := reader.Next()
+		if errors.Is(err, io.EOF) {
+			return records, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("could not read record %d of capture: %w", len(records), err)
+		}
+		records = append(records, record)
+	}
+}
+
+// ReadFile reads all the records of the capture file at the given path.
+// No errors are expected during normal operation.
+func ReadFile(path string) ([]*Record, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not open capture file %s: %w", path, err)
+	}
+	defer f.Close()
+
+	return ReadAll(f)
+}
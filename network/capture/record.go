@@ -0,0 +1,148 @@
+package capture
+
+import (
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/network/channels"
+	"github.com/onflow/flow-go/network/codec"
+)
+
+// Direction indicates whether a recorded message is received or sent by the node.
+type Direction uint8
+
+const (
+	// Inbound denotes a message received by the node.
+	Inbound Direction = iota + 1
+	// Outbound denotes a message sent by the node.
+	Outbound
+)
+
+func (d Direction) String() string {
+	switch d {
+	case Inbound:
+		return "inbound"
+	case Outbound:
+		return "outbound"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(d))
+	}
+}
+
+const (
+	// frameHeaderSize is the size of the length prefix of each record in a capture.
+	frameHeaderSize = 4
+
+	// MaxRecordSize is the maximum size of a single encoded record in a capture. It is well above the maximum size of
+	// any message on the Flow network, and guards the reader against allocating arbitrarily large buffers on corrupted captures.
+	MaxRecordSize = 128 << 20 // 128 MB
+
+	// fixedRecordSize is the size of the fixed-size fields of an encoded record, i.e.,
+	// direction (1) + timestamp (8) + origin ID (32) + channel length (2) + number of targets (2).
+	fixedRecordSize = 1 + 8 + flow.IdentifierLen + 2 + 2
+)
+
+// Record is a single message captured at the networking layer.
+//
+// On disk, a capture is a sequence of length-prefixed records. Each record is prefixed by its size as a 4-byte big-endian
+// unsigned integer, and encoded as follows (all integers are big-endian):
+//
+//	direction (1 byte) | timestamp in unix nanoseconds (8 bytes) | origin ID (32 bytes) |
+//	channel length (2 bytes) | channel | number of targets (2 bytes) | target IDs (32 bytes each) | payload
+//
+// The payload is the codec-encoded message as sent on the wire, i.e., its first byte is the codec.MessageCode of the message.
+type Record struct {
+	// Timestamp is the time the message is recorded at.
+	Timestamp time.Time
+	// Direction indicates whether the message is received or sent by the node.
+	Direction Direction
+	// Channel is the channel the message is exchanged on.
+	Channel channels.Channel
+	// OriginID is the node ID of the sender of the message; for outbound messages it is the node itself.
+	OriginID flow.Identifier
+	// TargetIDs are the node IDs of the intended recipients of an outbound message; empty for inbound messages.
+	TargetIDs flow.IdentifierList
+	// Payload is the codec-encoded message.
+	Payload []byte
+}
+
+// MessageCode returns the codec.MessageCode of the recorded message.
+// Expected errors during normal operations:
+//   - codec.ErrInvalidEncoding if the payload is empty.
+func (r *Record) MessageCode() (codec.MessageCode, error) {
+	return codec.MessageCodeFromPayload(r.Payload)
+}
+
+// encode encodes the record into a length-prefixed frame.
+// Any error returned by this function indicates that the record cannot be represented in a capture (e.g., it is too large).
+func (r *Record) encode() ([]byte, error) {
+	if len(r.Channel) > 1<<16-1 {
+		return nil, fmt.Errorf("channel name too long: %d", len(r.Channel))
+	}
+	if len(r.TargetIDs) > 1<<16-1 {
+		return nil, fmt.Errorf("too many target ids: %d", len(r.TargetIDs))
+	}
+	size := fixedRecordSize + len(r.Channel) + len(r.TargetIDs)*flow.IdentifierLen + len(r.Payload)
+	if size > MaxRecordSize {
+		return nil, fmt.Errorf("record size %d exceeds max record size %d", size, MaxRecordSize)
+	}
+
+	frame := make([]byte, 0, frameHeaderSize+size)
+	frame = binary.BigEndian.AppendUint32(frame, uint32(size))
+	frame = append(frame, byte(r.Direction))
+	frame = binary.BigEndian.AppendUint64(frame, uint64(r.Timestamp.UnixNano()))
+	frame = append(frame, r.OriginID[:]...)
+	frame = binary.BigEndian.AppendUint16(frame, uint16(len(r.Channel)))
+	frame = append(frame, r.Channel...)
+	frame = binary.BigEndian.AppendUint16(frame, uint16(len(r.TargetIDs)))
+	for _, targetID := range r.TargetIDs {
+		frame = append(frame, targetID[:]...)
+	}
+	frame = append(frame, r.Payload...)
+
+	return frame, nil
+}
+
+// decodeRecord decodes a record from its encoding, excluding the length prefix.
+// Any error returned by this function indicates a malformed record.
+func decodeRecord(data []byte) (*Record, error) {
+	if len(data) < fixedRecordSize {
+		return nil, fmt.Errorf("record too short: %d", len(data))
+	}
+
+	r := &Record{}
+	r.Direction = Direction(data[0])
+	if r.Direction != Inbound && r.Direction != Outbound {
+		return nil, fmt.Errorf("invalid direction: %d", data[0])
+	}
+	r.Timestamp = time.Unix(0, int64(binary.BigEndian.Uint64(data[1:9]))).UTC()
+	copy(r.OriginID[:], data[9:9+flow.IdentifierLen])
+	data = data[9+flow.IdentifierLen:]
+
+	channelLen := int(binary.BigEndian.Uint16(data[:2]))
+	data = data[2:]
+	if len(data) < channelLen+2 {
+		return nil, fmt.Errorf("record too short for channel of length %d", channelLen)
+	}
+	r.Channel = channels.Channel(data[:channelLen])
+	data = data[channelLen:]
+
+	targetCount := int(binary.BigEndian.Uint16(data[:2]))
+	data = data[2:]
+	if len(data) < targetCount*flow.IdentifierLen {
+		return nil, fmt.Errorf("record too short for %d target ids", targetCount)
+	}
+	if targetCount > 0 {
+		r.TargetIDs = make(flow.IdentifierList, targetCount)
+		for i := range r.TargetIDs {
+			copy(r.TargetIDs[i][:], data[:flow.IdentifierLen])
+			data = data[flow.IdentifierLen:]
+		}
+	}
+
+	r.Payload = data
+
+	return r, nil
+}
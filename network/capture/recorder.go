@@ -0,0 +1,168 @@
+package capture
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/atomic"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/network"
+	"github.com/onflow/flow-go/network/channels"
+)
+
+// DefaultQueueSize is the default number of records buffered by a recorder before they are written to its writer.
+const DefaultQueueSize = 1000
+
+// Recorder is a network.MessageRecorder that writes the recorded messages as length-prefixed records (see Record) to
+// an underlying writer, e.g., a capture file. It is safe for concurrent use.
+// Recording is best-effort and never blocks or fails the delivery of the message at the networking layer: records are
+// queued and written by a single background goroutine, and a record is dropped when the queue is full or when it fails
+// to be written. The number of dropped records is reported by Dropped, and logged when the recorder is closed.
+type Recorder struct {
+	mu      sync.RWMutex // guards closing the queue against concurrent records.
+	logger  zerolog.Logger
+	w       io.Writer
+	closer  io.Closer // optional; closed along with the recorder.
+	closed  bool
+	queue   chan []byte
+	done    chan struct{}
+	dropped *atomic.Uint64
+}
+
+var _ network.MessageRecorder = (*Recorder)(nil)
+
+// NewRecorder creates a recorder that writes the recorded messages to the given writer, and buffers up to queueSize
+// records that are not written yet. The writer is only accessed by the background goroutine of the recorder, until
+// the recorder is closed.
+func NewRecorder(logger zerolog.Logger, w io.Writer, queueSize uint) *Recorder {
+	r := &Recorder{
+		logger:  logger.With().Str("component", "network_message_recorder").Logger(),
+		w:       w,
+		queue:   make(chan []byte, queueSize),
+		done:    make(chan struct{}),
+		dropped: atomic.NewUint64(0),
+	}
+	go r.writeLoop()
+	return r
+}
+
+// NewFileRecorder creates a recorder that appends the recorded messages to the capture file at the given path, and buffers
+// up to queueSize records that are not written yet. The file is created if it does not exist. The file is closed when the
+// recorder is closed.
+// No errors are expected during normal operation.
+func NewFileRecorder(logger zerolog.Logger, path string, queueSize uint) (*Recorder, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("could not open capture file %s: %w", path, err)
+	}
+
+	r := NewRecorder(logger.With().Str("capture_file", path).Logger(), f, queueSize)
+	r.closer = f
+	return r, nil
+}
+
+// RecordInbound records a message received by the node.
+func (r *Recorder) RecordInbound(channel channels.Channel, originID flow.Identifier, payload []byte) {
+	r.record(&Record{
+		Timestamp: time.Now().UTC(),
+		Direction: Inbound,
+		Channel:   channel,
+		OriginID:  originID,
+		Payload:   payload,
+	})
+}
+
+// RecordOutbound records a message sent by the node.
+func (r *Recorder) RecordOutbound(channel channels.Channel, originID flow.Identifier, targetIDs flow.IdentifierList, payload []byte) {
+	r.record(&Record{
+		Timestamp: time.Now().UTC(),
+		Direction: Outbound,
+		Channel:   channel,
+		OriginID:  originID,
+		TargetIDs: targetIDs,
+		Payload:   payload,
+	})
+}
+
+// Dropped returns the number of records dropped so far, because the queue of the recorder was full or the record
+// could not be encoded or written.
+func (r *Recorder) Dropped() uint64 {
+	return r.dropped.Load()
+}
+
+// record encodes the record and queues it for writing without blocking. The record is encoded by the caller, so that the
+// queued frame does not share memory with the message of the caller. The record is dropped if the queue is full.
+func (r *Recorder) record(record *Record) {
+	frame, err := record.encode()
+	if err != nil {
+		r.dropped.Inc()
+		r.logger.Error().
+			Err(err).
+			Str("channel", record.Channel.String()).
+			Str("direction", record.Direction.String()).
+			Msg("could not encode network message record, dropping record")
+		return
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	if r.closed {
+		return
+	}
+
+	select {
+	case r.queue <- frame:
+	default:
+		// logging every dropped record would only add to the load that fills up the queue, hence the dropped records
+		// are only counted, and the count is logged when the recorder is closed.
+		r.dropped.Inc()
+	}
+}
+
+// writeLoop writes the queued records to the underlying writer until the queue is closed and drained. Each record is
+// written with a single write call, so that a capture only contains whole records as long as the writes succeed.
+func (r *Recorder) writeLoop() {
+	defer close(r.done)
+
+	for frame := range r.queue {
+		if _, err := r.w.Write(frame); err != nil {
+			r.dropped.Inc()
+			r.logger.Error().
+				Err(err).
+				Msg("could not write network message record, dropping record")
+		}
+	}
+}
+
+// Close stops the recorder, waits for the queued records to be written, and closes the underlying capture file if the
+// recorder is created by NewFileRecorder. Any message recorded after closing the recorder is dropped.
+// No errors are expected during normal operation.
+func (r *Recorder) Close() error {
+	r.mu.Lock()
+	if r.closed {
+		r.mu.Unlock()
+		return nil
+	}
+	r.closed = true
+	close(r.queue)
+	r.mu.Unlock()
+
+	<-r.done
+
+	if dropped := r.dropped.Load(); dropped > 0 {
+		r.logger.Warn().
+			Uint64("dropped_records", dropped).
+			Msg("network message records were dropped, the capture is incomplete")
+	}
+
+	if r.closer != nil {
+		return r.closer.Close()
+	}
+	return nil
+}
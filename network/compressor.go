@@ -15,3 +15,13 @@ type WriteCloseFlusher interface {
 	io.WriteCloser
 	Flush() error
 }
+
+// CompressedStreamStats is implemented by the unicast streams that compress the data written on them, and
+// allows the networking layer to track the compression ratio of the outbound messages.
+type CompressedStreamStats interface {
+	// Compressor returns the name of the compressor of the stream, e.g., "gzip-compression".
+	Compressor() string
+	// CompressionStats returns the total number of bytes written on the stream, before and after compression.
+	// Note that the compressed size only accounts for the data flushed to the underlying stream so far.
+	CompressionStats() (uncompressedBytes uint64, compressedBytes uint64)
+}
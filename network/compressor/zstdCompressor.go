@@ -0,0 +1,75 @@
+package compressor
+
+import (
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+
+	"github.com/onflow/flow-go/network"
+)
+
+var _ network.Compressor = (*ZstdStreamCompressor)(nil)
+
+// ZstdStreamCompressor is a zstd stream compressor. Its decoders reject frames with a window larger than
+// maxWindowSize, and never allocate more than maxWindowSize for the window, so that a remote peer cannot
+// make a decoder allocate more memory than the messages it is allowed to send.
+type ZstdStreamCompressor struct {
+	maxWindowSize uint64
+}
+
+// NewZstdStreamCompressor returns a zstd stream compressor whose decoders accept frames with a window of
+// at most maxWindowSize bytes, which must be at least zstd.MinWindowSize.
+func NewZstdStreamCompressor(maxWindowSize uint64) ZstdStreamCompressor {
+	return ZstdStreamCompressor{maxWindowSize: maxWindowSize}
+}
+
+func (zstdStreamComp ZstdStreamCompressor) NewReader(r io.Reader) (io.ReadCloser, error) {
+	// the decoder is used for a single stream, hence a single goroutine suffices and avoids the
+	// overhead of spinning up concurrent decoders for each stream.
+	// when streaming, the maximum memory of the decoder is the maximum window size, hence both are capped.
+	d, err := zstd.NewReader(r,
+		zstd.WithDecoderConcurrency(1),
+		zstd.WithDecoderMaxWindow(zstdStreamComp.maxWindowSize),
+		zstd.WithDecoderMaxMemory(zstdStreamComp.maxWindowSize))
+	if err != nil {
+		return nil, err
+	}
+	return &zstdReadCloser{d: d}, nil
+}
+
+func (zstdStreamComp ZstdStreamCompressor) NewWriter(w io.Writer) (network.WriteCloseFlusher, error) {
+	e, err := zstd.NewWriter(w, zstd.WithEncoderConcurrency(1))
+	if err != nil {
+		return nil, err
+	}
+	return &zstdWriteCloseFlusher{w: e}, nil
+}
+
+type zstdReadCloser struct {
+	d *zstd.Decoder
+}
+
+func (zstdR *zstdReadCloser) Read(p []byte) (int, error) {
+	return zstdR.d.Read(p)
+}
+
+func (zstdR *zstdReadCloser) Close() error {
+	zstdR.d.Close()
+	return nil
+}
+
+type zstdWriteCloseFlusher struct {
+	w *zstd.Encoder
+}
+
+func (zstdW *zstdWriteCloseFlusher) Write(p []byte) (int, error) {
+	return zstdW.w.Write(p)
+}
+
+func (zstdW *zstdWriteCloseFlusher) Close() error {
+	return zstdW.w.Close()
+}
+
+func (zstdW *zstdWriteCloseFlusher) Flush() error {
+	return zstdW.w.Flush()
+}
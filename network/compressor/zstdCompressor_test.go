@@ -0,0 +1,92 @@
+package compressor_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/network/compressor"
+)
+
+// TestZstdRoundTrip evaluates that (1) reading what has been written by the zstd compressor yields in same result,
+// and (2) data is compressed when written.
+func TestZstdRoundTrip(t *testing.T) {
+	textBytes := bytes.Repeat([]byte("hello world, hello world!"), 100)
+	textBytesLen := len(textBytes)
+	buf := new(bytes.Buffer)
+
+	zstdComp := compressor.NewZstdStreamCompressor(10 << 20)
+
+	w, err := zstdComp.NewWriter(buf)
+	require.NoError(t, err)
+
+	// testing write
+	//
+	n, err := w.Write(textBytes)
+	require.NoError(t, err)
+	// written bytes should match original data
+	require.Equal(t, n, textBytesLen)
+	require.NoError(t, w.Flush())
+	// written data on buffer should be compressed in size.
+	require.Less(t, buf.Len(), textBytesLen)
+	require.NoError(t, w.Close())
+
+	// testing read
+	//
+	r, err := zstdComp.NewReader(buf)
+	require.NoError(t, err)
+
+	b, err := io.ReadAll(r)
+	require.NoError(t, err)
+	// we should read what we have written
+	require.Equal(t, textBytes, b)
+	require.NoError(t, r.Close())
+}
+
+// TestZstdOversizedWindow evaluates that the zstd compressor rejects frames whose window is larger than its
+// maximum window size, while it still reads data larger than its maximum window size written with a window
+// within the limit.
+func TestZstdOversizedWindow(t *testing.T) {
+	maxWindowSize := uint64(1 << 20)
+	zstdComp := compressor.NewZstdStreamCompressor(maxWindowSize)
+
+	// random data larger than a block, so that the frame header carries the window size rather than the content size.
+	data := make([]byte, 4*maxWindowSize)
+	_, err := rand.Read(data)
+	require.NoError(t, err)
+
+	t.Run("oversized window is rejected", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		w, err := zstd.NewWriter(buf, zstd.WithWindowSize(4*int(maxWindowSize)))
+		require.NoError(t, err)
+		_, err = w.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		r, err := zstdComp.NewReader(buf)
+		require.NoError(t, err)
+		_, err = io.ReadAll(r)
+		require.Error(t, err)
+		require.NoError(t, r.Close())
+	})
+
+	t.Run("data larger than the window is read", func(t *testing.T) {
+		buf := new(bytes.Buffer)
+		w, err := zstd.NewWriter(buf, zstd.WithWindowSize(int(maxWindowSize)))
+		require.NoError(t, err)
+		_, err = w.Write(data)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		r, err := zstdComp.NewReader(buf)
+		require.NoError(t, err)
+		b, err := io.ReadAll(r)
+		require.NoError(t, err)
+		require.Equal(t, data, b)
+		require.NoError(t, r.Close())
+	})
+}
@@ -223,7 +223,8 @@ func SubsMustNeverReceiveAnyMessage(t *testing.T, ctx context.Context, subs []p2
 	}
 }
 
-// AddNodesToEachOthersPeerStore adds the dialing address of all nodes to the peer store of all other nodes.
+// AddNodesToEachOthersPeerStore adds the dialing address of all nodes to the peer store of all other nodes, including the
+// QUIC multi-addresses derived from their identities on the nodes with the QUIC transport enabled.
 // However, it does not connect them to each other.
 func AddNodesToEachOthersPeerStore(t *testing.T, nodes []p2p.LibP2PNode, ids flow.IdentityList) {
 	for _, node := range nodes {
@@ -232,6 +233,10 @@ func AddNodesToEachOthersPeerStore(t *testing.T, nodes []p2p.LibP2PNode, ids flo
 				continue
 			}
 			otherPInfo, err := utils.PeerAddressInfo(ids[i].IdentitySkeleton)
+			if utils.ListensOnQUIC(node.Host()) {
+				// as the networking layer does, nodes with quic enabled derive the quic address of the other nodes from their identities.
+				otherPInfo, err = utils.PeerAddressInfoWithQUIC(ids[i].IdentitySkeleton)
+			}
 			require.NoError(t, err)
 			node.Host().Peerstore().AddAddrs(otherPInfo.ID, otherPInfo.Addrs, peerstore.AddressTTL)
 		}
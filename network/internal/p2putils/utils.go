@@ -184,7 +184,7 @@ func NetworkingInfo(identity flow.IdentitySkeleton) (string, string, crypto.PubK
 }
 
 // IPPortFromMultiAddress returns the IP/hostname and the port for the given multi-addresses
-// associated with a libp2p host
+// associated with a libp2p host. The first IPv4 or DNS4 based multi-address is used, with either a TCP or a QUIC (i.e., UDP) port.
 func IPPortFromMultiAddress(addrs ...multiaddr.Multiaddr) (string, string, error) {
 
 	var ipOrHostname, port string
@@ -204,8 +204,12 @@ func IPPortFromMultiAddress(addrs ...multiaddr.Multiaddr) (string, string, error
 		// if either IP address or hostname is found, look for the port number
 		port, err = a.ValueForProtocol(multiaddr.P_TCP)
 		if err != nil {
-			// an IPv4 or DNS4 based multiaddress should have a port number
-			return "", "", err
+			// a QUIC multiaddress carries its port number on udp
+			port, err = a.ValueForProtocol(multiaddr.P_UDP)
+			if err != nil {
+				// an IPv4 or DNS4 based multiaddress should have a port number
+				return "", "", err
+			}
 		}
 
 		// there should only be one valid IPv4 address
@@ -8,6 +8,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	model "github.com/onflow/flow-go/network/alsp/model"
+
 	network "github.com/onflow/flow-go/network"
 )
 
@@ -37,6 +39,16 @@ func (_m *MisbehaviorReportManager) HandleMisbehaviorReport(_a0 channels.Channel
 	_m.Called(_a0, _a1)
 }
 
+// OnAllowListNotification provides a mock function with given fields: _a0
+func (_m *MisbehaviorReportManager) OnAllowListNotification(_a0 *network.AllowListingUpdate) {
+	_m.Called(_a0)
+}
+
+// OnDisallowListNotification provides a mock function with given fields: _a0
+func (_m *MisbehaviorReportManager) OnDisallowListNotification(_a0 *network.DisallowListingUpdate) {
+	_m.Called(_a0)
+}
+
 // Ready provides a mock function with given fields:
 func (_m *MisbehaviorReportManager) Ready() <-chan struct{} {
 	ret := _m.Called()
@@ -53,6 +65,22 @@ func (_m *MisbehaviorReportManager) Ready() <-chan struct{} {
 	return r0
 }
 
+// SpamRecords provides a mock function with given fields:
+func (_m *MisbehaviorReportManager) SpamRecords() []model.ProtocolSpamRecord {
+	ret := _m.Called()
+
+	var r0 []model.ProtocolSpamRecord
+	if rf, ok := ret.Get(0).(func() []model.ProtocolSpamRecord); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.ProtocolSpamRecord)
+		}
+	}
+
+	return r0
+}
+
 // Start provides a mock function with given fields: _a0
 func (_m *MisbehaviorReportManager) Start(_a0 irrecoverable.SignalerContext) {
 	_m.Called(_a0)
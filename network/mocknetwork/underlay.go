@@ -6,6 +6,8 @@ import (
 	channels "github.com/onflow/flow-go/network/channels"
 	mock "github.com/stretchr/testify/mock"
 
+	model "github.com/onflow/flow-go/network/alsp/model"
+
 	network "github.com/onflow/flow-go/network"
 )
 
@@ -56,6 +58,22 @@ func (_m *Underlay) Ready() <-chan struct{} {
 	return r0
 }
 
+// SpamRecords provides a mock function with given fields:
+func (_m *Underlay) SpamRecords() []model.ProtocolSpamRecord {
+	ret := _m.Called()
+
+	var r0 []model.ProtocolSpamRecord
+	if rf, ok := ret.Get(0).(func() []model.ProtocolSpamRecord); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.ProtocolSpamRecord)
+		}
+	}
+
+	return r0
+}
+
 // Subscribe provides a mock function with given fields: channel
 func (_m *Underlay) Subscribe(channel channels.Channel) error {
 	ret := _m.Called(channel)
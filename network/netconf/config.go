@@ -33,6 +33,13 @@ type Config struct {
 	DNSCacheTTL time.Duration `validate:"gt=0s" mapstructure:"dns-cache-ttl"`
 	// DisallowListNotificationCacheSize size of the queue for notifications about new peers in the disallow list.
 	DisallowListNotificationCacheSize uint32 `validate:"gt=0" mapstructure:"disallow-list-notification-cache-size"`
+	// MessageCaptureFile is the file every inbound and outbound message of the node is recorded to, so that the traffic of the node
+	// can be replayed for debugging purposes. Recording is disabled if empty. The messages of all the networks of the node, e.g., the public
+	// network of an access node, are recorded to the same file.
+	MessageCaptureFile string `mapstructure:"message-capture-file"`
+	// QUICTransportEnabled determines whether the libp2p node of the node also listens on, and dials, the QUIC (v1) transport
+	// on the UDP port with the same number as its TCP bind port, in addition to the TCP transport.
+	QUICTransportEnabled bool `mapstructure:"quic-transport-enabled"`
 }
 
 // AlspConfig is the config for the Application Layer Spam Prevention (ALSP) protocol.
@@ -56,6 +63,13 @@ type AlspConfig struct {
 	// events that are used to perform critical ALSP tasks, such as updating the spam records cache.
 	HearBeatInterval time.Duration `mapstructure:"alsp-heart-beat-interval"`
 
+	// SpamRecordSnapshotDir is the directory the spam records are snapshotted to, so that the penalties and disallow-listing
+	// of nodes survive node restarts. Snapshots are disabled if empty.
+	SpamRecordSnapshotDir string `mapstructure:"alsp-spam-record-snapshot-dir"`
+
+	// SpamRecordSnapshotInterval is the interval between two snapshots of the spam records. A snapshot is also taken at shutdown.
+	SpamRecordSnapshotInterval time.Duration `validate:"gt=0s" mapstructure:"alsp-spam-record-snapshot-interval"`
+
 	SyncEngine SyncEngineAlspConfig `mapstructure:",squash"`
 }
 
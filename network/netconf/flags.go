@@ -19,6 +19,8 @@ const (
 	peerUpdateInterval                = "peerupdate-interval"
 	dnsCacheTTL                       = "dns-cache-ttl"
 	disallowListNotificationCacheSize = "disallow-list-notification-cache-size"
+	messageCaptureFile                = "message-capture-file"
+	quicTransportEnabled              = "quic-transport-enabled"
 	// resource manager config
 	rootResourceManagerPrefix  = "libp2p-resource-manager"
 	memoryLimitRatioPrefix     = "memory-limit-ratio"
@@ -40,6 +42,8 @@ const (
 	alspSpamRecordCacheSize            = "alsp-spam-record-cache-size"
 	alspSpamRecordQueueSize            = "alsp-spam-report-queue-size"
 	alspHearBeatInterval               = "alsp-heart-beat-interval"
+	alspSpamRecordSnapshotDir          = "alsp-spam-record-snapshot-dir"
+	alspSpamRecordSnapshotInterval     = "alsp-spam-record-snapshot-interval"
 	alspSyncEngineBatchRequestBaseProb = "alsp-sync-engine-batch-request-base-prob"
 	alspSyncEngineRangeRequestBaseProb = "alsp-sync-engine-range-request-base-prob"
 	alspSyncEngineSyncRequestProb      = "alsp-sync-engine-sync-request-prob"
@@ -58,6 +62,8 @@ func AllFlagNames() []string {
 		BuildFlagName(unicastKey, unicastManagerKey, configCacheSizeKey),
 		dnsCacheTTL,
 		disallowListNotificationCacheSize,
+		messageCaptureFile,
+		quicTransportEnabled,
 		BuildFlagName(unicastKey, rateLimiterKey, messageRateLimitKey),
 		BuildFlagName(unicastKey, rateLimiterKey, BandwidthRateLimitKey),
 		BuildFlagName(unicastKey, rateLimiterKey, BandwidthBurstLimitKey),
@@ -74,6 +80,8 @@ func AllFlagNames() []string {
 		alspSpamRecordCacheSize,
 		alspSpamRecordQueueSize,
 		alspHearBeatInterval,
+		alspSpamRecordSnapshotDir,
+		alspSpamRecordSnapshotInterval,
 		alspSyncEngineBatchRequestBaseProb,
 		alspSyncEngineRangeRequestBaseProb,
 		alspSyncEngineSyncRequestProb,
@@ -193,6 +201,8 @@ func AllFlagNames() []string {
 		BuildFlagName(gossipsubKey, p2pconfig.ScoreParamsKey, p2pconfig.ScoringRegistryKey, p2pconfig.SpamRecordCacheKey, p2pconfig.DecayKey, p2pconfig.MinimumSpamPenaltyDecayFactorKey),
 		BuildFlagName(gossipsubKey, p2pconfig.ScoreParamsKey, p2pconfig.ScoringRegistryKey, p2pconfig.SpamRecordCacheKey, p2pconfig.DecayKey, p2pconfig.MaximumSpamPenaltyDecayFactorKey),
 		BuildFlagName(gossipsubKey, p2pconfig.ScoreParamsKey, p2pconfig.ScoringRegistryKey, p2pconfig.SpamRecordCacheKey, p2pconfig.DecayKey, p2pconfig.SkipDecayThresholdKey),
+		BuildFlagName(gossipsubKey, p2pconfig.ScoreParamsKey, p2pconfig.ScoringRegistryKey, p2pconfig.SpamRecordCacheKey, p2pconfig.SnapshotKey, p2pconfig.SnapshotDirKey),
+		BuildFlagName(gossipsubKey, p2pconfig.ScoreParamsKey, p2pconfig.ScoringRegistryKey, p2pconfig.SpamRecordCacheKey, p2pconfig.SnapshotKey, p2pconfig.SnapshotIntervalKey),
 
 		BuildFlagName(gossipsubKey, p2pconfig.ScoreParamsKey, p2pconfig.ScoringRegistryKey, p2pconfig.MisbehaviourPenaltiesKey, p2pconfig.GraftKey),
 		BuildFlagName(gossipsubKey, p2pconfig.ScoreParamsKey, p2pconfig.ScoringRegistryKey, p2pconfig.MisbehaviourPenaltiesKey, p2pconfig.PruneKey),
@@ -225,8 +235,14 @@ func InitializeNetworkFlags(flags *pflag.FlagSet, config *Config) {
 	flags.Bool(networkingConnectionPruning, config.NetworkConnectionPruning, "enabling connection trimming")
 	flags.Duration(dnsCacheTTL, config.DNSCacheTTL, "time-to-live for dns cache")
 	flags.StringSlice(
-		preferredUnicastsProtocols, config.PreferredUnicastProtocols, "preferred unicast protocols in ascending order of preference")
+		preferredUnicastsProtocols, config.PreferredUnicastProtocols, "preferred unicast protocols in ascending order of preference, e.g., gzip-compression,zstd-compression")
 	flags.Uint32(receivedMessageCacheSize, config.NetworkReceivedMessageCacheSize, "incoming message cache size at networking layer")
+	flags.String(messageCaptureFile,
+		config.MessageCaptureFile,
+		"file every inbound and outbound message of the node is recorded to for debugging purposes, recording is disabled if empty")
+	flags.Bool(quicTransportEnabled,
+		config.QUICTransportEnabled,
+		"enabling the QUIC transport in addition to TCP, the node listens for QUIC connections on the UDP port with the same number as its bind port")
 	flags.Uint32(
 		disallowListNotificationCacheSize,
 		config.DisallowListNotificationCacheSize,
@@ -311,6 +327,12 @@ func InitializeNetworkFlags(flags *pflag.FlagSet, config *Config) {
 	flags.Duration(alspHearBeatInterval,
 		config.AlspConfig.HearBeatInterval,
 		"interval between two consecutive heartbeat events at alsp, recommended to leave it as default unless you know what you are doing.")
+	flags.String(alspSpamRecordSnapshotDir,
+		config.AlspConfig.SpamRecordSnapshotDir,
+		"directory the alsp spam records are snapshotted to, so that penalties survive node restarts. snapshots are disabled if empty")
+	flags.Duration(alspSpamRecordSnapshotInterval,
+		config.AlspConfig.SpamRecordSnapshotInterval,
+		"interval between two snapshots of the alsp spam records, a snapshot is also taken at shutdown")
 	flags.Float32(alspSyncEngineBatchRequestBaseProb,
 		config.AlspConfig.SyncEngine.BatchRequestBaseProb,
 		"base probability of creating a misbehavior report for a batch request message")
@@ -577,6 +599,12 @@ func InitializeNetworkFlags(flags *pflag.FlagSet, config *Config) {
 	flags.Float64(BuildFlagName(gossipsubKey, p2pconfig.ScoreParamsKey, p2pconfig.ScoringRegistryKey, p2pconfig.SpamRecordCacheKey, p2pconfig.DecayKey, p2pconfig.SkipDecayThresholdKey),
 		config.GossipSub.ScoringParameters.ScoringRegistryParameters.SpamRecordCache.Decay.SkipDecayThreshold,
 		"the threshold for which when the negative penalty is above this value, the decay function will not be called")
+	flags.String(BuildFlagName(gossipsubKey, p2pconfig.ScoreParamsKey, p2pconfig.ScoringRegistryKey, p2pconfig.SpamRecordCacheKey, p2pconfig.SnapshotKey, p2pconfig.SnapshotDirKey),
+		config.GossipSub.ScoringParameters.ScoringRegistryParameters.SpamRecordCache.Snapshot.Dir,
+		"directory the gossipsub spam records are snapshotted to, so that penalties survive node restarts. snapshots are disabled if empty")
+	flags.Duration(BuildFlagName(gossipsubKey, p2pconfig.ScoreParamsKey, p2pconfig.ScoringRegistryKey, p2pconfig.SpamRecordCacheKey, p2pconfig.SnapshotKey, p2pconfig.SnapshotIntervalKey),
+		config.GossipSub.ScoringParameters.ScoringRegistryParameters.SpamRecordCache.Snapshot.Interval,
+		"interval between two snapshots of the gossipsub spam records, a snapshot is also taken at shutdown")
 
 	flags.Float64(BuildFlagName(gossipsubKey, p2pconfig.ScoreParamsKey, p2pconfig.ScoringRegistryKey, p2pconfig.MisbehaviourPenaltiesKey, p2pconfig.GraftKey),
 		config.GossipSub.ScoringParameters.ScoringRegistryParameters.MisbehaviourPenalties.GraftMisbehaviour,
@@ -82,6 +82,8 @@ type ConduitAdapter interface {
 type Underlay interface {
 	module.ReadyDoneAware
 	DisallowListNotificationConsumer
+	// SpamRecordsExposer exposes the spam records of the application layer spam prevention (ALSP) module of the network.
+	SpamRecordsExposer
 
 	// Subscribe subscribes the network Underlay to a channel.
 	// No errors are expected during normal operation.
@@ -160,6 +160,18 @@ type NodeBuilder interface {
 	// - NodeBuilder: the node builder
 	OverrideDefaultRpcInspectorFactory(GossipSubRpcInspectorFactoryFunc) NodeBuilder
 
+	// EnableQUICTransport enables the QUIC (v1) transport on the libp2p node in addition to the TCP transport.
+	// The node listens for QUIC connections on the UDP port with the same number as its TCP port, and is able to dial
+	// QUIC multi-addresses. The addresses of the nodes stay TCP, the QUIC multi-addresses of the peers are derived from them
+	// (see utils.PeerAddressInfoWithQUIC). QUIC connections and streams are subject to the same resource manager
+	// limits and connection gating as the TCP ones. Building the node fails if the Go toolchain is not supported by the
+	// QUIC implementation of libp2p.
+	// Args:
+	// none
+	// Returns:
+	// - NodeBuilder: the node builder
+	EnableQUICTransport() NodeBuilder
+
 	// Build creates a new libp2p node. It returns the newly created libp2p node and any errors encountered during its creation.
 	// Args:
 	// none
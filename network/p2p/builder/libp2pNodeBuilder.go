@@ -5,6 +5,8 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"runtime"
+	"strings"
 
 	none "github.com/ipfs/boxo/routing/none"
 	"github.com/libp2p/go-libp2p"
@@ -16,6 +18,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/routing"
 	"github.com/libp2p/go-libp2p/core/transport"
 	rcmgr "github.com/libp2p/go-libp2p/p2p/host/resource-manager"
+	quic "github.com/libp2p/go-libp2p/p2p/transport/quic"
 	"github.com/libp2p/go-libp2p/p2p/transport/tcp"
 	"github.com/multiformats/go-multiaddr"
 	madns "github.com/multiformats/go-multiaddr-dns"
@@ -72,6 +75,7 @@ type LibP2PNodeBuilder struct {
 	disallowListCacheCfg *p2p.DisallowListCacheConfig
 	unicastConfig        *p2pbuilderconfig.UnicastConfig
 	networkingType       flownet.NetworkingType // whether the node is running in private (staked) or public (unstaked) network
+	quicEnabled          bool                   // whether the node listens on and dials the QUIC transport in addition to TCP
 }
 
 func NewNodeBuilder(
@@ -200,6 +204,20 @@ func (builder *LibP2PNodeBuilder) OverrideDefaultRpcInspectorFactory(factory p2p
 	return builder
 }
 
+// EnableQUICTransport enables the QUIC (v1) transport on the libp2p node in addition to the TCP transport.
+// The node listens for QUIC connections on the UDP port with the same number as the TCP port of its address, and is able to
+// dial QUIC multi-addresses, e.g., the ones derived from the addresses of its peers (see utils.PeerAddressInfoWithQUIC).
+// QUIC connections and streams are subject to the same resource manager limits and connection gater as the TCP ones.
+// Build fails if the Go toolchain is not supported by the QUIC implementation of libp2p (see QUICSupported).
+// Args:
+// none
+// Returns:
+// - NodeBuilder: the node builder
+func (builder *LibP2PNodeBuilder) EnableQUICTransport() p2p.NodeBuilder {
+	builder.quicEnabled = true
+	return builder
+}
+
 // Build creates a new libp2p node using the configured options.
 func (builder *LibP2PNodeBuilder) Build() (p2p.LibP2PNode, error) {
 	var opts []libp2p.Option
@@ -241,6 +259,18 @@ func (builder *LibP2PNodeBuilder) Build() (p2p.LibP2PNode, error) {
 		opts = append(opts, libp2p.ConnectionGater(builder.connGater))
 	}
 
+	if builder.quicEnabled {
+		if !QUICSupported(runtime.Version()) {
+			return nil, fmt.Errorf("quic transport is not supported with %s, it requires go1.20 or go1.21", runtime.Version())
+		}
+		quicOpts, err := quicLibP2POptions(builder.address)
+		if err != nil {
+			return nil, fmt.Errorf("could not create quic transport options: %w", err)
+		}
+		opts = append(opts, quicOpts...)
+		builder.logger.Info().Msg("quic transport is enabled")
+	}
+
 	h, err := DefaultLibP2PHost(builder.address, builder.networkKey, opts...)
 	if err != nil {
 		return nil, err
@@ -406,6 +436,34 @@ func defaultLibP2POptions(address string, key fcrypto.PrivateKey) ([]config.Opti
 	return options, nil
 }
 
+// QUICSupported returns whether the QUIC implementation of libp2p supports the given Go toolchain version, as reported by
+// runtime.Version(). The quic-go version used by libp2p only supports Go 1.20 and 1.21; with newer toolchains crypto/tls no
+// longer reports the disabled session tickets of libp2p as an error, and the QUIC handshake panics.
+func QUICSupported(goVersion string) bool {
+	return strings.HasPrefix(goVersion, "go1.20") || strings.HasPrefix(goVersion, "go1.21")
+}
+
+// quicLibP2POptions creates and returns the LibP2P host options that enable the QUIC (v1) transport on the UDP port with the
+// same number as the TCP port of the given address, in addition to the standard TCP transport.
+// The QUIC transport is constructed by libp2p, hence it is provided with the resource manager and connection gater of the host,
+// i.e., the resource manager limits on connections and streams, and the connection gating, apply to QUIC as they do to TCP.
+func quicLibP2POptions(address string) ([]config.Option, error) {
+	ip, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, fmt.Errorf("could not split node address %s:%w", address, err)
+	}
+
+	quicMultiAddr, err := multiaddr.NewMultiaddr(utils.QUICMultiAddressStr(ip, port))
+	if err != nil {
+		return nil, fmt.Errorf("failed to translate Flow address to Libp2p quic multiaddress: %w", err)
+	}
+
+	return []config.Option{
+		libp2p.ListenAddrs(quicMultiAddr), // adds the quic listen address to the tcp one
+		libp2p.Transport(quic.NewTransport),
+	}, nil
+}
+
 // DefaultNodeBuilder returns a node builder.
 func DefaultNodeBuilder(
 	logger zerolog.Logger,
@@ -62,6 +62,22 @@ type GossipSubSpamRecordCache interface {
 	// Returns:
 	// - bool: true if the cache contains the GossipSubSpamRecord of the given peer, false otherwise.
 	Has(peerID peer.ID) bool
+
+	// Peers returns the peer IDs of all peers which have a GossipSubSpamRecord in the cache.
+	// Returns:
+	// - []peer.ID: the peer IDs of the peers in the cache.
+	Peers() []peer.ID
+
+	// Restore adds the given GossipSubSpamRecord of a peer to the cache, as it was last updated at the given time.
+	// It is used to restore the spam records persisted before a restart, so that the pre-processing functions
+	// (e.g., decay) are applied for the time elapsed since the record was last updated.
+	// Args:
+	// - peerID: the peer ID of the peer in the GossipSub protocol.
+	// - record: the GossipSubSpamRecord of the peer.
+	// - lastUpdated: the time the record was last updated.
+	// Returns:
+	// - bool: true if the record was added, false if the cache already contains a record for the peer.
+	Restore(peerID peer.ID, record GossipSubSpamRecord, lastUpdated time.Time) bool
 }
 
 // GossipSubApplicationSpecificScoreCache is a cache for storing the application specific score of peers.
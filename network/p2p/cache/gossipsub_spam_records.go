@@ -189,6 +189,36 @@ func (a *GossipSubSpamRecordCache) Get(peerID peer.ID) (*p2p.GossipSubSpamRecord
 	return &r, nil, true
 }
 
+// Peers returns the peer IDs of all peers which have a spam record in the cache.
+// Returns:
+// - []peer.ID: the peer IDs of the peers in the cache.
+func (a *GossipSubSpamRecordCache) Peers() []peer.ID {
+	entities := a.c.All()
+	peers := make([]peer.ID, 0, len(entities))
+	for _, entity := range entities {
+		peers = append(peers, mustBeGossipSubSpamRecordEntity(entity).peerID)
+	}
+	return peers
+}
+
+// Restore adds the given spam record of a peer to the cache, as it was last updated at the given time.
+// The pre-processing functions are applied to the record for the time elapsed since lastUpdated when the record is
+// next read or updated.
+// Args:
+// - peerID: the peer ID of the peer in the GossipSub protocol.
+// - record: the spam record of the peer.
+// - lastUpdated: the time the record was last updated.
+// Returns:
+// - true if the record was added, false if the cache already contains a record for the peer.
+func (a *GossipSubSpamRecordCache) Restore(peerID peer.ID, record p2p.GossipSubSpamRecord, lastUpdated time.Time) bool {
+	return a.c.Add(gossipsubSpamRecordEntity{
+		entityId:            entityIdOf(peerID),
+		peerID:              peerID,
+		lastUpdated:         lastUpdated,
+		GossipSubSpamRecord: record,
+	})
+}
+
 // GossipSubSpamRecord represents an Entity implementation GossipSubSpamRecord.
 // It is internally used by the HeroCache to store the GossipSubSpamRecord.
 type gossipsubSpamRecordEntity struct {
@@ -541,3 +541,41 @@ func TestGossipSubSpamRecordCache_Duplicate_Adjust_Concurrent(t *testing.T) {
 	require.True(t, ok)
 	require.True(t, cachedRecord.Penalty == 1 && cachedRecord.Decay == 1 || cachedRecord.Penalty == 2 && cachedRecord.Decay == 1)
 }
+
+// TestGossipSubSpamRecordCache_Restore tests that a restored record is added to the cache with its last updated time,
+// so that the preprocessors are applied relative to that time, and that restoring does not overwrite an existing record.
+func TestGossipSubSpamRecordCache_Restore(t *testing.T) {
+	var lastUpdatedSeen time.Time
+	cache := netcache.NewGossipSubSpamRecordCache(10, unittest.Logger(), metrics.NewNoopCollector(),
+		func() p2p.GossipSubSpamRecord {
+			return p2p.GossipSubSpamRecord{}
+		},
+		// records the last updated time the preprocessor is called with.
+		func(record p2p.GossipSubSpamRecord, lastUpdated time.Time) (p2p.GossipSubSpamRecord, error) {
+			lastUpdatedSeen = lastUpdated
+			return record, nil
+		},
+	)
+
+	peerId := unittest.PeerIdFixture(t)
+	lastUpdated := time.Now().Add(-time.Hour)
+	restored := p2p.GossipSubSpamRecord{
+		Decay:   0.5,
+		Penalty: -10,
+	}
+	require.True(t, cache.Restore(peerId, restored, lastUpdated))
+	require.ElementsMatch(t, []peer.ID{peerId}, cache.Peers())
+
+	cachedRecord, err, ok := cache.Get(peerId)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, restored, *cachedRecord)
+	require.True(t, lastUpdated.Equal(lastUpdatedSeen))
+
+	// restoring an existing record is a no-op.
+	require.False(t, cache.Restore(peerId, p2p.GossipSubSpamRecord{Decay: 0.1, Penalty: -1}, time.Now()))
+	cachedRecord, err, ok = cache.Get(peerId)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, restored, *cachedRecord)
+}
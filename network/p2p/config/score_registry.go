@@ -51,14 +51,30 @@ type AppSpecificScoreParameters struct {
 }
 
 const (
-	DecayKey = "decay"
+	DecayKey    = "decay"
+	SnapshotKey = "snapshot"
 )
 
 type SpamRecordCacheParameters struct {
 	// CacheSize is size of the cache used to store the spam records of peers.
 	// The spam records are used to penalize peers that send invalid messages.
-	CacheSize uint32               `validate:"gt=0" mapstructure:"cache-size"`
-	Decay     SpamRecordCacheDecay `validate:"required" mapstructure:"decay"`
+	CacheSize uint32                       `validate:"gt=0" mapstructure:"cache-size"`
+	Decay     SpamRecordCacheDecay         `validate:"required" mapstructure:"decay"`
+	Snapshot  SpamRecordSnapshotParameters `validate:"required" mapstructure:"snapshot"`
+}
+
+const (
+	SnapshotDirKey      = "dir"
+	SnapshotIntervalKey = "interval"
+)
+
+// SpamRecordSnapshotParameters are the parameters for persisting the spam records of peers, so that the penalties
+// survive node restarts.
+type SpamRecordSnapshotParameters struct {
+	// Dir is the directory the spam records are snapshotted to. Snapshots are disabled if empty.
+	Dir string `mapstructure:"dir"`
+	// Interval is the interval between two snapshots of the spam records. A snapshot is also taken at shutdown.
+	Interval time.Duration `validate:"gt=0" mapstructure:"interval"`
 }
 
 const (
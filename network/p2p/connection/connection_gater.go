@@ -13,6 +13,7 @@ import (
 	"github.com/onflow/flow-go/module"
 	"github.com/onflow/flow-go/network/p2p"
 	p2plogging "github.com/onflow/flow-go/network/p2p/logging"
+	"github.com/onflow/flow-go/network/p2p/utils"
 	"github.com/onflow/flow-go/utils/logging"
 )
 
@@ -113,8 +114,18 @@ func (c *ConnGater) InterceptPeerDial(p peer.ID) bool {
 	return true
 }
 
-// InterceptAddrDial is not used. Currently, allowlisting is only implemented by Peer IDs and not multi-addresses
-func (c *ConnGater) InterceptAddrDial(_ peer.ID, ma multiaddr.Multiaddr) bool {
+// InterceptAddrDial a callback which allows or disallows outbound connection to a multi-address of a peer. Allowlisting is
+// implemented by Peer IDs (see InterceptPeerDial), hence this callback only rejects the multi-addresses of transports that
+// are not supported by Flow nodes, i.e., any transport other than TCP and QUIC (v1).
+func (c *ConnGater) InterceptAddrDial(p peer.ID, ma multiaddr.Multiaddr) bool {
+	if _, err := utils.TransportOf(ma); err != nil {
+		c.log.Debug().
+			Err(err).
+			Str("peer_id", p2plogging.PeerId(p)).
+			Str("remote_address", ma.String()).
+			Msg("outbound connection attempt to unsupported transport address is rejected")
+		return false
+	}
 	return true
 }
 
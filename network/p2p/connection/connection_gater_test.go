@@ -9,6 +9,7 @@ import (
 	"github.com/libp2p/go-libp2p/core/control"
 	"github.com/libp2p/go-libp2p/core/network"
 	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 
@@ -24,6 +25,7 @@ import (
 	mockp2p "github.com/onflow/flow-go/network/p2p/mock"
 	p2ptest "github.com/onflow/flow-go/network/p2p/test"
 	"github.com/onflow/flow-go/network/p2p/unicast/stream"
+	"github.com/onflow/flow-go/network/p2p/utils"
 	"github.com/onflow/flow-go/utils/unittest"
 )
 
@@ -107,6 +109,91 @@ func TestConnectionGating(t *testing.T) {
 	})
 }
 
+// TestConnectionGating_QUIC tests node allow listing by peer ID over the QUIC transport, i.e., the connection gater applies to
+// the connections of the QUIC transport as it does to the TCP ones.
+func TestConnectionGating_QUIC(t *testing.T) {
+	p2ptest.SkipIfQUICUnsupported(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	signalerCtx := irrecoverable.NewMockSignalerContext(t, ctx)
+
+	sporkID := unittest.IdentifierFixture()
+	idProvider := mockmodule.NewIdentityProvider(t)
+	node1Peers := unittest.NewProtectedMap[peer.ID, struct{}]()
+	node1, node1Id := p2ptest.NodeFixture(
+		t,
+		sporkID,
+		t.Name(),
+		idProvider,
+		p2ptest.WithQUICTransport(),
+		p2ptest.WithConnectionGater(p2ptest.NewConnectionGater(idProvider, func(p peer.ID) error {
+			if !node1Peers.Has(p) {
+				return fmt.Errorf("id not found: %s", p2plogging.PeerId(p))
+			}
+			return nil
+		})))
+	idProvider.On("ByPeerID", node1.ID()).Return(&node1Id, true).Maybe()
+
+	node2Peers := unittest.NewProtectedMap[peer.ID, struct{}]()
+	node2, node2Id := p2ptest.NodeFixture(
+		t,
+		sporkID,
+		t.Name(),
+		idProvider,
+		p2ptest.WithQUICTransport(),
+		p2ptest.WithConnectionGater(p2ptest.NewConnectionGater(idProvider, func(p peer.ID) error {
+			if !node2Peers.Has(p) {
+				return fmt.Errorf("id not found: %s", p2plogging.PeerId(p))
+			}
+			return nil
+		})))
+	idProvider.On("ByPeerID", node2.ID()).Return(&node2Id, true).Maybe()
+
+	nodes := []p2p.LibP2PNode{node1, node2}
+	ids := flow.IdentityList{&node1Id, &node2Id}
+	p2ptest.StartNodes(t, signalerCtx, nodes)
+	defer p2ptest.StopNodes(t, nodes, cancel)
+
+	p2pfixtures.AddNodesToEachOthersPeerStore(t, nodes, ids)
+
+	// the nodes are not in the allow-lists of each other, hence they should not be able to connect to each other.
+	p2pfixtures.EnsureNoStreamCreationBetweenGroups(t, ctx, []p2p.LibP2PNode{node1}, []p2p.LibP2PNode{node2}, func(t *testing.T, err error) {
+		require.Truef(t, stream.IsErrGaterDisallowedConnection(err), "expected ErrGaterDisallowedConnection, got: %v", err)
+	})
+
+	// adding both nodes to each other's allow lists, now both nodes should be able to connect to each other over QUIC.
+	node1Peers.Add(node2.ID(), struct{}{})
+	node2Peers.Add(node1.ID(), struct{}{})
+	p2ptest.EnsureStreamCreationInBothDirections(t, ctx, nodes)
+	for _, conn := range node1.Host().Network().ConnsToPeer(node2.ID()) {
+		transport, err := utils.TransportOf(conn.RemoteMultiaddr())
+		require.NoError(t, err)
+		require.Equal(t, utils.TransportQUIC, transport)
+	}
+}
+
+// TestConnectionGater_InterceptAddrDial tests the connection gater only allows dialing the multi-addresses of the transports
+// supported by Flow nodes, i.e., TCP and QUIC (v1).
+func TestConnectionGater_InterceptAddrDial(t *testing.T) {
+	connGater := connection.NewConnGater(unittest.Logger(), mockmodule.NewIdentityProvider(t))
+	pid := unittest.PeerIdFixture(t)
+
+	for addr, allowed := range map[string]bool{
+		"/ip4/192.168.1.1/tcp/3569":                      true,
+		"/dns4/flow.com/tcp/3569":                        true,
+		"/ip6/::1/tcp/3569":                              true,
+		"/ip4/192.168.1.1/udp/3569/quic-v1":              true,
+		"/dns4/flow.com/udp/3569/quic-v1":                true,
+		"/ip4/192.168.1.1/tcp/3569/ws":                   false,
+		"/ip4/192.168.1.1/udp/3569/quic":                 false,
+		"/ip4/192.168.1.1/udp/3569/quic-v1/webtransport": false,
+		"/ip4/192.168.1.1/udp/3569":                      false,
+	} {
+		maddr, err := multiaddr.NewMultiaddr(addr)
+		require.NoError(t, err)
+		require.Equal(t, allowed, connGater.InterceptAddrDial(pid, maddr), addr)
+	}
+}
+
 // TestConnectionGating_ResourceAllocation_AllowListing tests resource allocation when a connection from an allow-listed node is established.
 // The test directly mocks the underlying resource manager metrics of the libp2p native resource manager to ensure that the
 // expected set of resources are allocated for the connection upon establishment.
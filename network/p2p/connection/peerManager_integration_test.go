@@ -43,7 +43,7 @@ func TestPeerManager_Integration(t *testing.T) {
 	topologyPeers := identities[1:]
 
 	// adds address of all other nodes into the peer store of this node, so that it can dial them.
-	info, invalid := utils.PeerInfosFromIDs(topologyPeers)
+	info, invalid := utils.PeerInfosFromIDs(topologyPeers, false)
 	require.Empty(t, invalid)
 	for _, i := range info {
 		thisNode.Host().Peerstore().SetAddrs(i.ID, i.Addrs, peerstore.PermanentAddrTTL)
@@ -172,6 +172,8 @@ type LibP2PNode interface {
 type Subscriptions interface {
 	// HasSubscription returns true if the node currently has an active subscription to the topic.
 	HasSubscription(topic channels.Topic) bool
+	// SubscribedTopics returns the topics the node currently has an active subscription to.
+	SubscribedTopics() []channels.Topic
 	// SetUnicastManager sets the unicast manager for the node.
 	SetUnicastManager(uniMgr UnicastManager)
 }
@@ -7,6 +7,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	peer "github.com/libp2p/go-libp2p/core/peer"
+
+	time "time"
 )
 
 // GossipSubSpamRecordCache is an autogenerated mock type for the GossipSubSpamRecordCache type
@@ -87,6 +89,36 @@ func (_m *GossipSubSpamRecordCache) Has(peerID peer.ID) bool {
 	return r0
 }
 
+// Peers provides a mock function with given fields:
+func (_m *GossipSubSpamRecordCache) Peers() []peer.ID {
+	ret := _m.Called()
+
+	var r0 []peer.ID
+	if rf, ok := ret.Get(0).(func() []peer.ID); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]peer.ID)
+		}
+	}
+
+	return r0
+}
+
+// Restore provides a mock function with given fields: peerID, record, lastUpdated
+func (_m *GossipSubSpamRecordCache) Restore(peerID peer.ID, record p2p.GossipSubSpamRecord, lastUpdated time.Time) bool {
+	ret := _m.Called(peerID, record, lastUpdated)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(peer.ID, p2p.GossipSubSpamRecord, time.Time) bool); ok {
+		r0 = rf(peerID, record, lastUpdated)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewGossipSubSpamRecordCache interface {
 	mock.TestingT
 	Cleanup(func())
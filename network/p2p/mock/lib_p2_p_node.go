@@ -457,6 +457,22 @@ func (_m *LibP2PNode) Subscribe(topic channels.Topic, topicValidator p2p.TopicVa
 	return r0, r1
 }
 
+// SubscribedTopics provides a mock function with given fields:
+func (_m *LibP2PNode) SubscribedTopics() []channels.Topic {
+	ret := _m.Called()
+
+	var r0 []channels.Topic
+	if rf, ok := ret.Get(0).(func() []channels.Topic); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]channels.Topic)
+		}
+	}
+
+	return r0
+}
+
 // Unsubscribe provides a mock function with given fields: topic
 func (_m *LibP2PNode) Unsubscribe(topic channels.Topic) error {
 	ret := _m.Called(topic)
@@ -33,6 +33,22 @@ func (_m *Subscriptions) SetUnicastManager(uniMgr p2p.UnicastManager) {
 	_m.Called(uniMgr)
 }
 
+// SubscribedTopics provides a mock function with given fields:
+func (_m *Subscriptions) SubscribedTopics() []channels.Topic {
+	ret := _m.Called()
+
+	var r0 []channels.Topic
+	if rf, ok := ret.Get(0).(func() []channels.Topic); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]channels.Topic)
+		}
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewSubscriptions interface {
 	mock.TestingT
 	Cleanup(func())
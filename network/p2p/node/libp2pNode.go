@@ -470,6 +470,17 @@ func (n *Node) HasSubscription(topic channels.Topic) bool {
 	return ok
 }
 
+// SubscribedTopics returns the topics the node currently has an active subscription to.
+func (n *Node) SubscribedTopics() []channels.Topic {
+	n.RLock()
+	defer n.RUnlock()
+	topics := make([]channels.Topic, 0, len(n.subs))
+	for topic := range n.subs {
+		topics = append(topics, topic)
+	}
+	return topics
+}
+
 // Host returns pointer to host object of node.
 func (n *Node) Host() host.Host {
 	return n.host
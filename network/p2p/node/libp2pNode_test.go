@@ -130,7 +130,7 @@ func TestRemovePeers(t *testing.T) {
 	idProvider := unittest.NewUpdatableIDProvider(flow.IdentityList{})
 	// create nodes
 	nodes, identities := p2ptest.NodesFixture(t, unittest.IdentifierFixture(), "test_remove_peers", count, idProvider)
-	peerInfos, errs := utils.PeerInfosFromIDs(identities)
+	peerInfos, errs := utils.PeerInfosFromIDs(identities, false)
 	assert.Len(t, errs, 0)
 
 	p2ptest.StartNodes(t, signalerCtx, nodes)
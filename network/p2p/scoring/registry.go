@@ -78,6 +78,11 @@ type GossipSubAppSpecificScoreRegistry struct {
 	silencePeriodStartTime time.Time
 	// silencePeriodElapsed atomic bool that stores a bool flag which indicates if the silence period is over or not.
 	silencePeriodElapsed *atomic.Bool
+
+	// spamRecordSnapshotFile is the file the spam records are snapshotted to, empty if snapshots are disabled.
+	spamRecordSnapshotFile string
+	// spamRecordSnapshotInterval is the interval between two snapshots of the spam records.
+	spamRecordSnapshotInterval time.Duration
 }
 
 // GossipSubAppSpecificScoreRegistryConfig is the configuration for the GossipSubAppSpecificScoreRegistry.
@@ -122,6 +127,10 @@ type GossipSubAppSpecificScoreRegistryConfig struct {
 	DuplicateMessageThreshold float64 `validate:"gt=0"`
 
 	Collector module.GossipSubScoringRegistryMetrics `validate:"required"`
+
+	// SpamRecordSnapshot configures persisting the spam records of peers, so that the penalties survive node restarts.
+	// Snapshots are disabled if the directory is empty, in which case the interval is not validated.
+	SpamRecordSnapshot p2pconfig.SpamRecordSnapshotParameters `validate:"-"`
 }
 
 // NewGossipSubAppSpecificScoreRegistry returns a new GossipSubAppSpecificScoreRegistry.
@@ -157,6 +166,14 @@ func NewGossipSubAppSpecificScoreRegistry(config *GossipSubAppSpecificScoreRegis
 		collector:                 config.Collector,
 	}
 
+	if config.SpamRecordSnapshot.Dir != "" {
+		if config.SpamRecordSnapshot.Interval <= 0 {
+			return nil, fmt.Errorf("invalid config: spam record snapshot interval must be positive, got %v", config.SpamRecordSnapshot.Interval)
+		}
+		reg.spamRecordSnapshotFile = spamRecordSnapshotFilePath(config.SpamRecordSnapshot.Dir, config.NetworkingType)
+		reg.spamRecordSnapshotInterval = config.SpamRecordSnapshot.Interval
+	}
+
 	appSpecificScore := queue.NewHeroStore(config.Parameters.ScoreUpdateRequestQueueSize,
 		lg.With().Str("component", "app_specific_score_update").Logger(),
 		metrics.GossipSubAppSpecificScoreUpdateQueueMetricFactory(config.HeroCacheMetricsFactory, config.NetworkingType))
@@ -190,9 +207,23 @@ func NewGossipSubAppSpecificScoreRegistry(config *GossipSubAppSpecificScoreRegis
 			parent.Throw(fmt.Errorf("gossipsub scoring registry started more than once"))
 		}
 		reg.silencePeriodStartTime = time.Now()
+		if reg.spamRecordSnapshotFile != "" {
+			// restores the spam records before the registry is ready, so that the restored penalties are in place
+			// once the startup silence period is over.
+			if err := reg.restoreSpamRecordSnapshot(); err != nil {
+				parent.Throw(fmt.Errorf("failed to restore spam record snapshot: %w", err))
+			}
+		}
 		ready()
 	}).AddWorker(reg.invCtrlMsgNotifWorkerPool.WorkerLogic()) // we must NOT have more than one worker for processing notifications; handling notifications are NOT idempotent.
 
+	if reg.spamRecordSnapshotFile != "" {
+		builder.AddWorker(func(ctx irrecoverable.SignalerContext, ready component.ReadyFunc) {
+			ready()
+			reg.spamRecordSnapshotLoop(ctx) // blocking call
+		})
+	}
+
 	for i := 0; i < config.Parameters.ScoreUpdateWorkerNum; i++ {
 		builder.AddWorker(reg.appScoreUpdateWorkerPool.WorkerLogic())
 	}
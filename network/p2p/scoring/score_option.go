@@ -129,6 +129,7 @@ func NewScoreOption(cfg *ScoreOptionConfig, provider p2p.SubscriptionProvider) (
 		AppSpecificScoreParams:    cfg.params.PeerScoring.Protocol.AppSpecificScore,
 		DuplicateMessageThreshold: cfg.params.PeerScoring.Protocol.AppSpecificScore.DuplicateMessageThreshold,
 		Collector:                 cfg.scoringRegistryMetricsCollector,
+		SpamRecordSnapshot:        cfg.params.ScoringRegistryParameters.SpamRecordCache.Snapshot,
 	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create gossipsub app specific score registry: %w", err)
@@ -0,0 +1,160 @@
+package scoring
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/onflow/flow-go/module/irrecoverable"
+	"github.com/onflow/flow-go/network"
+	"github.com/onflow/flow-go/network/p2p"
+	p2plogging "github.com/onflow/flow-go/network/p2p/logging"
+	"github.com/onflow/flow-go/utils/io"
+)
+
+// spamRecordSnapshot is the content of the spam record snapshot file.
+type spamRecordSnapshot struct {
+	// TakenAt is the time the snapshot was taken. The restored records are considered last updated at this time,
+	// so that they are decayed for the time the node was down.
+	TakenAt time.Time `json:"taken_at"`
+	// Records are the spam records at the time the snapshot was taken.
+	Records []spamRecordSnapshotEntry `json:"records"`
+}
+
+// spamRecordSnapshotEntry is the spam record of a single peer in the snapshot.
+type spamRecordSnapshotEntry struct {
+	PeerID peer.ID                 `json:"peer_id"`
+	Record p2p.GossipSubSpamRecord `json:"record"`
+}
+
+// spamRecordSnapshotFilePath returns the path of the spam record snapshot file in the given directory.
+// The file name includes the networking type, as nodes may run a private and a public network side by side.
+func spamRecordSnapshotFilePath(dir string, networkingType network.NetworkingType) string {
+	return filepath.Join(dir, fmt.Sprintf("gossipsub-spam-records-%s.json", networkingType))
+}
+
+// spamRecordSnapshotLoop takes a snapshot of the spam records at the snapshot interval, and a final snapshot when the
+// context is canceled. It is a blocking function, and should be called in a separate goroutine.
+// Failing to take a snapshot is logged but not considered irrecoverable, as the penalties are still enforced in memory.
+// Args:
+// - ctx: the context.
+func (r *GossipSubAppSpecificScoreRegistry) spamRecordSnapshotLoop(ctx irrecoverable.SignalerContext) {
+	ticker := time.NewTicker(r.spamRecordSnapshotInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			if err := r.snapshotSpamRecords(); err != nil {
+				r.logger.Error().Err(err).Str("file", r.spamRecordSnapshotFile).Msg("failed to snapshot spam records at shutdown")
+			}
+			return
+		case <-ticker.C:
+			if err := r.snapshotSpamRecords(); err != nil {
+				r.logger.Error().Err(err).Str("file", r.spamRecordSnapshotFile).Msg("failed to snapshot spam records")
+			}
+		}
+	}
+}
+
+// snapshotSpamRecords writes the spam records of all peers in the cache to the snapshot file.
+// The records are decayed up to the time of the snapshot before they are written.
+// Returns:
+// - error: if the snapshot cannot be taken or written. No error is irrecoverable.
+func (r *GossipSubAppSpecificScoreRegistry) snapshotSpamRecords() error {
+	peers := r.spamScoreCache.Peers()
+	snapshot := spamRecordSnapshot{
+		TakenAt: time.Now(),
+		Records: make([]spamRecordSnapshotEntry, 0, len(peers)),
+	}
+	for _, pid := range peers {
+		record, err, ok := r.spamScoreCache.Get(pid)
+		if err != nil {
+			return fmt.Errorf("could not get spam record of peer %s: %w", p2plogging.PeerId(pid), err)
+		}
+		if !ok {
+			// the record has been ejected since listing the peers.
+			continue
+		}
+		snapshot.Records = append(snapshot.Records, spamRecordSnapshotEntry{
+			PeerID: pid,
+			Record: *record,
+		})
+	}
+
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("could not encode spam records: %w", err)
+	}
+
+	err = io.WriteFileAtomically(r.spamRecordSnapshotFile, data)
+	if err != nil {
+		return fmt.Errorf("could not write spam records: %w", err)
+	}
+
+	r.logger.Debug().Int("records", len(snapshot.Records)).Msg("spam records snapshotted")
+	return nil
+}
+
+// restoreSpamRecordSnapshot restores the spam records from the snapshot file into the cache. Records of peers that
+// are no longer in the protocol state are dropped, and the remaining records are decayed for the time elapsed since
+// the snapshot was taken. A missing or corrupted snapshot is logged and the registry starts with empty spam records.
+// Returns:
+// - error: if a restored record cannot be read back from the cache; any returned error is irrecoverable.
+func (r *GossipSubAppSpecificScoreRegistry) restoreSpamRecordSnapshot() error {
+	lg := r.logger.With().Str("file", r.spamRecordSnapshotFile).Logger()
+
+	data, err := os.ReadFile(r.spamRecordSnapshotFile)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			lg.Info().Msg("no spam record snapshot found, starting with empty spam records")
+			return nil
+		}
+		lg.Error().Err(err).Msg("failed to read spam record snapshot, starting with empty spam records")
+		return nil
+	}
+
+	var snapshot spamRecordSnapshot
+	err = json.Unmarshal(data, &snapshot)
+	if err != nil {
+		lg.Error().Err(err).Msg("failed to decode spam record snapshot, starting with empty spam records")
+		return nil
+	}
+
+	// the decay function rejects records last updated in the future, e.g., due to a clock adjustment.
+	lastUpdated := snapshot.TakenAt
+	if now := time.Now(); lastUpdated.After(now) {
+		lastUpdated = now
+	}
+
+	restored, dropped := 0, 0
+	for _, entry := range snapshot.Records {
+		if _, ok := r.idProvider.ByPeerID(entry.PeerID); !ok {
+			dropped++
+			continue
+		}
+
+		if !r.spamScoreCache.Restore(entry.PeerID, entry.Record, lastUpdated) {
+			// a record has already been created for the peer since startup.
+			continue
+		}
+
+		// reading the record applies the decay for the time elapsed since the snapshot.
+		_, err, _ := r.spamScoreCache.Get(entry.PeerID)
+		if err != nil {
+			return fmt.Errorf("could not decay restored spam record of peer %s: %w", p2plogging.PeerId(entry.PeerID), err)
+		}
+		restored++
+	}
+
+	lg.Info().
+		Time("taken_at", snapshot.TakenAt).
+		Int("restored", restored).
+		Int("dropped", dropped).
+		Msg("spam records restored from snapshot")
+	return nil
+}
@@ -0,0 +1,69 @@
+package scoring_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/config"
+	"github.com/onflow/flow-go/module/irrecoverable"
+	"github.com/onflow/flow-go/network/p2p"
+	"github.com/onflow/flow-go/network/p2p/scoring"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestScoreRegistry_SpamRecordSnapshot tests that the spam records are snapshotted when the registry stops, and restored
+// by the next registry using the same snapshot directory. The restored records are decayed for the time elapsed since
+// the snapshot, and records of peers that are no longer in the protocol state are dropped.
+func TestScoreRegistry_SpamRecordSnapshot(t *testing.T) {
+	stakedPeer := unittest.PeerIdFixture(t)
+	unknownPeer := unittest.PeerIdFixture(t)
+
+	cfg, err := config.DefaultConfig()
+	require.NoError(t, err)
+	params := cfg.NetworkConfig.GossipSub.ScoringParameters
+	params.ScoringRegistryParameters.SpamRecordCache.Snapshot.Dir = t.TempDir()
+	params.ScoringRegistryParameters.SpamRecordCache.Snapshot.Interval = time.Hour
+	initFunc := scoring.InitAppScoreRecordStateFunc(params.ScoringRegistryParameters.SpamRecordCache.Decay.MaximumSpamPenaltyDecayFactor)
+
+	withSnapshot := func(cfg *scoring.GossipSubAppSpecificScoreRegistryConfig) {
+		cfg.SpamRecordSnapshot = params.ScoringRegistryParameters.SpamRecordCache.Snapshot
+	}
+
+	// the first registry accumulates the spam records, and snapshots them when it stops.
+	reg, spamRecords, _ := newGossipSubAppSpecificScoreRegistry(t, params, initFunc, withStakedIdentities(stakedPeer), withSnapshot)
+	ctx, cancel := context.WithCancel(context.Background())
+	reg.Start(irrecoverable.NewMockSignalerContext(t, ctx))
+	unittest.RequireCloseBefore(t, reg.Ready(), 1*time.Second, "registry did not start in time")
+
+	for _, pid := range []peer.ID{stakedPeer, unknownPeer} {
+		_, err := spamRecords.Adjust(pid, func(record p2p.GossipSubSpamRecord) p2p.GossipSubSpamRecord {
+			record.Penalty = -100
+			record.Decay = 0.9
+			return record
+		})
+		require.NoError(t, err)
+	}
+	stopRegistry(t, cancel, reg)
+
+	// the penalty decays by a factor of 0.9 per second while the node is down.
+	time.Sleep(1 * time.Second)
+
+	// the second registry restores the spam records on startup.
+	reg, spamRecords, _ = newGossipSubAppSpecificScoreRegistry(t, params, initFunc, withStakedIdentities(stakedPeer), withSnapshot)
+	ctx, cancel = context.WithCancel(context.Background())
+	reg.Start(irrecoverable.NewMockSignalerContext(t, ctx))
+	unittest.RequireCloseBefore(t, reg.Ready(), 1*time.Second, "registry did not start in time")
+	defer stopRegistry(t, cancel, reg)
+
+	record, err, ok := spamRecords.Get(stakedPeer)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Less(t, record.Penalty, -50.0)
+	require.Greater(t, record.Penalty, -100*0.9)
+
+	require.False(t, spamRecords.Has(unknownPeer))
+}
@@ -12,8 +12,9 @@ import (
 // it can create libp2p streams with finer granularity.
 type StreamFactory interface {
 	SetStreamHandler(protocol.ID, network.StreamHandler)
-	// NewStream creates a new stream on the libp2p host.
+	// NewStream creates a new stream on the libp2p host. When multiple protocol IDs are given, they are offered to the
+	// remote peer in descending order of preference, and the stream is created on the first one the remote peer supports.
 	// Expected errors during normal operations:
-	//   - ErrProtocolNotSupported this indicates remote node is running on a different spork.
-	NewStream(context.Context, peer.ID, protocol.ID) (network.Stream, error)
+	//   - ErrProtocolNotSupported this indicates remote node supports none of the protocols, e.g., it is running on a different spork.
+	NewStream(context.Context, peer.ID, ...protocol.ID) (network.Stream, error)
 }
@@ -4,6 +4,9 @@ import (
 	"bufio"
 	"context"
 	crand "math/rand"
+	"net"
+	"runtime"
+	"strconv"
 	"sync"
 	"testing"
 	"time"
@@ -110,6 +113,10 @@ func NodeFixture(t *testing.T,
 		opt(parameters)
 	}
 
+	if parameters.QUICTransportEnabled {
+		parameters.Address = quicAddressFixture(t, parameters.Address)
+	}
+
 	identity := unittest.IdentityFixture(unittest.WithNetworkingKey(parameters.Key.PublicKey()),
 		unittest.WithAddress(parameters.Address),
 		unittest.WithRole(parameters.Role))
@@ -183,6 +190,10 @@ func NodeFixture(t *testing.T,
 		builder.SetConnectionManager(parameters.ConnManager)
 	}
 
+	if parameters.QUICTransportEnabled {
+		builder.EnableQUICTransport()
+	}
+
 	n, err := builder.Build()
 	require.NoError(t, err)
 
@@ -248,6 +259,7 @@ type NodeFixtureParameters struct {
 	GossipSubRpcInspectorFactory  p2p.GossipSubRpcInspectorFactoryFunc
 	FlowConfig                    *config.FlowConfig
 	UnicastRateLimiterDistributor p2p.UnicastRateLimiterDistributor
+	QUICTransportEnabled          bool
 }
 
 func WithUnicastRateLimitDistributor(distributor p2p.UnicastRateLimiterDistributor) NodeFixtureParameterOption {
@@ -375,6 +387,49 @@ func WithResourceManager(resourceManager network.ResourceManager) NodeFixturePar
 	}
 }
 
+// WithQUICTransport enables the QUIC transport on the node in addition to TCP. The address of the node identity stays its TCP
+// address, and the node listens for QUIC connections on the UDP port with the same number as its TCP port, so that the nodes
+// with QUIC enabled derive its QUIC multi-address from its identity (see utils.PeerAddressInfoWithQUIC).
+func WithQUICTransport() NodeFixtureParameterOption {
+	return func(p *NodeFixtureParameters) {
+		p.QUICTransportEnabled = true
+	}
+}
+
+// quicAddressFixture returns the given address with its port set to a port that is free on both TCP and UDP, if the given address
+// has no port, i.e., port 0. Otherwise, the node would listen on different ephemeral TCP and UDP ports, and its QUIC multi-address
+// could not be derived from its identity.
+func quicAddressFixture(t *testing.T, address string) string {
+	host, port, err := net.SplitHostPort(address)
+	require.NoError(t, err)
+	if port != "0" {
+		return address
+	}
+
+	for attempt := 0; attempt < 10; attempt++ {
+		tcpListener, err := net.Listen("tcp", net.JoinHostPort(host, "0"))
+		require.NoError(t, err)
+		port = strconv.Itoa(tcpListener.Addr().(*net.TCPAddr).Port)
+		udpConn, err := net.ListenPacket("udp", net.JoinHostPort(host, port))
+		require.NoError(t, tcpListener.Close())
+		if err != nil {
+			continue // the udp port is taken, tries another port.
+		}
+		require.NoError(t, udpConn.Close())
+		return net.JoinHostPort(host, port)
+	}
+	require.Fail(t, "could not find a port that is free on both tcp and udp")
+	return ""
+}
+
+// SkipIfQUICUnsupported skips the test if the Go toolchain is not supported by the QUIC implementation of libp2p, in which
+// case nodes with the QUIC transport enabled fail to build (see p2pbuilder.QUICSupported).
+func SkipIfQUICUnsupported(t *testing.T) {
+	if !p2pbuilder.QUICSupported(runtime.Version()) {
+		t.Skipf("quic transport is not supported with %s", runtime.Version())
+	}
+}
+
 func WithUnicastHandlerFunc(handler network.StreamHandler) NodeFixtureParameterOption {
 	return func(p *NodeFixtureParameters) {
 		p.HandlerFunc = handler
@@ -503,6 +558,15 @@ func StreamHandlerFixture(t *testing.T) (func(s network.Stream), chan string) {
 	}, ch
 }
 
+// peerAddressInfoFixture returns the peer.AddrInfo the given node dials the given identity on, i.e., including the QUIC
+// multi-address derived from the identity if the node has the QUIC transport enabled, as the networking layer does.
+func peerAddressInfoFixture(node p2p.LibP2PNode, identity flow.IdentitySkeleton) (peer.AddrInfo, error) {
+	if utils.ListensOnQUIC(node.Host()) {
+		return utils.PeerAddressInfoWithQUIC(identity)
+	}
+	return utils.PeerAddressInfo(identity)
+}
+
 // LetNodesDiscoverEachOther connects all nodes to each other on the pubsub mesh.
 func LetNodesDiscoverEachOther(t *testing.T, ctx context.Context, nodes []p2p.LibP2PNode, ids flow.IdentityList) {
 	for _, node := range nodes {
@@ -510,7 +574,7 @@ func LetNodesDiscoverEachOther(t *testing.T, ctx context.Context, nodes []p2p.Li
 			if node == other {
 				continue
 			}
-			otherPInfo, err := utils.PeerAddressInfo(ids[i].IdentitySkeleton)
+			otherPInfo, err := peerAddressInfoFixture(node, ids[i].IdentitySkeleton)
 			require.NoError(t, err)
 			require.NoError(t, node.ConnectToPeer(ctx, otherPInfo))
 		}
@@ -0,0 +1,294 @@
+package p2ptest_test
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"os"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/network"
+	rcmgr "github.com/libp2p/go-libp2p/p2p/host/resource-manager"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/config"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/irrecoverable"
+	"github.com/onflow/flow-go/module/metrics"
+	mockmodule "github.com/onflow/flow-go/module/mock"
+	flownet "github.com/onflow/flow-go/network"
+	"github.com/onflow/flow-go/network/internal/p2pfixtures"
+	"github.com/onflow/flow-go/network/p2p"
+	p2pbuilder "github.com/onflow/flow-go/network/p2p/builder"
+	p2pbuilderconfig "github.com/onflow/flow-go/network/p2p/builder/config"
+	p2ptest "github.com/onflow/flow-go/network/p2p/test"
+	"github.com/onflow/flow-go/network/p2p/unicast/protocols"
+	"github.com/onflow/flow-go/network/p2p/utils"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestQUICTransport_ListenAddresses evaluates that a node with the QUIC transport enabled listens on both the TCP and the QUIC
+// transports, while a node without it only listens on TCP.
+func TestQUICTransport_ListenAddresses(t *testing.T) {
+	p2ptest.SkipIfQUICUnsupported(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	signalerCtx := irrecoverable.NewMockSignalerContext(t, ctx)
+
+	sporkID := unittest.IdentifierFixture()
+	idProvider := mockmodule.NewIdentityProvider(t)
+
+	quicNode, _ := p2ptest.NodeFixture(t, sporkID, t.Name(), idProvider, p2ptest.WithQUICTransport())
+	tcpNode, _ := p2ptest.NodeFixture(t, sporkID, t.Name(), idProvider)
+
+	nodes := []p2p.LibP2PNode{quicNode, tcpNode}
+	p2ptest.StartNodes(t, signalerCtx, nodes)
+	defer p2ptest.StopNodes(t, nodes, cancel)
+
+	for node, expected := range map[p2p.LibP2PNode][]utils.Transport{
+		quicNode: {utils.TransportTCP, utils.TransportQUIC},
+		tcpNode:  {utils.TransportTCP},
+	} {
+		var transports []utils.Transport
+		for _, addr := range node.Host().Network().ListenAddresses() {
+			transport, err := utils.TransportOf(addr)
+			if err != nil {
+				continue // e.g., the circuit relay address of libp2p.
+			}
+			transports = append(transports, transport)
+		}
+		require.ElementsMatch(t, expected, transports)
+	}
+}
+
+// TestQUICTransport_UnsupportedToolchain evaluates that a node with the QUIC transport enabled refuses to build when the Go
+// toolchain is not supported by the QUIC implementation of libp2p, rather than panicking on its first QUIC handshake.
+func TestQUICTransport_UnsupportedToolchain(t *testing.T) {
+	require.True(t, p2pbuilder.QUICSupported("go1.20.14"))
+	require.True(t, p2pbuilder.QUICSupported("go1.21.13"))
+	require.False(t, p2pbuilder.QUICSupported("go1.19.13"))
+	require.False(t, p2pbuilder.QUICSupported("go1.22.0"))
+
+	if p2pbuilder.QUICSupported(runtime.Version()) {
+		t.Skipf("quic transport is supported with %s", runtime.Version())
+	}
+
+	flowConfig, err := config.DefaultConfig()
+	require.NoError(t, err)
+
+	builder := p2pbuilder.NewNodeBuilder(
+		unittest.Logger(),
+		&flowConfig.NetworkConfig.GossipSub,
+		&p2pbuilderconfig.MetricsConfig{
+			HeroCacheFactory: metrics.NewNoopHeroCacheMetricsFactory(),
+			Metrics:          metrics.NewNoopCollector(),
+		},
+		flownet.PrivateNetwork,
+		unittest.DefaultAddress,
+		p2ptest.NetworkingKeyFixtures(t),
+		unittest.IdentifierFixture(),
+		mockmodule.NewIdentityProvider(t),
+		&flowConfig.NetworkConfig.ResourceManager,
+		p2ptest.PeerManagerConfigFixture(),
+		&p2p.DisallowListCacheConfig{
+			MaxSize: uint32(1000),
+			Metrics: metrics.NewNoopCollector(),
+		},
+		&p2pbuilderconfig.UnicastConfig{
+			Unicast: flowConfig.NetworkConfig.Unicast,
+		})
+
+	_, err = builder.EnableQUICTransport().Build()
+	require.ErrorContains(t, err, "quic transport is not supported")
+}
+
+// TestQUICTransport_Unicast evaluates that two nodes with the QUIC transport enabled, whose identities carry their TCP addresses,
+// derive the QUIC multi-addresses of each other, connect to each other over QUIC, and exchange unicast messages in both directions.
+func TestQUICTransport_Unicast(t *testing.T) {
+	p2ptest.SkipIfQUICUnsupported(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	signalerCtx := irrecoverable.NewMockSignalerContext(t, ctx)
+
+	sporkID := unittest.IdentifierFixture()
+	idProvider := mockmodule.NewIdentityProvider(t)
+
+	received := make(chan string, 2)
+	handler := func(s network.Stream) {
+		msg, err := bufio.NewReader(s).ReadString('\n')
+		require.NoError(t, err)
+		received <- msg
+		_ = s.Close()
+	}
+
+	node1, id1 := p2ptest.NodeFixture(t, sporkID, t.Name(), idProvider, p2ptest.WithQUICTransport(), p2ptest.WithDefaultStreamHandler(handler))
+	node2, id2 := p2ptest.NodeFixture(t, sporkID, t.Name(), idProvider, p2ptest.WithQUICTransport(), p2ptest.WithDefaultStreamHandler(handler))
+	idProvider.On("ByPeerID", node1.ID()).Return(&id1, true).Maybe()
+	idProvider.On("ByPeerID", node2.ID()).Return(&id2, true).Maybe()
+
+	nodes := []p2p.LibP2PNode{node1, node2}
+	p2ptest.StartNodes(t, signalerCtx, nodes)
+	defer p2ptest.StopNodes(t, nodes, cancel)
+
+	// the identities carry the tcp addresses of the nodes, and the quic multi-addresses are derived from them.
+	for _, id := range []flow.Identity{id1, id2} {
+		pInfo, err := utils.PeerAddressInfoWithQUIC(id.IdentitySkeleton)
+		require.NoError(t, err)
+		var transports []utils.Transport
+		for _, addr := range pInfo.Addrs {
+			transport, err := utils.TransportOf(addr)
+			require.NoError(t, err)
+			transports = append(transports, transport)
+		}
+		require.Equal(t, []utils.Transport{utils.TransportTCP, utils.TransportQUIC}, transports)
+	}
+	p2pfixtures.AddNodesToEachOthersPeerStore(t, nodes, flow.IdentityList{&id1, &id2})
+
+	for _, this := range nodes {
+		for _, other := range nodes {
+			if this == other {
+				continue
+			}
+			err := this.OpenAndWriteOnStream(ctx, other.ID(), t.Name(), func(s network.Stream) error {
+				_, err := s.Write([]byte(this.ID().String() + "\n"))
+				return err
+			})
+			require.NoError(t, err)
+
+			unittest.RequireReturnsBefore(t, func() {
+				require.Equal(t, this.ID().String()+"\n", <-received)
+			}, 5*time.Second, "could not receive unicast message over quic")
+
+			conns := this.Host().Network().ConnsToPeer(other.ID())
+			require.NotEmpty(t, conns)
+			for _, conn := range conns {
+				transport, err := utils.TransportOf(conn.RemoteMultiaddr())
+				require.NoError(t, err)
+				require.Equal(t, utils.TransportQUIC, transport)
+			}
+		}
+	}
+}
+
+// TestQUICTransport_TCPOnlyPeer evaluates that a node with the QUIC transport enabled and a node without it connect to each other
+// over TCP in both directions: the TCP-only node dials the TCP address of the identity of the other node, and the node with QUIC
+// enabled falls back to TCP when the QUIC multi-address it derives for the TCP-only node is not reachable.
+func TestQUICTransport_TCPOnlyPeer(t *testing.T) {
+	p2ptest.SkipIfQUICUnsupported(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	signalerCtx := irrecoverable.NewMockSignalerContext(t, ctx)
+
+	sporkID := unittest.IdentifierFixture()
+	idProvider := mockmodule.NewIdentityProvider(t)
+
+	quicNode, quicId := p2ptest.NodeFixture(t, sporkID, t.Name(), idProvider, p2ptest.WithQUICTransport())
+	tcpNode, tcpId := p2ptest.NodeFixture(t, sporkID, t.Name(), idProvider)
+	idProvider.On("ByPeerID", quicNode.ID()).Return(&quicId, true).Maybe()
+	idProvider.On("ByPeerID", tcpNode.ID()).Return(&tcpId, true).Maybe()
+
+	nodes := []p2p.LibP2PNode{quicNode, tcpNode}
+	p2ptest.StartNodes(t, signalerCtx, nodes)
+	defer p2ptest.StopNodes(t, nodes, cancel)
+
+	p2pfixtures.AddNodesToEachOthersPeerStore(t, nodes, flow.IdentityList{&quicId, &tcpId})
+
+	for _, dial := range []struct {
+		from p2p.LibP2PNode
+		to   p2p.LibP2PNode
+	}{
+		{from: tcpNode, to: quicNode},
+		{from: quicNode, to: tcpNode},
+	} {
+		err := dial.from.OpenAndWriteOnStream(ctx, dial.to.ID(), t.Name(), func(s network.Stream) error {
+			return nil
+		})
+		require.NoError(t, err)
+		conns := dial.from.Host().Network().ConnsToPeer(dial.to.ID())
+		require.NotEmpty(t, conns)
+		for _, conn := range conns {
+			transport, err := utils.TransportOf(conn.RemoteMultiaddr())
+			require.NoError(t, err)
+			require.Equal(t, utils.TransportTCP, transport)
+		}
+
+		// closes the connection, so that the next dial opens a new one.
+		require.NoError(t, dial.from.Host().Network().ClosePeer(dial.to.ID()))
+	}
+}
+
+// TestQUICTransport_ResourceManagerStreamLimit evaluates that the resource manager limits of a node apply to the streams it
+// receives over QUIC, i.e., once a remote peer reaches its inbound stream limit, the node rejects any further stream from it.
+func TestQUICTransport_ResourceManagerStreamLimit(t *testing.T) {
+	p2ptest.SkipIfQUICUnsupported(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	signalerCtx := irrecoverable.NewMockSignalerContext(t, ctx)
+
+	sporkID := unittest.IdentifierFixture()
+	idProvider := mockmodule.NewIdentityProvider(t)
+
+	// the receiver accepts at most streamLimit inbound streams from each peer, including the libp2p service streams, e.g., identify.
+	streamLimit := 5
+	limits := rcmgr.PartialLimitConfig{
+		PeerDefault: rcmgr.ResourceLimits{
+			StreamsInbound: rcmgr.LimitVal(streamLimit),
+		},
+	}.Build(rcmgr.InfiniteLimits)
+	resourceManager, err := rcmgr.NewResourceManager(rcmgr.NewFixedLimiter(limits))
+	require.NoError(t, err)
+
+	// the receiver holds the streams open till the end of the test, so that they keep counting towards the limit.
+	done := make(chan struct{})
+	defer close(done)
+	receiver, receiverId := p2ptest.NodeFixture(t,
+		sporkID,
+		t.Name(),
+		idProvider,
+		p2ptest.WithQUICTransport(),
+		p2ptest.WithResourceManager(resourceManager),
+		p2ptest.WithDefaultStreamHandler(func(s network.Stream) {
+			<-done
+			_ = s.Reset()
+		}))
+	sender, senderId := p2ptest.NodeFixture(t, sporkID, t.Name(), idProvider, p2ptest.WithQUICTransport())
+	idProvider.On("ByPeerID", receiver.ID()).Return(&receiverId, true).Maybe()
+	idProvider.On("ByPeerID", sender.ID()).Return(&senderId, true).Maybe()
+
+	nodes := []p2p.LibP2PNode{receiver, sender}
+	p2ptest.StartNodes(t, signalerCtx, nodes)
+	defer p2ptest.StopNodes(t, nodes, cancel)
+
+	p2pfixtures.AddNodesToEachOthersPeerStore(t, nodes, flow.IdentityList{&receiverId, &senderId})
+
+	// the protocol negotiation of the streams is lazy, hence a stream rejected by the receiver is only reset once it is used.
+	accepted, rejected := 0, 0
+	for i := 0; i < 2*streamLimit; i++ {
+		s, err := sender.Host().NewStream(ctx, receiver.ID(), protocols.FlowProtocolID(sporkID))
+		require.NoError(t, err)
+		defer func() { _ = s.Reset() }()
+
+		_, err = s.Write([]byte{0})
+		if err == nil {
+			// the receiver never writes on the accepted streams, hence reading from them times out.
+			require.NoError(t, s.SetReadDeadline(time.Now().Add(time.Second)))
+			_, err = s.Read(make([]byte, 1))
+		}
+		if errors.Is(err, network.ErrReset) {
+			rejected++
+			continue
+		}
+		require.True(t, os.IsTimeout(err), "unexpected stream error: %v", err)
+		accepted++
+	}
+
+	// the streams are opened over quic.
+	conns := sender.Host().Network().ConnsToPeer(receiver.ID())
+	require.NotEmpty(t, conns)
+	transport, err := utils.TransportOf(conns[0].RemoteMultiaddr())
+	require.NoError(t, err)
+	require.Equal(t, utils.TransportQUIC, transport)
+
+	// the receiver rejects the streams beyond its limit.
+	require.Greater(t, accepted, 0)
+	require.LessOrEqual(t, accepted, streamLimit)
+	require.GreaterOrEqual(t, rejected, streamLimit)
+}
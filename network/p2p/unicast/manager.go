@@ -140,8 +140,8 @@ func (m *Manager) Register(protocol protocols.ProtocolName) error {
 	return nil
 }
 
-// CreateStream tries establishing a libp2p stream to the remote peer id. It tries creating streams in the descending order of preference until
-// it either creates a successful stream or runs out of options.
+// CreateStream tries establishing a libp2p stream to the remote peer id. The registered unicast protocols are negotiated with the remote peer
+// in the descending order of preference, and the stream is created on the most preferred protocol that the remote peer supports.
 // Args:
 //   - ctx: context for the stream creation.
 //   - peerID: peer ID of the remote peer.
@@ -150,7 +150,6 @@ func (m *Manager) Register(protocol protocols.ProtocolName) error {
 //   - a new libp2p stream.
 //   - error if the stream creation fails; the error is benign and can be retried.
 func (m *Manager) CreateStream(ctx context.Context, peerID peer.ID) (libp2pnet.Stream, error) {
-	var errs error
 	dialCfg, err := m.getDialConfig(peerID)
 	if err != nil {
 		// TODO: technically, we better to return an error here, but the error must be irrecoverable, and we cannot
@@ -168,14 +167,10 @@ func (m *Manager) CreateStream(ctx context.Context, peerID peer.ID) (libp2pnet.S
 		Str("dial_config", fmt.Sprintf("%+v", dialCfg)).
 		Msg("dial config for the peer retrieved")
 
-	for i := len(m.protocols) - 1; i >= 0; i-- {
-		s, err := m.createStream(ctx, peerID, m.protocols[i], dialCfg)
-		if err != nil {
-			errs = multierror.Append(errs, err)
-			continue
-		}
-
-		// return first successful stream
+	// all registered protocols are offered to the remote peer in descending order of preference, so that the stream
+	// is negotiated on the most preferred protocol (e.g., the best compressor) that both peers support.
+	s, streamErr := m.createStream(ctx, peerID, m.preferredProtocols(), dialCfg)
+	if streamErr == nil {
 		return s, nil
 	}
 
@@ -192,20 +187,49 @@ func (m *Manager) CreateStream(ctx context.Context, peerID peer.ID) (libp2pnet.S
 	}
 
 	m.logger.Warn().
-		Err(errs).
+		Err(streamErr).
 		Bool(logging.KeySuspicious, true).
 		Str("peer_id", p2plogging.PeerId(peerID)).
 		Str("dial_config", fmt.Sprintf("%+v", updatedCfg)).
 		Msg("failed to create stream to peer id, dial config adjusted")
 
-	return nil, fmt.Errorf("could not create stream on any available unicast protocol: %w", errs)
+	return nil, fmt.Errorf("could not create stream on any available unicast protocol: %w", streamErr)
 }
 
-// createStream attempts to establish a new stream with a peer using the specified protocol. It employs
-// exponential backoff with a maximum number of attempts defined by dialCfg.StreamCreationRetryAttemptBudget.
+// preferredProtocols returns the registered unicast protocols in descending order of preference.
+func (m *Manager) preferredProtocols() []protocols.Protocol {
+	preferred := make([]protocols.Protocol, 0, len(m.protocols))
+	for i := len(m.protocols) - 1; i >= 0; i-- {
+		preferred = append(preferred, m.protocols[i])
+	}
+	return preferred
+}
+
+// negotiatedProtocol returns the protocol among the candidates that the stream has been negotiated on.
+// Args:
+// - s: the raw libp2p stream.
+// - candidates: the protocols offered to the remote peer when creating the stream.
+// Returns:
+// - the negotiated protocol.
+// - error if the stream is negotiated on a protocol that was not offered; the error is benign and the stream can be discarded.
+func negotiatedProtocol(s libp2pnet.Stream, candidates []protocols.Protocol) (protocols.Protocol, error) {
+	if len(candidates) == 1 {
+		// with a single candidate there is nothing to negotiate.
+		return candidates[0], nil
+	}
+	for _, candidate := range candidates {
+		if candidate.ProtocolId() == s.Protocol() {
+			return candidate, nil
+		}
+	}
+	return nil, fmt.Errorf("stream negotiated on unexpected protocol: %s", s.Protocol())
+}
+
+// createStream attempts to establish a new stream with a peer, negotiating the given protocols in descending order of
+// preference. It employs exponential backoff with a maximum number of attempts defined by dialCfg.StreamCreationRetryAttemptBudget.
 // If the stream cannot be established after the maximum attempts, it returns a compiled multierror of all
 // encountered errors. Errors related to in-progress dials trigger a retry until a connection is established
-// or the attempt budget is exhausted.
+// or the attempt budget is exhausted. Once established, the stream is upgraded by the negotiated protocol (e.g., compressed).
 //
 // The function increments the Config's ConsecutiveSuccessfulStream count upon success. In the case of
 // adjustment errors in Config, a fatal error is logged indicating an issue that requires attention.
@@ -214,22 +238,33 @@ func (m *Manager) CreateStream(ctx context.Context, peerID peer.ID) (libp2pnet.S
 // Arguments:
 // - ctx: Context to control the lifecycle of the stream creation.
 // - peerID: The ID of the peer with which the stream is to be established.
-// - protocol: The specific protocol used for the stream.
+// - candidates: The protocols to negotiate for the stream, in descending order of preference.
 // - dialCfg: Configuration parameters for dialing and stream creation, including retry logic.
 //
 // Returns:
 // - libp2pnet.Stream: The successfully created stream, or nil if the stream creation fails.
 // - error: An aggregated multierror of all encountered errors during stream creation, or nil if successful; any returned error is benign and can be retried.
-func (m *Manager) createStream(ctx context.Context, peerID peer.ID, protocol protocols.Protocol, dialCfg *Config) (libp2pnet.Stream, error) {
+func (m *Manager) createStream(ctx context.Context, peerID peer.ID, candidates []protocols.Protocol, dialCfg *Config) (libp2pnet.Stream, error) {
 	var err error
 	var s libp2pnet.Stream
 
-	s, err = m.createStreamWithRetry(ctx, peerID, protocol.ProtocolId(), dialCfg)
+	protocolIDs := make([]protocol.ID, 0, len(candidates))
+	for _, candidate := range candidates {
+		protocolIDs = append(protocolIDs, candidate.ProtocolId())
+	}
+
+	s, err = m.createStreamWithRetry(ctx, peerID, protocolIDs, dialCfg)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create a stream to peer: %w", err)
 	}
 
-	s, err = protocol.UpgradeRawStream(s)
+	negotiated, err := negotiatedProtocol(s, candidates)
+	if err != nil {
+		_ = s.Reset()
+		return nil, fmt.Errorf("failed to resolve negotiated protocol: %w", err)
+	}
+
+	s, err = negotiated.UpgradeRawStream(s)
 	if err != nil {
 		return nil, fmt.Errorf("failed to upgrade raw stream: %w", err)
 	}
@@ -256,26 +291,26 @@ func (m *Manager) createStream(ctx context.Context, peerID peer.ID, protocol pro
 	return s, nil
 }
 
-// createStreamWithRetry attempts to create a new stream to the specified peer using the given protocolID.
+// createStreamWithRetry attempts to create a new stream to the specified peer, negotiating the given protocolIDs in descending order of preference.
 // This function is streamlined for use-cases where retries are managed externally or
 // not required at all.
 //
 // Expected errors:
 //   - If the context expires before stream creation, it returns a context-related error with the number of attempts.
-//   - If the protocol ID is not supported, no retries are attempted and the error is returned immediately.
+//   - If none of the protocol IDs is supported, no retries are attempted and the error is returned immediately.
 //
 // Metrics are collected to monitor the duration and attempts of the stream creation process.
 //
 // Arguments:
 // - ctx: Context to control the lifecycle of the stream creation.
 // - peerID: The ID of the peer with which the stream is to be established.
-// - protocolID: The identifier for the protocol used for the stream.
+// - protocolIDs: The identifiers of the protocols to negotiate for the stream, in descending order of preference.
 // - dialCfg: Configuration parameters for dialing, including the retry attempt budget.
 //
 // Returns:
 // - libp2pnet.Stream: The successfully created stream, or nil if an error occurs.
 // - error: An error encountered during the stream creation, or nil if the stream is successfully established.
-func (m *Manager) createStreamWithRetry(ctx context.Context, peerID peer.ID, protocolID protocol.ID, dialCfg *Config) (libp2pnet.Stream, error) {
+func (m *Manager) createStreamWithRetry(ctx context.Context, peerID peer.ID, protocolIDs []protocol.ID, dialCfg *Config) (libp2pnet.Stream, error) {
 	// aggregated retryable errors that occur during retries, errs will be returned
 	// if retry context times out or maxAttempts have been made before a successful retry occurs
 	var errs error
@@ -291,7 +326,7 @@ func (m *Manager) createStreamWithRetry(ctx context.Context, peerID peer.ID, pro
 
 		var err error
 		// creates stream using stream factory
-		s, err = m.streamFactory.NewStream(ctx, peerID, protocolID)
+		s, err = m.streamFactory.NewStream(ctx, peerID, protocolIDs...)
 		if err != nil {
 			// if the stream creation failed due to invalid protocol id or no address, skip the re-attempt
 			if stream.IsErrProtocolNotSupported(err) ||
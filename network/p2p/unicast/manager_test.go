@@ -3,9 +3,11 @@ package unicast_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	libp2pnet "github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/libp2p/go-libp2p/core/protocol"
 	"github.com/libp2p/go-libp2p/p2p/net/swarm"
 	"github.com/stretchr/testify/mock"
@@ -13,10 +15,12 @@ import (
 
 	"github.com/onflow/flow-go/config"
 	"github.com/onflow/flow-go/module/metrics"
+	flownet "github.com/onflow/flow-go/network"
 	mockp2p "github.com/onflow/flow-go/network/p2p/mock"
 	p2ptest "github.com/onflow/flow-go/network/p2p/test"
 	"github.com/onflow/flow-go/network/p2p/unicast"
 	unicastcache "github.com/onflow/flow-go/network/p2p/unicast/cache"
+	"github.com/onflow/flow-go/network/p2p/unicast/protocols"
 	"github.com/onflow/flow-go/network/p2p/unicast/stream"
 	"github.com/onflow/flow-go/utils/unittest"
 )
@@ -148,6 +152,54 @@ func TestUnicastManager_SuccessfulStream(t *testing.T) {
 	require.Equal(t, uint64(1), unicastCfg.ConsecutiveSuccessfulStream)                                                                        // consecutive successful stream must incremented.
 }
 
+// TestUnicastManager_ProtocolNegotiation tests that when multiple unicast protocols are registered, CreateStream offers all of them
+// to the remote peer in a single stream creation in descending order of preference, and upgrades the stream with the protocol the
+// stream has been negotiated on.
+func TestUnicastManager_ProtocolNegotiation(t *testing.T) {
+	peerID := unittest.PeerIdFixture(t)
+	mgr, streamFactory, _ := unicastManagerFixture(t)
+
+	streamFactory.On("SetStreamHandler", mock.AnythingOfType("protocol.ID"), mock.AnythingOfType("network.StreamHandler")).Return().Twice()
+	require.NoError(t, mgr.Register(protocols.GzipCompressionUnicast))
+	require.NoError(t, mgr.Register(protocols.ZstdCompressionUnicast))
+
+	// the remote peer is mocked to support gzip but not zstd, hence the stream is negotiated on the gzip protocol.
+	var offered []protocol.ID
+	streamFactory.On("NewStream", mock.Anything, peerID, mock.Anything, mock.Anything, mock.Anything).
+		Return(func(_ context.Context, _ peer.ID, pids ...protocol.ID) (libp2pnet.Stream, error) {
+			offered = pids
+			return &negotiatedStream{MockStream: &p2ptest.MockStream{}, protocolID: pids[1]}, nil
+		}).
+		Once()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s, err := mgr.CreateStream(ctx, peerID)
+	require.NoError(t, err)
+
+	// protocols must be offered in descending order of preference, i.e., zstd, gzip, and then the default uncompressed protocol.
+	require.Len(t, offered, 3)
+	require.True(t, strings.HasPrefix(string(offered[0]), protocols.FlowLibP2PProtocolZstdCompressedOneToOne))
+	require.True(t, strings.HasPrefix(string(offered[1]), protocols.FlowLibP2PProtocolGzipCompressedOneToOne))
+	require.True(t, strings.HasPrefix(string(offered[2]), protocols.FlowLibP2POneToOneProtocolIDPrefix))
+
+	// the returned stream must be upgraded by the negotiated protocol.
+	compressed, ok := s.(flownet.CompressedStreamStats)
+	require.True(t, ok)
+	require.Equal(t, string(protocols.GzipCompressionUnicast), compressed.Compressor())
+}
+
+// negotiatedStream is a mock stream that reports the given protocol as the negotiated protocol of the stream.
+type negotiatedStream struct {
+	*p2ptest.MockStream
+	protocolID protocol.ID
+}
+
+func (n *negotiatedStream) Protocol() protocol.ID {
+	return n.protocolID
+}
+
 // TestUnicastManager_StreamBackoff tests the backoff mechanism of the unicast manager for stream creation.
 // It tests the situation that CreateStream is called but the stream creation fails.
 // It tests that it tries to create a stream some number of times (unicastmodel.MaxStreamCreationAttemptTimes), before giving up.
@@ -289,7 +341,7 @@ func TestUnicastManager_StreamFactory_ErrProtocolNotSupported(t *testing.T) {
 
 	// mocks that upon creating a stream, it returns a protocol not supported error, the mock is set to once, meaning that it won't retry stream creation again.
 	streamFactory.On("NewStream", mock.Anything, peerID, mock.Anything).
-		Return(nil, stream.NewProtocolNotSupportedErr(peerID, protocol.ID("protocol-1"), fmt.Errorf("some error"))).
+		Return(nil, stream.NewProtocolNotSupportedErr(peerID, []protocol.ID{"protocol-1"}, fmt.Errorf("some error"))).
 		Once()
 
 	ctx, cancel := context.WithCancel(context.Background())
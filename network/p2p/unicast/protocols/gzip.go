@@ -33,7 +33,7 @@ func NewGzipCompressedUnicast(logger zerolog.Logger, sporkId flow.Identifier, de
 
 // UpgradeRawStream wraps gzip compression and decompression around the plain libp2p stream.
 func (g GzipStream) UpgradeRawStream(s libp2pnet.Stream) (libp2pnet.Stream, error) {
-	return internal.NewCompressedStream(s, compressor.GzipStreamCompressor{})
+	return internal.NewCompressedStream(s, compressor.GzipStreamCompressor{}, string(GzipCompressionUnicast))
 }
 
 func (g GzipStream) Handler(s libp2pnet.Stream) {
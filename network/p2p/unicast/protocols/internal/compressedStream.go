@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"sync/atomic"
 
 	"github.com/libp2p/go-libp2p/core/network"
 	"go.uber.org/multierr"
@@ -17,22 +18,32 @@ import (
 type CompressedStream struct {
 	network.Stream
 
-	writeLock  sync.Mutex
-	readLock   sync.Mutex
-	compressor flownet.Compressor
+	writeLock      sync.Mutex
+	readLock       sync.Mutex
+	compressor     flownet.Compressor
+	compressorName string
 
 	r io.ReadCloser
 	w flownet.WriteCloseFlusher
+
+	// uncompressedBytes is the number of bytes written on the stream before compression.
+	uncompressedBytes atomic.Uint64
+	// compressedBytes is the number of bytes written on the underlying stream after compression.
+	compressedBytes atomic.Uint64
 }
 
-// NewCompressedStream creates a compressed stream with gzip as default compressor.
-func NewCompressedStream(s network.Stream, compressor flownet.Compressor) (*CompressedStream, error) {
+var _ flownet.CompressedStreamStats = (*CompressedStream)(nil)
+
+// NewCompressedStream creates a compressed stream with the given compressor, the name of the compressor is
+// reported through the stream's compression stats.
+func NewCompressedStream(s network.Stream, compressor flownet.Compressor, compressorName string) (*CompressedStream, error) {
 	c := &CompressedStream{
-		Stream:     s,
-		compressor: compressor,
+		Stream:         s,
+		compressor:     compressor,
+		compressorName: compressorName,
 	}
 
-	w, err := c.compressor.NewWriter(s)
+	w, err := c.compressor.NewWriter(&countingWriter{w: s, count: &c.compressedBytes})
 	if err != nil {
 		return nil, fmt.Errorf("could not create compressor writer: %w", err)
 	}
@@ -47,10 +58,21 @@ func (c *CompressedStream) Write(b []byte) (int, error) {
 	defer c.writeLock.Unlock()
 
 	n, err := c.w.Write(b)
+	c.uncompressedBytes.Add(uint64(n))
 
 	return n, multierr.Combine(err, c.w.Flush())
 }
 
+// Compressor returns the name of the compressor of the stream.
+func (c *CompressedStream) Compressor() string {
+	return c.compressorName
+}
+
+// CompressionStats returns the total number of bytes written on the stream, before and after compression.
+func (c *CompressedStream) CompressionStats() (uint64, uint64) {
+	return c.uncompressedBytes.Load(), c.compressedBytes.Load()
+}
+
 func (c *CompressedStream) Read(b []byte) (int, error) {
 	c.readLock.Lock()
 	defer c.readLock.Unlock()
@@ -77,3 +99,15 @@ func (c *CompressedStream) Close() error {
 
 	return multierr.Combine(c.w.Close(), c.Stream.Close())
 }
+
+// countingWriter counts the bytes written on the underlying writer.
+type countingWriter struct {
+	w     io.Writer
+	count *atomic.Uint64
+}
+
+func (cw *countingWriter) Write(b []byte) (int, error) {
+	n, err := cw.w.Write(b)
+	cw.count.Add(uint64(n))
+	return n, err
+}
@@ -2,6 +2,7 @@ package internal_test
 
 import (
 	"io"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -98,6 +99,36 @@ func TestUnhappyPath(t *testing.T) {
 	unittest.RequireReturnsBefore(t, readWG.Wait, 1*time.Second, "timeout for reading from stream")
 }
 
+// TestCompressionStats evaluates that a compressed stream reports the number of bytes written on it before and after compression.
+func TestCompressionStats(t *testing.T) {
+	// a highly repetitive text to guarantee a compression ratio above one.
+	textByte := []byte(strings.Repeat("hello world, ", 100))
+
+	mca, _, mcb, _ := newCompressedStreamPair(t)
+	require.Equal(t, "gzip-compression", mca.Compressor())
+
+	// drains the receiver side so that writes on the pipe do not block.
+	go func() {
+		_, _ = io.Copy(io.Discard, mcb)
+	}()
+
+	writeWG := sync.WaitGroup{}
+	writeWG.Add(1)
+	go func() {
+		defer writeWG.Done()
+
+		n, err := mca.Write(textByte)
+		require.NoError(t, err)
+		require.Equal(t, len(textByte), n)
+	}()
+	unittest.RequireReturnsBefore(t, writeWG.Wait, 1*time.Second, "timeout for writing on stream")
+
+	uncompressedBytes, compressedBytes := mca.CompressionStats()
+	require.Equal(t, uint64(len(textByte)), uncompressedBytes)
+	require.Greater(t, compressedBytes, uint64(0))
+	require.Less(t, compressedBytes, uncompressedBytes)
+}
+
 // newStreamPair is a test helper that creates a pair of compressed streams a and b such that
 // a reads what b writes and b reads what a writes.
 func newStreamPair() (*p2ptest.MockStream, *p2ptest.MockStream) {
@@ -115,10 +146,10 @@ func newStreamPair() (*p2ptest.MockStream, *p2ptest.MockStream) {
 func newCompressedStreamPair(t *testing.T) (*internal.CompressedStream, *p2ptest.MockStream, *internal.CompressedStream, *p2ptest.MockStream) {
 	sa, sb := newStreamPair()
 
-	mca, err := internal.NewCompressedStream(sa, compressor.GzipStreamCompressor{})
+	mca, err := internal.NewCompressedStream(sa, compressor.GzipStreamCompressor{}, "gzip-compression")
 	require.NoError(t, err)
 
-	mcb, err := internal.NewCompressedStream(sb, compressor.GzipStreamCompressor{})
+	mcb, err := internal.NewCompressedStream(sb, compressor.GzipStreamCompressor{}, "gzip-compression")
 	require.NoError(t, err)
 
 	return mca, sa, mcb, sb
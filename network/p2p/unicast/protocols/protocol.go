@@ -28,6 +28,9 @@ const (
 
 	// FlowLibP2PProtocolGzipCompressedOneToOne represents the protocol id for compressed streams under gzip compressor.
 	FlowLibP2PProtocolGzipCompressedOneToOne = FlowLibP2POneToOneProtocolIDPrefix + "/gzip/"
+
+	// FlowLibP2PProtocolZstdCompressedOneToOne represents the protocol id for compressed streams under zstd compressor.
+	FlowLibP2PProtocolZstdCompressedOneToOne = FlowLibP2POneToOneProtocolIDPrefix + "/zstd/"
 )
 
 // IsFlowProtocolStream returns true if the libp2p stream is for a Flow protocol
@@ -69,6 +72,10 @@ func ToProtocolFactory(name ProtocolName) (ProtocolFactory, error) {
 		return func(logger zerolog.Logger, sporkId flow.Identifier, handler libp2pnet.StreamHandler) Protocol {
 			return NewGzipCompressedUnicast(logger, sporkId, handler)
 		}, nil
+	case ZstdCompressionUnicast:
+		return func(logger zerolog.Logger, sporkId flow.Identifier, handler libp2pnet.StreamHandler) Protocol {
+			return NewZstdCompressedUnicast(logger, sporkId, handler)
+		}, nil
 	default:
 		return nil, fmt.Errorf("unknown unicast protocol name: %s", name)
 	}
@@ -33,21 +33,21 @@ func NewSecurityProtocolNegotiationErr(pid peer.ID, err error) ErrSecurityProtoc
 
 // ErrProtocolNotSupported indicates node is running on a different spork.
 type ErrProtocolNotSupported struct {
-	peerID     peer.ID
-	protocolID protocol.ID
-	err        error
+	peerID      peer.ID
+	protocolIDs []protocol.ID
+	err         error
 }
 
 func (e ErrProtocolNotSupported) Error() string {
-	return fmt.Errorf("failed to dial remote peer %s remote node is running on a different spork: %w, protocol attempted: %s",
+	return fmt.Errorf("failed to dial remote peer %s remote node is running on a different spork: %w, protocols attempted: %s",
 		p2plogging.PeerId(e.peerID),
 		e.err,
-		e.protocolID).Error()
+		e.protocolIDs).Error()
 }
 
-// NewProtocolNotSupportedErr returns a new ErrSecurityProtocolNegotiationFailed.
-func NewProtocolNotSupportedErr(peerID peer.ID, protocolID protocol.ID, err error) ErrProtocolNotSupported {
-	return ErrProtocolNotSupported{peerID: peerID, protocolID: protocolID, err: err}
+// NewProtocolNotSupportedErr returns a new ErrProtocolNotSupported.
+func NewProtocolNotSupportedErr(peerID peer.ID, protocolIDs []protocol.ID, err error) ErrProtocolNotSupported {
+	return ErrProtocolNotSupported{peerID: peerID, protocolIDs: protocolIDs, err: err}
 }
 
 // IsErrProtocolNotSupported returns whether an error is ErrProtocolNotSupported.
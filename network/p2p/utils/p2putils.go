@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net"
 
+	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/multiformats/go-multiaddr"
 	"github.com/onflow/crypto/hash"
@@ -19,6 +20,9 @@ import (
 //	flow.Identity        ---> peer.AddrInfo
 //	|-- Address          --->   |-- []multiaddr.Multiaddr
 //	|-- NetworkPublicKey --->   |-- ID
+//
+// The address of the identity is in the hostname:port or ip:port format, and is translated into its TCP multi-address,
+// i.e., TCP is the transport every node is dialed on. Nodes with the QUIC transport enabled use PeerAddressInfoWithQUIC instead.
 func PeerAddressInfo(identity flow.IdentitySkeleton) (peer.AddrInfo, error) {
 	ip, port, key, err := p2putils.NetworkingInfo(identity)
 	if err != nil {
@@ -39,14 +43,46 @@ func PeerAddressInfo(identity flow.IdentitySkeleton) (peer.AddrInfo, error) {
 	return pInfo, err
 }
 
+// PeerAddressInfoWithQUIC generates the libp2p peer.AddrInfo for the given Flow.Identity, as PeerAddressInfo does, and adds
+// the QUIC (v1) multi-address of the node to it. The QUIC multi-address is derived from the address of the identity, i.e.,
+// it is on the same host, and on the UDP port with the same number as the TCP port, which is the UDP port that nodes with the
+// QUIC transport enabled listen on.
+// The identity keeps a single (TCP) address, hence a node that dials it over QUIC falls back to TCP if the remote node does not
+// have the QUIC transport enabled. Only nodes with the QUIC transport enabled should use this function; libp2p ignores the QUIC
+// multi-address on nodes without a QUIC transport anyway.
+func PeerAddressInfoWithQUIC(identity flow.IdentitySkeleton) (peer.AddrInfo, error) {
+	pInfo, err := PeerAddressInfo(identity)
+	if err != nil {
+		return peer.AddrInfo{}, err
+	}
+
+	ip, port, err := net.SplitHostPort(identity.Address)
+	if err != nil {
+		return peer.AddrInfo{}, fmt.Errorf("could not parse address of identity %s: %w", identity.NodeID.String(), err)
+	}
+	quicAddr, err := multiaddr.NewMultiaddr(QUICMultiAddressStr(ip, port))
+	if err != nil {
+		return peer.AddrInfo{}, fmt.Errorf("could not derive quic multiaddress of identity %s: %w", identity.NodeID.String(), err)
+	}
+	pInfo.Addrs = append(pInfo.Addrs, quicAddr)
+	return pInfo, nil
+}
+
 // PeerInfosFromIDs converts the given flow.Identity to peer.AddrInfo.
 // For each identity, if the conversion succeeds, the peer.AddrInfo is included in the result else it is
-// included in the error map with the corresponding error
-func PeerInfosFromIDs(ids flow.IdentityList) ([]peer.AddrInfo, map[flow.Identifier]error) {
+// included in the error map with the corresponding error.
+// If quicEnabled is true, the peer.AddrInfo also includes the QUIC multi-address derived from the address of the identity
+// (see PeerAddressInfoWithQUIC).
+func PeerInfosFromIDs(ids flow.IdentityList, quicEnabled bool) ([]peer.AddrInfo, map[flow.Identifier]error) {
+	addressInfo := PeerAddressInfo
+	if quicEnabled {
+		addressInfo = PeerAddressInfoWithQUIC
+	}
+
 	validIDs := make([]peer.AddrInfo, 0, len(ids))
 	invalidIDs := make(map[flow.Identifier]error)
 	for _, id := range ids {
-		peerInfo, err := PeerAddressInfo(id.IdentitySkeleton)
+		peerInfo, err := addressInfo(id.IdentitySkeleton)
 		if err != nil {
 			invalidIDs[id.NodeID] = err
 			continue
@@ -59,19 +95,89 @@ func PeerInfosFromIDs(ids flow.IdentityList) ([]peer.AddrInfo, map[flow.Identifi
 // MultiAddressStr receives a node ip and port and returns
 // its corresponding Libp2p MultiAddressStr in string format
 // in current implementation IP part of the node address is
-// either an IP or a dns4.
+// either an IPv4, an IPv6 or a dns4.
 // https://docs.libp2p.io/concepts/addressing/
 func MultiAddressStr(ip, port string) string {
 	parsedIP := net.ParseIP(ip)
 	if parsedIP != nil {
 		// returns parsed ip version of the multi-address
-		return fmt.Sprintf("/ip4/%s/tcp/%s", ip, port)
+		return fmt.Sprintf("/%s/%s/tcp/%s", ipProtocol(parsedIP), ip, port)
 	}
 	// could not parse it as an IP address and returns the dns version of the
 	// multi-address
 	return fmt.Sprintf("/dns4/%s/tcp/%s", ip, port)
 }
 
+// QUICMultiAddressStr receives a node ip and port and returns its corresponding Libp2p QUIC (v1) multi-address
+// in string format, i.e., the QUIC counterpart of MultiAddressStr.
+func QUICMultiAddressStr(ip, port string) string {
+	parsedIP := net.ParseIP(ip)
+	if parsedIP != nil {
+		return fmt.Sprintf("/%s/%s/udp/%s/quic-v1", ipProtocol(parsedIP), ip, port)
+	}
+	return fmt.Sprintf("/dns4/%s/udp/%s/quic-v1", ip, port)
+}
+
+// ipProtocol returns the multi-address protocol of the given IP address, i.e., ip4 for IPv4 addresses
+// (including IPv4-mapped IPv6 addresses) and ip6 for IPv6 addresses.
+func ipProtocol(ip net.IP) string {
+	if ip.To4() != nil {
+		return "ip4"
+	}
+	return "ip6"
+}
+
+// Transport is a libp2p transport supported by Flow nodes.
+type Transport string
+
+const (
+	// TransportTCP is the TCP transport; it is the default transport of Flow nodes.
+	TransportTCP Transport = "tcp"
+	// TransportQUIC is the QUIC (v1) transport.
+	TransportQUIC Transport = "quic-v1"
+)
+
+// TransportOf returns the transport of the given multi-address. A supported multi-address is an ip4, ip6, dns, dns4 or dns6
+// address followed by either a tcp port, or a udp port and quic-v1, optionally followed by the peer id, e.g.,
+// "/ip4/1.2.3.4/tcp/3569" or "/dns4/flow.com/udp/3569/quic-v1".
+// Returns an error if the multi-address is not of a supported transport, e.g., a websocket or webtransport address.
+func TransportOf(maddr multiaddr.Multiaddr) (Transport, error) {
+	protocols := maddr.Protocols()
+	if len(protocols) > 0 && protocols[len(protocols)-1].Code == multiaddr.P_P2P {
+		protocols = protocols[:len(protocols)-1]
+	}
+	if len(protocols) < 2 {
+		return "", fmt.Errorf("unsupported multiaddress %s: missing network or transport", maddr)
+	}
+
+	switch protocols[0].Code {
+	case multiaddr.P_IP4, multiaddr.P_IP6, multiaddr.P_DNS, multiaddr.P_DNS4, multiaddr.P_DNS6:
+	default:
+		return "", fmt.Errorf("unsupported multiaddress %s: unsupported network protocol %s", maddr, protocols[0].Name)
+	}
+
+	transport := protocols[1:]
+	switch {
+	case len(transport) == 1 && transport[0].Code == multiaddr.P_TCP:
+		return TransportTCP, nil
+	case len(transport) == 2 && transport[0].Code == multiaddr.P_UDP && transport[1].Code == multiaddr.P_QUIC_V1:
+		return TransportQUIC, nil
+	default:
+		return "", fmt.Errorf("unsupported multiaddress %s: only tcp and quic-v1 transports are supported", maddr)
+	}
+}
+
+// ListensOnQUIC returns true if the given libp2p host listens on the QUIC (v1) transport, i.e., the QUIC transport is enabled on
+// the libp2p node of the host.
+func ListensOnQUIC(h host.Host) bool {
+	for _, addr := range h.Network().ListenAddresses() {
+		if transport, err := TransportOf(addr); err == nil && transport == TransportQUIC {
+			return true
+		}
+	}
+	return false
+}
+
 // AllowedSubscription returns true if the given role is allowed to subscribe to the topic.
 func AllowedSubscription(role flow.Role, topic string) bool {
 	channel, ok := channels.ChannelFromTopic(channels.Topic(topic))
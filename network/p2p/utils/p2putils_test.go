@@ -0,0 +1,76 @@
+package utils_test
+
+import (
+	"testing"
+
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/network/p2p/utils"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestPeerAddressInfo evaluates that the address of an identity, in the hostname:port or ip:port format, is translated into its
+// TCP multi-address, and that the QUIC multi-address derived from it is only included by PeerAddressInfoWithQUIC, i.e., on the
+// same host and the UDP port with the same number.
+func TestPeerAddressInfo(t *testing.T) {
+	key := unittest.NetworkingPrivKeyFixture().PublicKey()
+	for address, expected := range map[string][]string{
+		"192.168.1.1:3569":   {"/ip4/192.168.1.1/tcp/3569", "/ip4/192.168.1.1/udp/3569/quic-v1"},
+		"[2001:db8::1]:3569": {"/ip6/2001:db8::1/tcp/3569", "/ip6/2001:db8::1/udp/3569/quic-v1"},
+		"flow.com:3569":      {"/dns4/flow.com/tcp/3569", "/dns4/flow.com/udp/3569/quic-v1"},
+	} {
+		identity := unittest.IdentityFixture(unittest.WithAddress(address), unittest.WithNetworkingKey(key))
+
+		pInfo, err := utils.PeerAddressInfo(identity.IdentitySkeleton)
+		require.NoError(t, err, address)
+		require.Len(t, pInfo.Addrs, 1)
+		require.Equal(t, expected[0], pInfo.Addrs[0].String())
+
+		quicInfo, err := utils.PeerAddressInfoWithQUIC(identity.IdentitySkeleton)
+		require.NoError(t, err, address)
+		require.Equal(t, pInfo.ID, quicInfo.ID)
+		require.Len(t, quicInfo.Addrs, 2)
+		require.Equal(t, expected[0], quicInfo.Addrs[0].String())
+		require.Equal(t, expected[1], quicInfo.Addrs[1].String())
+	}
+
+	// malformed addresses, including multi-addresses, are rejected, as the address of an identity is a single hostname:port
+	// or ip:port address.
+	for _, address := range []string{
+		"192.168.1.1",
+		"/ip4/192.168.1.1/tcp/3569",
+		"/ip4/192.168.1.1/udp/3569/quic-v1",
+	} {
+		identity := unittest.IdentityFixture(unittest.WithAddress(address), unittest.WithNetworkingKey(key))
+		_, err := utils.PeerAddressInfo(identity.IdentitySkeleton)
+		require.Error(t, err, address)
+		_, err = utils.PeerAddressInfoWithQUIC(identity.IdentitySkeleton)
+		require.Error(t, err, address)
+	}
+}
+
+// TestMultiAddressStr evaluates that the TCP and QUIC multi-addresses of an ip and port use the ip4 protocol for IPv4 addresses,
+// the ip6 protocol for IPv6 addresses and the dns4 protocol for hostnames.
+func TestMultiAddressStr(t *testing.T) {
+	for _, tc := range []struct {
+		ip   string
+		tcp  string
+		quic string
+	}{
+		{"192.168.1.1", "/ip4/192.168.1.1/tcp/3569", "/ip4/192.168.1.1/udp/3569/quic-v1"},
+		{"2001:db8::1", "/ip6/2001:db8::1/tcp/3569", "/ip6/2001:db8::1/udp/3569/quic-v1"},
+		{"::1", "/ip6/::1/tcp/3569", "/ip6/::1/udp/3569/quic-v1"},
+		{"::ffff:192.168.1.1", "/ip4/::ffff:192.168.1.1/tcp/3569", "/ip4/::ffff:192.168.1.1/udp/3569/quic-v1"},
+		{"flow.com", "/dns4/flow.com/tcp/3569", "/dns4/flow.com/udp/3569/quic-v1"},
+	} {
+		require.Equal(t, tc.tcp, utils.MultiAddressStr(tc.ip, "3569"), tc.ip)
+		require.Equal(t, tc.quic, utils.QUICMultiAddressStr(tc.ip, "3569"), tc.ip)
+
+		// the multi-addresses are valid, i.e., the protocol matches the ip version.
+		_, err := multiaddr.NewMultiaddr(utils.MultiAddressStr(tc.ip, "3569"))
+		require.NoError(t, err, tc.ip)
+		_, err = multiaddr.NewMultiaddr(utils.QUICMultiAddressStr(tc.ip, "3569"))
+		require.NoError(t, err, tc.ip)
+	}
+}
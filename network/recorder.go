@@ -0,0 +1,28 @@
+package network
+
+import (
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/network/channels"
+)
+
+// MessageRecorder records the messages exchanged by the node at the boundary between the engines and the networking layer,
+// i.e., the inbound messages right before they are queued for delivery to the engines, and the outbound messages right after
+// they are sent by the engines through their conduits. The recorded messages can be replayed later on to reproduce the behavior
+// of an engine deterministically, e.g., for debugging production incidents.
+// Recording is best-effort and never blocks or fails the delivery of a message, hence the methods do not return errors.
+type MessageRecorder interface {
+	// RecordInbound records a message received by the node.
+	// Args:
+	// - channel: the channel the message is received on.
+	// - originID: the node ID of the sender of the message.
+	// - payload: the codec-encoded payload of the message, i.e., prefixed by its codec.MessageCode.
+	RecordInbound(channel channels.Channel, originID flow.Identifier, payload []byte)
+
+	// RecordOutbound records a message sent by the node.
+	// Args:
+	// - channel: the channel the message is sent on.
+	// - originID: the node ID of the node itself.
+	// - targetIDs: the node IDs of the intended recipients of the message.
+	// - payload: the codec-encoded payload of the message, i.e., prefixed by its codec.MessageCode.
+	RecordOutbound(channel channels.Channel, originID flow.Identifier, targetIDs flow.IdentifierList, payload []byte)
+}
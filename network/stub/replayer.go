@@ -0,0 +1,72 @@
+package stub
+
+import (
+	"fmt"
+
+	"github.com/onflow/flow-go/network"
+	"github.com/onflow/flow-go/network/capture"
+)
+
+// Replayer is a test helper that feeds the inbound messages of a network capture (see network/capture) back into the
+// engines attached to a stub Network, as if they were received from their original senders. It turns a capture of a
+// node's traffic, e.g., recorded during a production incident, into a reproducible unit test of the engines of the node.
+//
+// The replay is deterministic: messages are delivered in the order they are recorded, and synchronously, i.e., a message
+// is only delivered once the engine has processed the previous one. Messages sent by the engines in response are buffered
+// by the stub network as usual, and can be delivered or inspected through the Hub.
+type Replayer struct {
+	net   *Network
+	codec network.Codec
+}
+
+// NewReplayer creates a replayer that delivers the replayed messages to the engines attached to the given network. The codec
+// must be the one the capture is recorded with, i.e., the codec of the recording node.
+func NewReplayer(net *Network, codec network.Codec) *Replayer {
+	return &Replayer{
+		net:   net,
+		codec: codec,
+	}
+}
+
+// ReplayFile replays the inbound messages of the capture file at the given path. See Replay.
+// No errors are expected during normal operation.
+func (r *Replayer) ReplayFile(path string) (int, error) {
+	records, err := capture.ReadFile(path)
+	if err != nil {
+		return 0, fmt.Errorf("could not read capture: %w", err)
+	}
+	return r.Replay(records)
+}
+
+// Replay delivers the inbound messages among the given records to the engines attached to the network, in order.
+// Outbound records, and inbound records on channels that no engine is attached to, are skipped.
+// Returns the number of delivered messages, and an error if a recorded payload cannot be decoded or an engine fails
+// to process a message; in that case, the replay stops at the failing record.
+func (r *Replayer) Replay(records []*capture.Record) (int, error) {
+	delivered := 0
+	for i, record := range records {
+		if record.Direction != capture.Inbound {
+			continue
+		}
+
+		r.net.Lock()
+		engine, ok := r.net.engines[record.Channel]
+		r.net.Unlock()
+		if !ok {
+			continue
+		}
+
+		event, err := r.codec.Decode(record.Payload)
+		if err != nil {
+			return delivered, fmt.Errorf("could not decode payload of record %d on channel %s: %w", i, record.Channel, err)
+		}
+
+		err = engine.Process(record.Channel, record.OriginID, event)
+		if err != nil {
+			return delivered, fmt.Errorf("engine failed to process record %d on channel %s from %v: %w", i, record.Channel, record.OriginID, err)
+		}
+		delivered++
+	}
+
+	return delivered, nil
+}
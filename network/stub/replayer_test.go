@@ -0,0 +1,79 @@
+package stub_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/model/messages"
+	"github.com/onflow/flow-go/network/capture"
+	"github.com/onflow/flow-go/network/channels"
+	"github.com/onflow/flow-go/network/codec/cbor"
+	"github.com/onflow/flow-go/network/mocknetwork"
+	"github.com/onflow/flow-go/network/stub"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestReplayer_Replay evaluates that replaying a capture file delivers its inbound messages to the engine under test in the
+// order they are recorded and as if they were received from their original senders, while skipping the outbound messages and
+// the messages on the channels the engine is not attached to.
+func TestReplayer_Replay(t *testing.T) {
+	c := cbor.NewCodec()
+	path := filepath.Join(t.TempDir(), "capture.bin")
+	me := unittest.IdentifierFixture()
+	origin1 := unittest.IdentifierFixture()
+	origin2 := unittest.IdentifierFixture()
+
+	first := &messages.SyncRequest{Nonce: 1, Height: 10}
+	second := &messages.SyncRequest{Nonce: 2, Height: 11}
+
+	// records the traffic of the node.
+	recorder, err := capture.NewFileRecorder(unittest.Logger(), path, capture.DefaultQueueSize)
+	require.NoError(t, err)
+	for _, m := range []struct {
+		direction capture.Direction
+		channel   channels.Channel
+		origin    flow.Identifier
+		msg       interface{}
+	}{
+		{capture.Inbound, channels.SyncCommittee, origin1, first},
+		{capture.Outbound, channels.SyncCommittee, me, &messages.SyncResponse{Nonce: 1, Height: 20}},
+		{capture.Inbound, channels.PushBlocks, origin1, &messages.SyncRequest{Nonce: 3, Height: 12}},
+		{capture.Inbound, channels.SyncCommittee, origin2, second},
+	} {
+		payload, err := c.Encode(m.msg)
+		require.NoError(t, err)
+		if m.direction == capture.Inbound {
+			recorder.RecordInbound(m.channel, m.origin, payload)
+		} else {
+			recorder.RecordOutbound(m.channel, m.origin, flow.IdentifierList{origin1}, payload)
+		}
+	}
+	require.NoError(t, recorder.Close())
+
+	// replays the traffic into the engine under test.
+	hub := stub.NewNetworkHub()
+	net := stub.NewNetwork(t, me, hub)
+	engine := mocknetwork.NewEngine(t)
+	_, err = net.Register(channels.SyncCommittee, engine)
+	require.NoError(t, err)
+
+	var origins []flow.Identifier
+	var events []interface{}
+	engine.On("Process", channels.SyncCommittee, mock.Anything, mock.Anything).
+		Run(func(args mock.Arguments) {
+			origins = append(origins, args.Get(1).(flow.Identifier))
+			events = append(events, args.Get(2))
+		}).
+		Return(nil).
+		Twice()
+
+	count, err := stub.NewReplayer(net, c).ReplayFile(path)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	require.Equal(t, []flow.Identifier{origin1, origin2}, origins)
+	require.Equal(t, []interface{}{first, second}, events)
+}
@@ -0,0 +1,102 @@
+package cohort2
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/model/libp2p/message"
+	"github.com/onflow/flow-go/module/irrecoverable"
+	"github.com/onflow/flow-go/network"
+	"github.com/onflow/flow-go/network/channels"
+	"github.com/onflow/flow-go/network/internal/testutils"
+	"github.com/onflow/flow-go/network/mocknetwork"
+	"github.com/onflow/flow-go/network/underlay"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestMessageRecorder evaluates that the network records the messages exchanged by its engines with the channel, origin,
+// targets and codec-encoded payload of each message, for inbound messages, unicast messages and pubsub messages.
+// It creates two networks, of which only the first one records its messages.
+func TestMessageRecorder(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	signalerCtx := irrecoverable.NewMockSignalerContext(t, ctx)
+
+	sporkId := unittest.IdentifierFixture()
+	ids, nodes := testutils.LibP2PNodeForNetworkFixture(t, sporkId, 2)
+	recorder := mocknetwork.NewMessageRecorder(t)
+
+	recordingNet, err := underlay.NewNetwork(
+		testutils.NetworkConfigFixture(t, *ids[0], unittest.NewUpdatableIDProvider(ids), sporkId, nodes[0]),
+		underlay.WithMessageRecorder(recorder))
+	require.NoError(t, err)
+	otherNet, err := underlay.NewNetwork(
+		testutils.NetworkConfigFixture(t, *ids[1], unittest.NewUpdatableIDProvider(ids), sporkId, nodes[1]))
+	require.NoError(t, err)
+	nets := []*underlay.Network{recordingNet, otherNet}
+
+	testutils.StartNodes(signalerCtx, t, nodes)
+	for _, net := range nets {
+		testutils.StartNetworks(signalerCtx, t, []network.EngineRegistry{net})
+		unittest.RequireComponentsReadyBefore(t, 1*time.Second, net)
+	}
+	defer func() {
+		cancel()
+		testutils.StopComponents(t, nets, 3*time.Second)
+		testutils.StopComponents(t, nodes, 3*time.Second)
+	}()
+
+	recordingEngine := mocknetwork.NewMessageProcessor(t)
+	recordingCon, err := recordingNet.Register(channels.TestNetworkChannel, recordingEngine)
+	require.NoError(t, err)
+	otherEngine := mocknetwork.NewMessageProcessor(t)
+	// the messages of the recording network may or may not be delivered before the networks are stopped.
+	otherEngine.On("Process", channels.TestNetworkChannel, ids[0].NodeID, mock.Anything).Return(nil).Maybe()
+	otherCon, err := otherNet.Register(channels.TestNetworkChannel, otherEngine)
+	require.NoError(t, err)
+
+	encode := func(msg *message.TestMessage) []byte {
+		payload, err := unittest.NetworkCodec().Encode(msg)
+		require.NoError(t, err)
+		return payload
+	}
+
+	t.Run("inbound", func(t *testing.T) {
+		msg := &message.TestMessage{Text: "inbound"}
+		recorded := make(chan struct{})
+		processed := make(chan struct{})
+
+		recorder.On("RecordInbound", channels.TestNetworkChannel, ids[1].NodeID, encode(msg)).
+			Run(func(mock.Arguments) { close(recorded) }).
+			Once()
+		recordingEngine.On("Process", channels.TestNetworkChannel, ids[1].NodeID, msg).
+			Run(func(mock.Arguments) { close(processed) }).
+			Return(nil).
+			Once()
+
+		require.NoError(t, otherCon.Unicast(msg, ids[0].NodeID))
+
+		unittest.RequireCloseBefore(t, recorded, 3*time.Second, "inbound message was not recorded")
+		unittest.RequireCloseBefore(t, processed, 3*time.Second, "inbound message was not processed")
+	})
+
+	t.Run("unicast", func(t *testing.T) {
+		msg := &message.TestMessage{Text: "unicast"}
+		recorder.On("RecordOutbound", channels.TestNetworkChannel, ids[0].NodeID, flow.IdentifierList{ids[1].NodeID}, encode(msg)).
+			Once()
+
+		require.NoError(t, recordingCon.Unicast(msg, ids[1].NodeID))
+	})
+
+	t.Run("pubsub", func(t *testing.T) {
+		msg := &message.TestMessage{Text: "pubsub"}
+		recorder.On("RecordOutbound", channels.TestNetworkChannel, ids[0].NodeID, flow.IdentifierList{ids[1].NodeID}, encode(msg)).
+			Once()
+
+		require.NoError(t, recordingCon.Publish(msg, ids[1].NodeID))
+	})
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/onflow/flow-go/module/irrecoverable"
 	"github.com/onflow/flow-go/network"
 	alspmgr "github.com/onflow/flow-go/network/alsp/manager"
+	"github.com/onflow/flow-go/network/alsp/model"
 	netcache "github.com/onflow/flow-go/network/cache"
 	"github.com/onflow/flow-go/network/channels"
 	"github.com/onflow/flow-go/network/codec"
@@ -113,6 +114,7 @@ type Network struct {
 	validators                  []network.MessageValidator
 	authorizedSenderValidator   *validator.AuthorizedSenderValidator
 	preferredUnicasts           []protocols.ProtocolName
+	messageRecorder             network.MessageRecorder // optional; records the inbound and outbound messages when set.
 }
 
 var _ network.EngineRegistry = &Network{}
@@ -255,6 +257,15 @@ func WithMessageValidators(validators ...network.MessageValidator) NetworkOption
 	}
 }
 
+// WithMessageRecorder sets the message recorder for the network. When set, every inbound message delivered to the engines
+// and every outbound message sent by the engines is recorded, e.g., to capture the traffic of a node for debugging purposes.
+// Recording is disabled by default.
+func WithMessageRecorder(recorder network.MessageRecorder) NetworkOption {
+	return func(n *Network) {
+		n.messageRecorder = recorder
+	}
+}
+
 // NewNetwork creates a new network with the given configuration.
 // Args:
 // param: network configuration
@@ -576,6 +587,10 @@ func (n *Network) processNetworkMessage(msg network.IncomingMessageScope) error
 		return nil
 	}
 
+	if n.messageRecorder != nil {
+		n.messageRecorder.RecordInbound(msg.Channel(), msg.OriginId(), msg.Proto().Payload)
+	}
+
 	// create queue message
 	qm := queue.QMessage{
 		Payload:  msg.DecodedPayload(),
@@ -658,6 +673,12 @@ func (n *Network) UnicastOnChannel(channel channels.Channel, payload interface{}
 			return fmt.Errorf("failed to flush stream for target id %x with peer id %s: %w", msg.TargetIds()[0], peerID, err)
 		}
 
+		// each unicast message is sent on its own stream, hence the compression stats of the stream are those of the message.
+		if compressed, ok := stream.(network.CompressedStreamStats); ok {
+			uncompressedBytes, compressedBytes := compressed.CompressionStats()
+			n.metrics.UnicastMessageCompressed(channel.String(), compressed.Compressor(), uncompressedBytes, compressedBytes)
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -665,6 +686,9 @@ func (n *Network) UnicastOnChannel(channel channels.Channel, payload interface{}
 	}
 
 	n.metrics.OutboundMessageSent(msg.Size(), channel.String(), message.ProtocolTypeUnicast.String(), msg.PayloadType())
+	if n.messageRecorder != nil {
+		n.messageRecorder.RecordOutbound(channel, n.me.NodeID(), msg.TargetIds(), msg.Proto().Payload)
+	}
 	return nil
 }
 
@@ -744,6 +768,9 @@ func (n *Network) sendOnChannel(channel channels.Channel, msg interface{}, targe
 	}
 
 	n.metrics.OutboundMessageSent(scope.Size(), channel.String(), message.ProtocolTypePubSub.String(), scope.PayloadType())
+	if n.messageRecorder != nil {
+		n.messageRecorder.RecordOutbound(channel, n.me.NodeID(), scope.TargetIds(), scope.Proto().Payload)
+	}
 
 	return nil
 }
@@ -842,7 +869,8 @@ func (n *Network) UpdateNodeAddresses() {
 	n.logger.Info().Msg("updating protocol state node addresses")
 
 	ids := n.Identities()
-	newInfos, invalid := utils.PeerInfosFromIDs(ids)
+	// the identities only carry the tcp addresses of the nodes, the quic addresses are derived from them if this node dials quic.
+	newInfos, invalid := utils.PeerInfosFromIDs(ids, utils.ListensOnQUIC(n.libP2PNode.Host()))
 
 	for id, err := range invalid {
 		n.logger.
@@ -906,12 +934,20 @@ func (n *Network) OnDisallowListNotification(notification *network.DisallowListi
 	for _, pid := range n.peerIDs(notification.FlowIds) {
 		n.libP2PNode.OnDisallowListNotification(pid, notification.Cause)
 	}
+	// records the cause, so that it survives restarts when spam record snapshots are enabled
+	n.misbehaviorReportManager.OnDisallowListNotification(notification)
 }
 
 func (n *Network) OnAllowListNotification(notification *network.AllowListingUpdate) {
 	for _, pid := range n.peerIDs(notification.FlowIds) {
 		n.libP2PNode.OnAllowListNotification(pid, notification.Cause)
 	}
+	n.misbehaviorReportManager.OnAllowListNotification(notification)
+}
+
+// SpamRecords returns a copy of the spam records kept by the misbehavior report manager of the network.
+func (n *Network) SpamRecords() []model.ProtocolSpamRecord {
+	return n.misbehaviorReportManager.SpamRecords()
 }
 
 // handleIncomingStream handles an incoming stream from a remote peer
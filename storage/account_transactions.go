@@ -0,0 +1,73 @@
+package storage
+
+import (
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// AccountTransactionRoles is a set of roles an account had in a transaction.
+type AccountTransactionRoles uint8
+
+const (
+	// AccountTransactionRolePayer is set if the account paid the fees of the transaction.
+	AccountTransactionRolePayer AccountTransactionRoles = 1 << iota
+	// AccountTransactionRoleProposer is set if the account provided the proposal key of the transaction.
+	AccountTransactionRoleProposer
+	// AccountTransactionRoleAuthorizer is set if the account authorized the transaction.
+	AccountTransactionRoleAuthorizer
+)
+
+// Has returns true if all the given roles are set.
+func (r AccountTransactionRoles) Has(roles AccountTransactionRoles) bool {
+	return r&roles == roles
+}
+
+// Strings returns the names of the roles that are set.
+func (r AccountTransactionRoles) Strings() []string {
+	names := make([]string, 0)
+	if r.Has(AccountTransactionRolePayer) {
+		names = append(names, "payer")
+	}
+	if r.Has(AccountTransactionRoleProposer) {
+		names = append(names, "proposer")
+	}
+	if r.Has(AccountTransactionRoleAuthorizer) {
+		names = append(names, "authorizer")
+	}
+	return names
+}
+
+// AccountTransaction is an entry of the account transactions index, linking an account to a transaction it was involved in.
+type AccountTransaction struct {
+	Address          flow.Address
+	BlockID          flow.Identifier
+	BlockHeight      uint64
+	TransactionID    flow.Identifier
+	TransactionIndex uint32
+	Roles            AccountTransactionRoles
+}
+
+// AccountTransactionCursor identifies the position of a transaction within the chain. Transactions are ordered
+// by block height, then by transaction index, which is the order in which they were executed.
+type AccountTransactionCursor struct {
+	Height           uint64
+	TransactionIndex uint32
+}
+
+// AccountTransactions represents a persistent index of transactions by the accounts involved in them.
+type AccountTransactions interface {
+	// BatchIndex indexes the given account transactions of the block at the given height in the provided batch.
+	// No errors are expected during normal operation.
+	BatchIndex(height uint64, transactions []AccountTransaction, batch BatchStorage) error
+
+	// FirstIndexedHeight returns the height of the first block indexed. The index is only populated from
+	// this height on, even if transactions of lower heights are stored.
+	// Expected errors:
+	//   - storage.ErrNotFound if no block has been indexed yet
+	FirstIndexedHeight() (uint64, error)
+
+	// ByAddress returns up to limit transactions the account with the given address was involved in, starting at
+	// the given cursor (inclusive) and ending at endHeight (inclusive), in execution order.
+	// The returned cursor points to the next transaction, or is nil if no transactions remain in the range.
+	// No errors are expected during normal operation.
+	ByAddress(address flow.Address, start AccountTransactionCursor, endHeight uint64, limit uint) ([]AccountTransaction, *AccountTransactionCursor, error)
+}
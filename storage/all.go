@@ -20,6 +20,8 @@ type All struct {
 	TransactionResults      TransactionResults
 	Collections             Collections
 	Events                  Events
+	EventLookups            EventLookups
+	AccountTransactions     AccountTransactions
 	EpochProtocolState      ProtocolState
 	ProtocolKVStore         ProtocolKVStore
 	VersionBeacons          VersionBeacons
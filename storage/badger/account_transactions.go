@@ -0,0 +1,103 @@
+package badger
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v2"
+	"go.uber.org/atomic"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/badger/operation"
+)
+
+var _ storage.AccountTransactions = (*AccountTransactions)(nil)
+
+// AccountTransactions implements an index of transactions by the accounts involved in them.
+type AccountTransactions struct {
+	db *badger.DB
+	// firstHeightStored is set once the height of the first indexed block is known to be persisted.
+	firstHeightStored *atomic.Bool
+}
+
+func NewAccountTransactions(db *badger.DB) *AccountTransactions {
+	return &AccountTransactions{
+		db:                db,
+		firstHeightStored: atomic.NewBool(false),
+	}
+}
+
+// BatchIndex indexes the given account transactions of the block at the given height in the provided batch.
+// The height of the first block indexed is persisted in the same batch, see FirstIndexedHeight.
+// No errors are expected during normal operation.
+func (a *AccountTransactions) BatchIndex(height uint64, transactions []storage.AccountTransaction, batch storage.BatchStorage) error {
+	writeBatch := batch.GetWriter()
+
+	if !a.firstHeightStored.Load() {
+		_, err := a.FirstIndexedHeight()
+		switch {
+		case errors.Is(err, storage.ErrNotFound):
+			err = operation.BatchInsertAccountTransactionsFirstHeight(height)(writeBatch)
+			if err != nil {
+				return fmt.Errorf("cannot batch insert first indexed height: %w", err)
+			}
+			batch.OnSucceed(func() {
+				a.firstHeightStored.Store(true)
+			})
+		case err != nil:
+			return err
+		default:
+			a.firstHeightStored.Store(true)
+		}
+	}
+
+	for _, tx := range transactions {
+		err := operation.BatchIndexAccountTransaction(tx)(writeBatch)
+		if err != nil {
+			return fmt.Errorf("cannot batch index account transaction: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// FirstIndexedHeight returns the height of the first block indexed.
+// Expected errors:
+//   - storage.ErrNotFound if no block has been indexed yet
+func (a *AccountTransactions) FirstIndexedHeight() (uint64, error) {
+	var height uint64
+	err := a.db.View(operation.RetrieveAccountTransactionsFirstHeight(&height))
+	if err != nil {
+		return 0, fmt.Errorf("could not retrieve first indexed height: %w", err)
+	}
+	return height, nil
+}
+
+// ByAddress returns up to limit transactions the account with the given address was involved in, starting at
+// the given cursor (inclusive) and ending at endHeight (inclusive), in execution order.
+// The returned cursor points to the next transaction, or is nil if no transactions remain in the range.
+// No errors are expected during normal operation.
+func (a *AccountTransactions) ByAddress(
+	address flow.Address,
+	start storage.AccountTransactionCursor,
+	endHeight uint64,
+	limit uint,
+) ([]storage.AccountTransaction, *storage.AccountTransactionCursor, error) {
+	var transactions []storage.AccountTransaction
+	// look up one more transaction than requested to find the position of the next page
+	err := a.db.View(operation.LookupAccountTransactions(address, start, endHeight, limit+1, &transactions))
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not look up transactions for account %s: %w", address, err)
+	}
+
+	if uint(len(transactions)) <= limit {
+		return transactions, nil, nil
+	}
+
+	next := transactions[limit]
+	return transactions[:limit], &storage.AccountTransactionCursor{
+		Height:           next.BlockHeight,
+		TransactionIndex: next.TransactionIndex,
+	}, nil
+}
@@ -0,0 +1,125 @@
+package badger_test
+
+import (
+	"testing"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+	badgerstorage "github.com/onflow/flow-go/storage/badger"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestAccountTransactions(t *testing.T) {
+	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+		store := badgerstorage.NewAccountTransactions(db)
+
+		address := unittest.RandomAddressFixture()
+		other := unittest.RandomAddressFixture()
+
+		// the account is involved in 2 transactions in each block
+		expected := make([]storage.AccountTransaction, 0)
+		for height := uint64(10); height < 15; height++ {
+			blockID := unittest.IdentifierFixture()
+			txs := []storage.AccountTransaction{
+				{
+					Address:          address,
+					BlockID:          blockID,
+					BlockHeight:      height,
+					TransactionID:    unittest.IdentifierFixture(),
+					TransactionIndex: 0,
+					Roles:            storage.AccountTransactionRolePayer | storage.AccountTransactionRoleProposer,
+				},
+				{
+					Address:          other,
+					BlockID:          blockID,
+					BlockHeight:      height,
+					TransactionID:    unittest.IdentifierFixture(),
+					TransactionIndex: 1,
+					Roles:            storage.AccountTransactionRolePayer,
+				},
+				{
+					Address:          address,
+					BlockID:          blockID,
+					BlockHeight:      height,
+					TransactionID:    unittest.IdentifierFixture(),
+					TransactionIndex: 2,
+					Roles:            storage.AccountTransactionRoleAuthorizer,
+				},
+			}
+
+			batch := badgerstorage.NewBatch(db)
+			require.NoError(t, store.BatchIndex(height, txs, batch))
+			require.NoError(t, batch.Flush())
+
+			expected = append(expected, txs[0], txs[2])
+		}
+
+		t.Run("all transactions", func(t *testing.T) {
+			actual, next, err := store.ByAddress(address, storage.AccountTransactionCursor{}, 100, 100)
+			require.NoError(t, err)
+			require.Nil(t, next)
+			require.Equal(t, expected, actual)
+		})
+
+		t.Run("paginated", func(t *testing.T) {
+			actual, next, err := store.ByAddress(address, storage.AccountTransactionCursor{Height: 10}, 100, 3)
+			require.NoError(t, err)
+			require.Equal(t, expected[:3], actual)
+			require.Equal(t, &storage.AccountTransactionCursor{Height: 11, TransactionIndex: 2}, next)
+
+			actual, next, err = store.ByAddress(address, *next, 100, 100)
+			require.NoError(t, err)
+			require.Nil(t, next)
+			require.Equal(t, expected[3:], actual)
+		})
+
+		t.Run("within height range", func(t *testing.T) {
+			start := storage.AccountTransactionCursor{Height: 11, TransactionIndex: 1}
+			actual, next, err := store.ByAddress(address, start, 12, 100)
+			require.NoError(t, err)
+			require.Nil(t, next)
+			require.Equal(t, expected[3:6], actual)
+		})
+
+		t.Run("unknown account", func(t *testing.T) {
+			actual, next, err := store.ByAddress(flow.EmptyAddress, storage.AccountTransactionCursor{}, 100, 100)
+			require.NoError(t, err)
+			require.Nil(t, next)
+			require.Empty(t, actual)
+		})
+	})
+}
+
+func TestAccountTransactions_FirstIndexedHeight(t *testing.T) {
+	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+		store := badgerstorage.NewAccountTransactions(db)
+
+		_, err := store.FirstIndexedHeight()
+		require.ErrorIs(t, err, storage.ErrNotFound)
+
+		// blocks without transactions are indexed too
+		index := func(store *badgerstorage.AccountTransactions, height uint64) {
+			batch := badgerstorage.NewBatch(db)
+			require.NoError(t, store.BatchIndex(height, nil, batch))
+			require.NoError(t, batch.Flush())
+		}
+
+		index(store, 10)
+		index(store, 11)
+
+		first, err := store.FirstIndexedHeight()
+		require.NoError(t, err)
+		require.Equal(t, uint64(10), first)
+
+		// the first height is persisted, and is not overwritten after a restart
+		store = badgerstorage.NewAccountTransactions(db)
+		index(store, 12)
+
+		first, err = store.FirstIndexedHeight()
+		require.NoError(t, err)
+		require.Equal(t, uint64(10), first)
+	})
+}
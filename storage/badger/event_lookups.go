@@ -0,0 +1,136 @@
+package badger
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v2"
+	"go.uber.org/atomic"
+
+	"github.com/onflow/flow-go/model/events"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/badger/operation"
+)
+
+var _ storage.EventLookups = (*EventLookups)(nil)
+
+// EventLookups implements secondary indexes of events by event type and by the address of the
+// emitting contract. The index entries reference events stored by Events, so both must be stored
+// for the same blocks.
+type EventLookups struct {
+	db *badger.DB
+	// firstHeightStored is set once the height of the first indexed block is known to be persisted.
+	firstHeightStored *atomic.Bool
+}
+
+func NewEventLookups(db *badger.DB) *EventLookups {
+	return &EventLookups{
+		db:                db,
+		firstHeightStored: atomic.NewBool(false),
+	}
+}
+
+// BatchIndex indexes the events of the block with the given ID and height in the provided batch.
+// Events are indexed by their type, and account events are also indexed by the address of the
+// contract that emitted them.
+// The height of the first block indexed is persisted in the same batch, see FirstIndexedHeight.
+// No errors are expected during normal operation.
+func (e *EventLookups) BatchIndex(blockID flow.Identifier, height uint64, blockEvents []flow.Event, batch storage.BatchStorage) error {
+	writeBatch := batch.GetWriter()
+
+	if !e.firstHeightStored.Load() {
+		_, err := e.FirstIndexedHeight()
+		switch {
+		case errors.Is(err, storage.ErrNotFound):
+			err = operation.BatchInsertEventLookupsFirstHeight(height)(writeBatch)
+			if err != nil {
+				return fmt.Errorf("cannot batch insert first indexed height: %w", err)
+			}
+			batch.OnSucceed(func() {
+				e.firstHeightStored.Store(true)
+			})
+		case err != nil:
+			return err
+		default:
+			e.firstHeightStored.Store(true)
+		}
+	}
+
+	for _, event := range blockEvents {
+		err := operation.BatchIndexEventByType(blockID, height, event)(writeBatch)
+		if err != nil {
+			return fmt.Errorf("cannot batch index event by type: %w", err)
+		}
+
+		parsed, err := events.ParseEvent(event.Type)
+		if err != nil || parsed.Type != events.AccountEventType {
+			// only account events are emitted by contracts
+			continue
+		}
+
+		address := flow.HexToAddress(parsed.Address)
+		err = operation.BatchIndexEventByContractAddress(address, blockID, height, event)(writeBatch)
+		if err != nil {
+			return fmt.Errorf("cannot batch index event by contract address: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// FirstIndexedHeight returns the height of the first block indexed by the lookup indexes.
+// Expected errors:
+//   - storage.ErrNotFound if no block has been indexed yet
+func (e *EventLookups) FirstIndexedHeight() (uint64, error) {
+	var height uint64
+	err := e.db.View(operation.RetrieveEventLookupsFirstHeight(&height))
+	if err != nil {
+		return 0, fmt.Errorf("could not retrieve first indexed height: %w", err)
+	}
+	return height, nil
+}
+
+// ByEventType returns up to limit events of the given type, starting at the given cursor (inclusive)
+// and ending at endHeight (inclusive), in execution order.
+// The returned cursor points to the next matching event, or is nil if no events remain in the range.
+// No errors are expected during normal operation.
+func (e *EventLookups) ByEventType(eventType flow.EventType, start storage.EventCursor, endHeight uint64, limit uint) ([]storage.IndexedEvent, *storage.EventCursor, error) {
+	var indexed []storage.IndexedEvent
+	// look up one more event than requested to find the position of the next page
+	err := e.db.View(operation.LookupEventsByType(eventType, start, endHeight, limit+1, &indexed))
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not look up events by type %s: %w", eventType, err)
+	}
+
+	return paginateIndexedEvents(indexed, limit)
+}
+
+// ByContractAddress returns up to limit events emitted by contracts deployed to the given address,
+// starting at the given cursor (inclusive) and ending at endHeight (inclusive), in execution order.
+// The returned cursor points to the next matching event, or is nil if no events remain in the range.
+// No errors are expected during normal operation.
+func (e *EventLookups) ByContractAddress(address flow.Address, start storage.EventCursor, endHeight uint64, limit uint) ([]storage.IndexedEvent, *storage.EventCursor, error) {
+	var indexed []storage.IndexedEvent
+	// look up one more event than requested to find the position of the next page
+	err := e.db.View(operation.LookupEventsByContractAddress(address, start, endHeight, limit+1, &indexed))
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not look up events by contract address %s: %w", address, err)
+	}
+
+	return paginateIndexedEvents(indexed, limit)
+}
+
+// paginateIndexedEvents splits off the events beyond the limit, and returns the cursor of the first of them.
+func paginateIndexedEvents(indexed []storage.IndexedEvent, limit uint) ([]storage.IndexedEvent, *storage.EventCursor, error) {
+	if uint(len(indexed)) <= limit {
+		return indexed, nil, nil
+	}
+
+	next := indexed[limit]
+	return indexed[:limit], &storage.EventCursor{
+		Height:           next.BlockHeight,
+		TransactionIndex: next.Event.TransactionIndex,
+		EventIndex:       next.Event.EventIndex,
+	}, nil
+}
@@ -0,0 +1,151 @@
+package badger_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/metrics"
+	"github.com/onflow/flow-go/storage"
+	badgerstorage "github.com/onflow/flow-go/storage/badger"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestEventLookups(t *testing.T) {
+	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+		events := badgerstorage.NewEvents(metrics.NewNoopCollector(), db)
+		lookups := badgerstorage.NewEventLookups(db)
+
+		address := unittest.RandomAddressFixture()
+		depositType := flow.EventType(fmt.Sprintf("A.%s.Token.Deposited", address.Hex()))
+		withdrawType := flow.EventType(fmt.Sprintf("A.%s.Token.Withdrawn", address.Hex()))
+		// a type with the deposit type as prefix must not be matched by deposit type lookups
+		otherType := flow.EventType(fmt.Sprintf("%sWithFee", depositType))
+
+		// each block contains a deposit and withdrawal in the first transaction, and a deposit, an account
+		// creation and an event of another type in the second transaction
+		expectedDeposits := make([]storage.IndexedEvent, 0)
+		expectedByAddress := make([]storage.IndexedEvent, 0)
+		for height := uint64(10); height < 15; height++ {
+			blockID := unittest.IdentifierFixture()
+
+			tx1ID := unittest.IdentifierFixture()
+			tx2ID := unittest.IdentifierFixture()
+			blockEvents := []flow.Event{
+				unittest.EventFixture(depositType, 0, 0, tx1ID, 0),
+				unittest.EventFixture(withdrawType, 0, 1, tx1ID, 0),
+				unittest.EventFixture(depositType, 1, 2, tx2ID, 0),
+				unittest.EventFixture(flow.EventAccountCreated, 1, 3, tx2ID, 0),
+				unittest.EventFixture(otherType, 1, 4, tx2ID, 0),
+			}
+
+			batch := badgerstorage.NewBatch(db)
+			require.NoError(t, events.BatchStore(blockID, []flow.EventsList{blockEvents}, batch))
+			require.NoError(t, lookups.BatchIndex(blockID, height, blockEvents, batch))
+			require.NoError(t, batch.Flush())
+
+			for _, event := range blockEvents {
+				indexed := storage.IndexedEvent{BlockID: blockID, BlockHeight: height, Event: event}
+				if event.Type == depositType {
+					expectedDeposits = append(expectedDeposits, indexed)
+				}
+				if event.Type != flow.EventAccountCreated {
+					expectedByAddress = append(expectedByAddress, indexed)
+				}
+			}
+		}
+
+		t.Run("by event type", func(t *testing.T) {
+			actual, next, err := lookups.ByEventType(depositType, storage.EventCursor{}, 100, 100)
+			require.NoError(t, err)
+			require.Nil(t, next)
+			require.Equal(t, expectedDeposits, actual)
+		})
+
+		t.Run("by event type paginated", func(t *testing.T) {
+			cursor := storage.EventCursor{Height: 10}
+			actual := make([]storage.IndexedEvent, 0)
+			for pages := 0; ; pages++ {
+				require.Less(t, pages, len(expectedDeposits))
+
+				page, next, err := lookups.ByEventType(depositType, cursor, 100, 3)
+				require.NoError(t, err)
+				require.LessOrEqual(t, len(page), 3)
+				actual = append(actual, page...)
+
+				if next == nil {
+					break
+				}
+				cursor = *next
+			}
+			require.Equal(t, expectedDeposits, actual)
+		})
+
+		t.Run("by event type within height range", func(t *testing.T) {
+			// start in the middle of block 11
+			start := storage.EventCursor{Height: 11, TransactionIndex: 1, EventIndex: 0}
+			actual, next, err := lookups.ByEventType(depositType, start, 12, 100)
+			require.NoError(t, err)
+			require.Nil(t, next)
+			require.Equal(t, expectedDeposits[3:6], actual)
+		})
+
+		t.Run("by contract address", func(t *testing.T) {
+			actual, next, err := lookups.ByContractAddress(address, storage.EventCursor{}, 100, 3)
+			require.NoError(t, err)
+			require.Equal(t, expectedByAddress[:3], actual)
+			require.Equal(t, &storage.EventCursor{Height: 10, TransactionIndex: 1, EventIndex: 4}, next)
+
+			actual, next, err = lookups.ByContractAddress(address, *next, 100, 100)
+			require.NoError(t, err)
+			require.Nil(t, next)
+			require.Equal(t, expectedByAddress[3:], actual)
+		})
+
+		t.Run("unknown event type and address", func(t *testing.T) {
+			actual, next, err := lookups.ByEventType("A.0000000000000001.Foo.Bar", storage.EventCursor{}, 100, 100)
+			require.NoError(t, err)
+			require.Nil(t, next)
+			require.Empty(t, actual)
+
+			actual, next, err = lookups.ByContractAddress(unittest.RandomAddressFixture(), storage.EventCursor{}, 100, 100)
+			require.NoError(t, err)
+			require.Nil(t, next)
+			require.Empty(t, actual)
+		})
+	})
+}
+
+func TestEventLookups_FirstIndexedHeight(t *testing.T) {
+	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+		lookups := badgerstorage.NewEventLookups(db)
+
+		_, err := lookups.FirstIndexedHeight()
+		require.ErrorIs(t, err, storage.ErrNotFound)
+
+		index := func(lookups *badgerstorage.EventLookups, height uint64) {
+			blockEvents := []flow.Event{unittest.EventFixture(flow.EventAccountCreated, 0, 0, unittest.IdentifierFixture(), 0)}
+			batch := badgerstorage.NewBatch(db)
+			require.NoError(t, lookups.BatchIndex(unittest.IdentifierFixture(), height, blockEvents, batch))
+			require.NoError(t, batch.Flush())
+		}
+
+		index(lookups, 10)
+		index(lookups, 11)
+
+		first, err := lookups.FirstIndexedHeight()
+		require.NoError(t, err)
+		require.Equal(t, uint64(10), first)
+
+		// the first height is persisted, and is not overwritten after a restart
+		lookups = badgerstorage.NewEventLookups(db)
+		index(lookups, 12)
+
+		first, err = lookups.FirstIndexedHeight()
+		require.NoError(t, err)
+		require.Equal(t, uint64(10), first)
+	})
+}
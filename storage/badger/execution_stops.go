@@ -0,0 +1,40 @@
+package badger
+
+import (
+	"fmt"
+
+	"github.com/dgraph-io/badger/v2"
+
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/badger/operation"
+)
+
+// ExecutionStops persists the stop control state of an execution node.
+type ExecutionStops struct {
+	db *badger.DB
+}
+
+var _ storage.ExecutionStops = (*ExecutionStops)(nil)
+
+func NewExecutionStops(db *badger.DB) *ExecutionStops {
+	return &ExecutionStops{
+		db: db,
+	}
+}
+
+func (s *ExecutionStops) Store(stop *storage.ExecutionStop) error {
+	err := operation.RetryOnConflict(s.db.Update, operation.UpsertExecutionStop(stop))
+	if err != nil {
+		return fmt.Errorf("could not store execution stop: %w", err)
+	}
+	return nil
+}
+
+func (s *ExecutionStops) Retrieve() (*storage.ExecutionStop, error) {
+	var stop storage.ExecutionStop
+	err := s.db.View(operation.RetrieveExecutionStop(&stop))
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve execution stop: %w", err)
+	}
+	return &stop, nil
+}
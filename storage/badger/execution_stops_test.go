@@ -0,0 +1,55 @@
+package badger_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/storage"
+	badgerstorage "github.com/onflow/flow-go/storage/badger"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+func TestExecutionStopsStoreAndRetrieve(t *testing.T) {
+	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+		store := badgerstorage.NewExecutionStops(db)
+
+		_, err := store.Retrieve()
+		require.ErrorIs(t, err, storage.ErrNotFound)
+
+		expected := &storage.ExecutionStop{
+			StopBeforeHeight:     math.MaxUint64,
+			ScheduledBeforeTime:  time.Unix(1700000000, 0).UTC(),
+			ScheduledBeforeEpoch: 3,
+			ScheduledShouldCrash: true,
+		}
+		require.NoError(t, store.Store(expected))
+
+		actual, err := store.Retrieve()
+		require.NoError(t, err)
+		require.Equal(t, expected.StopBeforeHeight, actual.StopBeforeHeight)
+		require.True(t, expected.ScheduledBeforeTime.Equal(actual.ScheduledBeforeTime))
+		require.Equal(t, expected.ScheduledBeforeEpoch, actual.ScheduledBeforeEpoch)
+		require.True(t, actual.ScheduledShouldCrash)
+
+		// storing again replaces the stored stop
+		paused := &storage.ExecutionStop{
+			StopBeforeHeight:    math.MaxUint64,
+			Paused:              true,
+			Reason:              "stop@21[manual]",
+			StoppedAfterHeight:  20,
+			StoppedAfterBlockID: unittest.IdentifierFixture(),
+		}
+		require.NoError(t, store.Store(paused))
+
+		actual, err = store.Retrieve()
+		require.NoError(t, err)
+		require.True(t, actual.Paused)
+		require.True(t, actual.ScheduledBeforeTime.IsZero())
+		require.Equal(t, paused.Reason, actual.Reason)
+		require.Equal(t, paused.StoppedAfterBlockID, actual.StoppedAfterBlockID)
+	})
+}
@@ -0,0 +1,106 @@
+package operation
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/vmihailenco/msgpack/v4"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/irrecoverable"
+	"github.com/onflow/flow-go/storage"
+)
+
+// accountTransactionEntry is the value stored in the account transactions index. The account address,
+// block height and transaction index are encoded in the key.
+type accountTransactionEntry struct {
+	BlockID       flow.Identifier
+	TransactionID flow.Identifier
+	Roles         storage.AccountTransactionRoles
+}
+
+// accountTransactionKey returns the index key of the transaction at the given height and index for the given account.
+func accountTransactionKey(address flow.Address, height uint64, txIndex uint32) []byte {
+	return makePrefix(codeAccountTransactionIndex, address, height, txIndex)
+}
+
+// BatchIndexAccountTransaction indexes the given transaction by the address of the account involved in it.
+func BatchIndexAccountTransaction(tx storage.AccountTransaction) func(*badger.WriteBatch) error {
+	return batchWrite(accountTransactionKey(tx.Address, tx.BlockHeight, tx.TransactionIndex), accountTransactionEntry{
+		BlockID:       tx.BlockID,
+		TransactionID: tx.TransactionID,
+		Roles:         tx.Roles,
+	})
+}
+
+// BatchInsertAccountTransactionsFirstHeight inserts the height of the first block indexed by the account transactions index.
+func BatchInsertAccountTransactionsFirstHeight(height uint64) func(*badger.WriteBatch) error {
+	return batchWrite(makePrefix(codeAccountTxIndexFirstHeight), height)
+}
+
+// RetrieveAccountTransactionsFirstHeight retrieves the height of the first block indexed by the account transactions index.
+// Returns storage.ErrNotFound if no block has been indexed yet.
+func RetrieveAccountTransactionsFirstHeight(height *uint64) func(*badger.Txn) error {
+	return retrieve(makePrefix(codeAccountTxIndexFirstHeight), height)
+}
+
+// LookupAccountTransactions retrieves up to limit transactions the account with the given address was involved in,
+// starting at the given cursor (inclusive) and ending at endHeight (inclusive).
+// No errors are expected during normal operation.
+func LookupAccountTransactions(
+	address flow.Address,
+	start storage.AccountTransactionCursor,
+	endHeight uint64,
+	limit uint,
+	transactions *[]storage.AccountTransaction,
+) func(*badger.Txn) error {
+	return func(tx *badger.Txn) error {
+		*transactions = make([]storage.AccountTransaction, 0)
+
+		prefix := makePrefix(codeAccountTransactionIndex, address)
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = prefix
+
+		it := tx.NewIterator(opts)
+		defer it.Close()
+
+		startKey := accountTransactionKey(address, start.Height, start.TransactionIndex)
+		for it.Seek(startKey); it.ValidForPrefix(prefix) && uint(len(*transactions)) < limit; it.Next() {
+			item := it.Item()
+
+			key := item.Key()
+			if len(key) != len(prefix)+12 {
+				return fmt.Errorf("malformed account transaction key: %x", key)
+			}
+			height := binary.BigEndian.Uint64(key[len(prefix):])
+			if height > endHeight {
+				break
+			}
+			txIndex := binary.BigEndian.Uint32(key[len(prefix)+8:])
+
+			var entry accountTransactionEntry
+			err := item.Value(func(val []byte) error {
+				err := msgpack.Unmarshal(val, &entry)
+				if err != nil {
+					return irrecoverable.NewExceptionf("could not decode entity: %w", err)
+				}
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("could not process value: %w", err)
+			}
+
+			*transactions = append(*transactions, storage.AccountTransaction{
+				Address:          address,
+				BlockID:          entry.BlockID,
+				BlockHeight:      height,
+				TransactionID:    entry.TransactionID,
+				TransactionIndex: txIndex,
+				Roles:            entry.Roles,
+			})
+		}
+
+		return nil
+	}
+}
@@ -16,6 +16,11 @@ func RetrieveResultApproval(approvalID flow.Identifier, approval *flow.ResultApp
 	return retrieve(makePrefix(codeResultApproval, approvalID), approval)
 }
 
+// RemoveResultApproval removes an approval by ID.
+func RemoveResultApproval(approvalID flow.Identifier) func(*badger.Txn) error {
+	return remove(makePrefix(codeResultApproval, approvalID))
+}
+
 // IndexResultApproval inserts a ResultApproval ID keyed by ExecutionResult ID
 // and chunk index. If a value for this key exists, a storage.ErrAlreadyExists
 // error is returned. This operation is only used by the ResultApprovals store,
@@ -29,3 +34,8 @@ func IndexResultApproval(resultID flow.Identifier, chunkIndex uint64, approvalID
 func LookupResultApproval(resultID flow.Identifier, chunkIndex uint64, approvalID *flow.Identifier) func(*badger.Txn) error {
 	return retrieve(makePrefix(codeIndexResultApprovalByChunk, resultID, chunkIndex), approvalID)
 }
+
+// RemoveResultApprovalIndex removes the approval index for the given result ID and chunk index.
+func RemoveResultApprovalIndex(resultID flow.Identifier, chunkIndex uint64) func(*badger.Txn) error {
+	return remove(makePrefix(codeIndexResultApprovalByChunk, resultID, chunkIndex))
+}
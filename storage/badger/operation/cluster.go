@@ -22,6 +22,14 @@ func LookupClusterBlockHeight(clusterID flow.ChainID, number uint64, blockID *fl
 	return retrieve(makePrefix(codeFinalizedCluster, clusterID, number), blockID)
 }
 
+// RemoveClusterBlockHeight removes the finalized block index for the given height of the given cluster.
+// Error returns:
+//   - storage.ErrNotFound if no block is indexed for the height
+//   - generic error in case of unexpected failure from the database layer
+func RemoveClusterBlockHeight(clusterID flow.ChainID, number uint64) func(*badger.Txn) error {
+	return remove(makePrefix(codeFinalizedCluster, clusterID, number))
+}
+
 // InsertClusterFinalizedHeight inserts the finalized boundary for the given cluster.
 func InsertClusterFinalizedHeight(clusterID flow.ChainID, number uint64) func(*badger.Txn) error {
 	return insert(makePrefix(codeClusterHeight, clusterID), number)
@@ -51,6 +59,14 @@ func LookupReferenceBlockByClusterBlock(clusterBlockID flow.Identifier, refID *f
 	return retrieve(makePrefix(codeClusterBlockToRefBlock, clusterBlockID), refID)
 }
 
+// RemoveReferenceBlockByClusterBlock removes the reference block index of the given cluster block.
+// Error returns:
+//   - storage.ErrNotFound if the cluster block is not indexed
+//   - generic error in case of unexpected failure from the database layer
+func RemoveReferenceBlockByClusterBlock(clusterBlockID flow.Identifier) func(*badger.Txn) error {
+	return remove(makePrefix(codeClusterBlockToRefBlock, clusterBlockID))
+}
+
 // IndexClusterBlockByReferenceHeight indexes a cluster block ID by its reference
 // block height. The cluster block ID is included in the key for more efficient
 // traversal. Only finalized cluster blocks should be included in this index.
@@ -59,6 +75,14 @@ func IndexClusterBlockByReferenceHeight(refHeight uint64, clusterBlockID flow.Id
 	return insert(makePrefix(codeRefHeightToClusterBlock, refHeight, clusterBlockID), nil)
 }
 
+// RemoveClusterBlockByReferenceHeight removes the given cluster block from the reference height index.
+// Error returns:
+//   - storage.ErrNotFound if the cluster block is not indexed at the height
+//   - generic error in case of unexpected failure from the database layer
+func RemoveClusterBlockByReferenceHeight(refHeight uint64, clusterBlockID flow.Identifier) func(*badger.Txn) error {
+	return remove(makePrefix(codeRefHeightToClusterBlock, refHeight, clusterBlockID))
+}
+
 // LookupClusterBlocksByReferenceHeightRange traverses the ref_height->cluster_block
 // index and returns any finalized cluster blocks which have a reference block with
 // height in the given range. This is used to avoid including duplicate transaction
@@ -33,6 +33,14 @@ func LookupCollectionPayload(blockID flow.Identifier, txIDs *[]flow.Identifier)
 	return retrieve(makePrefix(codeIndexCollection, blockID), txIDs)
 }
 
+// RemoveCollectionPayload removes the transaction index of the collection payload of a cluster block.
+// Error returns:
+//   - storage.ErrNotFound if the payload is not indexed
+//   - generic error in case of unexpected failure from the database layer
+func RemoveCollectionPayload(blockID flow.Identifier) func(*badger.Txn) error {
+	return remove(makePrefix(codeIndexCollection, blockID))
+}
+
 // IndexCollectionByTransaction inserts a collection id keyed by a transaction id
 func IndexCollectionByTransaction(txID flow.Identifier, collectionID flow.Identifier) func(*badger.Txn) error {
 	return insert(makePrefix(codeIndexCollectionByTransaction, txID), collectionID)
@@ -0,0 +1,135 @@
+package operation
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/vmihailenco/msgpack/v4"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/irrecoverable"
+	"github.com/onflow/flow-go/storage"
+)
+
+// eventLookupEntry is the value stored in the event lookup indexes. Together with the transaction
+// and event indexes encoded in the key, it references the event stored under codeEvent.
+type eventLookupEntry struct {
+	BlockID       flow.Identifier
+	TransactionID flow.Identifier
+}
+
+// eventTypePrefix returns the prefix shared by all index entries of the given event type.
+// Event types have variable length, so they are hashed to avoid one type being a prefix of another.
+func eventTypePrefix(eventType flow.EventType) []byte {
+	return makePrefix(codeEventTypeIndex, flow.MakeIDFromFingerPrint([]byte(eventType)))
+}
+
+// eventAddressPrefix returns the prefix shared by all index entries of the given contract address.
+func eventAddressPrefix(address flow.Address) []byte {
+	return makePrefix(codeEventAddressIndex, address)
+}
+
+// eventLookupKey appends the position of the event to the given index prefix.
+func eventLookupKey(prefix []byte, height uint64, txIndex uint32, eventIndex uint32) []byte {
+	key := make([]byte, 0, len(prefix)+16)
+	key = append(key, prefix...)
+	key = append(key, b(height)...)
+	key = append(key, b(txIndex)...)
+	key = append(key, b(eventIndex)...)
+	return key
+}
+
+// BatchIndexEventByType indexes the given event by its type and the height of the block it was emitted in.
+func BatchIndexEventByType(blockID flow.Identifier, height uint64, event flow.Event) func(*badger.WriteBatch) error {
+	key := eventLookupKey(eventTypePrefix(event.Type), height, event.TransactionIndex, event.EventIndex)
+	return batchWrite(key, eventLookupEntry{BlockID: blockID, TransactionID: event.TransactionID})
+}
+
+// BatchIndexEventByContractAddress indexes the given event by the address of the contract that emitted it
+// and the height of the block it was emitted in.
+func BatchIndexEventByContractAddress(address flow.Address, blockID flow.Identifier, height uint64, event flow.Event) func(*badger.WriteBatch) error {
+	key := eventLookupKey(eventAddressPrefix(address), height, event.TransactionIndex, event.EventIndex)
+	return batchWrite(key, eventLookupEntry{BlockID: blockID, TransactionID: event.TransactionID})
+}
+
+// BatchInsertEventLookupsFirstHeight inserts the height of the first block indexed by the event lookup indexes.
+func BatchInsertEventLookupsFirstHeight(height uint64) func(*badger.WriteBatch) error {
+	return batchWrite(makePrefix(codeEventLookupsFirstHeight), height)
+}
+
+// RetrieveEventLookupsFirstHeight retrieves the height of the first block indexed by the event lookup indexes.
+// Returns storage.ErrNotFound if no block has been indexed yet.
+func RetrieveEventLookupsFirstHeight(height *uint64) func(*badger.Txn) error {
+	return retrieve(makePrefix(codeEventLookupsFirstHeight), height)
+}
+
+// LookupEventsByType retrieves up to limit events of the given type, starting at the given cursor (inclusive)
+// and ending at endHeight (inclusive).
+// No errors are expected during normal operation.
+func LookupEventsByType(eventType flow.EventType, start storage.EventCursor, endHeight uint64, limit uint, events *[]storage.IndexedEvent) func(*badger.Txn) error {
+	return lookupIndexedEvents(eventTypePrefix(eventType), start, endHeight, limit, events)
+}
+
+// LookupEventsByContractAddress retrieves up to limit events emitted by contracts deployed to the given address,
+// starting at the given cursor (inclusive) and ending at endHeight (inclusive).
+// No errors are expected during normal operation.
+func LookupEventsByContractAddress(address flow.Address, start storage.EventCursor, endHeight uint64, limit uint, events *[]storage.IndexedEvent) func(*badger.Txn) error {
+	return lookupIndexedEvents(eventAddressPrefix(address), start, endHeight, limit, events)
+}
+
+// lookupIndexedEvents iterates over the event lookup index entries with the given prefix in key order,
+// and resolves each of them to the referenced event.
+func lookupIndexedEvents(prefix []byte, start storage.EventCursor, endHeight uint64, limit uint, events *[]storage.IndexedEvent) func(*badger.Txn) error {
+	return func(tx *badger.Txn) error {
+		*events = make([]storage.IndexedEvent, 0)
+
+		opts := badger.DefaultIteratorOptions
+		opts.Prefix = prefix
+
+		it := tx.NewIterator(opts)
+		defer it.Close()
+
+		startKey := eventLookupKey(prefix, start.Height, start.TransactionIndex, start.EventIndex)
+		for it.Seek(startKey); it.ValidForPrefix(prefix) && uint(len(*events)) < limit; it.Next() {
+			item := it.Item()
+
+			key := item.Key()
+			if len(key) != len(prefix)+16 {
+				return fmt.Errorf("malformed event lookup key: %x", key)
+			}
+			height := binary.BigEndian.Uint64(key[len(prefix):])
+			if height > endHeight {
+				break
+			}
+			txIndex := binary.BigEndian.Uint32(key[len(prefix)+8:])
+			eventIndex := binary.BigEndian.Uint32(key[len(prefix)+12:])
+
+			var entry eventLookupEntry
+			err := item.Value(func(val []byte) error {
+				err := msgpack.Unmarshal(val, &entry)
+				if err != nil {
+					return irrecoverable.NewExceptionf("could not decode entity: %w", err)
+				}
+				return nil
+			})
+			if err != nil {
+				return fmt.Errorf("could not process value: %w", err)
+			}
+
+			var event flow.Event
+			err = retrieve(makePrefix(codeEvent, entry.BlockID, entry.TransactionID, txIndex, eventIndex), &event)(tx)
+			if err != nil {
+				return fmt.Errorf("could not retrieve indexed event at height %d: %w", height, err)
+			}
+
+			*events = append(*events, storage.IndexedEvent{
+				BlockID:     entry.BlockID,
+				BlockHeight: height,
+				Event:       event,
+			})
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,20 @@
+package operation
+
+import (
+	"github.com/dgraph-io/badger/v2"
+
+	"github.com/onflow/flow-go/storage"
+)
+
+// UpsertExecutionStop writes the stop control state of an execution node into the database.
+// If an entry already exists, it is overwritten; otherwise a new entry is created.
+// No errors are expected during normal operations.
+func UpsertExecutionStop(stop *storage.ExecutionStop) func(*badger.Txn) error {
+	return upsert(makePrefix(codeExecutionStop), stop)
+}
+
+// RetrieveExecutionStop reads the stop control state of an execution node from the database.
+// Returns `storage.ErrNotFound` error in case no respective database entry is present.
+func RetrieveExecutionStop(stop *storage.ExecutionStop) func(*badger.Txn) error {
+	return retrieve(makePrefix(codeExecutionStop), stop)
+}
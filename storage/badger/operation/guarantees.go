@@ -14,6 +14,10 @@ func RetrieveGuarantee(collID flow.Identifier, guarantee *flow.CollectionGuarant
 	return retrieve(makePrefix(codeGuarantee, collID), guarantee)
 }
 
+func RemoveGuarantee(collID flow.Identifier) func(*badger.Txn) error {
+	return remove(makePrefix(codeGuarantee, collID))
+}
+
 func IndexPayloadGuarantees(blockID flow.Identifier, guarIDs []flow.Identifier) func(*badger.Txn) error {
 	return insert(makePrefix(codePayloadGuarantees, blockID), guarIDs)
 }
@@ -21,3 +25,7 @@ func IndexPayloadGuarantees(blockID flow.Identifier, guarIDs []flow.Identifier)
 func LookupPayloadGuarantees(blockID flow.Identifier, guarIDs *[]flow.Identifier) func(*badger.Txn) error {
 	return retrieve(makePrefix(codePayloadGuarantees, blockID), guarIDs)
 }
+
+func RemovePayloadGuarantees(blockID flow.Identifier) func(*badger.Txn) error {
+	return remove(makePrefix(codePayloadGuarantees, blockID))
+}
@@ -14,6 +14,14 @@ func RetrieveHeader(blockID flow.Identifier, header *flow.Header) func(*badger.T
 	return retrieve(makePrefix(codeHeader, blockID), header)
 }
 
+// RemoveHeader removes the header of the given block.
+// Error returns:
+//   - storage.ErrNotFound if no header is stored for the block
+//   - generic error in case of unexpected failure from the database layer
+func RemoveHeader(blockID flow.Identifier) func(*badger.Txn) error {
+	return remove(makePrefix(codeHeader, blockID))
+}
+
 // IndexBlockHeight indexes the height of a block. It should only be called on
 // finalized blocks.
 func IndexBlockHeight(height uint64, blockID flow.Identifier) func(*badger.Txn) error {
@@ -25,6 +33,14 @@ func LookupBlockHeight(height uint64, blockID *flow.Identifier) func(*badger.Txn
 	return retrieve(makePrefix(codeHeightToBlock, height), blockID)
 }
 
+// RemoveBlockHeight removes the finalized block index for the given height.
+// Error returns:
+//   - storage.ErrNotFound if no block is indexed for the height
+//   - generic error in case of unexpected failure from the database layer
+func RemoveBlockHeight(height uint64) func(*badger.Txn) error {
+	return remove(makePrefix(codeHeightToBlock, height))
+}
+
 // BlockExists checks whether the block exists in the database.
 // No errors are expected during normal operation.
 func BlockExists(blockID flow.Identifier, blockExists *bool) func(*badger.Txn) error {
@@ -53,6 +69,14 @@ func LookupCollectionBlock(collID flow.Identifier, blockID *flow.Identifier) fun
 	return retrieve(makePrefix(codeCollectionBlock, collID), blockID)
 }
 
+// RemoveCollectionBlock removes the block index of a collection.
+// Error returns:
+//   - storage.ErrNotFound if the collection is not indexed
+//   - generic error in case of unexpected failure from the database layer
+func RemoveCollectionBlock(collID flow.Identifier) func(*badger.Txn) error {
+	return remove(makePrefix(codeCollectionBlock, collID))
+}
+
 // FindHeaders iterates through all headers, calling `filter` on each, and adding
 // them to the `found` slice if `filter` returned true
 func FindHeaders(filter func(header *flow.Header) bool, found *[]flow.Header) func(*badger.Txn) error {
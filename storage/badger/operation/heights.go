@@ -44,6 +44,24 @@ func RetrieveSealedHeight(height *uint64) func(*badger.Txn) error {
 	return retrieve(makePrefix(codeSealedHeight), height)
 }
 
+// InsertProtocolDataPrunedHeight inserts the height below which all finalized blocks have been pruned.
+// Returns storage.ErrAlreadyExists if the height has already been inserted.
+func InsertProtocolDataPrunedHeight(height uint64) func(*badger.Txn) error {
+	return insert(makePrefix(codeProtocolDataPrunedHeight), height)
+}
+
+// UpdateProtocolDataPrunedHeight updates the height below which all finalized blocks have been pruned.
+// Returns storage.ErrNotFound if the height has not been inserted yet.
+func UpdateProtocolDataPrunedHeight(height uint64) func(*badger.Txn) error {
+	return update(makePrefix(codeProtocolDataPrunedHeight), height)
+}
+
+// RetrieveProtocolDataPrunedHeight retrieves the height below which all finalized blocks have been pruned.
+// Returns storage.ErrNotFound if no blocks have been pruned yet.
+func RetrieveProtocolDataPrunedHeight(height *uint64) func(*badger.Txn) error {
+	return retrieve(makePrefix(codeProtocolDataPrunedHeight), height)
+}
+
 // InsertEpochFirstHeight inserts the height of the first block in the given epoch.
 // The first block of an epoch E is the finalized block with view >= E.FirstView.
 // Although we don't store the final height of an epoch, it can be inferred from this index.
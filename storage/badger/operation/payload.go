@@ -14,6 +14,14 @@ func RetrieveSeal(sealID flow.Identifier, seal *flow.Seal) func(*badger.Txn) err
 	return retrieve(makePrefix(codeSeal, sealID), seal)
 }
 
+// RemoveSeal removes the seal with the given ID.
+// Error returns:
+//   - storage.ErrNotFound if the seal does not exist
+//   - generic error in case of unexpected failure from the database layer
+func RemoveSeal(sealID flow.Identifier) func(*badger.Txn) error {
+	return remove(makePrefix(codeSeal, sealID))
+}
+
 func IndexPayloadSeals(blockID flow.Identifier, sealIDs []flow.Identifier) func(*badger.Txn) error {
 	return insert(makePrefix(codePayloadSeals, blockID), sealIDs)
 }
@@ -46,6 +54,26 @@ func LookupPayloadResults(blockID flow.Identifier, resultIDs *[]flow.Identifier)
 	return retrieve(makePrefix(codePayloadResults, blockID), resultIDs)
 }
 
+// RemovePayloadSeals removes the payload seals index of the given block.
+func RemovePayloadSeals(blockID flow.Identifier) func(*badger.Txn) error {
+	return remove(makePrefix(codePayloadSeals, blockID))
+}
+
+// RemovePayloadReceipts removes the payload receipts index of the given block.
+func RemovePayloadReceipts(blockID flow.Identifier) func(*badger.Txn) error {
+	return remove(makePrefix(codePayloadReceipts, blockID))
+}
+
+// RemovePayloadResults removes the payload results index of the given block.
+func RemovePayloadResults(blockID flow.Identifier) func(*badger.Txn) error {
+	return remove(makePrefix(codePayloadResults, blockID))
+}
+
+// RemovePayloadProtocolStateID removes the payload protocol state ID index of the given block.
+func RemovePayloadProtocolStateID(blockID flow.Identifier) func(*badger.Txn) error {
+	return remove(makePrefix(codePayloadProtocolStateID, blockID))
+}
+
 // IndexLatestSealAtBlock persists the highest seal that was included in the fork up to (and including) blockID.
 // In most cases, it is the highest seal included in this block's payload. However, if there are no
 // seals in this block, sealID should reference the highest seal in blockID's ancestor.
@@ -60,6 +88,14 @@ func LookupLatestSealAtBlock(blockID flow.Identifier, sealID *flow.Identifier) f
 	return retrieve(makePrefix(codeBlockIDToLatestSealID, blockID), &sealID)
 }
 
+// RemoveLatestSealAtBlock removes the index of the latest seal as of the given block.
+// Error returns:
+//   - storage.ErrNotFound if the block is not indexed
+//   - generic error in case of unexpected failure from the database layer
+func RemoveLatestSealAtBlock(blockID flow.Identifier) func(*badger.Txn) error {
+	return remove(makePrefix(codeBlockIDToLatestSealID, blockID))
+}
+
 // IndexFinalizedSealByBlockID indexes the _finalized_ seal by the sealed block ID.
 // Example: A <- B <- C(SealA)
 // when block C is finalized, we create the index `A.ID->SealA.ID`
@@ -72,6 +108,14 @@ func LookupBySealedBlockID(sealedBlockID flow.Identifier, sealID *flow.Identifie
 	return retrieve(makePrefix(codeBlockIDToFinalizedSeal, sealedBlockID), &sealID)
 }
 
+// RemoveBySealedBlockID removes the index of the finalized seal for the given sealed block.
+// Error returns:
+//   - storage.ErrNotFound if the block is not indexed
+//   - generic error in case of unexpected failure from the database layer
+func RemoveBySealedBlockID(sealedBlockID flow.Identifier) func(*badger.Txn) error {
+	return remove(makePrefix(codeBlockIDToFinalizedSeal, sealedBlockID))
+}
+
 func InsertExecutionForkEvidence(conflictingSeals []*flow.IncorporatedResultSeal) func(*badger.Txn) error {
 	return insert(makePrefix(codeExecutionFork), conflictingSeals)
 }
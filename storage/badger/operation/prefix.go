@@ -24,14 +24,16 @@ const (
 	codeSporkRootBlockHeight       = 16
 
 	// code for heights with special meaning
-	codeFinalizedHeight         = 20 // latest finalized block height
-	codeSealedHeight            = 21 // latest sealed block height
-	codeClusterHeight           = 22 // latest finalized height on cluster
-	codeExecutedBlock           = 23 // latest executed block with max height
-	codeFinalizedRootHeight     = 24 // the height of the highest finalized block contained in the root snapshot
-	codeLastCompleteBlockHeight = 25 // the height of the last block for which all collections were received
-	codeEpochFirstHeight        = 26 // the height of the first block in a given epoch
-	codeSealedRootHeight        = 27 // the height of the highest sealed block contained in the root snapshot
+	codeFinalizedHeight          = 20 // latest finalized block height
+	codeSealedHeight             = 21 // latest sealed block height
+	codeClusterHeight            = 22 // latest finalized height on cluster
+	codeExecutedBlock            = 23 // latest executed block with max height
+	codeFinalizedRootHeight      = 24 // the height of the highest finalized block contained in the root snapshot
+	codeLastCompleteBlockHeight  = 25 // the height of the last block for which all collections were received
+	codeEpochFirstHeight         = 26 // the height of the first block in a given epoch
+	codeSealedRootHeight         = 27 // the height of the highest sealed block contained in the root snapshot
+	codeProtocolDataPrunedHeight = 28 // all finalized blocks below this height have been pruned
+	codeEventLookupsFirstHeight  = 29 // the height of the first block indexed by the event lookup indexes
 
 	// codes for single entity storage
 	codeHeader               = 30
@@ -99,6 +101,12 @@ const (
 	codeTransactionResultIndex       = 107
 	codeLightTransactionResult       = 108
 	codeLightTransactionResultIndex  = 109
+	codeEventTypeIndex               = 110 // index mapping event type and height to events
+	codeEventAddressIndex            = 111 // index mapping contract address and height to events
+	codeAccountTransactionIndex      = 112 // index mapping account address and height to transactions
+	codeTransactionProfile           = 113 // resource profiles of executed transactions
+	codeTransactionProfileIndex      = 114 // index mapping block ID and transaction index to transaction profiles
+	codeAccountTxIndexFirstHeight    = 115 // the height of the first block indexed by the account transactions index
 	codeIndexCollection              = 200
 	codeIndexExecutionResultByBlock  = 202
 	codeIndexCollectionByTransaction = 203
@@ -108,6 +116,7 @@ const (
 	blockedNodeIDs = 205 // manual override for adding node IDs to list of ejected nodes, applies to networking layer only
 
 	// internal failure information that should be preserved across restarts
+	codeExecutionStop                   = 253 // stop control state of execution nodes
 	codeExecutionFork                   = 254
 	codeEpochEmergencyFallbackTriggered = 255
 )
@@ -141,6 +150,8 @@ func b(v interface{}) []byte {
 		return i[:]
 	case flow.ChainID:
 		return []byte(i)
+	case flow.Address:
+		return i[:]
 	default:
 		panic(fmt.Sprintf("unsupported type to convert (%T)", v))
 	}
@@ -37,3 +37,11 @@ func IndexProtocolKVStore(blockID flow.Identifier, protocolKVStoreID flow.Identi
 func LookupProtocolKVStore(blockID flow.Identifier, protocolKVStoreID *flow.Identifier) func(*badger.Txn) error {
 	return retrieve(makePrefix(codeProtocolKVStoreByBlockID, blockID), protocolKVStoreID)
 }
+
+// RemoveProtocolKVStoreIndex removes the protocol KV store index of the given block.
+// Error returns:
+//   - storage.ErrNotFound if the key does not exist in the database
+//   - generic error in case of unexpected failure from the database layer
+func RemoveProtocolKVStoreIndex(blockID flow.Identifier) func(*badger.Txn) error {
+	return remove(makePrefix(codeProtocolKVStoreByBlockID, blockID))
+}
@@ -37,3 +37,11 @@ func IndexProtocolState(blockID flow.Identifier, protocolStateID flow.Identifier
 func LookupProtocolState(blockID flow.Identifier, protocolStateID *flow.Identifier) func(*badger.Txn) error {
 	return retrieve(makePrefix(codeProtocolStateByBlockID, blockID), protocolStateID)
 }
+
+// RemoveProtocolStateIndex removes the protocol state index of the given block.
+// Error returns:
+//   - storage.ErrNotFound if the key does not exist in the database
+//   - generic error in case of unexpected failure from the database layer
+func RemoveProtocolStateIndex(blockID flow.Identifier) func(*badger.Txn) error {
+	return remove(makePrefix(codeProtocolStateByBlockID, blockID))
+}
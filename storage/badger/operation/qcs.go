@@ -17,3 +17,9 @@ func InsertQuorumCertificate(qc *flow.QuorumCertificate) func(*badger.Txn) error
 func RetrieveQuorumCertificate(blockID flow.Identifier, qc *flow.QuorumCertificate) func(*badger.Txn) error {
 	return retrieve(makePrefix(codeBlockIDToQuorumCertificate, blockID), qc)
 }
+
+// RemoveQuorumCertificate removes the quorum certificate for the given block.
+// Returns storage.ErrNotFound if no QC is stored for the block.
+func RemoveQuorumCertificate(blockID flow.Identifier) func(*badger.Txn) error {
+	return remove(makePrefix(codeBlockIDToQuorumCertificate, blockID))
+}
@@ -22,6 +22,14 @@ func RetrieveExecutionReceiptMeta(receiptID flow.Identifier, meta *flow.Executio
 	return retrieve(makePrefix(codeExecutionReceiptMeta, receiptID), meta)
 }
 
+// RemoveExecutionReceiptMeta removes the execution receipt meta with the given ID.
+// Error returns:
+//   - storage.ErrNotFound if the receipt does not exist
+//   - generic error in case of unexpected failure from the database layer
+func RemoveExecutionReceiptMeta(receiptID flow.Identifier) func(*badger.Txn) error {
+	return remove(makePrefix(codeExecutionReceiptMeta, receiptID))
+}
+
 // IndexOwnExecutionReceipt inserts an execution receipt ID keyed by block ID
 func IndexOwnExecutionReceipt(blockID flow.Identifier, receiptID flow.Identifier) func(*badger.Txn) error {
 	return insert(makePrefix(codeOwnBlockReceipt, blockID), receiptID)
@@ -67,6 +75,12 @@ func LookupExecutionReceipts(blockID flow.Identifier, receiptIDs *[]flow.Identif
 	return traverse(makePrefix(codeAllBlockReceipts, blockID), iterationFunc)
 }
 
+// RemoveExecutionReceipts removes the index of all execution receipts for the given block.
+// No errors are expected during normal operation.
+func RemoveExecutionReceipts(blockID flow.Identifier) func(*badger.Txn) error {
+	return removeByPrefix(makePrefix(codeAllBlockReceipts, blockID))
+}
+
 // receiptIterationFunc returns an in iteration function which returns all receipt IDs found during traversal
 func receiptIterationFunc(receiptIDs *[]flow.Identifier) func() (checkFunc, createFunc, handleFunc) {
 	check := func(key []byte) bool {
@@ -21,6 +21,14 @@ func RetrieveExecutionResult(resultID flow.Identifier, result *flow.ExecutionRes
 	return retrieve(makePrefix(codeExecutionResult, resultID), result)
 }
 
+// RemoveExecutionResult removes the execution result with the given ID.
+// Error returns:
+//   - storage.ErrNotFound if the result does not exist
+//   - generic error in case of unexpected failure from the database layer
+func RemoveExecutionResult(resultID flow.Identifier) func(*badger.Txn) error {
+	return remove(makePrefix(codeExecutionResult, resultID))
+}
+
 // IndexExecutionResult inserts an execution result ID keyed by block ID
 func IndexExecutionResult(blockID flow.Identifier, resultID flow.Identifier) func(*badger.Txn) error {
 	return insert(makePrefix(codeIndexExecutionResultByBlock, blockID), resultID)
@@ -0,0 +1,64 @@
+package operation
+
+import (
+	"fmt"
+
+	"github.com/dgraph-io/badger/v2"
+
+	"github.com/onflow/flow-go/model/flow"
+)
+
+func BatchInsertTransactionProfile(blockID flow.Identifier, profile *flow.TransactionProfile) func(batch *badger.WriteBatch) error {
+	return batchWrite(makePrefix(codeTransactionProfile, blockID, profile.TransactionID), profile)
+}
+
+func BatchIndexTransactionProfile(blockID flow.Identifier, txIndex uint32, profile *flow.TransactionProfile) func(batch *badger.WriteBatch) error {
+	return batchWrite(makePrefix(codeTransactionProfileIndex, blockID, txIndex), profile)
+}
+
+func RetrieveTransactionProfile(blockID flow.Identifier, transactionID flow.Identifier, profile *flow.TransactionProfile) func(*badger.Txn) error {
+	return retrieve(makePrefix(codeTransactionProfile, blockID, transactionID), profile)
+}
+
+// LookupTransactionProfilesByBlockIDUsingIndex retrieves all transaction profiles for a block, by using
+// the tx_index index. This correctly handles cases of duplicate transactions within block.
+func LookupTransactionProfilesByBlockIDUsingIndex(blockID flow.Identifier, profiles *[]flow.TransactionProfile) func(*badger.Txn) error {
+
+	iterFunc := func() (checkFunc, createFunc, handleFunc) {
+		check := func(_ []byte) bool {
+			return true
+		}
+		var val flow.TransactionProfile
+		create := func() interface{} {
+			return &val
+		}
+		handle := func() error {
+			*profiles = append(*profiles, val)
+			return nil
+		}
+		return check, create, handle
+	}
+
+	return traverse(makePrefix(codeTransactionProfileIndex, blockID), iterFunc)
+}
+
+// BatchRemoveTransactionProfilesByBlockID removes transaction profiles and their index for the given blockID
+// in a provided batch.
+// No errors are expected during normal operation, but it may return generic error
+// if badger fails to process request
+func BatchRemoveTransactionProfilesByBlockID(blockID flow.Identifier, batch *badger.WriteBatch) func(*badger.Txn) error {
+	return func(txn *badger.Txn) error {
+
+		err := batchRemoveByPrefix(makePrefix(codeTransactionProfile, blockID))(txn, batch)
+		if err != nil {
+			return fmt.Errorf("could not remove transaction profiles for block %v: %w", blockID, err)
+		}
+
+		err = batchRemoveByPrefix(makePrefix(codeTransactionProfileIndex, blockID))(txn, batch)
+		if err != nil {
+			return fmt.Errorf("could not remove transaction profile index for block %v: %w", blockID, err)
+		}
+
+		return nil
+	}
+}
@@ -15,3 +15,11 @@ func InsertTransaction(txID flow.Identifier, tx *flow.TransactionBody) func(*bad
 func RetrieveTransaction(txID flow.Identifier, tx *flow.TransactionBody) func(*badger.Txn) error {
 	return retrieve(makePrefix(codeTransaction, txID), tx)
 }
+
+// RemoveTransaction removes the transaction with the given ID.
+// Error returns:
+//   - storage.ErrNotFound if the transaction is not stored
+//   - generic error in case of unexpected failure from the database layer
+func RemoveTransaction(txID flow.Identifier) func(*badger.Txn) error {
+	return remove(makePrefix(codeTransaction, txID))
+}
@@ -0,0 +1,695 @@
+package badger
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/rs/zerolog"
+	"go.uber.org/atomic"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/component"
+	"github.com/onflow/flow-go/module/irrecoverable"
+	"github.com/onflow/flow-go/state/protocol"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/badger/operation"
+	"github.com/onflow/flow-go/storage/badger/procedure"
+)
+
+const (
+	// DefaultProtocolDataPruningHeightRangeTarget is the default number of most recent finalized
+	// heights retained in the protocol database (roughly 3 epochs on mainnet).
+	DefaultProtocolDataPruningHeightRangeTarget = uint64(2_000_000)
+
+	// DefaultProtocolDataPruningThreshold is the default number of heights the retained range
+	// may exceed the target by before pruning is triggered.
+	DefaultProtocolDataPruningThreshold = uint64(100_000)
+
+	// DefaultProtocolDataPruningCheckInterval is the default interval at which the pruner checks
+	// whether pruning is needed.
+	DefaultProtocolDataPruningCheckInterval = 10 * time.Minute
+
+	// DefaultProtocolDataPruningBatchSize is the default number of heights pruned within a
+	// single database transaction.
+	DefaultProtocolDataPruningBatchSize = uint64(100)
+)
+
+// ProtocolDataPrunerProgress describes the state of the protocol data pruner.
+type ProtocolDataPrunerProgress struct {
+	// PrunedHeight is the lowest height retained: all finalized blocks below it have been pruned.
+	PrunedHeight uint64
+	// TargetHeight is the height the current (or last) pruning run prunes up to.
+	TargetHeight uint64
+	// HeightRangeTarget is the configured number of most recent finalized heights to retain.
+	HeightRangeTarget uint64
+	// Threshold is the configured number of heights the retained range may exceed the target by.
+	Threshold uint64
+	// InProgress is true while a pruning run is ongoing.
+	InProgress bool
+}
+
+// ProtocolDataPruner is a component responsible for removing finalized blocks and all data
+// indexed by them from the protocol database of non-archive nodes (collection and consensus nodes).
+// It is configured with the following parameters:
+//   - Height range target: The target number of most recent finalized heights to retain.
+//   - Threshold: The number of heights that we can exceed the height range target by
+//     before pruning is triggered. This controls the frequency of pruning.
+//
+// The pruner never prunes into the sealing segment of the latest finalized block, so that the node
+// is always able to serve protocol snapshots. The finalized and sealed root blocks, as well as the
+// root seal, are never pruned, as they are required to open the protocol state on startup.
+//
+// For every pruned block B, the pruner removes its header, height index, payload indexes,
+// guarantees, quorum certificate and protocol state indexes. For every block E sealed by B,
+// it also removes the seal, all receipts and results for E and the approvals for these results:
+// once B is pruned, no retained block can reference them anymore.
+//
+// On collection nodes, the finalized cluster blocks whose reference block is at a pruned height are
+// removed as well, together with their collections and transactions. The latest finalized block of
+// each cluster chain is retained, as it is required to open the cluster state.
+// Blocks which were never finalized (orphaned forks) are not pruned.
+//
+// If configured with the node's stores, pruned entities are removed from their caches after each
+// batch is committed, so they are not served from memory anymore.
+//
+// Progress is persisted after every batch, so pruning resumes where it stopped after a restart.
+type ProtocolDataPruner struct {
+	component.Component
+
+	log   zerolog.Logger
+	db    *badger.DB
+	state protocol.State
+
+	heightRangeTarget uint64
+	threshold         uint64
+	checkInterval     time.Duration
+	batchSize         uint64
+
+	caches protocolDataCaches
+
+	prunedHeight *atomic.Uint64
+	targetHeight *atomic.Uint64
+	inProgress   *atomic.Bool
+}
+
+var _ component.Component = (*ProtocolDataPruner)(nil)
+
+type ProtocolDataPrunerOption func(*ProtocolDataPruner)
+
+// WithProtocolDataPruningHeightRangeTarget is used to configure the pruner with a custom
+// height range target.
+func WithProtocolDataPruningHeightRangeTarget(heightRangeTarget uint64) ProtocolDataPrunerOption {
+	return func(p *ProtocolDataPruner) {
+		p.heightRangeTarget = heightRangeTarget
+	}
+}
+
+// WithProtocolDataPruningThreshold is used to configure the pruner with a custom threshold.
+func WithProtocolDataPruningThreshold(threshold uint64) ProtocolDataPrunerOption {
+	return func(p *ProtocolDataPruner) {
+		p.threshold = threshold
+	}
+}
+
+// WithProtocolDataPruningCheckInterval is used to configure the pruner with a custom interval
+// between pruning checks.
+func WithProtocolDataPruningCheckInterval(interval time.Duration) ProtocolDataPrunerOption {
+	return func(p *ProtocolDataPruner) {
+		p.checkInterval = interval
+	}
+}
+
+// WithProtocolDataPruningBatchSize is used to configure the number of heights pruned within
+// a single database transaction.
+func WithProtocolDataPruningBatchSize(batchSize uint64) ProtocolDataPrunerOption {
+	return func(p *ProtocolDataPruner) {
+		p.batchSize = batchSize
+	}
+}
+
+// WithProtocolDataPruningCaches is used to configure the pruner to remove pruned entities from the
+// caches of the given stores. Stores which are not implemented by this package are ignored.
+func WithProtocolDataPruningCaches(stores storage.All) ProtocolDataPrunerOption {
+	return func(p *ProtocolDataPruner) {
+		p.caches.headers, _ = stores.Headers.(*Headers)
+		p.caches.index, _ = stores.Index.(*Index)
+		p.caches.seals, _ = stores.Seals.(*Seals)
+		p.caches.guarantees, _ = stores.Guarantees.(*Guarantees)
+		p.caches.qcs, _ = stores.QuorumCertificates.(*QuorumCertificates)
+		p.caches.results, _ = stores.Results.(*ExecutionResults)
+		p.caches.receipts, _ = stores.Receipts.(*ExecutionReceipts)
+		p.caches.transactions, _ = stores.Transactions.(*Transactions)
+	}
+}
+
+// NewProtocolDataPruner creates a new ProtocolDataPruner. If the database was never pruned,
+// pruning starts at the spork root block height.
+// No errors are expected during normal operations.
+func NewProtocolDataPruner(
+	log zerolog.Logger,
+	db *badger.DB,
+	state protocol.State,
+	opts ...ProtocolDataPrunerOption,
+) (*ProtocolDataPruner, error) {
+	p := &ProtocolDataPruner{
+		log:               log.With().Str("component", "protocol_data_pruner").Logger(),
+		db:                db,
+		state:             state,
+		heightRangeTarget: DefaultProtocolDataPruningHeightRangeTarget,
+		threshold:         DefaultProtocolDataPruningThreshold,
+		checkInterval:     DefaultProtocolDataPruningCheckInterval,
+		batchSize:         DefaultProtocolDataPruningBatchSize,
+		prunedHeight:      atomic.NewUint64(0),
+		targetHeight:      atomic.NewUint64(0),
+		inProgress:        atomic.NewBool(false),
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	if p.batchSize == 0 {
+		return nil, fmt.Errorf("batch size must be greater than 0")
+	}
+
+	var prunedHeight uint64
+	err := db.View(operation.RetrieveProtocolDataPrunedHeight(&prunedHeight))
+	if errors.Is(err, storage.ErrNotFound) {
+		prunedHeight = state.Params().SporkRootBlockHeight()
+		err = db.Update(operation.InsertProtocolDataPrunedHeight(prunedHeight))
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not initialize pruned height: %w", err)
+	}
+	p.prunedHeight.Store(prunedHeight)
+	p.targetHeight.Store(prunedHeight)
+
+	p.Component = component.NewComponentManagerBuilder().
+		AddWorker(p.loop).
+		Build()
+
+	return p, nil
+}
+
+// Progress returns the current state of the pruner.
+func (p *ProtocolDataPruner) Progress() ProtocolDataPrunerProgress {
+	return ProtocolDataPrunerProgress{
+		PrunedHeight:      p.prunedHeight.Load(),
+		TargetHeight:      p.targetHeight.Load(),
+		HeightRangeTarget: p.heightRangeTarget,
+		Threshold:         p.threshold,
+		InProgress:        p.inProgress.Load(),
+	}
+}
+
+func (p *ProtocolDataPruner) loop(ctx irrecoverable.SignalerContext, ready component.ReadyFunc) {
+	ready()
+
+	ticker := time.NewTicker(p.checkInterval)
+	defer ticker.Stop()
+
+	// check once on startup, so an interrupted pruning run is resumed without delay
+	p.checkPrune(ctx)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.checkPrune(ctx)
+		}
+	}
+}
+
+// checkPrune prunes the protocol database if the finalized height range exceeds the height
+// range target plus threshold.
+func (p *ProtocolDataPruner) checkPrune(ctx irrecoverable.SignalerContext) {
+	heightRangeTarget := p.heightRangeTarget
+	threshold := p.threshold
+	prunedHeight := p.prunedHeight.Load()
+
+	final := p.state.Final()
+	head, err := final.Head()
+	if err != nil {
+		ctx.Throw(fmt.Errorf("could not get finalized header: %w", err))
+		return
+	}
+
+	if head.Height <= prunedHeight+heightRangeTarget+threshold {
+		return
+	}
+
+	// never prune into the sealing segment of the latest finalized block
+	segment, err := final.SealingSegment()
+	if err != nil {
+		ctx.Throw(fmt.Errorf("could not get sealing segment of finalized block %d: %w", head.Height, err))
+		return
+	}
+
+	pruneHeight := head.Height - heightRangeTarget
+	lowestSegmentHeight := segment.AllBlocks()[0].Header.Height
+	if pruneHeight > lowestSegmentHeight {
+		pruneHeight = lowestSegmentHeight
+	}
+
+	if pruneHeight <= prunedHeight {
+		return
+	}
+
+	lg := p.log.With().
+		Uint64("pruned_height", prunedHeight).
+		Uint64("prune_height", pruneHeight).
+		Uint64("finalized_height", head.Height).
+		Uint64("sealing_segment_lowest_height", lowestSegmentHeight).
+		Logger()
+
+	lg.Info().Msg("pruning protocol data")
+	start := time.Now()
+
+	p.targetHeight.Store(pruneHeight)
+	p.inProgress.Store(true)
+	defer p.inProgress.Store(false)
+
+	err = p.pruneUpToHeight(ctx, pruneHeight)
+	if err != nil {
+		ctx.Throw(fmt.Errorf("failed to prune protocol data: %w", err))
+		return
+	}
+
+	lg.Info().
+		Uint64("pruned_height", p.prunedHeight.Load()).
+		Dur("duration", time.Since(start)).
+		Msg("pruned protocol data")
+}
+
+// pruneUpToHeight prunes all finalized blocks below the given height, in batches of batchSize
+// heights. The pruned height is persisted together with each batch. Pruning stops early without
+// error if the context is cancelled.
+// No errors are expected during normal operations.
+func (p *ProtocolDataPruner) pruneUpToHeight(ctx irrecoverable.SignalerContext, height uint64) error {
+	protected, err := p.protectedData(height)
+	if err != nil {
+		return fmt.Errorf("could not determine protected data: %w", err)
+	}
+
+	for start := p.prunedHeight.Load(); start < height; {
+		select {
+		case <-ctx.Done():
+			return nil
+		default:
+		}
+
+		end := start + p.batchSize
+		if end > height {
+			end = height
+		}
+
+		var pruned *prunedEntities
+		err := operation.RetryOnConflict(p.db.Update, func(tx *badger.Txn) error {
+			// the transaction may be retried, only the entities of the committed attempt are recorded
+			pruned = &prunedEntities{}
+			for h := start; h < end; h++ {
+				err := p.pruneHeightTx(tx, h, protected, pruned)
+				if err != nil {
+					return fmt.Errorf("could not prune height %d: %w", h, err)
+				}
+			}
+			return operation.UpdateProtocolDataPrunedHeight(end)(tx)
+		})
+		if err != nil {
+			return err
+		}
+
+		p.caches.remove(pruned)
+		p.prunedHeight.Store(end)
+		p.log.Debug().
+			Uint64("pruned_height", end).
+			Uint64("prune_height", height).
+			Msg("pruned protocol data batch")
+
+		start = end
+	}
+
+	return nil
+}
+
+// protectedData contains the blocks and seals that must be retained when pruning.
+type protectedData struct {
+	// blocks are the finalized and sealed root blocks, which are required on startup.
+	blocks map[flow.Identifier]struct{}
+	// seals are the root seal, as well as the latest seal as of the lowest retained block, which
+	// may be included in a pruned block.
+	seals map[flow.Identifier]struct{}
+}
+
+// protectedData returns the blocks and seals which must be retained when pruning all finalized
+// blocks below the given height.
+// No errors are expected during normal operations.
+func (p *ProtocolDataPruner) protectedData(height uint64) (*protectedData, error) {
+	params := p.state.Params()
+
+	var lowestRetainedID flow.Identifier
+	var latestSealID flow.Identifier
+	err := p.db.View(func(tx *badger.Txn) error {
+		err := operation.LookupBlockHeight(height, &lowestRetainedID)(tx)
+		if err != nil {
+			return fmt.Errorf("could not look up lowest retained block at height %d: %w", height, err)
+		}
+		err = operation.LookupLatestSealAtBlock(lowestRetainedID, &latestSealID)(tx)
+		if err != nil {
+			return fmt.Errorf("could not look up latest seal as of block %v: %w", lowestRetainedID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &protectedData{
+		blocks: map[flow.Identifier]struct{}{
+			params.FinalizedRoot().ID(): {},
+			params.SealedRoot().ID():    {},
+		},
+		seals: map[flow.Identifier]struct{}{
+			params.Seal().ID(): {},
+			latestSealID:       {},
+		},
+	}, nil
+}
+
+// prunedEntities are the IDs of the cached entities removed within a batch.
+type prunedEntities struct {
+	heights      []uint64
+	blocks       []flow.Identifier
+	seals        []flow.Identifier
+	guarantees   []flow.Identifier
+	results      []flow.Identifier
+	receipts     []flow.Identifier
+	transactions []flow.Identifier
+}
+
+// protocolDataCaches are the stores whose cache entries are removed for pruned entities.
+// Stores which are nil are skipped.
+//
+// Cluster blocks and payloads are cached by stores created for each epoch's cluster state.
+// Pruned cluster blocks belong to past epochs, whose stores have been discarded.
+type protocolDataCaches struct {
+	headers      *Headers
+	index        *Index
+	seals        *Seals
+	guarantees   *Guarantees
+	qcs          *QuorumCertificates
+	results      *ExecutionResults
+	receipts     *ExecutionReceipts
+	transactions *Transactions
+}
+
+// remove removes the cache entries of the given pruned entities.
+func (c *protocolDataCaches) remove(pruned *prunedEntities) {
+	if c.headers != nil {
+		for _, height := range pruned.heights {
+			c.headers.heightCache.Remove(height)
+		}
+		for _, blockID := range pruned.blocks {
+			c.headers.cache.Remove(blockID)
+		}
+	}
+	for _, blockID := range pruned.blocks {
+		if c.index != nil {
+			c.index.cache.Remove(blockID)
+		}
+		if c.qcs != nil {
+			c.qcs.cache.Remove(blockID)
+		}
+	}
+	if c.seals != nil {
+		for _, sealID := range pruned.seals {
+			c.seals.cache.Remove(sealID)
+		}
+	}
+	if c.guarantees != nil {
+		for _, collID := range pruned.guarantees {
+			c.guarantees.cache.Remove(collID)
+		}
+	}
+	if c.results != nil {
+		for _, resultID := range pruned.results {
+			c.results.cache.Remove(resultID)
+		}
+	}
+	if c.receipts != nil {
+		for _, receiptID := range pruned.receipts {
+			c.receipts.cache.Remove(receiptID)
+		}
+	}
+	if c.transactions != nil {
+		for _, txID := range pruned.transactions {
+			c.transactions.cache.Remove(txID)
+		}
+	}
+}
+
+// pruneHeightTx removes the finalized block at the given height, if any, and the data indexed by it,
+// as well as the finalized cluster blocks referencing it.
+// No errors are expected during normal operations.
+func (p *ProtocolDataPruner) pruneHeightTx(tx *badger.Txn, height uint64, protected *protectedData, pruned *prunedEntities) error {
+	err := pruneClusterBlocksTx(tx, height, pruned)
+	if err != nil {
+		return fmt.Errorf("could not prune cluster blocks: %w", err)
+	}
+
+	var blockID flow.Identifier
+	err = operation.LookupBlockHeight(height, &blockID)(tx)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			// the node was bootstrapped above this height, there is nothing to prune
+			return nil
+		}
+		return fmt.Errorf("could not look up block: %w", err)
+	}
+
+	if _, ok := protected.blocks[blockID]; ok {
+		return nil
+	}
+
+	var index flow.Index
+	err = procedure.RetrieveIndex(blockID, &index)(tx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve payload index of block %v: %w", blockID, err)
+	}
+
+	for _, sealID := range index.SealIDs {
+		if _, ok := protected.seals[sealID]; ok {
+			continue
+		}
+
+		var seal flow.Seal
+		err = operation.RetrieveSeal(sealID, &seal)(tx)
+		if err != nil {
+			return fmt.Errorf("could not retrieve seal %v: %w", sealID, err)
+		}
+		err = pruneSealedBlockTx(tx, seal.BlockID, pruned)
+		if err != nil {
+			return fmt.Errorf("could not prune execution data of sealed block %v: %w", seal.BlockID, err)
+		}
+		err = operation.RemoveSeal(sealID)(tx)
+		if err != nil {
+			return fmt.Errorf("could not remove seal %v: %w", sealID, err)
+		}
+		pruned.seals = append(pruned.seals, sealID)
+	}
+
+	for _, collID := range index.CollectionIDs {
+		err = operation.SkipNonExist(operation.RemoveGuarantee(collID))(tx)
+		if err != nil {
+			return fmt.Errorf("could not remove guarantee %v: %w", collID, err)
+		}
+		err = operation.SkipNonExist(operation.RemoveCollectionBlock(collID))(tx)
+		if err != nil {
+			return fmt.Errorf("could not remove block index of collection %v: %w", collID, err)
+		}
+		pruned.guarantees = append(pruned.guarantees, collID)
+	}
+
+	removals := []struct {
+		name string
+		op   func(*badger.Txn) error
+	}{
+		{"payload guarantees", operation.RemovePayloadGuarantees(blockID)},
+		{"payload seals", operation.RemovePayloadSeals(blockID)},
+		{"payload receipts", operation.RemovePayloadReceipts(blockID)},
+		{"payload results", operation.RemovePayloadResults(blockID)},
+		{"payload protocol state ID", operation.RemovePayloadProtocolStateID(blockID)},
+		{"latest seal index", operation.SkipNonExist(operation.RemoveLatestSealAtBlock(blockID))},
+		{"quorum certificate", operation.SkipNonExist(operation.RemoveQuorumCertificate(blockID))},
+		{"children index", operation.SkipNonExist(operation.RemoveBlockChildren(blockID))},
+		{"protocol state index", operation.SkipNonExist(operation.RemoveProtocolStateIndex(blockID))},
+		{"protocol kv store index", operation.SkipNonExist(operation.RemoveProtocolKVStoreIndex(blockID))},
+		{"height index", operation.RemoveBlockHeight(height)},
+		{"header", operation.RemoveHeader(blockID)},
+	}
+	for _, removal := range removals {
+		err = removal.op(tx)
+		if err != nil {
+			return fmt.Errorf("could not remove %s of block %v: %w", removal.name, blockID, err)
+		}
+	}
+	pruned.heights = append(pruned.heights, height)
+	pruned.blocks = append(pruned.blocks, blockID)
+
+	return nil
+}
+
+// pruneClusterBlocksTx removes the finalized cluster blocks whose reference block is at the given
+// height, together with their collections and transactions. The latest finalized block of each
+// cluster chain is retained.
+// No errors are expected during normal operations.
+func pruneClusterBlocksTx(tx *badger.Txn, refHeight uint64, pruned *prunedEntities) error {
+	var clusterBlockIDs []flow.Identifier
+	err := operation.LookupClusterBlocksByReferenceHeightRange(refHeight, refHeight, &clusterBlockIDs)(tx)
+	if err != nil {
+		return fmt.Errorf("could not look up cluster blocks: %w", err)
+	}
+
+	for _, blockID := range clusterBlockIDs {
+		var header flow.Header
+		err = operation.RetrieveHeader(blockID, &header)(tx)
+		if err != nil {
+			return fmt.Errorf("could not retrieve header of cluster block %v: %w", blockID, err)
+		}
+
+		var finalizedHeight uint64
+		err = operation.RetrieveClusterFinalizedHeight(header.ChainID, &finalizedHeight)(tx)
+		if err != nil {
+			return fmt.Errorf("could not retrieve finalized height of cluster %v: %w", header.ChainID, err)
+		}
+		if header.Height == finalizedHeight {
+			continue
+		}
+
+		var txIDs []flow.Identifier
+		err = operation.LookupCollectionPayload(blockID, &txIDs)(tx)
+		if err != nil {
+			return fmt.Errorf("could not look up payload of cluster block %v: %w", blockID, err)
+		}
+		for _, txID := range txIDs {
+			err = operation.SkipNonExist(operation.RemoveTransaction(txID))(tx)
+			if err != nil {
+				return fmt.Errorf("could not remove transaction %v: %w", txID, err)
+			}
+			pruned.transactions = append(pruned.transactions, txID)
+		}
+
+		collection := flow.LightCollection{Transactions: txIDs}
+		removals := []struct {
+			name string
+			op   func(*badger.Txn) error
+		}{
+			{"collection", operation.SkipNonExist(operation.RemoveCollection(collection.ID()))},
+			{"collection payload", operation.RemoveCollectionPayload(blockID)},
+			{"reference block index", operation.RemoveReferenceBlockByClusterBlock(blockID)},
+			{"reference height index", operation.RemoveClusterBlockByReferenceHeight(refHeight, blockID)},
+			{"height index", operation.SkipNonExist(operation.RemoveClusterBlockHeight(header.ChainID, header.Height))},
+			{"quorum certificate", operation.SkipNonExist(operation.RemoveQuorumCertificate(blockID))},
+			{"children index", operation.SkipNonExist(operation.RemoveBlockChildren(blockID))},
+			{"header", operation.RemoveHeader(blockID)},
+		}
+		for _, removal := range removals {
+			err = removal.op(tx)
+			if err != nil {
+				return fmt.Errorf("could not remove %s of cluster block %v: %w", removal.name, blockID, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// pruneSealedBlockTx removes all receipts and results for the given sealed block, as well as
+// the approvals for these results and the index of the seal for the block.
+// No errors are expected during normal operations.
+func pruneSealedBlockTx(tx *badger.Txn, blockID flow.Identifier, pruned *prunedEntities) error {
+	var receiptIDs []flow.Identifier
+	err := operation.LookupExecutionReceipts(blockID, &receiptIDs)(tx)
+	if err != nil {
+		return fmt.Errorf("could not look up receipts: %w", err)
+	}
+
+	resultIDs := make(map[flow.Identifier]struct{})
+	for _, receiptID := range receiptIDs {
+		var meta flow.ExecutionReceiptMeta
+		err = operation.RetrieveExecutionReceiptMeta(receiptID, &meta)(tx)
+		if errors.Is(err, storage.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("could not retrieve receipt %v: %w", receiptID, err)
+		}
+		resultIDs[meta.ResultID] = struct{}{}
+
+		err = operation.RemoveExecutionReceiptMeta(receiptID)(tx)
+		if err != nil {
+			return fmt.Errorf("could not remove receipt %v: %w", receiptID, err)
+		}
+		pruned.receipts = append(pruned.receipts, receiptID)
+	}
+	err = operation.RemoveExecutionReceipts(blockID)(tx)
+	if err != nil {
+		return fmt.Errorf("could not remove receipts index: %w", err)
+	}
+
+	var indexedResultID flow.Identifier
+	err = operation.LookupExecutionResult(blockID, &indexedResultID)(tx)
+	if err == nil {
+		resultIDs[indexedResultID] = struct{}{}
+		err = operation.RemoveExecutionResultIndex(blockID)(tx)
+	}
+	if err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return fmt.Errorf("could not remove result index: %w", err)
+	}
+
+	for resultID := range resultIDs {
+		var result flow.ExecutionResult
+		err = operation.RetrieveExecutionResult(resultID, &result)(tx)
+		if errors.Is(err, storage.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("could not retrieve result %v: %w", resultID, err)
+		}
+
+		for _, chunk := range result.Chunks {
+			var approvalID flow.Identifier
+			err = operation.LookupResultApproval(resultID, chunk.Index, &approvalID)(tx)
+			if errors.Is(err, storage.ErrNotFound) {
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("could not look up approval for chunk %d of result %v: %w", chunk.Index, resultID, err)
+			}
+			err = operation.SkipNonExist(operation.RemoveResultApproval(approvalID))(tx)
+			if err != nil {
+				return fmt.Errorf("could not remove approval %v: %w", approvalID, err)
+			}
+			err = operation.RemoveResultApprovalIndex(resultID, chunk.Index)(tx)
+			if err != nil {
+				return fmt.Errorf("could not remove approval index for chunk %d of result %v: %w", chunk.Index, resultID, err)
+			}
+		}
+
+		err = operation.RemoveExecutionResult(resultID)(tx)
+		if err != nil {
+			return fmt.Errorf("could not remove result %v: %w", resultID, err)
+		}
+		pruned.results = append(pruned.results, resultID)
+	}
+
+	err = operation.SkipNonExist(operation.RemoveBySealedBlockID(blockID))(tx)
+	if err != nil {
+		return fmt.Errorf("could not remove finalized seal index: %w", err)
+	}
+
+	return nil
+}
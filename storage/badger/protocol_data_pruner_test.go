@@ -0,0 +1,206 @@
+package badger_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/cluster"
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/irrecoverable"
+	"github.com/onflow/flow-go/module/metrics"
+	"github.com/onflow/flow-go/state/protocol"
+	pbadger "github.com/onflow/flow-go/state/protocol/badger"
+	"github.com/onflow/flow-go/state/protocol/util"
+	"github.com/onflow/flow-go/storage"
+	badgerstorage "github.com/onflow/flow-go/storage/badger"
+	"github.com/onflow/flow-go/storage/badger/operation"
+	"github.com/onflow/flow-go/storage/badger/procedure"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestProtocolDataPruner_Prune tests that the pruner removes finalized blocks and the execution
+// data they seal below the height range target, without pruning into the sealing segment of the
+// latest finalized block, and that the pruned height is persisted. Cluster blocks referencing
+// pruned blocks and the cache entries of pruned entities are removed as well.
+func TestProtocolDataPruner_Prune(t *testing.T) {
+	rootSnapshot := unittest.RootSnapshotFixture(unittest.CompleteIdentitySet())
+	rootHeader, err := rootSnapshot.Head()
+	require.NoError(t, err)
+
+	util.RunWithFullProtocolStateAndMutator(t, rootSnapshot, func(db *badger.DB, state *pbadger.ParticipantState, mutableState protocol.MutableProtocolState) {
+		extend := func(parent *flow.Header) *flow.Block {
+			block := unittest.BlockWithParentFixture(parent)
+			stateID, _, err := mutableState.EvolveState(parent.ID(), block.Header.View, nil)
+			require.NoError(t, err)
+			block.SetPayload(flow.Payload{ProtocolStateID: stateID})
+			unittest.InsertAndFinalize(t, state, block)
+			return block
+		}
+		seal := func(block *flow.Block) (*flow.ExecutionReceipt, *flow.Seal, *flow.Block) {
+			receipt, seal := unittest.ReceiptAndSealForBlock(block)
+			receiptBlock, sealingBlock := unittest.SealBlock(t, state, mutableState, block, receipt, seal)
+			require.NoError(t, state.Finalize(context.Background(), receiptBlock.ID()))
+			require.NoError(t, state.Finalize(context.Background(), sealingBlock.ID()))
+			return receipt, seal, sealingBlock
+		}
+
+		// seal two blocks at the start of the chain
+		block1 := extend(rootHeader)
+		receipt1, seal1, sealingBlock1 := seal(block1)
+		receipt2, seal2, head := seal(sealingBlock1)
+
+		// extend the chain beyond the transaction expiry, and seal the last block
+		for i := 0; i < flow.DefaultTransactionExpiry+50; i++ {
+			head = extend(head.Header)
+		}
+		sealedBlock := head
+		_, _, head = seal(sealedBlock)
+
+		// the sealing segment of the latest finalized block starts at the sealed block minus the transaction expiry
+		expectedPrunedHeight := sealedBlock.Header.Height - flow.DefaultTransactionExpiry
+		segment, err := state.Final().SealingSegment()
+		require.NoError(t, err)
+		require.Equal(t, expectedPrunedHeight, segment.AllBlocks()[0].Header.Height)
+
+		// two finalized cluster blocks referencing the first block, the second is the latest finalized cluster block
+		clusterBlocks := unittest.ClusterBlockChainFixture(2)
+		chainID := clusterBlocks[0].Header.ChainID
+		require.NoError(t, db.Update(func(tx *badger.Txn) error {
+			for _, clusterBlock := range clusterBlocks {
+				err := procedure.InsertClusterBlock(&clusterBlock)(tx)
+				if err != nil {
+					return err
+				}
+				err = operation.IndexClusterBlockHeight(chainID, clusterBlock.Header.Height, clusterBlock.ID())(tx)
+				if err != nil {
+					return err
+				}
+				err = operation.IndexClusterBlockByReferenceHeight(block1.Header.Height, clusterBlock.ID())(tx)
+				if err != nil {
+					return err
+				}
+			}
+			return operation.InsertClusterFinalizedHeight(chainID, clusterBlocks[1].Header.Height)(tx)
+		}))
+
+		// populate the caches with entities which are pruned
+		stores := badgerstorage.InitAll(metrics.NewNoopCollector(), db)
+		_, err = stores.Headers.ByBlockID(block1.ID())
+		require.NoError(t, err)
+		_, err = stores.Headers.ByHeight(block1.Header.Height)
+		require.NoError(t, err)
+		_, err = stores.Seals.ByID(seal1.ID())
+		require.NoError(t, err)
+		_, err = stores.Results.ByID(receipt1.ExecutionResult.ID())
+		require.NoError(t, err)
+
+		pruner, err := badgerstorage.NewProtocolDataPruner(
+			zerolog.Nop(),
+			db,
+			state,
+			badgerstorage.WithProtocolDataPruningCaches(*stores),
+			badgerstorage.WithProtocolDataPruningHeightRangeTarget(10),
+			badgerstorage.WithProtocolDataPruningThreshold(0),
+			badgerstorage.WithProtocolDataPruningCheckInterval(time.Hour),
+			badgerstorage.WithProtocolDataPruningBatchSize(7),
+		)
+		require.NoError(t, err)
+		require.Equal(t, rootHeader.Height, pruner.Progress().PrunedHeight)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		signalerCtx, errChan := irrecoverable.WithSignaler(ctx)
+
+		pruner.Start(signalerCtx)
+		unittest.RequireCloseBefore(t, pruner.Ready(), time.Second, "pruner did not start")
+
+		// pruning is performed on startup
+		require.Eventually(t, func() bool {
+			progress := pruner.Progress()
+			return progress.PrunedHeight == expectedPrunedHeight && !progress.InProgress
+		}, 10*time.Second, 10*time.Millisecond)
+
+		cancel()
+		unittest.RequireCloseBefore(t, pruner.Done(), time.Second, "pruner did not stop")
+		select {
+		case err := <-errChan:
+			require.NoError(t, err)
+		default:
+		}
+
+		var prunedHeight uint64
+		require.NoError(t, db.View(operation.RetrieveProtocolDataPrunedHeight(&prunedHeight)))
+		require.Equal(t, expectedPrunedHeight, prunedHeight)
+
+		// all finalized blocks below the pruned height were removed, except for the root block
+		for height := rootHeader.Height + 1; height < expectedPrunedHeight; height++ {
+			var blockID flow.Identifier
+			err := db.View(operation.LookupBlockHeight(height, &blockID))
+			require.ErrorIs(t, err, storage.ErrNotFound)
+		}
+		var header flow.Header
+		require.ErrorIs(t, db.View(operation.RetrieveHeader(block1.ID(), &header)), storage.ErrNotFound)
+		require.NoError(t, db.View(operation.RetrieveHeader(rootHeader.ID(), &header)))
+
+		// the execution data of the first sealed block was removed
+		var result flow.ExecutionResult
+		require.ErrorIs(t, db.View(operation.RetrieveExecutionResult(receipt1.ExecutionResult.ID(), &result)), storage.ErrNotFound)
+		var meta flow.ExecutionReceiptMeta
+		require.ErrorIs(t, db.View(operation.RetrieveExecutionReceiptMeta(receipt1.ID(), &meta)), storage.ErrNotFound)
+		var s flow.Seal
+		require.ErrorIs(t, db.View(operation.RetrieveSeal(seal1.ID(), &s)), storage.ErrNotFound)
+
+		// the latest seal as of the lowest retained block and its result are retained
+		require.NoError(t, db.View(operation.RetrieveSeal(seal2.ID(), &s)))
+		require.NoError(t, db.View(operation.RetrieveExecutionResult(receipt2.ExecutionResult.ID(), &result)))
+
+		// pruned entities are not served from the caches
+		_, err = stores.Headers.ByBlockID(block1.ID())
+		require.ErrorIs(t, err, storage.ErrNotFound)
+		_, err = stores.Headers.ByHeight(block1.Header.Height)
+		require.ErrorIs(t, err, storage.ErrNotFound)
+		_, err = stores.Seals.ByID(seal1.ID())
+		require.ErrorIs(t, err, storage.ErrNotFound)
+		_, err = stores.Results.ByID(receipt1.ExecutionResult.ID())
+		require.ErrorIs(t, err, storage.ErrNotFound)
+
+		// the first cluster block, its collection and transactions were removed
+		var clusterBlock cluster.Block
+		require.ErrorIs(t, db.View(procedure.RetrieveClusterBlock(clusterBlocks[0].ID(), &clusterBlock)), storage.ErrNotFound)
+		var collection flow.LightCollection
+		light := clusterBlocks[0].Payload.Collection.Light()
+		require.ErrorIs(t, db.View(operation.RetrieveCollection(light.ID(), &collection)), storage.ErrNotFound)
+		for _, txID := range light.Transactions {
+			var tx flow.TransactionBody
+			require.ErrorIs(t, db.View(operation.RetrieveTransaction(txID, &tx)), storage.ErrNotFound)
+		}
+		var clusterBlockID flow.Identifier
+		require.ErrorIs(t, db.View(operation.LookupClusterBlockHeight(chainID, clusterBlocks[0].Header.Height, &clusterBlockID)), storage.ErrNotFound)
+
+		// the latest finalized cluster block is retained
+		require.NoError(t, db.View(procedure.RetrieveClusterBlock(clusterBlocks[1].ID(), &clusterBlock)))
+		var final flow.Header
+		require.NoError(t, db.View(procedure.RetrieveLatestFinalizedClusterHeader(chainID, &final)))
+		require.Equal(t, clusterBlocks[1].ID(), final.ID())
+
+		// all blocks from the pruned height up are retained
+		blocks := badgerstorage.InitAll(metrics.NewNoopCollector(), db).Blocks
+		for height := expectedPrunedHeight; height <= head.Header.Height; height++ {
+			_, err := blocks.ByHeight(height)
+			require.NoError(t, err)
+		}
+		_, err = state.Final().SealingSegment()
+		require.NoError(t, err)
+		_, err = pbadger.ReadFinalizedRoot(db)
+		require.NoError(t, err)
+
+		// the pruned height is loaded on restart
+		pruner, err = badgerstorage.NewProtocolDataPruner(zerolog.Nop(), db, state)
+		require.NoError(t, err)
+		require.Equal(t, expectedPrunedHeight, pruner.Progress().PrunedHeight)
+	})
+}
@@ -0,0 +1,126 @@
+package badger
+
+import (
+	"fmt"
+
+	"github.com/dgraph-io/badger/v2"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module"
+	"github.com/onflow/flow-go/module/metrics"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/badger/operation"
+)
+
+var _ storage.TransactionProfiles = (*TransactionProfiles)(nil)
+
+type TransactionProfiles struct {
+	db         *badger.DB
+	cache      *Cache[string, flow.TransactionProfile]
+	blockCache *Cache[string, []flow.TransactionProfile]
+}
+
+func NewTransactionProfiles(collector module.CacheMetrics, db *badger.DB, cacheSize uint) *TransactionProfiles {
+	retrieve := func(key string) func(tx *badger.Txn) (flow.TransactionProfile, error) {
+		var profile flow.TransactionProfile
+		return func(tx *badger.Txn) (flow.TransactionProfile, error) {
+
+			blockID, txID, err := KeyToBlockIDTransactionID(key)
+			if err != nil {
+				return flow.TransactionProfile{}, fmt.Errorf("could not convert key: %w", err)
+			}
+
+			err = operation.RetrieveTransactionProfile(blockID, txID, &profile)(tx)
+			if err != nil {
+				return flow.TransactionProfile{}, handleError(err, flow.TransactionProfile{})
+			}
+			return profile, nil
+		}
+	}
+	retrieveForBlock := func(key string) func(tx *badger.Txn) ([]flow.TransactionProfile, error) {
+		var profiles []flow.TransactionProfile
+		return func(tx *badger.Txn) ([]flow.TransactionProfile, error) {
+
+			blockID, err := KeyToBlockID(key)
+			if err != nil {
+				return nil, fmt.Errorf("could not convert index key: %w", err)
+			}
+
+			err = operation.LookupTransactionProfilesByBlockIDUsingIndex(blockID, &profiles)(tx)
+			if err != nil {
+				return nil, handleError(err, flow.TransactionProfile{})
+			}
+			return profiles, nil
+		}
+	}
+	return &TransactionProfiles{
+		db: db,
+		cache: newCache[string, flow.TransactionProfile](collector, metrics.ResourceTransactionProfiles,
+			withLimit[string, flow.TransactionProfile](cacheSize),
+			withStore(noopStore[string, flow.TransactionProfile]),
+			withRetrieve(retrieve),
+		),
+		blockCache: newCache[string, []flow.TransactionProfile](collector, metrics.ResourceTransactionProfileByBlock,
+			withLimit[string, []flow.TransactionProfile](cacheSize),
+			withStore(noopStore[string, []flow.TransactionProfile]),
+			withRetrieve(retrieveForBlock),
+		),
+	}
+}
+
+// BatchStore will store the transaction profiles for the given block ID in a batch
+func (tp *TransactionProfiles) BatchStore(blockID flow.Identifier, profiles []flow.TransactionProfile, batch storage.BatchStorage) error {
+	writeBatch := batch.GetWriter()
+
+	for i, profile := range profiles {
+		err := operation.BatchInsertTransactionProfile(blockID, &profile)(writeBatch)
+		if err != nil {
+			return fmt.Errorf("cannot batch insert tx profile: %w", err)
+		}
+
+		err = operation.BatchIndexTransactionProfile(blockID, uint32(i), &profile)(writeBatch)
+		if err != nil {
+			return fmt.Errorf("cannot batch index tx profile: %w", err)
+		}
+	}
+
+	batch.OnSucceed(func() {
+		for _, profile := range profiles {
+			key := KeyFromBlockIDTransactionID(blockID, profile.TransactionID)
+			tp.cache.Insert(key, profile)
+		}
+
+		tp.blockCache.Insert(KeyFromBlockID(blockID), profiles)
+	})
+	return nil
+}
+
+// ByBlockIDTransactionID returns the transaction profile for the given block ID and transaction ID
+func (tp *TransactionProfiles) ByBlockIDTransactionID(blockID flow.Identifier, txID flow.Identifier) (*flow.TransactionProfile, error) {
+	tx := tp.db.NewTransaction(false)
+	defer tx.Discard()
+	key := KeyFromBlockIDTransactionID(blockID, txID)
+	profile, err := tp.cache.Get(key)(tx)
+	if err != nil {
+		return nil, err
+	}
+	return &profile, nil
+}
+
+// ByBlockID gets all transaction profiles for a block, ordered by transaction index
+func (tp *TransactionProfiles) ByBlockID(blockID flow.Identifier) ([]flow.TransactionProfile, error) {
+	tx := tp.db.NewTransaction(false)
+	defer tx.Discard()
+	key := KeyFromBlockID(blockID)
+	profiles, err := tp.blockCache.Get(key)(tx)
+	if err != nil {
+		return nil, err
+	}
+	return profiles, nil
+}
+
+// BatchRemoveByBlockID batch removes transaction profiles by block ID
+func (tp *TransactionProfiles) BatchRemoveByBlockID(blockID flow.Identifier, batch storage.BatchStorage) error {
+	writeBatch := batch.GetWriter()
+	return tp.db.View(operation.BatchRemoveTransactionProfilesByBlockID(blockID, writeBatch))
+}
@@ -0,0 +1,81 @@
+package badger_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/badger/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/metrics"
+	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/utils/unittest"
+
+	bstorage "github.com/onflow/flow-go/storage/badger"
+)
+
+func TestBatchStoringTransactionProfiles(t *testing.T) {
+	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+		metrics := metrics.NewNoopCollector()
+		store := bstorage.NewTransactionProfiles(metrics, db, 1000)
+
+		blockID := unittest.IdentifierFixture()
+		profiles := make([]flow.TransactionProfile, 0)
+		for i := 0; i < 10; i++ {
+			profiles = append(profiles, flow.TransactionProfile{
+				TransactionID:          unittest.IdentifierFixture(),
+				ComputationUsed:        uint64(100 + i),
+				ComputationIntensities: map[uint]uint{1: uint(i), 2: 3},
+				MemoryEstimate:         uint64(1000 + i),
+				MemoryIntensities:      map[uint]uint{4: uint(i)},
+				RegistersRead:          uint64(i),
+				RegistersWritten:       2,
+				BytesRead:              uint64(10 * i),
+				BytesWritten:           20,
+				EventsSize:             30,
+				ExecutionTime:          time.Duration(i) * time.Millisecond,
+			})
+		}
+
+		writeBatch := bstorage.NewBatch(db)
+		err := store.BatchStore(blockID, profiles, writeBatch)
+		require.NoError(t, err)
+
+		err = writeBatch.Flush()
+		require.NoError(t, err)
+
+		// test loading from the cache and from the database
+		newStore := bstorage.NewTransactionProfiles(metrics, db, 1000)
+		for _, s := range []*bstorage.TransactionProfiles{store, newStore} {
+			for _, profile := range profiles {
+				actual, err := s.ByBlockIDTransactionID(blockID, profile.TransactionID)
+				require.NoError(t, err)
+				assert.Equal(t, profile, *actual)
+			}
+
+			actual, err := s.ByBlockID(blockID)
+			require.NoError(t, err)
+			assert.Equal(t, profiles, actual)
+		}
+
+		// test removing the profiles of the block
+		writeBatch = bstorage.NewBatch(db)
+		err = newStore.BatchRemoveByBlockID(blockID, writeBatch)
+		require.NoError(t, err)
+		require.NoError(t, writeBatch.Flush())
+
+		_, err = bstorage.NewTransactionProfiles(metrics, db, 1000).ByBlockIDTransactionID(blockID, profiles[0].TransactionID)
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
+
+func TestReadingNotStoredTransactionProfile(t *testing.T) {
+	unittest.RunWithBadgerDB(t, func(db *badger.DB) {
+		store := bstorage.NewTransactionProfiles(metrics.NewNoopCollector(), db, 1000)
+
+		_, err := store.ByBlockIDTransactionID(unittest.IdentifierFixture(), unittest.IdentifierFixture())
+		assert.ErrorIs(t, err, storage.ErrNotFound)
+	})
+}
@@ -44,3 +44,45 @@ type ServiceEvents interface {
 	// If Badger unexpectedly fails to process the request, the error is wrapped in a generic error and returned.
 	BatchRemoveByBlockID(blockID flow.Identifier, batch BatchStorage) error
 }
+
+// EventCursor identifies the position of an event within the chain. Events are ordered by block height,
+// then transaction index, then event index, which is the order in which they were emitted.
+type EventCursor struct {
+	Height           uint64
+	TransactionIndex uint32
+	EventIndex       uint32
+}
+
+// IndexedEvent is an event returned from an event index, together with the block it was emitted in.
+type IndexedEvent struct {
+	BlockID     flow.Identifier
+	BlockHeight uint64
+	Event       flow.Event
+}
+
+// EventLookups represents persistent secondary indexes of events by event type and by the address
+// of the contract that emitted them. The indexes are ordered by block height, which allows querying
+// events over arbitrary height ranges without scanning every block.
+type EventLookups interface {
+	// BatchIndex indexes the events of the block with the given ID and height in the provided batch.
+	// No errors are expected during normal operation.
+	BatchIndex(blockID flow.Identifier, height uint64, events []flow.Event, batch BatchStorage) error
+
+	// FirstIndexedHeight returns the height of the first block indexed by the lookup indexes. The indexes
+	// are only populated from this height on, even if events of lower heights are stored.
+	// Expected errors:
+	//   - storage.ErrNotFound if no block has been indexed yet
+	FirstIndexedHeight() (uint64, error)
+
+	// ByEventType returns up to limit events of the given type, starting at the given cursor (inclusive)
+	// and ending at endHeight (inclusive), in execution order.
+	// The returned cursor points to the next matching event, or is nil if no events remain in the range.
+	// No errors are expected during normal operation.
+	ByEventType(eventType flow.EventType, start EventCursor, endHeight uint64, limit uint) ([]IndexedEvent, *EventCursor, error)
+
+	// ByContractAddress returns up to limit events emitted by contracts deployed to the given address,
+	// starting at the given cursor (inclusive) and ending at endHeight (inclusive), in execution order.
+	// The returned cursor points to the next matching event, or is nil if no events remain in the range.
+	// No errors are expected during normal operation.
+	ByContractAddress(address flow.Address, start EventCursor, endHeight uint64, limit uint) ([]IndexedEvent, *EventCursor, error)
+}
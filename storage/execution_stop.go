@@ -0,0 +1,47 @@
+package storage
+
+import (
+	"time"
+
+	"github.com/onflow/flow-go/model/flow"
+)
+
+// ExecutionStop is the state of the execution stop control of an execution node,
+// which is persisted so that pending stops and stops are not forgotten on restart.
+type ExecutionStop struct {
+	// StopBeforeHeight is the height of the pending manual stop, the block at this height is not executed.
+	// It is math.MaxUint64 if no manual stop is pending.
+	StopBeforeHeight uint64
+	// ShouldCrash is true if the node should crash when the pending manual stop is reached.
+	ShouldCrash bool
+
+	// ScheduledBeforeTime, ScheduledBeforeView and ScheduledBeforeEpoch are the conditions of the pending
+	// scheduled stop, which stops before the first block satisfying any of them. Zero values are unset.
+	ScheduledBeforeTime  time.Time
+	ScheduledBeforeView  uint64
+	ScheduledBeforeEpoch uint64
+	// ScheduledShouldCrash is true if the node should crash when the scheduled stop is reached.
+	ScheduledShouldCrash bool
+
+	// Paused is true if execution is stopped without crashing, and has not been resumed.
+	Paused bool
+	// Reason describes the last reached stop.
+	Reason string
+	// StoppedAfterHeight and StoppedAfterBlockID identify the last block executed before the last reached stop.
+	StoppedAfterHeight  uint64
+	StoppedAfterBlockID flow.Identifier
+	// StoppedAt is the time the last stop was reached.
+	StoppedAt time.Time
+}
+
+// ExecutionStops represents persistent storage for the state of the execution stop control.
+type ExecutionStops interface {
+	// Store stores the stop state, replacing the previously stored one.
+	// No errors are expected during normal operation.
+	Store(stop *ExecutionStop) error
+
+	// Retrieve returns the stored stop state.
+	// Expected errors during normal operation:
+	//   - storage.ErrNotFound if no stop state has been stored
+	Retrieve() (*ExecutionStop, error)
+}
@@ -11,6 +11,10 @@ const (
 	// register bootstrap process
 	pebbleBootstrapRegisterBatchLen = 1000
 
+	// pruneRegisterBatchLen is the number of register deletions committed to pebble at once
+	// by the register pruning process
+	pruneRegisterBatchLen = 1000
+
 	// placeHolderHeight is an element of the height lookup keys of length HeightSuffixLen
 	// 10 bits per key yields a filter with <1% false positive rate.
 	placeHolderHeight = uint64(0)
@@ -36,4 +40,11 @@ const (
 	// codeFirstBlockHeight and codeLatestBlockHeight are keys for the range of block heights in the register store
 	codeFirstBlockHeight  byte = 3
 	codeLatestBlockHeight byte = 4
+	// codePrunedHeight is the key for the height up to which shadowed register versions have been deleted
+	codePrunedHeight byte = 5
+	// codeFirstUpdateIndexHeight is the key for the first height with entries in the register update index.
+	// Databases created before the index existed have no entries for the heights stored before the upgrade.
+	codeFirstUpdateIndexHeight byte = 6
+	// codeRegisterUpdate prefixes the register update index, which lists the lookup keys stored at each height
+	codeRegisterUpdate byte = 7
 )
@@ -21,6 +21,36 @@ var latestHeightKey = binary.BigEndian.AppendUint64(
 var firstHeightKey = binary.BigEndian.AppendUint64(
 	[]byte{codeFirstBlockHeight, byte('/'), byte('/')}, placeHolderHeight)
 
+// prunedHeightKey is a special case of a lookupKey
+// with codePrunedHeight as key, no owner and a placeholder height of 0.
+var prunedHeightKey = binary.BigEndian.AppendUint64(
+	[]byte{codePrunedHeight, byte('/'), byte('/')}, placeHolderHeight)
+
+// firstUpdateIndexHeightKey is a special case of a lookupKey
+// with codeFirstUpdateIndexHeight as key, no owner and a placeholder height of 0.
+var firstUpdateIndexHeightKey = binary.BigEndian.AppendUint64(
+	[]byte{codeFirstUpdateIndexHeight, byte('/'), byte('/')}, placeHolderHeight)
+
+// registerUpdatePrefix returns the prefix of all register update index keys of the given height.
+//
+// Update index keys use the following format:
+//
+//	[codeRegisterUpdate] [height] [lookup key]
+//
+// The height is big-endian encoded, so the index is ordered by height. The key ends with the
+// height suffix of the lookup key, which keeps it compatible with the MVCC comparer.
+func registerUpdatePrefix(height uint64) []byte {
+	return binary.BigEndian.AppendUint64([]byte{codeRegisterUpdate}, height)
+}
+
+// newRegisterUpdateKey returns the update index key recording that the register with the given
+// lookup key was stored at the given height.
+func newRegisterUpdateKey(height uint64, lookupKey []byte) []byte {
+	key := make([]byte, 0, 1+registers.HeightSuffixLen+len(lookupKey))
+	key = append(key, registerUpdatePrefix(height)...)
+	return append(key, lookupKey...)
+}
+
 // lookupKey is the encoded format of the storage key for looking up register value
 type lookupKey struct {
 	encoded []byte
@@ -111,6 +141,47 @@ func lookupKeyToRegisterID(lookupKey []byte) (uint64, flow.RegisterID, error) {
 	return height, regID, nil
 }
 
+// lookupKeyToAccountRegisterID decodes the register ID of a lookup key, for registers whose owner is
+// either empty or an account address. Unlike lookupKeyToRegisterID, it supports addresses containing '/'.
+//
+// The encoding is ambiguous for registers with an empty owner and a key containing '/' at the position
+// where the separator after an address would be, which are decoded as registers of an account.
+// No such registers exist in the execution state.
+func lookupKeyToAccountRegisterID(lookupKey []byte) (flow.RegisterID, error) {
+	if len(lookupKey) < MinLookupKeyLen {
+		return flow.RegisterID{}, fmt.Errorf("invalid lookup key format: expected >= %d bytes, got %d bytes",
+			MinLookupKeyLen, len(lookupKey))
+	}
+
+	// check and exclude db prefix
+	prefix := lookupKey[0]
+	if prefix != codeRegister {
+		return flow.RegisterID{}, fmt.Errorf("incorrect prefix %d for register lookup key, expected %d",
+			prefix, codeRegister)
+	}
+
+	// exclude the db prefix, and the separator and encoded height suffix
+	register := lookupKey[1 : len(lookupKey)-registers.HeightSuffixLen-1]
+	if lookupKey[len(lookupKey)-registers.HeightSuffixLen-1] != '/' {
+		return flow.RegisterID{}, fmt.Errorf("invalid lookup key format: cannot find last slash")
+	}
+
+	switch {
+	case len(register) > flow.AddressLength && register[flow.AddressLength] == '/':
+		return flow.RegisterID{
+			Owner: string(register[:flow.AddressLength]),
+			Key:   string(register[flow.AddressLength+1:]),
+		}, nil
+	case len(register) > 0 && register[0] == '/':
+		return flow.RegisterID{
+			Owner: "",
+			Key:   string(register[1:]),
+		}, nil
+	default:
+		return flow.RegisterID{}, fmt.Errorf("invalid lookup key format: owner is neither empty nor an address")
+	}
+}
+
 // Bytes returns the encoded lookup key.
 func (h lookupKey) Bytes() []byte {
 	return h.encoded
@@ -106,3 +106,32 @@ func Test_prefix_error(t *testing.T) {
 	_, _, err = lookupKeyToRegisterID(incorrectKey)
 	require.ErrorContains(t, err, "incorrect prefix")
 }
+
+// Test_decodeAccountRegisterKey tests decoding lookup keys of registers owned by accounts,
+// including addresses containing '/'.
+func Test_decodeAccountRegisterKey(t *testing.T) {
+	t.Parallel()
+
+	slashAddress := flow.BytesToAddress([]byte{'/', 1, 2, '/', 3, 4, 5, '/'})
+
+	cases := []flow.RegisterID{
+		flow.NewRegisterID(flow.HexToAddress("0x01"), "public/storage/hasslash-in-key"),
+		flow.NewRegisterID(slashAddress, "key"),
+		flow.NewRegisterID(slashAddress, ""),
+		flow.NewRegisterID(flow.EmptyAddress, "uuid"),
+		{Owner: "", Key: "uuid"},
+		{Owner: "", Key: ""},
+	}
+
+	for _, reg := range cases {
+		lookupKey := newLookupKey(10, reg)
+		decodedReg, err := lookupKeyToAccountRegisterID(lookupKey.Bytes())
+		require.NoError(t, err)
+		require.Equal(t, reg, decodedReg)
+	}
+
+	// owners which are neither empty nor an address fail
+	lookupKey := newLookupKey(10, flow.RegisterID{Owner: "owner", Key: "key"})
+	_, err := lookupKeyToAccountRegisterID(lookupKey.Bytes())
+	require.Error(t, err)
+}
@@ -98,6 +98,16 @@ func initHeights(db *pebble.DB, firstHeight uint64) error {
 	if err != nil {
 		return fmt.Errorf("unable to add latest height to batch: %w", err)
 	}
+	// registers stored at the first height do not shadow any other version, so there is nothing to
+	// prune at or below it, and every later height is recorded in the register update index
+	err = batch.Set(prunedHeightKey, encodedUint64(firstHeight), nil)
+	if err != nil {
+		return fmt.Errorf("unable to add pruned height to batch: %w", err)
+	}
+	err = batch.Set(firstUpdateIndexHeightKey, encodedUint64(firstHeight+1), nil)
+	if err != nil {
+		return fmt.Errorf("unable to add first update index height to batch: %w", err)
+	}
 	err = batch.Commit(pebble.Sync)
 	if err != nil {
 		return fmt.Errorf("unable to index first and latest heights: %w", err)
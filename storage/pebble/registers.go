@@ -1,6 +1,7 @@
 package pebble
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 
@@ -10,13 +11,14 @@ import (
 
 	"github.com/onflow/flow-go/model/flow"
 	"github.com/onflow/flow-go/storage"
+	"github.com/onflow/flow-go/storage/pebble/registers"
 )
 
 // Registers library that implements pebble storage for registers
 // given a pebble instance with root block and root height populated
 type Registers struct {
 	db           *pebble.DB
-	firstHeight  uint64
+	firstHeight  *atomic.Uint64
 	latestHeight *atomic.Uint64
 }
 
@@ -33,10 +35,15 @@ func NewRegisters(db *pebble.DB) (*Registers, error) {
 		return nil, fmt.Errorf("unable to initialize register storage, latest height unavailable in db: %w", err)
 	}
 
+	err = initPruningHeights(db, firstHeight, latestHeight)
+	if err != nil {
+		return nil, fmt.Errorf("unable to initialize register pruning heights: %w", err)
+	}
+
 	// All registers between firstHeight and lastHeight have been indexed
 	return &Registers{
 		db:           db,
-		firstHeight:  firstHeight,
+		firstHeight:  atomic.NewUint64(firstHeight),
 		latestHeight: atomic.NewUint64(latestHeight),
 	}, nil
 }
@@ -53,15 +60,170 @@ func (s *Registers) Get(
 	reg flow.RegisterID,
 	height uint64,
 ) (flow.RegisterValue, error) {
+	err := s.checkHeightIndexed(height)
+	if err != nil {
+		return nil, err
+	}
+	key := newLookupKey(height, reg)
+	return s.lookupRegister(key.Bytes())
+}
+
+// IterateOwnerRegisters calls fn for the value of every register of the given owner at the given height,
+// in order of the register key. Registers which do not exist at the height, or were removed
+// at or below it, are skipped. Iteration stops at the first error returned by fn, which is returned.
+//
+// - storage.ErrHeightNotIndexed if the requested height is out of the range of stored heights
+func (s *Registers) IterateOwnerRegisters(
+	owner string,
+	height uint64,
+	fn func(entry flow.RegisterEntry) error,
+) error {
+	err := s.checkHeightIndexed(height)
+	if err != nil {
+		return err
+	}
+
+	// all lookup keys of the owner's registers start with "<code><owner>/"
+	prefix := make([]byte, 0, len(owner)+2)
+	prefix = append(prefix, codeRegister)
+	prefix = append(prefix, owner...)
+	prefix = append(prefix, '/')
+
+	upperBound := make([]byte, len(prefix))
+	copy(upperBound, prefix)
+	upperBound[len(upperBound)-1]++
+
+	return s.iterateRegisters(prefix, upperBound, height, func(key []byte, value flow.RegisterValue) error {
+		if len(key) < len(prefix)+registers.HeightSuffixLen+1 {
+			return fmt.Errorf("malformed lookup key %x", key)
+		}
+
+		// the owner may contain '/', so the key is located by the owner's length
+		// rather than by the separators.
+		regKey := string(key[len(prefix) : len(key)-registers.HeightSuffixLen-1])
+
+		return fn(flow.RegisterEntry{
+			Key:   flow.RegisterID{Owner: owner, Key: regKey},
+			Value: value,
+		})
+	})
+}
+
+// IterateRegisters calls fn for the value of every register at the given height, in order of the
+// lookup key. Registers which do not exist at the height, or were removed at or below it, are skipped.
+// Iteration stops at the first error returned by fn, which is returned.
+//
+// Registers are read one at a time, so the full state is never held in memory.
+//
+// - storage.ErrHeightNotIndexed if the requested height is out of the range of stored heights
+func (s *Registers) IterateRegisters(
+	height uint64,
+	fn func(entry flow.RegisterEntry) error,
+) error {
+	err := s.checkHeightIndexed(height)
+	if err != nil {
+		return err
+	}
+
+	return s.iterateRegisters(
+		[]byte{codeRegister},
+		[]byte{codeRegister + 1},
+		height,
+		func(key []byte, value flow.RegisterValue) error {
+			id, err := lookupKeyToAccountRegisterID(key)
+			if err != nil {
+				return fmt.Errorf("malformed lookup key %x: %w", key, err)
+			}
+
+			return fn(flow.RegisterEntry{
+				Key:   id,
+				Value: value,
+			})
+		})
+}
+
+// checkHeightIndexed returns storage.ErrHeightNotIndexed if the given height is out of the range of stored heights.
+func (s *Registers) checkHeightIndexed(height uint64) error {
 	latestHeight := s.latestHeight.Load()
-	if height > latestHeight || height < s.firstHeight {
-		return nil, errors.Wrap(
+	firstHeight := s.firstHeight.Load()
+	if height > latestHeight || height < firstHeight {
+		return errors.Wrap(
 			storage.ErrHeightNotIndexed,
-			fmt.Sprintf("height %d not indexed, indexed range is [%d-%d]", height, s.firstHeight, latestHeight),
+			fmt.Sprintf("height %d not indexed, indexed range is [%d-%d]", height, firstHeight, latestHeight),
 		)
 	}
-	key := newLookupKey(height, reg)
-	return s.lookupRegister(key.Bytes())
+	return nil
+}
+
+// iterateRegisters calls fn with the lookup key and value of every register with lookup keys in the given bounds,
+// for the most recent version of the register at or below the given height. Removed registers are skipped.
+//
+// Lookup keys of the same register are ordered by descending height, so the first version
+// at or below the requested height is the value of the register at that height.
+func (s *Registers) iterateRegisters(
+	lowerBound []byte,
+	upperBound []byte,
+	height uint64,
+	fn func(key []byte, value flow.RegisterValue) error,
+) error {
+	iter, err := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: lowerBound,
+		UpperBound: upperBound,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create iterator: %w", err)
+	}
+	defer iter.Close()
+
+	var currentRegister []byte
+	found := false
+
+	for iter.First(); iter.Valid(); iter.Next() {
+		key := iter.Key()
+		if len(key) < MinLookupKeyLen {
+			return fmt.Errorf("malformed lookup key %x", key)
+		}
+
+		registerPrefix := key[:len(key)-registers.HeightSuffixLen]
+		if !bytes.Equal(registerPrefix, currentRegister) {
+			currentRegister = append(currentRegister[:0], registerPrefix...)
+			found = false
+		}
+
+		if found {
+			continue
+		}
+
+		versionHeight := ^binary.BigEndian.Uint64(key[len(key)-registers.HeightSuffixLen:])
+		if versionHeight > height {
+			continue
+		}
+		found = true
+
+		value, err := iter.ValueAndErr()
+		if err != nil {
+			return fmt.Errorf("failed to get value: %w", err)
+		}
+		if len(value) == 0 {
+			// the register was removed
+			continue
+		}
+
+		// preventing caller from modifying the iterator's value slices
+		valueCopy := make([]byte, len(value))
+		copy(valueCopy, value)
+
+		err = fn(key, valueCopy)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := iter.Error(); err != nil {
+		return fmt.Errorf("failed to iterate registers: %w", err)
+	}
+
+	return nil
 }
 
 func (s *Registers) lookupRegister(key []byte) (flow.RegisterValue, error) {
@@ -122,6 +284,12 @@ func (s *Registers) Store(
 		if err != nil {
 			return fmt.Errorf("failed to set key: %w", err)
 		}
+
+		// record the update so pruning can find the version it shadows without scanning all registers
+		err = batch.Set(newRegisterUpdateKey(height, encoded), nil, nil)
+		if err != nil {
+			return fmt.Errorf("failed to set register update key: %w", err)
+		}
 	}
 	// increment height and commit
 	err := batch.Set(latestHeightKey, encodedUint64(height), nil)
@@ -142,9 +310,259 @@ func (s *Registers) LatestHeight() uint64 {
 	return s.latestHeight.Load()
 }
 
-// FirstHeight first indexed height found in the store, typically root block for the spork
+// FirstHeight first indexed height found in the store, typically root block for the spork.
+// The first height is advanced when the registers are pruned.
 func (s *Registers) FirstHeight() uint64 {
-	return s.firstHeight
+	return s.firstHeight.Load()
+}
+
+// PruneUpToHeight removes register versions that are no longer needed to serve queries at or
+// above the given height, and advances the first height to it.
+//
+// For every register, the most recent version at or below the prune height is retained, since
+// it is the value of the register at the new first height. All older versions are deleted.
+// Versions above the prune height are not touched.
+//
+// The first height is updated before any data is deleted, so concurrent readers never observe
+// a partially pruned height. If the process stops during pruning, the remaining stale versions
+// are unreachable and are removed by the next pruning run.
+//
+// Each run only visits the registers updated since the previous run, using the register update index.
+//
+// Returns the number of register versions deleted.
+// No errors are expected during normal operation.
+// CAUTION: This function is not safe for concurrent use with itself.
+func (s *Registers) PruneUpToHeight(pruneHeight uint64) (uint64, error) {
+	firstHeight := s.firstHeight.Load()
+	if pruneHeight <= firstHeight {
+		// already pruned
+		return 0, nil
+	}
+
+	latestHeight := s.latestHeight.Load()
+	if pruneHeight > latestHeight {
+		return 0, fmt.Errorf("prune height %d is above the latest indexed height %d", pruneHeight, latestHeight)
+	}
+
+	err := s.db.Set(firstHeightKey, encodedUint64(pruneHeight), pebble.Sync)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update first height %d: %w", pruneHeight, err)
+	}
+	s.firstHeight.Store(pruneHeight)
+
+	pruned, err := s.pruneRegisterVersions(pruneHeight)
+	if err != nil {
+		return pruned, fmt.Errorf("failed to prune registers below height %d: %w", pruneHeight, err)
+	}
+
+	return pruned, nil
+}
+
+// pruneRegisterVersions deletes all register versions that are shadowed by a more recent version
+// at or below the prune height, and advances the pruned height to it.
+//
+// Only registers updated after the previous pruned height can have new shadowed versions, so they
+// are found from the register update index instead of scanning all registers. Heights stored before
+// the index existed are pruned once with a full scan.
+func (s *Registers) pruneRegisterVersions(pruneHeight uint64) (uint64, error) {
+	prunedHeight, err := heightLookup(s.db, prunedHeightKey)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get pruned height: %w", err)
+	}
+	if pruneHeight <= prunedHeight {
+		return 0, nil
+	}
+
+	firstIndexedHeight, err := heightLookup(s.db, firstUpdateIndexHeightKey)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get first update index height: %w", err)
+	}
+
+	var pruned uint64
+	if prunedHeight+1 < firstIndexedHeight {
+		pruned, err = s.pruneAllRegisterVersions(pruneHeight)
+	} else {
+		pruned, err = s.pruneUpdatedRegisterVersions(prunedHeight, pruneHeight)
+	}
+	if err != nil {
+		return pruned, err
+	}
+
+	// the index entries up to the prune height are no longer needed. They are removed together with
+	// advancing the pruned height, so an interrupted run is repeated from the same height.
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	err = batch.DeleteRange(registerUpdatePrefix(0), registerUpdatePrefix(pruneHeight+1), nil)
+	if err != nil {
+		return pruned, fmt.Errorf("failed to delete register update keys: %w", err)
+	}
+	err = batch.Set(prunedHeightKey, encodedUint64(pruneHeight), nil)
+	if err != nil {
+		return pruned, fmt.Errorf("failed to update pruned height %d: %w", pruneHeight, err)
+	}
+	err = batch.Commit(pebble.Sync)
+	if err != nil {
+		return pruned, fmt.Errorf("failed to commit batch: %w", err)
+	}
+
+	return pruned, nil
+}
+
+// pruneUpdatedRegisterVersions deletes the versions shadowed by register updates stored at heights
+// in (prunedHeight, pruneHeight].
+//
+// All versions at or below prunedHeight, except the newest of each register, were already deleted.
+// Each update therefore shadows exactly one version which has not been deleted yet: the next older
+// version of the same register, which is the next lookup key after the update's own.
+func (s *Registers) pruneUpdatedRegisterVersions(prunedHeight uint64, pruneHeight uint64) (uint64, error) {
+	updates, err := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: registerUpdatePrefix(prunedHeight + 1),
+		UpperBound: registerUpdatePrefix(pruneHeight + 1),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to create update index iterator: %w", err)
+	}
+	defer updates.Close()
+
+	versions, err := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: []byte{codeRegister},
+		UpperBound: []byte{codeRegister + 1},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to create iterator: %w", err)
+	}
+	defer versions.Close()
+
+	batch := s.db.NewBatch()
+	defer func() {
+		batch.Close()
+	}()
+
+	var pruned uint64
+	prefixLen := len(registerUpdatePrefix(0))
+
+	for updates.First(); updates.Valid(); updates.Next() {
+		key := updates.Key()[prefixLen:]
+		if len(key) < MinLookupKeyLen {
+			return pruned, fmt.Errorf("malformed register update key %x", updates.Key())
+		}
+		registerPrefix := key[:len(key)-registers.HeightSuffixLen]
+
+		if !versions.SeekGE(key) || !bytes.Equal(versions.Key(), key) {
+			return pruned, fmt.Errorf("register update %x has no stored version", key)
+		}
+		if !versions.Next() || !bytes.HasPrefix(versions.Key(), registerPrefix) ||
+			len(versions.Key()) != len(key) {
+			// first version of the register
+			continue
+		}
+
+		err = batch.Delete(versions.Key(), nil)
+		if err != nil {
+			return pruned, fmt.Errorf("failed to delete key: %w", err)
+		}
+		pruned++
+
+		if batch.Count() >= pruneRegisterBatchLen {
+			err = batch.Commit(pebble.Sync)
+			if err != nil {
+				return pruned, fmt.Errorf("failed to commit batch: %w", err)
+			}
+			batch.Close()
+			batch = s.db.NewBatch()
+		}
+	}
+
+	if err := updates.Error(); err != nil {
+		return pruned, fmt.Errorf("failed to iterate register updates: %w", err)
+	}
+	if err := versions.Error(); err != nil {
+		return pruned, fmt.Errorf("failed to iterate registers: %w", err)
+	}
+
+	err = batch.Commit(pebble.Sync)
+	if err != nil {
+		return pruned, fmt.Errorf("failed to commit batch: %w", err)
+	}
+
+	return pruned, nil
+}
+
+// pruneAllRegisterVersions deletes all register versions that are shadowed by a more recent version
+// at or below the prune height.
+//
+// It is only used for heights which are not covered by the register update index. Lookup keys of the same register are stored next to each other, ordered by descending height,
+// so a single forward scan visits every register's versions from newest to oldest.
+func (s *Registers) pruneAllRegisterVersions(pruneHeight uint64) (uint64, error) {
+	iter, err := s.db.NewIter(&pebble.IterOptions{
+		LowerBound: []byte{codeRegister},
+		UpperBound: []byte{codeRegister + 1},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to create iterator: %w", err)
+	}
+	defer iter.Close()
+
+	batch := s.db.NewBatch()
+	defer func() {
+		batch.Close()
+	}()
+
+	var pruned uint64
+	var currentRegister []byte
+	retained := false
+
+	for iter.First(); iter.Valid(); iter.Next() {
+		key := iter.Key()
+
+		height, _, err := lookupKeyToRegisterID(key)
+		if err != nil {
+			return pruned, fmt.Errorf("malformed lookup key %x: %w", key, err)
+		}
+
+		registerPrefix := key[:len(key)-registers.HeightSuffixLen]
+		if !bytes.Equal(registerPrefix, currentRegister) {
+			currentRegister = append(currentRegister[:0], registerPrefix...)
+			retained = false
+		}
+
+		if height > pruneHeight {
+			continue
+		}
+
+		// keep the newest version at or below the prune height
+		if !retained {
+			retained = true
+			continue
+		}
+
+		err = batch.Delete(key, nil)
+		if err != nil {
+			return pruned, fmt.Errorf("failed to delete key: %w", err)
+		}
+		pruned++
+
+		if batch.Count() >= pruneRegisterBatchLen {
+			err = batch.Commit(pebble.Sync)
+			if err != nil {
+				return pruned, fmt.Errorf("failed to commit batch: %w", err)
+			}
+			batch.Close()
+			batch = s.db.NewBatch()
+		}
+	}
+
+	if err := iter.Error(); err != nil {
+		return pruned, fmt.Errorf("failed to iterate registers: %w", err)
+	}
+
+	err = batch.Commit(pebble.Sync)
+	if err != nil {
+		return pruned, fmt.Errorf("failed to commit batch: %w", err)
+	}
+
+	return pruned, nil
 }
 
 func firstStoredHeight(db *pebble.DB) (uint64, error) {
@@ -171,3 +589,33 @@ func convertNotFoundError(err error) error {
 	}
 	return err
 }
+
+// initPruningHeights sets the pruned height and the first update index height of databases created
+// before the register update index existed. The heights stored so far are not in the index, so the
+// index starts after the latest height, and the next pruning run scans all registers once.
+func initPruningHeights(db *pebble.DB, firstHeight uint64, latestHeight uint64) error {
+	_, err := heightLookup(db, firstUpdateIndexHeightKey)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, storage.ErrNotFound) {
+		return fmt.Errorf("failed to get first update index height: %w", err)
+	}
+
+	batch := db.NewBatch()
+	defer batch.Close()
+
+	err = batch.Set(prunedHeightKey, encodedUint64(firstHeight), nil)
+	if err != nil {
+		return fmt.Errorf("unable to add pruned height to batch: %w", err)
+	}
+	err = batch.Set(firstUpdateIndexHeightKey, encodedUint64(latestHeight+1), nil)
+	if err != nil {
+		return fmt.Errorf("unable to add first update index height to batch: %w", err)
+	}
+	err = batch.Commit(pebble.Sync)
+	if err != nil {
+		return fmt.Errorf("unable to index pruning heights: %w", err)
+	}
+	return nil
+}
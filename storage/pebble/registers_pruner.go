@@ -0,0 +1,220 @@
+package pebble
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+	"go.uber.org/atomic"
+
+	"github.com/onflow/flow-go/module"
+	"github.com/onflow/flow-go/module/component"
+	"github.com/onflow/flow-go/module/irrecoverable"
+)
+
+const (
+	// DefaultRegisterPruningHeightRangeTarget is the default number of most recent heights
+	// retained in the register db.
+	DefaultRegisterPruningHeightRangeTarget = uint64(2_000_000)
+
+	// DefaultRegisterPruningThreshold is the default number of heights the retained range
+	// may exceed the target by before pruning is triggered.
+	DefaultRegisterPruningThreshold = uint64(100_000)
+
+	// DefaultRegisterPruningCheckInterval is the default interval at which the pruner checks
+	// whether pruning is needed.
+	DefaultRegisterPruningCheckInterval = 10 * time.Minute
+)
+
+// RegisterPruner is a component responsible for pruning old register versions from the
+// register db. It is configured with the following parameters:
+//   - Height range target: The target number of most recent heights for which registers
+//     can be queried. This controls the total amount of data stored on disk.
+//   - Threshold: The number of heights that we can exceed the height range target by
+//     before pruning is triggered. This controls the frequency of pruning.
+//
+// The RegisterPruner periodically compares the latest indexed height with the first height
+// of the register db, and prunes once the difference reaches the height range target + threshold.
+// After pruning, the first height is `latest height - height range target`.
+//
+// The register db may need to be bootstrapped before it is available, which can take a long time.
+// The RegisterPruner can therefore be created before the register db is ready, and the
+// registers are provided later using Initialize. Until then, no pruning is performed.
+type RegisterPruner struct {
+	component.Component
+
+	log       zerolog.Logger
+	registers *atomic.Pointer[Registers]
+	metrics   module.RegisterDBPrunerMetrics
+
+	// heightRangeTarget is the target number of heights retained after pruning.
+	heightRangeTarget *atomic.Uint64
+
+	// threshold defines the maximum number of heights the retained range may exceed
+	// heightRangeTarget by, before pruning is triggered.
+	threshold *atomic.Uint64
+
+	checkInterval time.Duration
+
+	// notifier is used to trigger a pruning check outside of the regular interval,
+	// e.g. after the configuration was updated.
+	notifier chan struct{}
+}
+
+var _ component.Component = (*RegisterPruner)(nil)
+
+type RegisterPrunerOption func(*RegisterPruner)
+
+// WithRegisterPruningHeightRangeTarget is used to configure the pruner with a custom
+// height range target.
+func WithRegisterPruningHeightRangeTarget(heightRangeTarget uint64) RegisterPrunerOption {
+	return func(p *RegisterPruner) {
+		p.heightRangeTarget.Store(heightRangeTarget)
+	}
+}
+
+// WithRegisterPruningThreshold is used to configure the pruner with a custom threshold.
+func WithRegisterPruningThreshold(threshold uint64) RegisterPrunerOption {
+	return func(p *RegisterPruner) {
+		p.threshold.Store(threshold)
+	}
+}
+
+// WithRegisterPruningCheckInterval is used to configure the pruner with a custom interval
+// between pruning checks.
+func WithRegisterPruningCheckInterval(interval time.Duration) RegisterPrunerOption {
+	return func(p *RegisterPruner) {
+		p.checkInterval = interval
+	}
+}
+
+// NewRegisterPruner creates a new RegisterPruner.
+func NewRegisterPruner(
+	log zerolog.Logger,
+	metrics module.RegisterDBPrunerMetrics,
+	opts ...RegisterPrunerOption,
+) *RegisterPruner {
+	p := &RegisterPruner{
+		log:               log.With().Str("component", "register_db_pruner").Logger(),
+		registers:         atomic.NewPointer[Registers](nil),
+		metrics:           metrics,
+		heightRangeTarget: atomic.NewUint64(DefaultRegisterPruningHeightRangeTarget),
+		threshold:         atomic.NewUint64(DefaultRegisterPruningThreshold),
+		checkInterval:     DefaultRegisterPruningCheckInterval,
+		notifier:          make(chan struct{}, 1),
+	}
+
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	p.Component = component.NewComponentManagerBuilder().
+		AddWorker(p.loop).
+		Build()
+
+	return p
+}
+
+// Initialize sets the registers to prune. Can be called once.
+// No errors are expected during normal operations.
+func (p *RegisterPruner) Initialize(registers *Registers) error {
+	if p.registers.CompareAndSwap(nil, registers) {
+		p.notify()
+		return nil
+	}
+	return fmt.Errorf("registers already initialized")
+}
+
+// HeightRangeTarget returns the target number of heights retained after pruning.
+func (p *RegisterPruner) HeightRangeTarget() uint64 {
+	return p.heightRangeTarget.Load()
+}
+
+// SetHeightRangeTarget updates the pruner's height range target, and triggers a pruning check.
+func (p *RegisterPruner) SetHeightRangeTarget(heightRangeTarget uint64) {
+	p.heightRangeTarget.Store(heightRangeTarget)
+	p.notify()
+}
+
+// Threshold returns the number of heights the retained range may exceed the target by before
+// pruning is triggered.
+func (p *RegisterPruner) Threshold() uint64 {
+	return p.threshold.Load()
+}
+
+// SetThreshold updates the pruner's threshold, and triggers a pruning check.
+func (p *RegisterPruner) SetThreshold(threshold uint64) {
+	p.threshold.Store(threshold)
+	p.notify()
+}
+
+func (p *RegisterPruner) notify() {
+	select {
+	case p.notifier <- struct{}{}:
+	default:
+	}
+}
+
+func (p *RegisterPruner) loop(ctx irrecoverable.SignalerContext, ready component.ReadyFunc) {
+	ready()
+
+	ticker := time.NewTicker(p.checkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.checkPrune(ctx)
+		case <-p.notifier:
+			p.checkPrune(ctx)
+		}
+	}
+}
+
+// checkPrune prunes the register db if the indexed height range exceeds the height range target
+// plus threshold.
+func (p *RegisterPruner) checkPrune(ctx irrecoverable.SignalerContext) {
+	registers := p.registers.Load()
+	if registers == nil {
+		// registers are not initialized yet
+		return
+	}
+
+	heightRangeTarget := p.heightRangeTarget.Load()
+	threshold := p.threshold.Load()
+
+	firstHeight := registers.FirstHeight()
+	latestHeight := registers.LatestHeight()
+
+	if latestHeight <= firstHeight+heightRangeTarget+threshold {
+		return
+	}
+
+	pruneHeight := latestHeight - heightRangeTarget
+
+	lg := p.log.With().
+		Uint64("first_height", firstHeight).
+		Uint64("prune_height", pruneHeight).
+		Uint64("latest_height", latestHeight).
+		Logger()
+
+	lg.Info().Msg("pruning register db")
+	start := time.Now()
+
+	pruned, err := registers.PruneUpToHeight(pruneHeight)
+	if err != nil {
+		ctx.Throw(fmt.Errorf("failed to prune register db: %w", err))
+		return
+	}
+
+	duration := time.Since(start)
+	lg.Info().
+		Uint64("pruned_registers", pruned).
+		Dur("duration", duration).
+		Msg("pruned register db")
+
+	p.metrics.Pruned(pruneHeight, duration)
+	p.metrics.RegistersPruned(pruned)
+}
@@ -0,0 +1,82 @@
+package pebble
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/flow-go/model/flow"
+	"github.com/onflow/flow-go/module/irrecoverable"
+	modulemock "github.com/onflow/flow-go/module/mock"
+	"github.com/onflow/flow-go/utils/unittest"
+)
+
+// TestRegisterPruner_Prune tests that the pruner prunes the register db once the indexed height
+// range exceeds the height range target plus threshold, and that updating the height range target
+// triggers pruning.
+func TestRegisterPruner_Prune(t *testing.T) {
+	RunWithRegistersStorageAtHeight1(t, func(r *Registers) {
+		key := flow.RegisterID{Owner: "owner", Key: "key"}
+		for height := uint64(2); height <= 20; height++ {
+			require.NoError(t, r.Store(flow.RegisterEntries{{Key: key, Value: []byte{byte(height)}}}, height))
+		}
+
+		prunerMetrics := modulemock.NewRegisterDBPrunerMetrics(t)
+		pruner := NewRegisterPruner(
+			zerolog.Nop(),
+			prunerMetrics,
+			WithRegisterPruningHeightRangeTarget(10),
+			WithRegisterPruningThreshold(5),
+			WithRegisterPruningCheckInterval(time.Hour),
+		)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		signalerCtx, errChan := irrecoverable.WithSignaler(ctx)
+
+		pruner.Start(signalerCtx)
+		unittest.RequireCloseBefore(t, pruner.Ready(), time.Second, "pruner did not start")
+
+		// latest height 20 exceeds first height 1 + 10 + 5, so pruning is performed on initialization
+		pruned := make(chan struct{})
+		prunerMetrics.On("Pruned", uint64(10), mock.Anything).Once()
+		prunerMetrics.On("RegistersPruned", uint64(8)).Run(func(mock.Arguments) {
+			close(pruned)
+		}).Once()
+
+		require.NoError(t, pruner.Initialize(r))
+		unittest.AssertClosesBefore(t, pruned, time.Second)
+		require.Equal(t, uint64(10), r.FirstHeight())
+
+		// a second initialization is rejected
+		require.Error(t, pruner.Initialize(r))
+
+		// lowering the threshold and height range target triggers another pruning
+		pruned = make(chan struct{})
+		prunerMetrics.On("Pruned", uint64(15), mock.Anything).Once()
+		prunerMetrics.On("RegistersPruned", uint64(5)).Run(func(mock.Arguments) {
+			close(pruned)
+		}).Once()
+
+		pruner.SetThreshold(0)
+		pruner.SetHeightRangeTarget(5)
+		unittest.AssertClosesBefore(t, pruned, time.Second)
+		require.Equal(t, uint64(15), r.FirstHeight())
+
+		value, err := r.Get(key, 15)
+		require.NoError(t, err)
+		require.Equal(t, []byte{15}, value)
+
+		cancel()
+		unittest.RequireCloseBefore(t, pruner.Done(), time.Second, "pruner did not stop")
+
+		select {
+		case err := <-errChan:
+			require.NoError(t, err)
+		default:
+		}
+	})
+}
@@ -287,6 +287,312 @@ func TestRegisters_GetAndStoreEmptyOwner(t *testing.T) {
 	})
 }
 
+// TestRegisters_IterateOwnerRegisters tests that iterating the registers of an owner returns the value
+// of each register at the requested height, and skips other owners and removed registers.
+func TestRegisters_IterateOwnerRegisters(t *testing.T) {
+	t.Parallel()
+	RunWithRegistersStorageAtInitialHeights(t, 1, 1, func(r *Registers) {
+		owner := "owner/1"
+		key1 := flow.RegisterID{Owner: owner, Key: "key1"}
+		key2 := flow.RegisterID{Owner: owner, Key: "key/2"}
+		key3 := flow.RegisterID{Owner: owner, Key: "key3"}
+		otherOwnerKey := flow.RegisterID{Owner: "owner", Key: "key1"}
+		prefixOwnerKey := flow.RegisterID{Owner: "owner/10", Key: "key1"}
+
+		require.NoError(t, r.Store(flow.RegisterEntries{
+			{Key: key1, Value: []byte("v1-2")},
+			{Key: key3, Value: []byte("v3-2")},
+			{Key: otherOwnerKey, Value: []byte("other")},
+			{Key: prefixOwnerKey, Value: []byte("prefix")},
+		}, 2))
+		require.NoError(t, r.Store(flow.RegisterEntries{
+			{Key: key2, Value: []byte("v2-3")},
+			{Key: key3, Value: []byte{}},
+		}, 3))
+		require.NoError(t, r.Store(flow.RegisterEntries{
+			{Key: key1, Value: []byte("v1-4")},
+		}, 4))
+
+		collect := func(height uint64) (flow.RegisterEntries, error) {
+			var entries flow.RegisterEntries
+			err := r.IterateOwnerRegisters(owner, height, func(entry flow.RegisterEntry) error {
+				entries = append(entries, entry)
+				return nil
+			})
+			return entries, err
+		}
+
+		entries, err := collect(1)
+		require.NoError(t, err)
+		require.Empty(t, entries)
+
+		entries, err = collect(2)
+		require.NoError(t, err)
+		require.Equal(t, flow.RegisterEntries{
+			{Key: key1, Value: []byte("v1-2")},
+			{Key: key3, Value: []byte("v3-2")},
+		}, entries)
+
+		entries, err = collect(3)
+		require.NoError(t, err)
+		require.Equal(t, flow.RegisterEntries{
+			{Key: key2, Value: []byte("v2-3")},
+			{Key: key1, Value: []byte("v1-2")},
+		}, entries)
+
+		entries, err = collect(4)
+		require.NoError(t, err)
+		require.Equal(t, flow.RegisterEntries{
+			{Key: key2, Value: []byte("v2-3")},
+			{Key: key1, Value: []byte("v1-4")},
+		}, entries)
+
+		// errors returned by fn stop the iteration
+		expectedErr := fmt.Errorf("stop")
+		calls := 0
+		err = r.IterateOwnerRegisters(owner, 4, func(flow.RegisterEntry) error {
+			calls++
+			return expectedErr
+		})
+		require.ErrorIs(t, err, expectedErr)
+		require.Equal(t, 1, calls)
+
+		// out of range
+		_, err = collect(0)
+		require.ErrorIs(t, err, storage.ErrHeightNotIndexed)
+		_, err = collect(5)
+		require.ErrorIs(t, err, storage.ErrHeightNotIndexed)
+	})
+}
+
+// TestRegisters_IterateRegisters tests that iterating all registers returns the value of each register
+// at the requested height, and skips removed registers.
+func TestRegisters_IterateRegisters(t *testing.T) {
+	t.Parallel()
+	RunWithRegistersStorageAtInitialHeights(t, 1, 1, func(r *Registers) {
+		slashAddress := flow.BytesToAddress([]byte{'/', 1, 2, '/', 3, 4, 5, '/'})
+		global := flow.RegisterID{Owner: "", Key: "uuid"}
+		key1 := flow.NewRegisterID(flow.HexToAddress("0x01"), "key1")
+		key2 := flow.NewRegisterID(slashAddress, "key/2")
+		key3 := flow.NewRegisterID(slashAddress, "key3")
+
+		require.NoError(t, r.Store(flow.RegisterEntries{
+			{Key: global, Value: []byte("g-2")},
+			{Key: key1, Value: []byte("v1-2")},
+			{Key: key3, Value: []byte("v3-2")},
+		}, 2))
+		require.NoError(t, r.Store(flow.RegisterEntries{
+			{Key: key2, Value: []byte("v2-3")},
+			{Key: key3, Value: []byte{}},
+		}, 3))
+
+		collect := func(height uint64) (map[flow.RegisterID]string, error) {
+			values := make(map[flow.RegisterID]string)
+			err := r.IterateRegisters(height, func(entry flow.RegisterEntry) error {
+				values[entry.Key] = string(entry.Value)
+				return nil
+			})
+			return values, err
+		}
+
+		values, err := collect(2)
+		require.NoError(t, err)
+		require.Equal(t, map[flow.RegisterID]string{
+			global: "g-2",
+			key1:   "v1-2",
+			key3:   "v3-2",
+		}, values)
+
+		values, err = collect(3)
+		require.NoError(t, err)
+		require.Equal(t, map[flow.RegisterID]string{
+			global: "g-2",
+			key1:   "v1-2",
+			key2:   "v2-3",
+		}, values)
+
+		_, err = collect(4)
+		require.ErrorIs(t, err, storage.ErrHeightNotIndexed)
+	})
+}
+
+// TestRegisters_PruneUpToHeight tests that pruning removes shadowed register versions, while
+// keeping all values queryable at and above the new first height.
+func TestRegisters_PruneUpToHeight(t *testing.T) {
+	t.Parallel()
+	RunWithRegistersStorageAtHeight1(t, func(r *Registers) {
+		key1 := flow.RegisterID{Owner: "owner", Key: "key1"}
+		key11 := flow.RegisterID{Owner: "owner", Key: "key11"}
+		key2 := flow.RegisterID{Owner: "owner2", Key: "key2"}
+
+		// key1 is updated at every height, key11 only at height 2, key2 at heights 3 and 7
+		for height := uint64(2); height <= 10; height++ {
+			entries := flow.RegisterEntries{
+				{Key: key1, Value: []byte(fmt.Sprintf("value1-%d", height))},
+			}
+			if height == 2 {
+				entries = append(entries, flow.RegisterEntry{Key: key11, Value: []byte("value11")})
+			}
+			if height == 3 || height == 7 {
+				entries = append(entries, flow.RegisterEntry{Key: key2, Value: []byte(fmt.Sprintf("value2-%d", height))})
+			}
+			require.NoError(t, r.Store(entries, height))
+		}
+		require.Equal(t, 12, countRegisterVersions(t, r))
+
+		// prune height above the latest height is rejected
+		_, err := r.PruneUpToHeight(11)
+		require.Error(t, err)
+		require.Equal(t, uint64(1), r.FirstHeight())
+
+		pruned, err := r.PruneUpToHeight(6)
+		require.NoError(t, err)
+
+		// key1 versions at heights 2-5 are shadowed by the version at height 6,
+		// key11 has a single version, and key2's only version below 6 is retained.
+		require.Equal(t, uint64(4), pruned)
+		require.Equal(t, 8, countRegisterVersions(t, r))
+		require.Equal(t, uint64(6), r.FirstHeight())
+		require.Equal(t, uint64(10), r.LatestHeight())
+
+		// heights below the first height are no longer indexed
+		_, err = r.Get(key1, 5)
+		require.ErrorIs(t, err, storage.ErrHeightNotIndexed)
+
+		// values at and above the first height are unchanged
+		for height := uint64(6); height <= 10; height++ {
+			value, err := r.Get(key1, height)
+			require.NoError(t, err)
+			require.Equal(t, []byte(fmt.Sprintf("value1-%d", height)), value)
+
+			value, err = r.Get(key11, height)
+			require.NoError(t, err)
+			require.Equal(t, []byte("value11"), value)
+
+			expected2 := []byte("value2-3")
+			if height >= 7 {
+				expected2 = []byte("value2-7")
+			}
+			value, err = r.Get(key2, height)
+			require.NoError(t, err)
+			require.Equal(t, expected2, value)
+		}
+
+		// pruning to the same or a lower height is a no-op
+		pruned, err = r.PruneUpToHeight(4)
+		require.NoError(t, err)
+		require.Zero(t, pruned)
+		require.Equal(t, uint64(6), r.FirstHeight())
+
+		// the first height is persisted
+		firstHeight, err := firstStoredHeight(r.db)
+		require.NoError(t, err)
+		require.Equal(t, uint64(6), firstHeight)
+
+		// the update index only keeps the heights which were not pruned yet
+		require.Equal(t, 5, countRegisterUpdates(t, r))
+
+		// the next run only handles the updates at heights 7-10:
+		// key1 versions at heights 6-9 and key2's version at height 3
+		pruned, err = r.PruneUpToHeight(10)
+		require.NoError(t, err)
+		require.Equal(t, uint64(5), pruned)
+		require.Equal(t, 3, countRegisterVersions(t, r))
+		require.Zero(t, countRegisterUpdates(t, r))
+
+		value, err := r.Get(key1, 10)
+		require.NoError(t, err)
+		require.Equal(t, []byte("value1-10"), value)
+		value, err = r.Get(key11, 10)
+		require.NoError(t, err)
+		require.Equal(t, []byte("value11"), value)
+		value, err = r.Get(key2, 10)
+		require.NoError(t, err)
+		require.Equal(t, []byte("value2-7"), value)
+	})
+}
+
+// TestRegisters_PruneUpToHeight_BeforeUpdateIndex tests pruning a db with register versions
+// stored before the register update index existed.
+func TestRegisters_PruneUpToHeight_BeforeUpdateIndex(t *testing.T) {
+	t.Parallel()
+	unittest.RunWithTempDir(t, func(dir string) {
+		key1 := flow.RegisterID{Owner: "owner", Key: "key1"}
+
+		// versions at heights 1-5 written without update index entries
+		db := NewBootstrappedRegistersWithPathForTest(t, dir, 1, 5)
+		for height := uint64(1); height <= 5; height++ {
+			value := []byte(fmt.Sprintf("value1-%d", height))
+			require.NoError(t, db.Set(newLookupKey(height, key1).Bytes(), value, nil))
+		}
+
+		r, err := NewRegisters(db)
+		require.NoError(t, err)
+
+		for height := uint64(6); height <= 8; height++ {
+			entries := flow.RegisterEntries{{Key: key1, Value: []byte(fmt.Sprintf("value1-%d", height))}}
+			require.NoError(t, r.Store(entries, height))
+		}
+		require.Equal(t, 3, countRegisterUpdates(t, r))
+
+		// heights 2-5 are not in the update index, so all registers are scanned
+		pruned, err := r.PruneUpToHeight(4)
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), pruned)
+
+		pruned, err = r.PruneUpToHeight(7)
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), pruned)
+		require.Equal(t, 1, countRegisterUpdates(t, r))
+
+		// from here on only the update index is used
+		pruned, err = r.PruneUpToHeight(8)
+		require.NoError(t, err)
+		require.Equal(t, uint64(1), pruned)
+		require.Equal(t, 1, countRegisterVersions(t, r))
+
+		value, err := r.Get(key1, 8)
+		require.NoError(t, err)
+		require.Equal(t, []byte("value1-8"), value)
+
+		require.NoError(t, db.Close())
+	})
+}
+
+// countRegisterVersions returns the total number of register versions stored in the db.
+func countRegisterVersions(t *testing.T, r *Registers) int {
+	iter, err := r.db.NewIter(&pebble.IterOptions{
+		LowerBound: []byte{codeRegister},
+		UpperBound: []byte{codeRegister + 1},
+	})
+	require.NoError(t, err)
+	defer iter.Close()
+
+	count := 0
+	for iter.First(); iter.Valid(); iter.Next() {
+		count++
+	}
+	require.NoError(t, iter.Error())
+	return count
+}
+
+// countRegisterUpdates returns the number of entries in the register update index.
+func countRegisterUpdates(t *testing.T, r *Registers) int {
+	iter, err := r.db.NewIter(&pebble.IterOptions{
+		LowerBound: []byte{codeRegisterUpdate},
+		UpperBound: []byte{codeRegisterUpdate + 1},
+	})
+	require.NoError(t, err)
+	defer iter.Close()
+
+	count := 0
+	for iter.First(); iter.Valid(); iter.Next() {
+		count++
+	}
+	require.NoError(t, iter.Error())
+	return count
+}
+
 // Benchmark_PayloadStorage benchmarks the SetBatch method.
 func Benchmark_PayloadStorage(b *testing.B) {
 	cache := pebble.NewCache(32 << 20)
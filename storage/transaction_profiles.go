@@ -0,0 +1,19 @@
+package storage
+
+import "github.com/onflow/flow-go/model/flow"
+
+// TransactionProfiles represents persistent storage for the resource profiles of executed transactions
+type TransactionProfiles interface {
+
+	// BatchStore inserts a batch of transaction profiles into a batch
+	BatchStore(blockID flow.Identifier, profiles []flow.TransactionProfile, batch BatchStorage) error
+
+	// ByBlockIDTransactionID returns the transaction profile for the given block ID and transaction ID
+	//
+	// Expected errors during normal operation:
+	//   - storage.ErrNotFound if no profile of the transaction in the block is stored
+	ByBlockIDTransactionID(blockID flow.Identifier, transactionID flow.Identifier) (*flow.TransactionProfile, error)
+
+	// ByBlockID gets all transaction profiles for a block, ordered by transaction index
+	ByBlockID(blockID flow.Identifier) ([]flow.TransactionProfile, error)
+}
@@ -25,6 +25,49 @@ func WriteFile(path string, data []byte) error {
 	return nil
 }
 
+// WriteFileAtomically writes a byte array to the file at the given path, creating the directory as needed.
+// The data is first written to a temporary file in the same directory, which is then renamed to the given path,
+// so the file at the given path is never partially written.
+func WriteFileAtomically(path string, data []byte) error {
+	dir := filepath.Dir(path)
+	err := os.MkdirAll(dir, 0755)
+	if err != nil {
+		return fmt.Errorf("could not create output dir: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(dir, filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("could not create temporary file: %w", err)
+	}
+	defer func() {
+		// no-op if the temporary file has been renamed
+		_ = os.Remove(tmp.Name())
+	}()
+
+	_, err = tmp.Write(data)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return fmt.Errorf("could not write temporary file: %w", err)
+	}
+
+	err = os.Chmod(tmp.Name(), 0644)
+	if err != nil {
+		return fmt.Errorf("could not set file permissions: %w", err)
+	}
+
+	err = os.Rename(tmp.Name(), path)
+	if err != nil {
+		return fmt.Errorf("could not rename temporary file: %w", err)
+	}
+
+	return nil
+}
+
 // WriteText writes a byte array to the file at the given path.
 func WriteText(path string, data []byte) error {
 	err := os.WriteFile(path, data, 0644)
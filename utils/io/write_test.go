@@ -3,6 +3,8 @@ package io
 import (
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"syscall"
 	"testing"
 
@@ -33,3 +35,24 @@ func TestTerminateOnFullDisk(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestWriteFileAtomically(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "nested", "file.json")
+
+	require.NoError(t, WriteFileAtomically(path, []byte("first")))
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, []byte("first"), data)
+
+	// overwrites the existing file
+	require.NoError(t, WriteFileAtomically(path, []byte("second")))
+	data, err = os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, []byte("second"), data)
+
+	// no temporary files are left behind
+	entries, err := os.ReadDir(filepath.Dir(path))
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+}
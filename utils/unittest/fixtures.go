@@ -2478,6 +2478,26 @@ func LightTransactionResultsFixture(n int) []flow.LightTransactionResult {
 	return results
 }
 
+func TransactionProfilesFixture(n int) []flow.TransactionProfile {
+	profiles := make([]flow.TransactionProfile, 0, n)
+	for i := 0; i < n; i++ {
+		profiles = append(profiles, flow.TransactionProfile{
+			TransactionID:          IdentifierFixture(),
+			ComputationUsed:        Uint64InRange(1, 10_000),
+			ComputationIntensities: map[uint]uint{1: uint(i), 3: 7},
+			MemoryEstimate:         Uint64InRange(1, 10_000),
+			MemoryIntensities:      map[uint]uint{2: uint(i)},
+			RegistersRead:          Uint64InRange(1, 100),
+			RegistersWritten:       Uint64InRange(1, 100),
+			BytesRead:              Uint64InRange(1, 10_000),
+			BytesWritten:           Uint64InRange(1, 10_000),
+			EventsSize:             Uint64InRange(1, 10_000),
+			ExecutionTime:          time.Duration(Uint64InRange(1, 1_000_000)),
+		})
+	}
+	return profiles
+}
+
 func AllowAllPeerFilter() func(peer.ID) error {
 	return func(_ peer.ID) error {
 		return nil